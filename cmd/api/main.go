@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/config"
+	logport "github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/bootstrap"
+)
+
+// The api command serves the public HTTP API. Transcoding runs on worker nodes.
+func main() {
+	// Setup Signal-aware Context for graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Config (use environment variables)
+	cfg := config.Load()
+
+	app, err := bootstrap.New(cfg)
+	if err != nil {
+		log.Fatalf("bootstrap: %v", err)
+	}
+	defer app.Close()
+
+	// Sync db permissions
+	if err := app.SyncPermissions(ctx); err != nil {
+		log.Fatalf("sync permissions: %v", err)
+	}
+
+	// Seed the default roles
+	if err := app.SeedRoles(ctx); err != nil {
+		log.Fatalf("seed roles: %v", err)
+	}
+
+	// Give the admin role to the first admin
+	if err := app.BootstrapAdmin(ctx); err != nil {
+		log.Fatalf("bootstrap admin: %v", err)
+	}
+
+	// Load the keys signing access tokens, then keep them in sync and rotate them
+	if err := app.SyncSigningKeys(ctx); err != nil {
+		log.Fatalf("sync signing keys: %v", err)
+	}
+	signingKeySyncer := app.SigningKeySyncer()
+	signingKeySyncerDone := make(chan struct{})
+	go func() {
+		signingKeySyncer.Run(ctx)
+		close(signingKeySyncerDone)
+	}()
+
+	// Driving adapter (HTTP)
+	router := app.APIRouter()
+
+	// Server config
+	srv := &http.Server{
+		Handler:      router.Handler,
+		Addr:         ":" + cfg.ServerAdd,
+		WriteTimeout: 15 * time.Second,
+		ReadTimeout:  15 * time.Second,
+	}
+
+	if err := app.Serve(ctx, stop, srv); err != nil {
+		log.Fatalf("%v", err)
+	}
+	<-signingKeySyncerDone
+
+	app.Logger.Infof(ctx, logport.CategoryDefault, "", "exiting")
+}
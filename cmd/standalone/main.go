@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/config"
+	logport "github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/bootstrap"
+)
+
+// The standalone command serves the public HTTP API and runs the workers in one process.
+// It suits small deployments, which can then stream progress in memory and run without Redis.
+func main() {
+	// Setup Signal-aware Context for graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Config (use environment variables)
+	cfg := config.Load()
+	cfg.Standalone = true
+
+	app, err := bootstrap.New(cfg)
+	if err != nil {
+		log.Fatalf("bootstrap: %v", err)
+	}
+	defer app.Close()
+
+	// Sync db permissions
+	if err := app.SyncPermissions(ctx); err != nil {
+		log.Fatalf("sync permissions: %v", err)
+	}
+
+	// Seed the default roles
+	if err := app.SeedRoles(ctx); err != nil {
+		log.Fatalf("seed roles: %v", err)
+	}
+
+	// Give the admin role to the first admin
+	if err := app.BootstrapAdmin(ctx); err != nil {
+		log.Fatalf("bootstrap admin: %v", err)
+	}
+
+	// Load the keys signing access tokens
+	if err := app.SyncSigningKeys(ctx); err != nil {
+		log.Fatalf("sync signing keys: %v", err)
+	}
+
+	// Driving adapter (Worker)
+	workerPool := app.WorkerPool()
+	workerPool.Start(ctx)
+
+	// Driving adapter (Task Scheduler)
+	taskScheduler := app.TaskScheduler()
+	taskSchedulerDone := make(chan struct{})
+	go func() {
+		taskScheduler.Run(ctx)
+		close(taskSchedulerDone)
+	}()
+
+	// Driving adapter (Event Relay)
+	eventRelay := app.EventRelay()
+	eventRelayDone := make(chan struct{})
+	go func() {
+		eventRelay.Run(ctx)
+		close(eventRelayDone)
+	}()
+
+	// Driving adapter (Webhook Dispatcher)
+	webhookDispatcher := app.WebhookDispatcher()
+	webhookDispatcherDone := make(chan struct{})
+	go func() {
+		webhookDispatcher.Run(ctx)
+		close(webhookDispatcherDone)
+	}()
+
+	// Driving adapter (Signing Key Syncer)
+	signingKeySyncer := app.SigningKeySyncer()
+	signingKeySyncerDone := make(chan struct{})
+	go func() {
+		signingKeySyncer.Run(ctx)
+		close(signingKeySyncerDone)
+	}()
+
+	// Driving adapter (HTTP)
+	router := app.APIRouter()
+	srv := &http.Server{
+		Handler:      router.Handler,
+		Addr:         ":" + cfg.ServerAdd,
+		WriteTimeout: 15 * time.Second,
+		ReadTimeout:  15 * time.Second,
+	}
+
+	if err := app.Serve(ctx, stop, srv); err != nil {
+		app.Logger.Errorf(ctx, logport.CategoryDefault, "", "%v", err)
+	}
+	app.Logger.Infof(ctx, logport.CategoryDefault, "", "shutting down gracefully...")
+
+	workerDone := make(chan struct{})
+	go func() {
+		workerPool.Wait()
+		<-taskSchedulerDone
+		<-eventRelayDone
+		<-webhookDispatcherDone
+		<-signingKeySyncerDone
+		close(workerDone)
+	}()
+
+	select {
+	case <-workerDone:
+		app.Logger.Infof(ctx, logport.CategoryDefault, "", "workers exited cleanly")
+	case <-time.After(cfg.WorkerWaitTime):
+		app.Logger.Warnf(ctx, logport.CategoryDefault, "", "timed out waiting for workers; forcing exit")
+	}
+
+	app.Logger.Infof(ctx, logport.CategoryDefault, "", "exiting")
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/config"
+	logport "github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/bootstrap"
+)
+
+// The worker command runs transcoding jobs and scheduled tasks,
+// relays domain events from the outbox and sends webhook deliveries.
+// It only serves a health endpoint, the public API runs on api nodes.
+func main() {
+	// Setup Signal-aware Context for graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Config (use environment variables)
+	cfg := config.Load()
+
+	app, err := bootstrap.New(cfg)
+	if err != nil {
+		log.Fatalf("bootstrap: %v", err)
+	}
+	defer app.Close()
+
+	// Driving adapter (Worker)
+	workerPool := app.WorkerPool()
+	workerPool.Start(ctx)
+
+	// Driving adapter (Task Scheduler)
+	taskScheduler := app.TaskScheduler()
+	taskSchedulerDone := make(chan struct{})
+	go func() {
+		taskScheduler.Run(ctx)
+		close(taskSchedulerDone)
+	}()
+
+	// Driving adapter (Event Relay)
+	eventRelay := app.EventRelay()
+	eventRelayDone := make(chan struct{})
+	go func() {
+		eventRelay.Run(ctx)
+		close(eventRelayDone)
+	}()
+
+	// Driving adapter (Webhook Dispatcher)
+	webhookDispatcher := app.WebhookDispatcher()
+	webhookDispatcherDone := make(chan struct{})
+	go func() {
+		webhookDispatcher.Run(ctx)
+		close(webhookDispatcherDone)
+	}()
+
+	// Driving adapter (Health)
+	srv := &http.Server{
+		Handler:      app.HealthRouter("worker"),
+		Addr:         ":" + cfg.WorkerHealthAdd,
+		WriteTimeout: 5 * time.Second,
+		ReadTimeout:  5 * time.Second,
+	}
+
+	if err := app.Serve(ctx, stop, srv); err != nil {
+		app.Logger.Errorf(ctx, logport.CategoryDefault, "", "%v", err)
+	}
+	app.Logger.Infof(ctx, logport.CategoryDefault, "", "shutting down gracefully...")
+
+	workerDone := make(chan struct{})
+	go func() {
+		workerPool.Wait()
+		<-taskSchedulerDone
+		<-eventRelayDone
+		<-webhookDispatcherDone
+		close(workerDone)
+	}()
+
+	select {
+	case <-workerDone:
+		app.Logger.Infof(ctx, logport.CategoryDefault, "", "workers exited cleanly")
+	case <-time.After(cfg.WorkerWaitTime):
+		app.Logger.Warnf(ctx, logport.CategoryDefault, "", "timed out waiting for workers; forcing exit")
+	}
+
+	app.Logger.Infof(ctx, logport.CategoryDefault, "", "exiting")
+}
@@ -2,41 +2,135 @@ package config
 
 import (
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
 )
 
+// Progress streamers selectable with PROGRESS_STREAMER
+const (
+	// ProgressStreamerRedis streams progress across nodes over Redis pub/sub
+	ProgressStreamerRedis = "redis"
+	// ProgressStreamerMemory streams progress within a single process
+	ProgressStreamerMemory = "memory"
+)
+
 type Config struct {
-	ConnStr           string
-	ServerAdd         string
-	StoragePath       string
-	WorkerLimit       int
-	PollInterval      time.Duration
-	WorkerWaitTime    time.Duration
-	CorsAllowedOrigin []string
-	RedisAddrs        []string
-	RedisPassword     string
-	AccessSecret      []byte
-	RefreshSecret     []byte
+	ConnStr             string
+	ServerAdd           string
+	WorkerHealthAdd     string
+	StoragePath         string
+	WorkerLimit         int
+	WorkerCPUs          int
+	WorkerMemory        int64
+	FFmpegNice          int
+	FFmpegThreads       int
+	PollInterval        time.Duration
+	WorkerWaitTime      time.Duration
+	TaskPollInterval    time.Duration
+	TaskBatchSize       int
+	TaskLease           time.Duration // Longest run of a task before another instance runs it again
+	GCCron              string        // When garbage collection runs, in UTC
+	GCRetention         time.Duration // How long published events and finished tasks are kept
+	ChunkDuration       time.Duration
+	EventPollInterval   time.Duration
+	EventBatchSize      int
+	WebhookPollInterval time.Duration
+	WebhookBatchSize    int
+	WebhookTimeout      time.Duration
+	CorsAllowedOrigin   []string
+	RedisAddrs          []string
+	RedisPassword       string
+	ProgressStreamer    string
+	Standalone          bool // Set by cmd/standalone, which runs the API and the workers in one process
+	RefreshSecret       []byte
+	JwtAlgorithm        string
+	JwtKeyRotation      time.Duration
+	JwtKeyEncryption    string // Base64 encoded AES-256 key encrypting the signing keys stored in the db
+	OIDCIssuerURL       string // OpenID Connect login is enabled when set
+	OIDCClientID        string
+	OIDCClientSecret    string
+	OIDCRedirectURL     string
+	OIDCScopes          []string
+	OIDCGroupsClaim     string
+	OIDCGroupRoles      map[string]string
+	OIDCDefaultRole     string
+	SMTPAddr            string // Mails are only logged when empty
+	SMTPUsername        string
+	SMTPPassword        string
+	MailFrom            string
+	VerifyEmailURL      string // Page of the app verifying the token of the link mailed at signup
+	ResetPasswordURL    string // Page of the app choosing a new password with the token of the link
+	BootstrapAdmin      string // Email of the user given the admin role at startup
 }
 
 func Load() *Config {
 	return &Config{
-		ConnStr:           getEnv("DB_URL", ""),
-		ServerAdd:         getEnv("SERVER_ADD", "8085"),
-		StoragePath:       getEnv("STORAGE_PATH", "./storage"),
-		WorkerLimit:       getEnvInt("WORKER_LIMIT", 5),
-		PollInterval:      time.Duration(getEnvInt("POLL_INTERVAL_SEC", 10)) * time.Second,
-		WorkerWaitTime:    time.Duration(getEnvInt("WORKER_WAIT_SEC", 60)) * time.Second,
-		CorsAllowedOrigin: getEnvStringSlice("CORS_ALLOWED_STRING", []string{"*"}),
-		RedisAddrs:        getEnvStringSlice("REDIS_ADDRS", []string{""}),
-		RedisPassword:     getEnv("REDIS_PASSWORD", ""),
-		AccessSecret:      getEnvByteSlice("ACCESS_SECRET", []byte{}),
-		RefreshSecret:     getEnvByteSlice("REFRESH_SECRET", []byte{}),
+		ConnStr:             getEnv("DB_URL", ""),
+		ServerAdd:           getEnv("SERVER_ADD", "8085"),
+		WorkerHealthAdd:     getEnv("WORKER_HEALTH_ADD", "8086"),
+		StoragePath:         getEnv("STORAGE_PATH", "./storage"),
+		WorkerLimit:         getEnvInt("WORKER_LIMIT", 5),
+		WorkerCPUs:          getEnvInt("WORKER_CPUS", runtime.NumCPU()),
+		WorkerMemory:        int64(getEnvInt("WORKER_MEMORY_MB", 0)) << 20,
+		FFmpegNice:          getEnvInt("FFMPEG_NICE", 10),
+		FFmpegThreads:       getEnvInt("FFMPEG_THREADS", 0),
+		PollInterval:        time.Duration(getEnvInt("POLL_INTERVAL_SEC", 10)) * time.Second,
+		WorkerWaitTime:      time.Duration(getEnvInt("WORKER_WAIT_SEC", 60)) * time.Second,
+		TaskPollInterval:    time.Duration(getEnvInt("TASK_POLL_INTERVAL_SEC", 30)) * time.Second,
+		TaskBatchSize:       getEnvInt("TASK_BATCH_SIZE", 10),
+		TaskLease:           time.Duration(getEnvInt("TASK_LEASE_SEC", 600)) * time.Second,
+		GCCron:              getEnv("GC_CRON", "0 3 * * *"),
+		GCRetention:         time.Duration(getEnvInt("GC_RETENTION_DAYS", 30)) * 24 * time.Hour,
+		ChunkDuration:       time.Duration(getEnvInt("CHUNK_DURATION_SEC", 0)) * time.Second,
+		EventPollInterval:   time.Duration(getEnvInt("EVENT_POLL_INTERVAL_SEC", 1)) * time.Second,
+		EventBatchSize:      getEnvInt("EVENT_BATCH_SIZE", 100),
+		WebhookPollInterval: time.Duration(getEnvInt("WEBHOOK_POLL_INTERVAL_SEC", 5)) * time.Second,
+		WebhookBatchSize:    getEnvInt("WEBHOOK_BATCH_SIZE", 20),
+		WebhookTimeout:      time.Duration(getEnvInt("WEBHOOK_TIMEOUT_SEC", 10)) * time.Second,
+		CorsAllowedOrigin:   getEnvStringSlice("CORS_ALLOWED_STRING", []string{"*"}),
+		RedisAddrs:          getEnvStringSlice("REDIS_ADDRS", nil),
+		RedisPassword:       getEnv("REDIS_PASSWORD", ""),
+		ProgressStreamer:    getEnv("PROGRESS_STREAMER", ProgressStreamerRedis),
+		RefreshSecret:       getEnvByteSlice("REFRESH_SECRET", []byte{}),
+		JwtAlgorithm:        getEnv("JWT_ALGORITHM", "RS256"),
+		JwtKeyRotation:      time.Duration(getEnvInt("JWT_KEY_ROTATION_HOURS", 30*24)) * time.Hour,
+		JwtKeyEncryption:    getEnv("JWT_KEY_ENCRYPTION_KEY", ""),
+		OIDCIssuerURL:       getEnv("OIDC_ISSUER_URL", ""),
+		OIDCClientID:        getEnv("OIDC_CLIENT_ID", ""),
+		OIDCClientSecret:    getEnv("OIDC_CLIENT_SECRET", ""),
+		OIDCRedirectURL:     getEnv("OIDC_REDIRECT_URL", ""),
+		OIDCScopes:          getEnvStringSlice("OIDC_SCOPES", []string{"openid", "email", "profile"}),
+		OIDCGroupsClaim:     getEnv("OIDC_GROUPS_CLAIM", "groups"),
+		OIDCGroupRoles:      getEnvMap("OIDC_GROUP_ROLES"),
+		OIDCDefaultRole:     getEnv("OIDC_DEFAULT_ROLE", "viewer"),
+		SMTPAddr:            getEnv("SMTP_ADDR", ""),
+		SMTPUsername:        getEnv("SMTP_USERNAME", ""),
+		SMTPPassword:        getEnv("SMTP_PASSWORD", ""),
+		MailFrom:            getEnv("MAIL_FROM", "no-reply@localhost"),
+		VerifyEmailURL:      getEnv("VERIFY_EMAIL_URL", "http://localhost:8085/verify-email"),
+		ResetPasswordURL:    getEnv("RESET_PASSWORD_URL", "http://localhost:8085/reset-password"),
+		BootstrapAdmin:      getEnv("BOOTSTRAP_ADMIN", ""),
 	}
 }
 
+// UsesRedis reports whether the node connects to Redis.
+// Redis may be left out when progress is streamed in memory and no address is set.
+func (c *Config) UsesRedis() bool {
+	return c.ProgressStreamer != ProgressStreamerMemory || len(c.RedisAddrs) > 0
+}
+
+// UsesOIDC reports whether users may sign in through an OpenID Connect provider
+func (c *Config) UsesOIDC() bool {
+	return c.OIDCIssuerURL != ""
+}
+
+// UsesSMTP reports whether mails are sent through a mail server rather than logged
+func (c *Config) UsesSMTP() bool {
+	return c.SMTPAddr != ""
+}
+
 func getEnv(key, fallback string) string {
 	value, ok := os.LookupEnv(key)
 	if !ok {
@@ -72,3 +166,16 @@ func getEnvByteSlice(key string, fallback []byte) []byte {
 
 	return []byte(value)
 }
+
+// getEnvMap reads key=value pairs separated by commas, pairs without = are skipped
+func getEnvMap(key string) map[string]string {
+	m := make(map[string]string)
+	for _, pair := range getEnvStringSlice(key, nil) {
+		k, v, ok := strings.Cut(pair, "=")
+		if ok && strings.TrimSpace(k) != "" {
+			m[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,45 @@
+package memorydenylist
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/denylist"
+)
+
+// MemoryDenylist keeps revoked sessions in the process,
+// only the api node revoking a session rejects its tokens
+type MemoryDenylist struct {
+	mu     sync.Mutex
+	denied map[string]time.Time // session id to the end of its denial
+}
+
+func NewMemoryDenylist() denylist.Denylist {
+	return &MemoryDenylist{denied: make(map[string]time.Time)}
+}
+
+func (d *MemoryDenylist) Deny(ctx context.Context, sessionID string, ttl time.Duration) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	d.denied[sessionID] = now.Add(ttl)
+
+	// Forget the sessions whose tokens expired meanwhile
+	for id, until := range d.denied {
+		if !now.Before(until) {
+			delete(d.denied, id)
+		}
+	}
+
+	return nil
+}
+
+func (d *MemoryDenylist) IsDenied(ctx context.Context, sessionID string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	until, ok := d.denied[sessionID]
+	return ok && time.Now().Before(until), nil
+}
@@ -0,0 +1,36 @@
+package memorydenylist_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/memorydenylist"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryDenylist(t *testing.T) {
+	t.Run("success case - denied session is reported", func(t *testing.T) {
+		d := memorydenylist.NewMemoryDenylist()
+
+		require.NoError(t, d.Deny(t.Context(), "session-1", time.Minute))
+
+		denied, err := d.IsDenied(t.Context(), "session-1")
+		require.NoError(t, err)
+		require.True(t, denied)
+
+		denied, err = d.IsDenied(t.Context(), "session-2")
+		require.NoError(t, err)
+		require.False(t, denied)
+	})
+
+	t.Run("expiry - session is accepted again once its tokens expired", func(t *testing.T) {
+		d := memorydenylist.NewMemoryDenylist()
+
+		require.NoError(t, d.Deny(t.Context(), "session-1", 50*time.Millisecond))
+		time.Sleep(100 * time.Millisecond)
+
+		denied, err := d.IsDenied(t.Context(), "session-1")
+		require.NoError(t, err)
+		require.False(t, denied)
+	})
+}
@@ -0,0 +1,41 @@
+package redisdenylist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/redis"
+	"github.com/st-ember/streaming-api/internal/application/ports/denylist"
+)
+
+type RedisDenylist struct {
+	Client *redis.Client
+}
+
+// NewRedisDenylist shares the revoked sessions between every api node
+func NewRedisDenylist(client *redis.Client) denylist.Denylist {
+	return &RedisDenylist{Client: client}
+}
+
+// Deny stores the session with an expiry, so the list only holds sessions whose tokens may still be valid
+func (d *RedisDenylist) Deny(ctx context.Context, sessionID string, ttl time.Duration) error {
+	if err := d.Client.Rdb.Set(ctx, d.buildKey(sessionID), 1, ttl).Err(); err != nil {
+		return fmt.Errorf("deny session %s: %w", sessionID, err)
+	}
+
+	return nil
+}
+
+func (d *RedisDenylist) IsDenied(ctx context.Context, sessionID string) (bool, error) {
+	n, err := d.Client.Rdb.Exists(ctx, d.buildKey(sessionID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("check session %s: %w", sessionID, err)
+	}
+
+	return n > 0, nil
+}
+
+func (d *RedisDenylist) buildKey(sessionID string) string {
+	return fmt.Sprintf("auth:denylist:session:%s", sessionID)
+}
@@ -0,0 +1,52 @@
+package redisdenylist_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/redisdenylist"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/redis"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisDenylist(t *testing.T) {
+	t.Run("success case - denied session is reported", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		d := redisdenylist.NewRedisDenylist(client)
+
+		require.NoError(t, d.Deny(t.Context(), "session-1", time.Minute))
+
+		denied, err := d.IsDenied(t.Context(), "session-1")
+		require.NoError(t, err)
+		require.True(t, denied)
+
+		denied, err = d.IsDenied(t.Context(), "session-2")
+		require.NoError(t, err)
+		require.False(t, denied)
+	})
+
+	t.Run("expiry - session is accepted again once its tokens expired", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		d := redisdenylist.NewRedisDenylist(client)
+
+		require.NoError(t, d.Deny(t.Context(), "session-1", time.Minute))
+		s.FastForward(2 * time.Minute)
+
+		denied, err := d.IsDenied(t.Context(), "session-1")
+		require.NoError(t, err)
+		require.False(t, denied)
+	})
+
+	t.Run("redis failure - returns an error", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		d := redisdenylist.NewRedisDenylist(client)
+		s.Close()
+
+		_, err := d.IsDenied(t.Context(), "session-1")
+		require.Error(t, err)
+	})
+}
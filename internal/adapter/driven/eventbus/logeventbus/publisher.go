@@ -0,0 +1,27 @@
+package logeventbus
+
+import (
+	"context"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/eventbus"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+)
+
+type LogPublisher struct {
+	logger log.Logger
+}
+
+// NewLogPublisher initializes the LogPublisher struct.
+// It stands in for the bus of deployments running without Redis,
+// events still reach webhook subscriptions through the relay.
+func NewLogPublisher(logger log.Logger) eventbus.Publisher {
+	return &LogPublisher{logger: logger}
+}
+
+// Publish logs the event, no other system consumes it
+func (p *LogPublisher) Publish(ctx context.Context, e *event.Event) error {
+	p.logger.Infof(ctx, log.CategoryEvent, e.VideoID, "event %s %s (sequence %d)", e.ID, e.Type, e.Sequence)
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package logeventbus_test
+
+import (
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/eventbus/logeventbus"
+	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogPublisher_Publish(t *testing.T) {
+	logger := logmocks.NewMockLogger(t)
+	publisher := logeventbus.NewLogPublisher(logger)
+
+	uploaded, _ := event.NewEvent("event-1", event.TypeVideoUploaded, "video-1", map[string]string{"video_id": "video-1"})
+	logger.EXPECT().Infof(mock.Anything, mock.Anything, "video-1", mock.Anything, mock.Anything).Once()
+
+	require.NoError(t, publisher.Publish(t.Context(), uploaded))
+}
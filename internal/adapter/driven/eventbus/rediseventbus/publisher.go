@@ -0,0 +1,52 @@
+package rediseventbus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	redisclient "github.com/st-ember/streaming-api/internal/adapter/driven/redis"
+	"github.com/st-ember/streaming-api/internal/application/ports/eventbus"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+)
+
+const (
+	// Stream is the Redis Stream consumers read events from,
+	// a single stream keeps the events of a video in order
+	Stream = "events:video"
+	// streamMaxLen trims the oldest entries, consumers are expected to keep up
+	streamMaxLen = 100_000
+)
+
+type RedisStreamPublisher struct {
+	Client *redisclient.Client
+}
+
+// NewRedisStreamPublisher initializes the RedisStreamPublisher struct
+func NewRedisStreamPublisher(client *redisclient.Client) eventbus.Publisher {
+	return &RedisStreamPublisher{Client: client}
+}
+
+// Publish appends the event to the stream
+func (p *RedisStreamPublisher) Publish(ctx context.Context, e *event.Event) error {
+	err := p.Client.Rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: Stream,
+		MaxLen: streamMaxLen,
+		Approx: true,
+		Values: map[string]any{
+			"id":         e.ID,
+			"type":       string(e.Type),
+			"video_id":   e.VideoID,
+			"sequence":   strconv.FormatInt(e.Sequence, 10),
+			"payload":    e.Payload,
+			"created_at": e.CreatedAt.Format(time.RFC3339Nano),
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("add event %s to redis stream: %w", e.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package rediseventbus_test
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/eventbus/rediseventbus"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/redis"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisStreamPublisher_Publish(t *testing.T) {
+	s := miniredis.RunT(t)
+	client, _ := redis.NewClient([]string{s.Addr()}, "")
+	publisher := rediseventbus.NewRedisStreamPublisher(client)
+
+	uploaded, _ := event.NewEvent("event-1", event.TypeVideoUploaded, "video-1", map[string]string{"video_id": "video-1"})
+	uploaded.Sequence = 1
+	published, _ := event.NewEvent("event-2", event.TypeVideoPublished, "video-1", map[string]string{"video_id": "video-1"})
+	published.Sequence = 2
+
+	require.NoError(t, publisher.Publish(t.Context(), uploaded))
+	require.NoError(t, publisher.Publish(t.Context(), published))
+
+	// Entries are read in the order they were published
+	entries, err := client.Rdb.XRange(t.Context(), rediseventbus.Stream, "-", "+").Result()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "event-1", entries[0].Values["id"])
+	require.Equal(t, "video.uploaded", entries[0].Values["type"])
+	require.Equal(t, `{"video_id":"video-1"}`, entries[0].Values["payload"])
+	require.Equal(t, "event-2", entries[1].Values["id"])
+	require.Equal(t, "2", entries[1].Values["sequence"])
+}
@@ -0,0 +1,41 @@
+package stdlogger
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	logPort "github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// StdLogger writes log lines to stderr, for deployments running without Redis
+type StdLogger struct {
+	Logger *log.Logger
+}
+
+func NewStdLogger() logPort.Logger {
+	return &StdLogger{Logger: log.New(os.Stderr, "", log.LstdFlags|log.LUTC)}
+}
+
+func (l *StdLogger) Errorf(ctx context.Context, category logPort.LogCategory, sourceID string, format string, args ...any) {
+	l.write("ERROR", category, sourceID, fmt.Sprintf(format, args...))
+}
+
+func (l *StdLogger) Warnf(ctx context.Context, category logPort.LogCategory, sourceID string, format string, args ...any) {
+	l.write("WARN", category, sourceID, fmt.Sprintf(format, args...))
+}
+
+func (l *StdLogger) Infof(ctx context.Context, category logPort.LogCategory, sourceID string, format string, args ...any) {
+	l.write("INFO", category, sourceID, fmt.Sprintf(format, args...))
+}
+
+// write prints a line as "LEVEL [category] source_id: message", without the source when empty
+func (l *StdLogger) write(level string, category logPort.LogCategory, sourceID string, msg string) {
+	if sourceID == "" {
+		l.Logger.Printf("%s [%s] %s", level, category, msg)
+		return
+	}
+
+	l.Logger.Printf("%s [%s] %s: %s", level, category, sourceID, msg)
+}
@@ -0,0 +1,88 @@
+package memoryloginattempts
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/loginattempts"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+type entry struct {
+	failures  auth.LoginFailures
+	expiresAt time.Time
+}
+
+// MemoryStore keeps failed logins in the process,
+// each api node then throttles the logins it receives on its own
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+func NewMemoryStore() loginattempts.Store {
+	return &MemoryStore{entries: make(map[string]entry)}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) (auth.LoginFailures, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok || !time.Now().Before(e.expiresAt) {
+		return auth.LoginFailures{}, nil
+	}
+
+	return e.failures, nil
+}
+
+func (s *MemoryStore) Fail(ctx context.Context, key string, ttl time.Duration) (auth.LoginFailures, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	// Forget the keys whose failures expired meanwhile
+	for k, e := range s.entries {
+		if !now.Before(e.expiresAt) {
+			delete(s.entries, k)
+		}
+	}
+
+	e := s.entries[key]
+	e.failures.Count++
+	e.failures.LastAt = now
+	e.expiresAt = now.Add(ttl)
+	s.entries[key] = e
+
+	return e.failures, nil
+}
+
+func (s *MemoryStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		return nil
+	}
+
+	e.failures.Count--
+	if e.failures.Count <= 0 {
+		delete(s.entries, key)
+		return nil
+	}
+	s.entries[key] = e
+
+	return nil
+}
+
+func (s *MemoryStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+
+	return nil
+}
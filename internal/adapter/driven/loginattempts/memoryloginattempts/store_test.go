@@ -0,0 +1,77 @@
+package memoryloginattempts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/loginattempts/memoryloginattempts"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	t.Run("success case - failures are counted by key", func(t *testing.T) {
+		store := memoryloginattempts.NewMemoryStore()
+
+		_, err := store.Fail(t.Context(), "account:jane", time.Minute)
+		require.NoError(t, err)
+		f, err := store.Fail(t.Context(), "account:jane", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, 2, f.Count)
+
+		got, err := store.Get(t.Context(), "account:jane")
+		require.NoError(t, err)
+		require.Equal(t, f, got)
+
+		none, err := store.Get(t.Context(), "account:john")
+		require.NoError(t, err)
+		require.Zero(t, none.Count)
+	})
+
+	t.Run("expiry - failures are forgotten after the ttl", func(t *testing.T) {
+		store := memoryloginattempts.NewMemoryStore()
+
+		_, err := store.Fail(t.Context(), "account:jane", 50*time.Millisecond)
+		require.NoError(t, err)
+		time.Sleep(100 * time.Millisecond)
+
+		got, err := store.Get(t.Context(), "account:jane")
+		require.NoError(t, err)
+		require.Zero(t, got.Count)
+
+		// Counting starts over
+		f, err := store.Fail(t.Context(), "account:jane", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, 1, f.Count)
+	})
+
+	t.Run("reset - failures are forgotten", func(t *testing.T) {
+		store := memoryloginattempts.NewMemoryStore()
+
+		_, err := store.Fail(t.Context(), "account:jane", time.Minute)
+		require.NoError(t, err)
+		require.NoError(t, store.Reset(t.Context(), "account:jane"))
+
+		got, err := store.Get(t.Context(), "account:jane")
+		require.NoError(t, err)
+		require.Zero(t, got.Count)
+	})
+
+	t.Run("release - one failure is taken back", func(t *testing.T) {
+		store := memoryloginattempts.NewMemoryStore()
+
+		_, _ = store.Fail(t.Context(), "account:jane", time.Minute)
+		_, _ = store.Fail(t.Context(), "account:jane", time.Minute)
+		require.NoError(t, store.Release(t.Context(), "account:jane"))
+
+		got, err := store.Get(t.Context(), "account:jane")
+		require.NoError(t, err)
+		require.Equal(t, 1, got.Count)
+
+		// Counting starts over once no failure is left, even released again
+		require.NoError(t, store.Release(t.Context(), "account:jane"))
+		require.NoError(t, store.Release(t.Context(), "account:jane"))
+		f, err := store.Fail(t.Context(), "account:jane", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, 1, f.Count)
+	})
+}
@@ -0,0 +1,112 @@
+package redisloginattempts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/redis"
+	"github.com/st-ember/streaming-api/internal/application/ports/loginattempts"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+const (
+	countField = "count"
+	lastField  = "last_at" // unix milliseconds
+)
+
+// releaseScript takes back one failure, forgetting the key once none is left
+// rather than leaving a count without expiry behind
+var releaseScript = goredis.NewScript(`
+local count = redis.call('HINCRBY', KEYS[1], ARGV[1], -1)
+if count <= 0 then
+	redis.call('DEL', KEYS[1])
+end
+return count
+`)
+
+type RedisStore struct {
+	Client *redis.Client
+}
+
+// NewRedisStore shares the failed logins between every api node
+func NewRedisStore(client *redis.Client) loginattempts.Store {
+	return &RedisStore{Client: client}
+}
+
+func (s *RedisStore) Get(ctx context.Context, key string) (auth.LoginFailures, error) {
+	values, err := s.Client.Rdb.HMGet(ctx, s.buildKey(key), countField, lastField).Result()
+	if err != nil {
+		return auth.LoginFailures{}, fmt.Errorf("get login failures of %s: %w", key, err)
+	}
+
+	return parseFailures(values)
+}
+
+// Fail counts the failure and pushes the expiry back in one transaction,
+// so concurrent failures are all counted
+func (s *RedisStore) Fail(ctx context.Context, key string, ttl time.Duration) (auth.LoginFailures, error) {
+	k := s.buildKey(key)
+	now := time.Now()
+
+	var count *goredis.IntCmd
+	_, err := s.Client.Rdb.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		count = pipe.HIncrBy(ctx, k, countField, 1)
+		pipe.HSet(ctx, k, lastField, now.UnixMilli())
+		pipe.PExpire(ctx, k, ttl)
+		return nil
+	})
+	if err != nil {
+		return auth.LoginFailures{}, fmt.Errorf("record login failure of %s: %w", key, err)
+	}
+
+	return auth.LoginFailures{Count: int(count.Val()), LastAt: now}, nil
+}
+
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	if err := releaseScript.Run(ctx, s.Client.Rdb, []string{s.buildKey(key)}, countField).Err(); err != nil {
+		return fmt.Errorf("release login failure of %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *RedisStore) Reset(ctx context.Context, key string) error {
+	if err := s.Client.Rdb.Del(ctx, s.buildKey(key)).Err(); err != nil {
+		return fmt.Errorf("reset login failures of %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *RedisStore) buildKey(key string) string {
+	return fmt.Sprintf("auth:login:failures:%s", key)
+}
+
+// parseFailures reads the fields of the hash, missing when no failure is recorded
+func parseFailures(values []any) (auth.LoginFailures, error) {
+	countStr, ok := values[0].(string)
+	if !ok {
+		return auth.LoginFailures{}, nil
+	}
+
+	count, err := strconv.Atoi(countStr)
+	if err != nil {
+		return auth.LoginFailures{}, fmt.Errorf("parse login failure count: %w", err)
+	}
+
+	lastStr, ok := values[1].(string)
+	if !ok {
+		return auth.LoginFailures{}, errors.New("login failures without last failure")
+	}
+
+	lastMs, err := strconv.ParseInt(lastStr, 10, 64)
+	if err != nil {
+		return auth.LoginFailures{}, fmt.Errorf("parse last login failure: %w", err)
+	}
+
+	return auth.LoginFailures{Count: count, LastAt: time.UnixMilli(lastMs)}, nil
+}
@@ -0,0 +1,81 @@
+package redisloginattempts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/loginattempts/redisloginattempts"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/redis"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisStore(t *testing.T) {
+	t.Run("success case - failures are counted by key", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		store := redisloginattempts.NewRedisStore(client)
+
+		_, err := store.Fail(t.Context(), "account:jane", time.Minute)
+		require.NoError(t, err)
+		f, err := store.Fail(t.Context(), "account:jane", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, 2, f.Count)
+
+		got, err := store.Get(t.Context(), "account:jane")
+		require.NoError(t, err)
+		require.Equal(t, 2, got.Count)
+		require.WithinDuration(t, f.LastAt, got.LastAt, time.Millisecond)
+
+		none, err := store.Get(t.Context(), "account:john")
+		require.NoError(t, err)
+		require.Zero(t, none.Count)
+	})
+
+	t.Run("expiry - failures are forgotten after the ttl", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		store := redisloginattempts.NewRedisStore(client)
+
+		_, err := store.Fail(t.Context(), "account:jane", time.Minute)
+		require.NoError(t, err)
+		s.FastForward(2 * time.Minute)
+
+		got, err := store.Get(t.Context(), "account:jane")
+		require.NoError(t, err)
+		require.Zero(t, got.Count)
+	})
+
+	t.Run("reset - failures are forgotten", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		store := redisloginattempts.NewRedisStore(client)
+
+		_, err := store.Fail(t.Context(), "account:jane", time.Minute)
+		require.NoError(t, err)
+		require.NoError(t, store.Reset(t.Context(), "account:jane"))
+
+		got, err := store.Get(t.Context(), "account:jane")
+		require.NoError(t, err)
+		require.Zero(t, got.Count)
+	})
+
+	t.Run("release - one failure is taken back", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		store := redisloginattempts.NewRedisStore(client)
+
+		_, _ = store.Fail(t.Context(), "account:jane", time.Minute)
+		_, _ = store.Fail(t.Context(), "account:jane", time.Minute)
+		require.NoError(t, store.Release(t.Context(), "account:jane"))
+
+		got, err := store.Get(t.Context(), "account:jane")
+		require.NoError(t, err)
+		require.Equal(t, 1, got.Count)
+
+		// The key is forgotten once no failure is left, even released again
+		require.NoError(t, store.Release(t.Context(), "account:jane"))
+		require.NoError(t, store.Release(t.Context(), "account:jane"))
+		require.False(t, s.Exists("auth:login:failures:account:jane"))
+	})
+}
@@ -0,0 +1,24 @@
+package logmailsender
+
+import (
+	"context"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/ports/mailsender"
+)
+
+type LogSender struct {
+	logger log.Logger
+}
+
+// NewLogSender stands in for a mail server in development,
+// the mails, and so the links they carry, are only logged
+func NewLogSender(logger log.Logger) mailsender.Sender {
+	return &LogSender{logger: logger}
+}
+
+func (s *LogSender) Send(ctx context.Context, msg mailsender.Message) error {
+	s.logger.Infof(ctx, log.CategoryAuth, "", "mail to %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package smtpsender
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/mailsender"
+)
+
+// Config locates the mail server, mails are sent from From
+type Config struct {
+	Addr     string // host:port
+	Username string // Empty when the server does not authenticate senders
+	Password string
+	From     string
+}
+
+type SMTPSender struct {
+	cfg     Config
+	timeout time.Duration
+}
+
+// NewSMTPSender initializes the sender, each mail is sent over its own connection within timeout.
+// The connection is upgraded with STARTTLS when the server offers it.
+func NewSMTPSender(cfg Config, timeout time.Duration) mailsender.Sender {
+	return &SMTPSender{cfg: cfg, timeout: timeout}
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg mailsender.Message) error {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("parse recipient %q: %w", msg.To, err)
+	}
+	from, err := mail.ParseAddress(s.cfg.From)
+	if err != nil {
+		return fmt.Errorf("parse sender %q: %w", s.cfg.From, err)
+	}
+
+	data, err := s.compose(from, to, msg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", s.cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("dial mail server %s: %w", s.cfg.Addr, err)
+	}
+	defer conn.Close()
+
+	// The whole exchange shares the deadline, smtp does not take a context
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return fmt.Errorf("set deadline: %w", err)
+	}
+
+	host, _, _ := net.SplitHostPort(s.cfg.Addr)
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return fmt.Errorf("greet mail server %s: %w", s.cfg.Addr, err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return fmt.Errorf("starttls: %w", err)
+		}
+	}
+
+	if s.cfg.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, host)); err != nil {
+			return fmt.Errorf("authenticate as %s: %w", s.cfg.Username, err)
+		}
+	}
+
+	if err := c.Mail(from.Address); err != nil {
+		return fmt.Errorf("mail from %s: %w", from.Address, err)
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("rcpt to %s: %w", to.Address, err)
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("start data: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("write data: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("end data: %w", err)
+	}
+
+	return c.Quit()
+}
+
+// compose builds the mail, the subject is encoded and the body quoted-printable
+// so any text goes through
+func (s *SMTPSender) compose(from, to *mail.Address, msg mailsender.Message) ([]byte, error) {
+	if strings.ContainsAny(msg.Subject, "\r\n") {
+		return nil, errors.New("subject contains a line break")
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from.String())
+	fmt.Fprintf(&b, "To: %s\r\n", to.String())
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	qp := quotedprintable.NewWriter(&b)
+	if _, err := qp.Write([]byte(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))); err != nil {
+		return nil, fmt.Errorf("encode body: %w", err)
+	}
+	if err := qp.Close(); err != nil {
+		return nil, fmt.Errorf("encode body: %w", err)
+	}
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,71 @@
+package smtpsender_test
+
+import (
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/mailsender/smtpsender"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/mailsender/smtptest"
+	"github.com/st-ember/streaming-api/internal/application/ports/mailsender"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPSender_Send(t *testing.T) {
+	server := smtptest.NewServer()
+	defer server.Close()
+
+	sender := smtpsender.NewSMTPSender(smtpsender.Config{Addr: server.Addr(), From: "Streaming <no-reply@example.com>"}, time.Second)
+
+	err := sender.Send(t.Context(), mailsender.Message{
+		To:      "jane@example.com",
+		Subject: "Vérifiez votre email",
+		Body:    "Open https://app.test/verify-email?token=abc\n.\nThanks",
+	})
+	require.NoError(t, err)
+
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	require.Equal(t, "no-reply@example.com", messages[0].From)
+	require.Equal(t, []string{"jane@example.com"}, messages[0].To)
+
+	msg, err := mail.ReadMessage(strings.NewReader(messages[0].Data))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "Vérifiez votre email", subject)
+
+	body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	require.NoError(t, err)
+	require.Equal(t, "Open https://app.test/verify-email?token=abc\r\n.\r\nThanks\r\n", string(body))
+}
+
+func TestSMTPSender_Send_RejectsHeaderInjection(t *testing.T) {
+	server := smtptest.NewServer()
+	defer server.Close()
+
+	sender := smtpsender.NewSMTPSender(smtpsender.Config{Addr: server.Addr(), From: "no-reply@example.com"}, time.Second)
+
+	err := sender.Send(t.Context(), mailsender.Message{To: "jane@example.com", Subject: "Hi\r\nBcc: eve@example.com"})
+	require.Error(t, err)
+
+	err = sender.Send(t.Context(), mailsender.Message{To: "jane@example.com\r\nBcc: eve@example.com", Subject: "Hi"})
+	require.Error(t, err)
+
+	require.Empty(t, server.Messages())
+}
+
+func TestSMTPSender_Send_FailsWithoutServer(t *testing.T) {
+	server := smtptest.NewServer()
+	server.Close()
+
+	sender := smtpsender.NewSMTPSender(smtpsender.Config{Addr: server.Addr(), From: "no-reply@example.com"}, time.Second)
+
+	err := sender.Send(t.Context(), mailsender.Message{To: "jane@example.com", Subject: "Hi"})
+	require.Error(t, err)
+}
@@ -0,0 +1,167 @@
+// Package smtptest runs a stand-in mail server for tests and local development,
+// it accepts every mail without authentication and keeps them in memory
+package smtptest
+
+import (
+	"bufio"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+)
+
+// Message is a mail received by the server
+type Message struct {
+	From string
+	To   []string
+	Data string // Headers and body, as sent
+}
+
+// Header parses the headers of the mail
+func (m Message) Header() mail.Header {
+	msg, err := mail.ReadMessage(strings.NewReader(m.Data))
+	if err != nil {
+		return mail.Header{}
+	}
+	return msg.Header
+}
+
+type Server struct {
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewServer starts the server on a free local port
+func NewServer() *Server {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic("smtptest: listen: " + err.Error())
+	}
+
+	s := &Server{listener: l}
+	s.wg.Add(1)
+	go s.serve()
+
+	return s
+}
+
+// Addr is the host:port to send mails to
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Messages returns the mails received so far
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.messages...)
+}
+
+func (s *Server) Close() {
+	s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+// handle speaks enough SMTP for a client sending mails
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+	reply := func(line string) {
+		w.WriteString(line + "\r\n")
+		w.Flush()
+	}
+
+	reply("220 smtptest ready")
+
+	var msg Message
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			reply("250 smtptest")
+		case "MAIL":
+			msg = Message{From: address(arg)}
+			reply("250 OK")
+		case "RCPT":
+			msg.To = append(msg.To, address(arg))
+			reply("250 OK")
+		case "DATA":
+			if len(msg.To) == 0 {
+				reply("503 no recipients")
+				continue
+			}
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			data, err := readData(r)
+			if err != nil {
+				return
+			}
+			msg.Data = data
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			msg = Message{}
+			reply("250 OK")
+		case "RSET":
+			msg = Message{}
+			reply("250 OK")
+		case "NOOP":
+			reply("250 OK")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+// readData reads the mail up to the line holding a single dot, undoing dot stuffing
+func readData(r *bufio.Reader) (string, error) {
+	var b strings.Builder
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		if line == ".\r\n" {
+			return b.String(), nil
+		}
+		b.WriteString(strings.TrimPrefix(line, "."))
+	}
+}
+
+// address extracts the address of "FROM:<a@b>" or "TO:<a@b>"
+func address(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr, _, _ = strings.Cut(strings.TrimSpace(addr), " ")
+	return strings.Trim(addr, "<>")
+}
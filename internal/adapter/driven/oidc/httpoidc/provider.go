@@ -0,0 +1,282 @@
+package httpoidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/st-ember/streaming-api/internal/application/ports/oidc"
+)
+
+const (
+	// maxBodyBytes bounds how much of a provider response is read
+	maxBodyBytes = 1 << 20
+	// leeway absorbs clock skew with the provider when checking id token times
+	leeway = time.Minute
+)
+
+// signingAlgorithms are the id token algorithms accepted from providers
+var signingAlgorithms = []string{"RS256", "ES256", "EdDSA"}
+
+// Config identifies the api as a client of the provider
+type Config struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string // Empty for a public client
+	RedirectURL  string
+	Scopes       []string
+	GroupsClaim  string // Claim of the id token listing the groups of the user
+}
+
+// metadata is the part of the provider configuration the flow needs
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// HTTPProvider discovers the provider on first use, and loads its keys again
+// when an id token is signed by a key it does not know, as providers rotate them
+type HTTPProvider struct {
+	cfg    Config
+	client *http.Client
+
+	mu   sync.Mutex
+	meta *metadata
+	keys map[string]any // by kid
+}
+
+// NewHTTPProvider initializes the provider, requests time out after timeout
+func NewHTTPProvider(cfg Config, timeout time.Duration) oidc.Provider {
+	return &HTTPProvider{cfg: cfg, client: &http.Client{Timeout: timeout}}
+}
+
+func (p *HTTPProvider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	q := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.cfg.ClientID},
+		"redirect_uri":          {p.cfg.RedirectURL},
+		"scope":                 {strings.Join(p.cfg.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+
+	return meta.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+func (p *HTTPProvider) Exchange(ctx context.Context, code, codeVerifier string) (*oidc.Identity, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"code_verifier": {codeVerifier},
+	}
+	// Confidential clients authenticate with basic auth, public ones only name themselves
+	if p.cfg.ClientSecret == "" {
+		form.Set("client_id", p.cfg.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("redeem code at %s: %w", meta.TokenEndpoint, err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxBodyBytes))
+	if err != nil {
+		return nil, fmt.Errorf("read token response: %w", err)
+	}
+
+	// The provider answers 400 for codes it does not accept
+	if res.StatusCode >= 400 && res.StatusCode < 500 {
+		return nil, fmt.Errorf("%w: token endpoint returned %d: %s", oidc.ErrRejected, res.StatusCode, body)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint returned %d", res.StatusCode)
+	}
+
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &tokens); err != nil {
+		return nil, fmt.Errorf("decode token response: %w", err)
+	}
+	if tokens.IDToken == "" {
+		return nil, fmt.Errorf("%w: no id token in token response", oidc.ErrRejected)
+	}
+
+	return p.verify(ctx, meta, tokens.IDToken)
+}
+
+// verify checks the signature, issuer, audience and lifetime of the id token,
+// the nonce is returned for the caller to compare
+func (p *HTTPProvider) verify(ctx context.Context, meta *metadata, idToken string) (*oidc.Identity, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, meta, kid)
+	},
+		jwt.WithValidMethods(signingAlgorithms),
+		jwt.WithIssuer(meta.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(leeway),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: verify id token: %v", oidc.ErrRejected, err)
+	}
+
+	// A token issued to several clients names the one it was issued for
+	if azp, ok := claims["azp"].(string); ok && azp != p.cfg.ClientID {
+		return nil, fmt.Errorf("%w: id token issued for %s", oidc.ErrRejected, azp)
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, fmt.Errorf("%w: id token without subject", oidc.ErrRejected)
+	}
+
+	identity := &oidc.Identity{Issuer: meta.Issuer, Subject: subject}
+	identity.Email, _ = claims["email"].(string)
+	identity.EmailVerified, _ = claims["email_verified"].(bool)
+	identity.Username, _ = claims["preferred_username"].(string)
+	identity.Nonce, _ = claims["nonce"].(string)
+
+	// Groups are a list, or a single string for providers listing one group
+	switch groups := claims[p.cfg.GroupsClaim].(type) {
+	case []any:
+		for _, g := range groups {
+			if s, ok := g.(string); ok {
+				identity.Groups = append(identity.Groups, s)
+			}
+		}
+	case string:
+		identity.Groups = []string{groups}
+	}
+
+	return identity, nil
+}
+
+// discover loads the provider configuration once, failures are retried on the next call
+func (p *HTTPProvider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	issuer := strings.TrimSuffix(p.cfg.IssuerURL, "/")
+	meta := &metadata{}
+	if err := p.getJSON(ctx, issuer+"/.well-known/openid-configuration", meta); err != nil {
+		return nil, fmt.Errorf("discover provider: %w", err)
+	}
+
+	// The issuer of the configuration must be the one configured, tokens are checked against it
+	if strings.TrimSuffix(meta.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("discover provider: issuer %q does not match %q", meta.Issuer, p.cfg.IssuerURL)
+	}
+
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, errors.New("discover provider: missing endpoints")
+	}
+
+	p.meta = meta
+
+	return meta, nil
+}
+
+// key finds the key of the kid, loading the key set again when it is unknown
+func (p *HTTPProvider) key(ctx context.Context, meta *metadata, kid string) (any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if k, ok := p.keys[kid]; ok {
+		return k, nil
+	}
+
+	var set jwkSet
+	if err := p.getJSON(ctx, meta.JWKSURI, &set); err != nil {
+		return nil, fmt.Errorf("load provider keys: %w", err)
+	}
+
+	keys := make(map[string]any, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		// Keys of unsupported types are skipped, the provider may publish others
+		if pub, err := k.publicKey(); err == nil {
+			keys[k.Kid] = pub
+		}
+	}
+	p.keys = keys
+
+	k, ok := keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+
+	return k, nil
+}
+
+func (p *HTTPProvider) getJSON(ctx context.Context, u string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("get %s: %w", u, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s: status %d", u, res.StatusCode)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxBodyBytes)).Decode(v); err != nil {
+		return fmt.Errorf("decode %s: %w", u, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package httpoidc_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/oidc/httpoidc"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/oidc/oidctest"
+	"github.com/st-ember/streaming-api/internal/application/ports/oidc"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	redirectURL = "http://api.test/api/auth/oidc/callback"
+	verifier    = "verifier-verifier-verifier-verifier-verifier"
+)
+
+func challenge(v string) string {
+	sum := sha256.Sum256([]byte(v))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func newProvider(idp *oidctest.IdP, secret string) oidc.Provider {
+	return httpoidc.NewHTTPProvider(httpoidc.Config{
+		IssuerURL:    idp.Issuer(),
+		ClientID:     "client",
+		ClientSecret: secret,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"openid", "email"},
+		GroupsClaim:  "groups",
+	}, time.Second)
+}
+
+// authorize follows the sign in page of the provider and returns the query it redirects back with
+func authorize(t *testing.T, authURL string) url.Values {
+	t.Helper()
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	res, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusFound, res.StatusCode)
+
+	loc, err := url.Parse(res.Header.Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, redirectURL, loc.Scheme+"://"+loc.Host+loc.Path)
+
+	return loc.Query()
+}
+
+func TestHTTPProvider_Flow(t *testing.T) {
+	idp := oidctest.NewServer("client", "secret")
+	defer idp.Close()
+	idp.SetUser(oidctest.User{
+		Subject:       "sub-1",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		Username:      "jane",
+		Groups:        []string{"editors"},
+	})
+
+	provider := newProvider(idp, "secret")
+
+	authURL, err := provider.AuthCodeURL(t.Context(), "state-1", "nonce-1", challenge(verifier))
+	require.NoError(t, err)
+
+	back := authorize(t, authURL)
+	require.Equal(t, "state-1", back.Get("state"))
+
+	identity, err := provider.Exchange(t.Context(), back.Get("code"), verifier)
+
+	require.NoError(t, err)
+	require.Equal(t, &oidc.Identity{
+		Issuer:        idp.Issuer(),
+		Subject:       "sub-1",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		Username:      "jane",
+		Groups:        []string{"editors"},
+		Nonce:         "nonce-1",
+	}, identity)
+}
+
+func TestHTTPProvider_PublicClient(t *testing.T) {
+	idp := oidctest.NewServer("client", "")
+	defer idp.Close()
+	idp.SetUser(oidctest.User{Subject: "sub-1"})
+
+	provider := newProvider(idp, "")
+
+	authURL, err := provider.AuthCodeURL(t.Context(), "state-1", "nonce-1", challenge(verifier))
+	require.NoError(t, err)
+
+	identity, err := provider.Exchange(t.Context(), authorize(t, authURL).Get("code"), verifier)
+
+	require.NoError(t, err)
+	require.Equal(t, "sub-1", identity.Subject)
+}
+
+func TestHTTPProvider_Exchange_Rejected(t *testing.T) {
+	idp := oidctest.NewServer("client", "secret")
+	defer idp.Close()
+	idp.SetUser(oidctest.User{Subject: "sub-1"})
+
+	t.Run("wrong verifier", func(t *testing.T) {
+		provider := newProvider(idp, "secret")
+		authURL, err := provider.AuthCodeURL(t.Context(), "state", "nonce", challenge(verifier))
+		require.NoError(t, err)
+
+		_, err = provider.Exchange(t.Context(), authorize(t, authURL).Get("code"), "another-verifier")
+
+		require.ErrorIs(t, err, oidc.ErrRejected)
+	})
+
+	t.Run("code redeemed twice", func(t *testing.T) {
+		provider := newProvider(idp, "secret")
+		authURL, err := provider.AuthCodeURL(t.Context(), "state", "nonce", challenge(verifier))
+		require.NoError(t, err)
+		code := authorize(t, authURL).Get("code")
+
+		_, err = provider.Exchange(t.Context(), code, verifier)
+		require.NoError(t, err)
+
+		_, err = provider.Exchange(t.Context(), code, verifier)
+		require.ErrorIs(t, err, oidc.ErrRejected)
+	})
+
+	t.Run("wrong client secret", func(t *testing.T) {
+		provider := newProvider(idp, "not-the-secret")
+		authURL, err := provider.AuthCodeURL(t.Context(), "state", "nonce", challenge(verifier))
+		require.NoError(t, err)
+
+		_, err = provider.Exchange(t.Context(), authorize(t, authURL).Get("code"), verifier)
+
+		require.ErrorIs(t, err, oidc.ErrRejected)
+	})
+}
+
+func TestHTTPProvider_IssuerMismatch(t *testing.T) {
+	idp := oidctest.NewServer("client", "secret")
+	defer idp.Close()
+
+	provider := httpoidc.NewHTTPProvider(httpoidc.Config{
+		IssuerURL:   idp.Issuer() + "/",
+		ClientID:    "client",
+		RedirectURL: redirectURL,
+	}, time.Second)
+	_, err := provider.AuthCodeURL(t.Context(), "state", "nonce", challenge(verifier))
+	require.NoError(t, err, "a trailing slash names the same issuer")
+
+	// A provider publishing the configuration of another issuer is not trusted
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"issuer":"` + idp.Issuer() + `","authorization_endpoint":"x","token_endpoint":"x","jwks_uri":"x"}`))
+	}))
+	defer other.Close()
+
+	provider = httpoidc.NewHTTPProvider(httpoidc.Config{
+		IssuerURL:   other.URL,
+		ClientID:    "client",
+		RedirectURL: redirectURL,
+	}, time.Second)
+	_, err = provider.AuthCodeURL(t.Context(), "state", "nonce", challenge(verifier))
+	require.ErrorContains(t, err, "does not match")
+}
@@ -0,0 +1,88 @@
+package mailbox
+
+import (
+	"sync"
+
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+)
+
+// Mailbox holds the updates a reader has not received yet, at most one per job.
+// A newer update of a job replaces the pending one, so a slow reader never blocks
+// the pusher and skips to the newest state, the final progress of a job is never lost.
+type Mailbox struct {
+	mu      sync.Mutex
+	order   []string // Jobs with a pending update, oldest first
+	pending map[string]*progress.Progress
+	notify  chan struct{}
+	behind  bool // Whether an update was ever replaced before being received
+}
+
+func New() *Mailbox {
+	return &Mailbox{
+		pending: make(map[string]*progress.Progress),
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+// Put queues an update of a job.
+// It reports whether the reader fell behind for the first time, replacing an update not received yet.
+func (m *Mailbox) Put(jobID string, prg *progress.Progress) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fellBehind := false
+	if _, ok := m.pending[jobID]; ok {
+		fellBehind = !m.behind
+		m.behind = true
+	} else {
+		m.order = append(m.order, jobID)
+	}
+	m.pending[jobID] = prg
+
+	select {
+	case m.notify <- struct{}{}:
+	default:
+	}
+
+	return fellBehind
+}
+
+// Take removes the oldest pending update, false if there is none
+func (m *Mailbox) Take() (string, *progress.Progress, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.order) == 0 {
+		return "", nil, false
+	}
+
+	jobID := m.order[0]
+	m.order = m.order[1:]
+	prg := m.pending[jobID]
+	delete(m.pending, jobID)
+
+	return jobID, prg, true
+}
+
+// Drop discards the pending update of a job
+func (m *Mailbox) Drop(jobID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.pending[jobID]; !ok {
+		return
+	}
+	delete(m.pending, jobID)
+
+	for i, id := range m.order {
+		if id == jobID {
+			m.order = append(m.order[:i], m.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// Notify is signalled after updates are put, once for any number of them
+func (m *Mailbox) Notify() <-chan struct{} {
+	return m.notify
+}
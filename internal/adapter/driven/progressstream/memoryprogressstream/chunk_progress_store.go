@@ -0,0 +1,72 @@
+package memoryprogressstream
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+)
+
+// chunkProgressRetention keeps the progress of the chunks of a transcode around
+// long enough for the slowest chunk to finish
+const chunkProgressRetention = 24 * time.Hour
+
+// chunkGroup holds the progress of each chunk of a chunked transcode
+type chunkGroup struct {
+	parts      map[int]*progress.Progress
+	recordedAt time.Time
+	expiry     *time.Timer
+}
+
+// MemoryChunkProgressStore keeps the progress of the chunks encoded by the workers of the same process.
+// It serves deployments running the API and the workers in one process without Redis.
+type MemoryChunkProgressStore struct {
+	mu     sync.Mutex
+	groups map[string]*chunkGroup
+}
+
+// NewMemoryChunkProgressStore initializes the MemoryChunkProgressStore struct
+func NewMemoryChunkProgressStore() progressstream.ChunkProgressStore {
+	return &MemoryChunkProgressStore{groups: make(map[string]*chunkGroup)}
+}
+
+// Record stores the progress of a chunk and returns the progress aggregated across all chunks of groupID
+func (s *MemoryChunkProgressStore) Record(ctx context.Context, groupID string, index, count int, prg *progress.Progress) (*progress.Progress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	group, ok := s.groups[groupID]
+	if !ok {
+		group = &chunkGroup{parts: make(map[int]*progress.Progress)}
+		group.expiry = time.AfterFunc(chunkProgressRetention, func() { s.expire(groupID) })
+		s.groups[groupID] = group
+	} else {
+		group.expiry.Reset(chunkProgressRetention)
+	}
+
+	part := *prg
+	group.parts[index] = &part
+	group.recordedAt = time.Now()
+
+	parts := make([]*progress.Progress, 0, len(group.parts))
+	for _, part := range group.parts {
+		parts = append(parts, part)
+	}
+
+	return progress.Aggregate(parts, count), nil
+}
+
+// expire forgets the chunks of a transcode no progress was recorded for in a while
+func (s *MemoryChunkProgressStore) expire(groupID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	group, ok := s.groups[groupID]
+	if !ok || time.Since(group.recordedAt) < chunkProgressRetention {
+		return // recorded again since the timer fired
+	}
+
+	delete(s.groups, groupID)
+}
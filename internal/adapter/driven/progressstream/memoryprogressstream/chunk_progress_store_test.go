@@ -0,0 +1,54 @@
+package memoryprogressstream_test
+
+import (
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/progressstream/memoryprogressstream"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryChunkProgressStore(t *testing.T) {
+	t.Run("success case - aggregated across chunks", func(t *testing.T) {
+		store := memoryprogressstream.NewMemoryChunkProgressStore()
+		groupID := "assemble_job"
+
+		// First of two chunks half done, the other one is estimated as long
+		first, _ := progress.NewProgress(100)
+		first.UpdateCurrentFrames(50)
+		aggregated, err := store.Record(t.Context(), groupID, 0, 2, first)
+		require.NoError(t, err)
+		require.Equal(t, int64(200), aggregated.TotalFrames)
+		require.Equal(t, 25, aggregated.Stages[0].Percentage) // Encode stage
+
+		// Second chunk reports its real length
+		second, _ := progress.NewProgress(300)
+		second.UpdateCurrentFrames(150)
+		aggregated, err = store.Record(t.Context(), groupID, 1, 2, second)
+		require.NoError(t, err)
+		require.Equal(t, int64(400), aggregated.TotalFrames)
+		require.Equal(t, int64(200), aggregated.CurrentFrames)
+		require.Equal(t, 50, aggregated.Stages[0].Percentage) // Encode stage
+
+		// A failing chunk fails the whole transcode
+		failed, _ := progress.NewProgress(100)
+		failed.MarkAsError()
+		aggregated, err = store.Record(t.Context(), groupID, 1, 2, failed)
+		require.NoError(t, err)
+		require.Equal(t, progress.StatusError, aggregated.Status)
+	})
+
+	t.Run("group isolation - chunks of other transcodes are not aggregated", func(t *testing.T) {
+		store := memoryprogressstream.NewMemoryChunkProgressStore()
+
+		other, _ := progress.NewProgress(100)
+		other.UpdateCurrentFrames(100)
+		_, err := store.Record(t.Context(), "other_job", 0, 1, other)
+		require.NoError(t, err)
+
+		prg, _ := progress.NewProgress(100)
+		aggregated, err := store.Record(t.Context(), "assemble_job", 0, 1, prg)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), aggregated.CurrentFrames)
+	})
+}
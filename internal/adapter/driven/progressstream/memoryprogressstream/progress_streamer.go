@@ -0,0 +1,203 @@
+package memoryprogressstream
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/progressstream/mailbox"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+)
+
+// latestProgressRetention keeps the last progress of a job for clients connecting late
+const latestProgressRetention = 24 * time.Hour
+
+// jobStream holds the progress of a job and the readers following it
+type jobStream struct {
+	sequence int64
+	latest   *progress.Progress
+	readers  map[*mailbox.Mailbox]struct{}
+	pushedAt time.Time
+	expiry   *time.Timer
+}
+
+// MemoryProgressStreamer fans progress out to the readers of the same process.
+// It serves deployments running the API and the workers in one process without Redis.
+type MemoryProgressStreamer struct {
+	logger log.Logger
+
+	mu   sync.Mutex
+	jobs map[string]*jobStream
+	subs map[*memorySubscription]struct{}
+}
+
+// NewMemoryProgressStreamer initializes the MemoryProgressStreamer struct
+func NewMemoryProgressStreamer(logger log.Logger) progressstream.ProgressStreamer {
+	return &MemoryProgressStreamer{
+		logger: logger,
+		jobs:   make(map[string]*jobStream),
+		subs:   make(map[*memorySubscription]struct{}),
+	}
+}
+
+// Push hands progress objects to the readers of the job without waiting for them,
+// and keeps the latest one for readers coming later.
+// A reader slower than the updates skips to the latest progress.
+func (p *MemoryProgressStreamer) Push(ctx context.Context, jobID string, prg *progress.Progress) error {
+	p.mu.Lock()
+	behind := p.push(jobID, prg)
+	p.mu.Unlock()
+
+	if behind > 0 {
+		p.logger.Warnf(ctx, log.CategoryJob, jobID, "%d readers fell behind the progress of job %s, skipping to the latest", behind, jobID)
+	}
+
+	return nil
+}
+
+// Read returns a channel with continuously updated progress objects for a client connection to consume.
+// The latest progress pushed comes first, the channel is closed after a finished progress.
+func (p *MemoryProgressStreamer) Read(ctx context.Context, jobID string) (<-chan *progress.Progress, error) {
+	ch := make(chan *progress.Progress)
+	mb := mailbox.New()
+
+	// Queue the snapshot while registering, so no update falls in between
+	p.mu.Lock()
+	stream := p.stream(jobID)
+	if stream.latest != nil {
+		mb.Put(jobID, stream.latest)
+	}
+	if stream.latest == nil || !stream.latest.IsFinished() {
+		stream.readers[mb] = struct{}{}
+	}
+	p.mu.Unlock()
+
+	go func() {
+		defer close(ch)
+		defer p.removeReader(jobID, mb)
+
+		for {
+			for {
+				_, prg, ok := mb.Take()
+				if !ok {
+					break
+				}
+
+				select {
+				case ch <- prg:
+				case <-ctx.Done():
+					return
+				}
+
+				if prg.IsFinished() {
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-mb.Notify():
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// Reset forgets the latest progress of the job, the sequence numbering its updates goes on
+func (p *MemoryProgressStreamer) Reset(ctx context.Context, jobID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if stream, ok := p.jobs[jobID]; ok {
+		stream.latest = nil
+	}
+
+	return nil
+}
+
+// push numbers an update, keeps it as the latest and queues it for every reader of the job.
+// It returns the number of readers that fell behind, p.mu must be held.
+func (p *MemoryProgressStreamer) push(jobID string, prg *progress.Progress) int {
+	stream := p.stream(jobID)
+	if stream.expiry == nil {
+		stream.expiry = time.AfterFunc(latestProgressRetention, func() { p.expireJob(jobID) })
+	} else {
+		stream.expiry.Reset(latestProgressRetention)
+	}
+
+	// Number the update, so clients can tell the ones they already received
+	stream.sequence++
+	numbered := *prg
+	numbered.Sequence = stream.sequence
+	stream.latest = &numbered
+	stream.pushedAt = time.Now()
+
+	behind := 0
+	for mb := range stream.readers {
+		if mb.Put(jobID, &numbered) {
+			behind++
+		}
+	}
+	for sub := range p.subs {
+		if sub.deliver(jobID, &numbered) {
+			behind++
+		}
+	}
+
+	// Nothing follows a finished progress
+	if numbered.IsFinished() {
+		clear(stream.readers)
+	}
+
+	return behind
+}
+
+// stream returns the state of a job, creating it if needed. p.mu must be held.
+func (p *MemoryProgressStreamer) stream(jobID string) *jobStream {
+	stream, ok := p.jobs[jobID]
+	if !ok {
+		stream = &jobStream{readers: make(map[*mailbox.Mailbox]struct{})}
+		p.jobs[jobID] = stream
+	}
+
+	return stream
+}
+
+// removeReader stops queuing updates for a reader,
+// forgetting the job if nothing was ever pushed for it
+func (p *MemoryProgressStreamer) removeReader(jobID string, mb *mailbox.Mailbox) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stream, ok := p.jobs[jobID]
+	if !ok {
+		return
+	}
+
+	delete(stream.readers, mb)
+	if stream.latest == nil && len(stream.readers) == 0 {
+		delete(p.jobs, jobID)
+	}
+}
+
+// expireJob forgets a job no progress was pushed for in a while, unless it is still read
+func (p *MemoryProgressStreamer) expireJob(jobID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stream, ok := p.jobs[jobID]
+	if !ok || time.Since(stream.pushedAt) < latestProgressRetention {
+		return // pushed again since the timer fired
+	}
+
+	if len(stream.readers) > 0 {
+		stream.expiry.Reset(latestProgressRetention)
+		return
+	}
+
+	delete(p.jobs, jobID)
+}
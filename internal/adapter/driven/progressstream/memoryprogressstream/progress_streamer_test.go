@@ -0,0 +1,187 @@
+package memoryprogressstream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/progressstream/memoryprogressstream"
+	mockLog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// next waits for the next progress of a reader
+func next(t *testing.T, ch <-chan *progress.Progress) *progress.Progress {
+	t.Helper()
+
+	select {
+	case received, ok := <-ch:
+		require.True(t, ok, "Channel closed unexpectedly")
+		return received
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Timed out waiting for an update")
+		return nil
+	}
+}
+
+func TestMemoryProgressStreamer(t *testing.T) {
+	t.Run("success case - multiple updates", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		jobID := "test_job"
+		ch, err := streamer.Read(t.Context(), jobID)
+		require.NoError(t, err)
+
+		prg, _ := progress.NewProgress(100)
+
+		// Sequence of updates
+		updates := []int64{25, 50, 75, 100}
+		for i, val := range updates {
+			prg.UpdateCurrentFrames(val)
+			require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+
+			received := next(t, ch)
+			require.Equal(t, int(val), received.Percentage)
+			require.Equal(t, int64(i+1), received.Sequence)
+		}
+	})
+
+	t.Run("late subscriber - receives the latest progress first", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		jobID := "late_job"
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(30)
+		require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+
+		// Subscribe after the push
+		ch, err := streamer.Read(t.Context(), jobID)
+		require.NoError(t, err)
+		require.Equal(t, 30, next(t, ch).Percentage)
+
+		// Live updates follow the snapshot
+		prg.UpdateCurrentFrames(60)
+		require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+		require.Equal(t, 60, next(t, ch).Percentage)
+	})
+
+	t.Run("finished job - replays the final progress and closes channel", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		jobID := "finished_job"
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(100)
+		prg.End()
+		require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+
+		ch, err := streamer.Read(t.Context(), jobID)
+		require.NoError(t, err)
+		require.Equal(t, progress.StatusEnd, next(t, ch).Status)
+
+		select {
+		case _, ok := <-ch:
+			require.False(t, ok, "Channel should be closed after the final progress")
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Channel was not closed in time")
+		}
+	})
+
+	t.Run("retried job - the final progress of the previous attempt is forgotten", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		jobID := "retried_job"
+		prg, _ := progress.NewProgress(100)
+		prg.MarkAsError()
+		require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+
+		require.NoError(t, streamer.Reset(t.Context(), jobID))
+
+		// The reader waits for the new attempt instead of closing on the failed one
+		ch, err := streamer.Read(t.Context(), jobID)
+		require.NoError(t, err)
+
+		retry, _ := progress.NewProgress(100)
+		retry.UpdateCurrentFrames(10)
+		require.NoError(t, streamer.Push(t.Context(), jobID, retry))
+
+		received := next(t, ch)
+		require.Equal(t, progress.StatusContinue, received.Status)
+		require.Equal(t, 10, received.Percentage)
+		// Sequences go on, clients resuming from the previous attempt miss nothing
+		require.Equal(t, int64(2), received.Sequence)
+	})
+
+	t.Run("context cancellation - stops goroutine and closes channel", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		ctx, cancel := context.WithCancel(t.Context())
+		ch, err := streamer.Read(ctx, "cancel_job")
+		require.NoError(t, err)
+
+		// Cancel context
+		cancel()
+
+		select {
+		case _, ok := <-ch:
+			require.False(t, ok, "Channel should be closed after context cancellation")
+		case <-time.After(time.Second):
+			t.Fatal("Channel was not closed in time")
+		}
+	})
+
+	t.Run("slow reader - skips to the latest progress without blocking the pusher", func(t *testing.T) {
+		logger := mockLog.NewMockLogger(t)
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(logger)
+
+		jobID := "slow_job"
+		ch, err := streamer.Read(t.Context(), jobID)
+		require.NoError(t, err)
+
+		// Falling behind is reported once
+		logger.EXPECT().Warnf(mock.Anything, mock.Anything, jobID, mock.Anything, mock.Anything).Once()
+
+		// Push many updates while nothing is received
+		prg, _ := progress.NewProgress(1000)
+		for frames := int64(1); frames <= 1000; frames++ {
+			prg.UpdateCurrentFrames(frames)
+			require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+		}
+		prg.End()
+		require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+
+		// Intermediate updates are skipped, the final one is never lost
+		var last *progress.Progress
+		for received := range ch {
+			require.True(t, last == nil || received.Sequence > last.Sequence)
+			last = received
+		}
+		require.NotNil(t, last)
+		require.Equal(t, progress.StatusEnd, last.Status)
+		require.Equal(t, int64(1001), last.Sequence)
+	})
+
+	t.Run("channel isolation - updates go to correct job", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		jobA, jobB := "job_A", "job_B"
+		chA, _ := streamer.Read(t.Context(), jobA)
+		chB, _ := streamer.Read(t.Context(), jobB)
+
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(42)
+
+		// Push to Job A
+		require.NoError(t, streamer.Push(t.Context(), jobA, prg))
+
+		select {
+		case received := <-chA:
+			require.Equal(t, 42, received.Percentage)
+		case <-chB:
+			t.Fatal("Received update on Job B channel meant for Job A")
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Did not receive update for Job A")
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package memoryprogressstream
+
+import (
+	"context"
+	"sync"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/progressstream/mailbox"
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+)
+
+// memorySubscription receives the updates of the followed jobs from every push.
+// Its followed jobs are guarded by the streamer lock, so following a job
+// and queuing its updates never interleave.
+type memorySubscription struct {
+	streamer *MemoryProgressStreamer
+	mailbox  *mailbox.Mailbox
+	ch       chan progressstream.JobProgress
+	done     chan struct{}
+	close    sync.Once
+
+	jobs map[string]struct{} // Followed jobs
+}
+
+// Subscribe opens a subscription on the progress of every job.
+// Nothing is delivered until jobs are followed.
+func (p *MemoryProgressStreamer) Subscribe(ctx context.Context) (progressstream.Subscription, error) {
+	sub := &memorySubscription{
+		streamer: p,
+		mailbox:  mailbox.New(),
+		ch:       make(chan progressstream.JobProgress),
+		done:     make(chan struct{}),
+		jobs:     make(map[string]struct{}),
+	}
+
+	p.mu.Lock()
+	p.subs[sub] = struct{}{}
+	p.mu.Unlock()
+
+	go sub.run(ctx)
+
+	return sub, nil
+}
+
+// Follow starts delivering the updates of a job, after its latest progress.
+// It never waits for Progress to be received.
+func (s *memorySubscription) Follow(ctx context.Context, jobID string) error {
+	s.streamer.mu.Lock()
+	defer s.streamer.mu.Unlock()
+
+	if _, ok := s.jobs[jobID]; ok {
+		return nil
+	}
+	s.jobs[jobID] = struct{}{}
+
+	if stream, ok := s.streamer.jobs[jobID]; ok && stream.latest != nil {
+		s.deliver(jobID, stream.latest)
+	}
+
+	return nil
+}
+
+// Unfollow stops delivering the updates of a job, dropping the one not received yet
+func (s *memorySubscription) Unfollow(jobID string) {
+	s.streamer.mu.Lock()
+	defer s.streamer.mu.Unlock()
+
+	delete(s.jobs, jobID)
+	s.mailbox.Drop(jobID)
+}
+
+// Progress delivers the updates of every followed job
+func (s *memorySubscription) Progress() <-chan progressstream.JobProgress {
+	return s.ch
+}
+
+// Close ends the subscription, closing its progress channel
+func (s *memorySubscription) Close() error {
+	s.close.Do(func() {
+		close(s.done)
+
+		s.streamer.mu.Lock()
+		delete(s.streamer.subs, s)
+		s.streamer.mu.Unlock()
+	})
+
+	return nil
+}
+
+// run hands the queued updates to the reader until the subscription is closed
+func (s *memorySubscription) run(ctx context.Context) {
+	defer close(s.ch)
+	defer s.Close()
+
+	for {
+		for {
+			jobID, prg, ok := s.mailbox.Take()
+			if !ok {
+				break
+			}
+
+			select {
+			case s.ch <- progressstream.JobProgress{JobID: jobID, Progress: prg}:
+			case <-ctx.Done():
+				return
+			case <-s.done:
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.done:
+			return
+		case <-s.mailbox.Notify():
+		}
+	}
+}
+
+// deliver queues an update if the job is followed, reporting whether the reader fell behind.
+// The job is no longer followed after a finished progress. The streamer lock must be held.
+func (s *memorySubscription) deliver(jobID string, prg *progress.Progress) bool {
+	if _, ok := s.jobs[jobID]; !ok {
+		return false
+	}
+	if prg.IsFinished() {
+		delete(s.jobs, jobID)
+	}
+
+	return s.mailbox.Put(jobID, prg)
+}
@@ -0,0 +1,171 @@
+package memoryprogressstream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/progressstream/memoryprogressstream"
+	mockLog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+	"github.com/stretchr/testify/require"
+)
+
+// receive waits for the next update of a subscription
+func receive(t *testing.T, sub progressstream.Subscription) progressstream.JobProgress {
+	t.Helper()
+
+	select {
+	case jp, ok := <-sub.Progress():
+		require.True(t, ok, "Subscription closed unexpectedly")
+		return jp
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Timed out waiting for an update")
+		return progressstream.JobProgress{}
+	}
+}
+
+// requireNoUpdate checks that nothing is delivered for a while
+func requireNoUpdate(t *testing.T, sub progressstream.Subscription) {
+	t.Helper()
+
+	select {
+	case jp := <-sub.Progress():
+		t.Fatalf("Unexpected update for job %s", jp.JobID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestMemorySubscription(t *testing.T) {
+	t.Run("success case - updates of followed jobs are tagged", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		sub, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer sub.Close()
+
+		require.NoError(t, sub.Follow(t.Context(), "job_A"))
+		require.NoError(t, sub.Follow(t.Context(), "job_B"))
+
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(20)
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+		prg.UpdateCurrentFrames(70)
+		require.NoError(t, streamer.Push(t.Context(), "job_B", prg))
+
+		jp := receive(t, sub)
+		require.Equal(t, "job_A", jp.JobID)
+		require.Equal(t, 20, jp.Progress.Percentage)
+
+		jp = receive(t, sub)
+		require.Equal(t, "job_B", jp.JobID)
+		require.Equal(t, 70, jp.Progress.Percentage)
+	})
+
+	t.Run("unfollowed jobs - updates are not delivered", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		sub, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer sub.Close()
+
+		require.NoError(t, sub.Follow(t.Context(), "job_A"))
+		sub.Unfollow("job_A")
+
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(20)
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+		require.NoError(t, streamer.Push(t.Context(), "job_C", prg))
+
+		requireNoUpdate(t, sub)
+	})
+
+	t.Run("follow - replays the latest progress once", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(30)
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+
+		sub, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer sub.Close()
+
+		// Following never waits for the snapshot to be received
+		require.NoError(t, sub.Follow(t.Context(), "job_A"))
+
+		jp := receive(t, sub)
+		require.Equal(t, 30, jp.Progress.Percentage)
+		require.Equal(t, int64(1), jp.Progress.Sequence)
+
+		prg.UpdateCurrentFrames(60)
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+
+		jp = receive(t, sub)
+		require.Equal(t, 60, jp.Progress.Percentage)
+		require.Equal(t, int64(2), jp.Progress.Sequence)
+	})
+
+	t.Run("finished job - is no longer followed", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		sub, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer sub.Close()
+
+		require.NoError(t, sub.Follow(t.Context(), "job_A"))
+
+		prg, _ := progress.NewProgress(100)
+		prg.End()
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+		require.Equal(t, progress.StatusEnd, receive(t, sub).Progress.Status)
+
+		// A stray update after the end is dropped
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+		requireNoUpdate(t, sub)
+	})
+
+	t.Run("retried job - follows the new attempt", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		prg, _ := progress.NewProgress(100)
+		prg.MarkAsError()
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+		require.NoError(t, streamer.Reset(t.Context(), "job_A"))
+
+		sub, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer sub.Close()
+
+		// Nothing is replayed, the job stays followed
+		require.NoError(t, sub.Follow(t.Context(), "job_A"))
+		requireNoUpdate(t, sub)
+
+		retry, _ := progress.NewProgress(100)
+		retry.UpdateCurrentFrames(10)
+		require.NoError(t, streamer.Push(t.Context(), "job_A", retry))
+
+		jp := receive(t, sub)
+		require.Equal(t, progress.StatusContinue, jp.Progress.Status)
+		require.Equal(t, int64(2), jp.Progress.Sequence)
+	})
+
+	t.Run("context cancellation - closes the progress channel", func(t *testing.T) {
+		streamer := memoryprogressstream.NewMemoryProgressStreamer(mockLog.NewMockLogger(t))
+
+		ctx, cancel := context.WithCancel(t.Context())
+		sub, err := streamer.Subscribe(ctx)
+		require.NoError(t, err)
+
+		cancel()
+
+		select {
+		case _, ok := <-sub.Progress():
+			require.False(t, ok, "Channel should be closed after context cancellation")
+		case <-time.After(time.Second):
+			t.Fatal("Channel was not closed in time")
+		}
+		require.NoError(t, sub.Close())
+	})
+}
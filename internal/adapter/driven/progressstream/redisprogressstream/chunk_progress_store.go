@@ -0,0 +1,67 @@
+package redisprogressstream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/redis"
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+)
+
+// chunkProgressTTL keeps the progress of the chunks of a transcode around
+// long enough for the slowest chunk to finish
+const chunkProgressTTL = 24 * time.Hour
+
+type RedisChunkProgressStore struct {
+	Client *redis.Client
+}
+
+// NewRedisChunkProgressStore initializes the RedisChunkProgressStore struct
+func NewRedisChunkProgressStore(client *redis.Client) progressstream.ChunkProgressStore {
+	return &RedisChunkProgressStore{Client: client}
+}
+
+// Record stores the progress of a chunk in a hash shared by the workers of every node,
+// then returns the progress aggregated across all chunks of groupID
+func (s *RedisChunkProgressStore) Record(ctx context.Context, groupID string, index, count int, prg *progress.Progress) (*progress.Progress, error) {
+	// Marshal progress object
+	data, err := json.Marshal(prg)
+	if err != nil {
+		return nil, fmt.Errorf("marshal progress for chunk %d of %s: %w", index, groupID, err)
+	}
+
+	// Record the chunk progress
+	key := s.buildChunksKey(groupID)
+	if err := s.Client.Rdb.HSet(ctx, key, strconv.Itoa(index), data).Err(); err != nil {
+		return nil, fmt.Errorf("store progress for chunk %d of %s: %w", index, groupID, err)
+	}
+	if err := s.Client.Rdb.Expire(ctx, key, chunkProgressTTL).Err(); err != nil {
+		return nil, fmt.Errorf("set expiry of chunk progress for %s: %w", groupID, err)
+	}
+
+	// Read the progress of every chunk reported so far
+	fields, err := s.Client.Rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("read chunk progress for %s: %w", groupID, err)
+	}
+
+	parts := make([]*progress.Progress, 0, len(fields))
+	for field, value := range fields {
+		var part progress.Progress
+		if err := json.Unmarshal([]byte(value), &part); err != nil {
+			return nil, fmt.Errorf("unmarshal progress for chunk %s of %s: %w", field, groupID, err)
+		}
+		parts = append(parts, &part)
+	}
+
+	return progress.Aggregate(parts, count), nil
+}
+
+// buildChunksKey builds the key of the hash holding the progress of each chunk
+func (s *RedisChunkProgressStore) buildChunksKey(groupID string) string {
+	return fmt.Sprintf("video:%s:chunks", groupID)
+}
@@ -0,0 +1,75 @@
+package redisprogressstream_test
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/progressstream/redisprogressstream"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/redis"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisChunkProgressStore(t *testing.T) {
+	t.Run("success case - aggregated across chunks", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		store := redisprogressstream.NewRedisChunkProgressStore(client)
+
+		groupID := "assemble_job"
+
+		// First of two chunks half done, the other one is estimated as long
+		first, _ := progress.NewProgress(100)
+		first.UpdateCurrentFrames(50)
+		aggregated, err := store.Record(t.Context(), groupID, 0, 2, first)
+		require.NoError(t, err)
+		require.Equal(t, int64(200), aggregated.TotalFrames)
+		require.Equal(t, 25, aggregated.Stages[0].Percentage) // Encode stage
+
+		// Second chunk reports its real length
+		second, _ := progress.NewProgress(300)
+		second.UpdateCurrentFrames(150)
+		aggregated, err = store.Record(t.Context(), groupID, 1, 2, second)
+		require.NoError(t, err)
+		require.Equal(t, int64(400), aggregated.TotalFrames)
+		require.Equal(t, int64(200), aggregated.CurrentFrames)
+		require.Equal(t, 50, aggregated.Stages[0].Percentage) // Encode stage
+
+		// Finished chunks leave the end to the assemble job
+		first.UpdateCurrentFrames(100)
+		first.End()
+		second.UpdateCurrentFrames(300)
+		second.End()
+		_, err = store.Record(t.Context(), groupID, 0, 2, first)
+		require.NoError(t, err)
+		aggregated, err = store.Record(t.Context(), groupID, 1, 2, second)
+		require.NoError(t, err)
+		require.Equal(t, 100, aggregated.Stages[0].Percentage) // Encode stage
+		require.Equal(t, progress.StatusContinue, aggregated.Status)
+
+		// A failing chunk fails the whole transcode
+		failed, _ := progress.NewProgress(100)
+		failed.MarkAsError()
+		aggregated, err = store.Record(t.Context(), groupID, 1, 2, failed)
+		require.NoError(t, err)
+		require.Equal(t, progress.StatusError, aggregated.Status)
+	})
+
+	t.Run("shared store - chunks recorded by another node are aggregated", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		node1 := redisprogressstream.NewRedisChunkProgressStore(client)
+		node2 := redisprogressstream.NewRedisChunkProgressStore(client)
+
+		first, _ := progress.NewProgress(100)
+		first.UpdateCurrentFrames(100)
+		_, err := node1.Record(t.Context(), "assemble_job", 0, 2, first)
+		require.NoError(t, err)
+
+		second, _ := progress.NewProgress(100)
+		aggregated, err := node2.Record(t.Context(), "assemble_job", 1, 2, second)
+		require.NoError(t, err)
+		require.Equal(t, int64(100), aggregated.CurrentFrames)
+		require.Equal(t, 50, aggregated.Stages[0].Percentage) // Encode stage
+	})
+}
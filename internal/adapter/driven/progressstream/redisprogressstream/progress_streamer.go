@@ -3,41 +3,67 @@ package redisprogressstream
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
+	goredis "github.com/redis/go-redis/v9"
 	"github.com/st-ember/streaming-api/internal/adapter/driven/redis"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
 	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
 	"github.com/st-ember/streaming-api/internal/domain/progress"
 )
 
+// latestProgressTTL keeps the last progress of a job for clients connecting late
+const latestProgressTTL = 24 * time.Hour
+
 type RedisProgressStreamer struct {
 	Client *redis.Client
 	logger log.Logger
+	hub    *subscriptionHub
 }
 
 // NewRedisProgressStreamer initializes the RedisProgressStreamer struct
 func NewRedisProgressStreamer(client *redis.Client, logger log.Logger) progressstream.ProgressStreamer {
-	return &RedisProgressStreamer{Client: client, logger: logger}
+	return &RedisProgressStreamer{Client: client, logger: logger, hub: newSubscriptionHub(client, logger)}
 }
 
-// Push publishes progress objects to redis for clients to consume in real-time
+// Push publishes progress objects to redis for clients to consume in real-time,
+// and keeps the latest one for clients subscribing later
 func (p *RedisProgressStreamer) Push(ctx context.Context, jobID string, prg *progress.Progress) error {
+	// Number the update, so clients can tell the ones they already received
+	seqKey := p.buildSequenceKey(jobID)
+	seq, err := p.Client.Rdb.Incr(ctx, seqKey).Result()
+	if err != nil {
+		return fmt.Errorf("number progress for job %s: %w", jobID, err)
+	}
+	if err := p.Client.Rdb.Expire(ctx, seqKey, latestProgressTTL).Err(); err != nil {
+		return fmt.Errorf("set expiry of progress sequence for job %s: %w", jobID, err)
+	}
+
 	// Marshal progress object
-	data, err := json.Marshal(prg)
+	numbered := *prg
+	numbered.Sequence = seq
+	data, err := json.Marshal(&numbered)
 	if err != nil {
 		return fmt.Errorf("marshal progress for job %s: %w", jobID, err)
 	}
 
-	// Push to redis
-	if err := p.Client.Rdb.Publish(ctx, p.buildChannel(jobID), data).Err(); err != nil {
+	// Store and push to redis at once, so a reader never misses both
+	_, err = p.Client.Rdb.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Set(ctx, p.buildLatestKey(jobID), data, latestProgressTTL)
+		pipe.Publish(ctx, p.buildChannel(jobID), data)
+		return nil
+	})
+	if err != nil {
 		return fmt.Errorf("publish progress to redis for job %s: %w", jobID, err)
 	}
 
 	return nil
 }
 
-// Read returns a channel with continuously updated progress objects for a client connection to consume
+// Read returns a channel with continuously updated progress objects for a client connection to consume.
+// The latest progress pushed comes first, the channel is closed after a finished progress.
 func (p *RedisProgressStreamer) Read(ctx context.Context, jobID string) (<-chan *progress.Progress, error) {
 	ch := make(chan *progress.Progress)
 	redisCh := p.buildChannel(jobID)
@@ -49,10 +75,29 @@ func (p *RedisProgressStreamer) Read(ctx context.Context, jobID string) (<-chan
 		return nil, fmt.Errorf("subscribe to redis channel %s: %w", redisCh, err)
 	}
 
+	// Read the snapshot once subscribed, so no update falls in between
+	latest, err := p.latest(ctx, jobID)
+	if err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+
 	go func() {
 		defer close(ch)
 		defer pubsub.Close()
 
+		if latest != nil {
+			select {
+			case ch <- latest:
+			case <-ctx.Done():
+				return
+			}
+
+			if latest.IsFinished() {
+				return
+			}
+		}
+
 		redisMsgCh := pubsub.Channel()
 
 		// Push all received progress to go channel
@@ -71,11 +116,20 @@ func (p *RedisProgressStreamer) Read(ctx context.Context, jobID string) (<-chan
 					continue
 				}
 
+				// Pushed before the snapshot was read, the reader already has it or a later one
+				if latest != nil && prg.Sequence <= latest.Sequence {
+					continue
+				}
+
 				select {
 				case ch <- &prg:
 				case <-ctx.Done():
 					return
 				}
+
+				if prg.IsFinished() {
+					return
+				}
 			}
 		}
 	}()
@@ -83,7 +137,44 @@ func (p *RedisProgressStreamer) Read(ctx context.Context, jobID string) (<-chan
 	return ch, nil
 }
 
+// Reset deletes the latest progress kept for the job, the sequence numbering its updates goes on
+func (p *RedisProgressStreamer) Reset(ctx context.Context, jobID string) error {
+	if err := p.Client.Rdb.Del(ctx, p.buildLatestKey(jobID)).Err(); err != nil {
+		return fmt.Errorf("delete latest progress for job %s: %w", jobID, err)
+	}
+
+	return nil
+}
+
+// latest reads the last progress pushed for the job, nil if none is kept
+func (p *RedisProgressStreamer) latest(ctx context.Context, jobID string) (*progress.Progress, error) {
+	data, err := p.Client.Rdb.Get(ctx, p.buildLatestKey(jobID)).Bytes()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read latest progress for job %s: %w", jobID, err)
+	}
+
+	var prg progress.Progress
+	if err := json.Unmarshal(data, &prg); err != nil {
+		return nil, fmt.Errorf("unmarshal latest progress for job %s: %w", jobID, err)
+	}
+
+	return &prg, nil
+}
+
 // buildChannel builds channel name for methods in RedisProgressStreamer
 func (p *RedisProgressStreamer) buildChannel(jobID string) string {
 	return fmt.Sprintf("video:%s:progress", jobID)
 }
+
+// buildLatestKey builds the key holding the last progress pushed for a job
+func (p *RedisProgressStreamer) buildLatestKey(jobID string) string {
+	return fmt.Sprintf("video:%s:progress:latest", jobID)
+}
+
+// buildSequenceKey builds the key of the counter numbering the progress of a job
+func (p *RedisProgressStreamer) buildSequenceKey(jobID string) string {
+	return fmt.Sprintf("video:%s:progress:seq", jobID)
+}
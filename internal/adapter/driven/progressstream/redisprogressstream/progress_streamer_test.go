@@ -30,7 +30,7 @@ func TestRedisProgressStreamer(t *testing.T) {
 
 		// Sequence of updates
 		updates := []int64{25, 50, 75, 100}
-		for _, val := range updates {
+		for i, val := range updates {
 			prg.UpdateCurrentFrames(val)
 			err = streamer.Push(t.Context(), jobID, prg)
 			require.NoError(t, err)
@@ -38,12 +38,147 @@ func TestRedisProgressStreamer(t *testing.T) {
 			select {
 			case received := <-ch:
 				require.Equal(t, int(val), received.Percentage)
+				require.Equal(t, int64(i+1), received.Sequence)
 			case <-time.After(500 * time.Millisecond):
 				t.Fatalf("Timed out waiting for update %d", val)
 			}
 		}
 	})
 
+	t.Run("late subscriber - receives the latest progress first", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		logger := mockLog.NewMockLogger(t)
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, logger)
+
+		jobID := "late_job"
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(30)
+		require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+
+		// Subscribe after the push
+		ch, err := streamer.Read(t.Context(), jobID)
+		require.NoError(t, err)
+
+		select {
+		case received := <-ch:
+			require.Equal(t, 30, received.Percentage)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Latest progress was not replayed")
+		}
+
+		// Live updates follow the snapshot
+		prg.UpdateCurrentFrames(60)
+		require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+
+		select {
+		case received := <-ch:
+			require.Equal(t, 60, received.Percentage)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Did not receive live update after the snapshot")
+		}
+	})
+
+	t.Run("finished job - replays the final progress and closes channel", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		logger := mockLog.NewMockLogger(t)
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, logger)
+
+		jobID := "finished_job"
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(100)
+		prg.End()
+		require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+
+		ch, err := streamer.Read(t.Context(), jobID)
+		require.NoError(t, err)
+
+		select {
+		case received := <-ch:
+			require.Equal(t, progress.StatusEnd, received.Status)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Final progress was not replayed")
+		}
+
+		select {
+		case _, ok := <-ch:
+			require.False(t, ok, "Channel should be closed after the final progress")
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Channel was not closed in time")
+		}
+
+		// The snapshot expires eventually
+		require.Greater(t, s.TTL(fmt.Sprintf("video:%s:progress:latest", jobID)), time.Duration(0))
+	})
+
+	t.Run("retried job - the final progress of the previous attempt is forgotten", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		logger := mockLog.NewMockLogger(t)
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, logger)
+
+		jobID := "retried_job"
+		prg, _ := progress.NewProgress(100)
+		prg.MarkAsError()
+		require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+
+		require.NoError(t, streamer.Reset(t.Context(), jobID))
+
+		ch, err := streamer.Read(t.Context(), jobID)
+		require.NoError(t, err)
+
+		// The reader waits for the new attempt instead of closing on the failed one
+		retry, _ := progress.NewProgress(100)
+		retry.UpdateCurrentFrames(10)
+		require.NoError(t, streamer.Push(t.Context(), jobID, retry))
+
+		select {
+		case received := <-ch:
+			require.Equal(t, progress.StatusContinue, received.Status)
+			require.Equal(t, 10, received.Percentage)
+			// Sequences go on, clients resuming from the previous attempt miss nothing
+			require.Equal(t, int64(2), received.Sequence)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Did not receive the progress of the new attempt")
+		}
+	})
+
+	t.Run("late subscriber - skips live updates older than the snapshot", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		logger := mockLog.NewMockLogger(t)
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, logger)
+
+		jobID := "overlap_job"
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(30)
+		require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+
+		ch, err := streamer.Read(t.Context(), jobID)
+		require.NoError(t, err)
+
+		select {
+		case received := <-ch:
+			require.Equal(t, int64(1), received.Sequence)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Latest progress was not replayed")
+		}
+
+		// An update already in the snapshot, received live after it
+		s.Publish(fmt.Sprintf("video:%s:progress", jobID), `{"Percentage":30,"Sequence":1}`)
+		prg.UpdateCurrentFrames(60)
+		require.NoError(t, streamer.Push(t.Context(), jobID, prg))
+
+		select {
+		case received := <-ch:
+			require.Equal(t, int64(2), received.Sequence)
+			require.Equal(t, 60, received.Percentage)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Did not receive live update after the snapshot")
+		}
+	})
+
 	t.Run("context cancellation - stops goroutine and closes channel", func(t *testing.T) {
 		s := miniredis.RunT(t)
 		client, _ := redis.NewClient([]string{s.Addr()}, "")
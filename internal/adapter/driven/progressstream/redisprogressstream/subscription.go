@@ -0,0 +1,166 @@
+package redisprogressstream
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/progressstream/mailbox"
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+)
+
+// redisSubscription follows many jobs through the pattern subscription shared by the process,
+// receiving the updates of the followed jobs only
+type redisSubscription struct {
+	streamer *RedisProgressStreamer
+	mailbox  *mailbox.Mailbox
+	ch       chan progressstream.JobProgress
+	done     chan struct{}
+	close    sync.Once
+
+	// Followed jobs, with the sequence of the last update queued.
+	// Updates are queued under the lock, so the latest progress read when following a job
+	// and the live updates of that job are queued in sequence order.
+	mu   sync.Mutex
+	jobs map[string]int64
+}
+
+// Subscribe opens a subscription on the progress channels of every job,
+// the first one of the process subscribes to their pattern.
+// Nothing is delivered until jobs are followed.
+func (p *RedisProgressStreamer) Subscribe(ctx context.Context) (progressstream.Subscription, error) {
+	sub := &redisSubscription{
+		streamer: p,
+		mailbox:  mailbox.New(),
+		ch:       make(chan progressstream.JobProgress),
+		done:     make(chan struct{}),
+		jobs:     make(map[string]int64),
+	}
+
+	if err := p.hub.add(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	go sub.run(ctx)
+
+	return sub, nil
+}
+
+// Follow starts delivering the updates of a job, after its latest progress.
+// It never waits for Progress to be received.
+func (s *redisSubscription) Follow(ctx context.Context, jobID string) error {
+	s.mu.Lock()
+	if _, ok := s.jobs[jobID]; ok {
+		s.mu.Unlock()
+		return nil
+	}
+	s.jobs[jobID] = 0
+	s.mu.Unlock()
+
+	// Read the snapshot once followed, so no update falls in between
+	latest, err := s.streamer.latest(ctx, jobID)
+	if err != nil {
+		s.Unfollow(jobID)
+		return err
+	}
+
+	if latest != nil {
+		s.deliver(jobID, latest)
+	}
+
+	return nil
+}
+
+// Unfollow stops delivering the updates of a job, dropping the one not received yet
+func (s *redisSubscription) Unfollow(jobID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.jobs, jobID)
+	s.mailbox.Drop(jobID)
+}
+
+// Progress delivers the updates of every followed job
+func (s *redisSubscription) Progress() <-chan progressstream.JobProgress {
+	return s.ch
+}
+
+// Close ends the subscription, closing its progress channel
+func (s *redisSubscription) Close() error {
+	var err error
+	s.close.Do(func() {
+		close(s.done)
+		err = s.streamer.hub.remove(s)
+	})
+
+	return err
+}
+
+// run hands the queued updates to the reader until the subscription is closed
+func (s *redisSubscription) run(ctx context.Context) {
+	defer close(s.ch)
+	defer s.Close()
+
+	for {
+		for {
+			jobID, prg, ok := s.mailbox.Take()
+			if !ok {
+				break
+			}
+
+			select {
+			case s.ch <- progressstream.JobProgress{JobID: jobID, Progress: prg}:
+			case <-ctx.Done():
+				return
+			case <-s.done:
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.done:
+			return
+		case <-s.mailbox.Notify():
+		}
+	}
+}
+
+// deliver queues an update of a followed job, unless an update as recent was already queued,
+// reporting whether the reader fell behind. The job is no longer followed after a finished progress.
+func (s *redisSubscription) deliver(jobID string, prg *progress.Progress) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last, ok := s.jobs[jobID]
+	if !ok || (last > 0 && prg.Sequence <= last) {
+		return false
+	}
+	if prg.IsFinished() {
+		delete(s.jobs, jobID)
+	} else {
+		s.jobs[jobID] = prg.Sequence
+	}
+
+	return s.mailbox.Put(jobID, prg)
+}
+
+func (s *redisSubscription) follows(jobID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.jobs[jobID]
+	return ok
+}
+
+// jobIDFromChannel extracts the job of a channel built by buildChannel
+func jobIDFromChannel(channel string) (string, bool) {
+	jobID, ok := strings.CutPrefix(channel, "video:")
+	if !ok {
+		return "", false
+	}
+
+	return strings.CutSuffix(jobID, ":progress")
+}
@@ -0,0 +1,129 @@
+package redisprogressstream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/redis"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+)
+
+// progressPattern matches the progress channel of every job
+const progressPattern = "video:*:progress"
+
+// subscriptionHub shares a single pattern subscription between the subscriptions of the process.
+// The pattern is subscribed with the first subscription and dropped with the last one.
+// Every message is decoded once and handed to the subscriptions without waiting for them.
+type subscriptionHub struct {
+	client *redis.Client
+	logger log.Logger
+
+	mu     sync.Mutex
+	pubsub *goredis.PubSub // nil while no subscription is open
+	subs   map[*redisSubscription]struct{}
+}
+
+func newSubscriptionHub(client *redis.Client, logger log.Logger) *subscriptionHub {
+	return &subscriptionHub{
+		client: client,
+		logger: logger,
+		subs:   make(map[*redisSubscription]struct{}),
+	}
+}
+
+// add registers a subscription, subscribing to the pattern if it is the first one
+func (h *subscriptionHub) add(ctx context.Context, sub *redisSubscription) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.pubsub == nil {
+		// The pattern subscription outlives the request opening it
+		pubsub := h.client.Rdb.PSubscribe(context.WithoutCancel(ctx), progressPattern)
+
+		// Waits for error or first confirmation message
+		if _, err := pubsub.Receive(ctx); err != nil {
+			pubsub.Close()
+			return fmt.Errorf("subscribe to redis pattern %s: %w", progressPattern, err)
+		}
+
+		h.pubsub = pubsub
+		go h.run(pubsub)
+	}
+
+	h.subs[sub] = struct{}{}
+
+	return nil
+}
+
+// remove unregisters a subscription, dropping the pattern subscription after the last one
+func (h *subscriptionHub) remove(sub *redisSubscription) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subs[sub]; !ok {
+		return nil
+	}
+	delete(h.subs, sub)
+
+	if len(h.subs) > 0 || h.pubsub == nil {
+		return nil
+	}
+
+	pubsub := h.pubsub
+	h.pubsub = nil
+
+	return pubsub.Close()
+}
+
+// run fans the messages of a pattern subscription out until it is closed
+func (h *subscriptionHub) run(pubsub *goredis.PubSub) {
+	ctx := context.Background()
+
+	for msg := range pubsub.Channel() {
+		jobID, ok := jobIDFromChannel(msg.Channel)
+		if !ok {
+			continue
+		}
+
+		subs := h.following(jobID)
+		if len(subs) == 0 {
+			continue
+		}
+
+		var prg progress.Progress
+		if err := json.Unmarshal([]byte(msg.Payload), &prg); err != nil {
+			h.logger.Errorf(ctx, log.CategoryJob, jobID, "unmarshal progress for job %s: %v", jobID, err)
+			continue
+		}
+
+		behind := 0
+		for _, sub := range subs {
+			if sub.deliver(jobID, &prg) {
+				behind++
+			}
+		}
+
+		if behind > 0 {
+			h.logger.Warnf(ctx, log.CategoryJob, jobID, "%d readers fell behind the progress of job %s, skipping to the latest", behind, jobID)
+		}
+	}
+}
+
+// following returns the subscriptions following a job
+func (h *subscriptionHub) following(jobID string) []*redisSubscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var subs []*redisSubscription
+	for sub := range h.subs {
+		if sub.follows(jobID) {
+			subs = append(subs, sub)
+		}
+	}
+
+	return subs
+}
@@ -0,0 +1,247 @@
+package redisprogressstream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/progressstream/redisprogressstream"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/redis"
+	mockLog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// receive waits for the next update of a subscription
+func receive(t *testing.T, sub progressstream.Subscription) progressstream.JobProgress {
+	t.Helper()
+
+	select {
+	case jp, ok := <-sub.Progress():
+		require.True(t, ok, "Subscription closed unexpectedly")
+		return jp
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Timed out waiting for an update")
+		return progressstream.JobProgress{}
+	}
+}
+
+// requireNoUpdate checks that nothing is delivered for a while
+func requireNoUpdate(t *testing.T, sub progressstream.Subscription) {
+	t.Helper()
+
+	select {
+	case jp := <-sub.Progress():
+		t.Fatalf("Unexpected update for job %s", jp.JobID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestRedisSubscription(t *testing.T) {
+	t.Run("success case - updates of followed jobs are tagged", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, mockLog.NewMockLogger(t))
+
+		sub, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer sub.Close()
+
+		require.NoError(t, sub.Follow(t.Context(), "job_A"))
+		require.NoError(t, sub.Follow(t.Context(), "job_B"))
+
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(20)
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+		prg.UpdateCurrentFrames(70)
+		require.NoError(t, streamer.Push(t.Context(), "job_B", prg))
+
+		jp := receive(t, sub)
+		require.Equal(t, "job_A", jp.JobID)
+		require.Equal(t, 20, jp.Progress.Percentage)
+
+		jp = receive(t, sub)
+		require.Equal(t, "job_B", jp.JobID)
+		require.Equal(t, 70, jp.Progress.Percentage)
+	})
+
+	t.Run("unfollowed jobs - updates are not delivered", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, mockLog.NewMockLogger(t))
+
+		sub, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer sub.Close()
+
+		require.NoError(t, sub.Follow(t.Context(), "job_A"))
+		sub.Unfollow("job_A")
+
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(20)
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+		require.NoError(t, streamer.Push(t.Context(), "job_C", prg))
+
+		requireNoUpdate(t, sub)
+	})
+
+	t.Run("follow - replays the latest progress once", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, mockLog.NewMockLogger(t))
+
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(30)
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+
+		sub, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer sub.Close()
+
+		// Follow waits for the snapshot to be received
+		followed := make(chan error, 1)
+		go func() { followed <- sub.Follow(t.Context(), "job_A") }()
+
+		jp := receive(t, sub)
+		require.Equal(t, 30, jp.Progress.Percentage)
+		require.Equal(t, int64(1), jp.Progress.Sequence)
+		require.NoError(t, <-followed)
+
+		prg.UpdateCurrentFrames(60)
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+
+		jp = receive(t, sub)
+		require.Equal(t, 60, jp.Progress.Percentage)
+		require.Equal(t, int64(2), jp.Progress.Sequence)
+	})
+
+	t.Run("finished job - is no longer followed", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, mockLog.NewMockLogger(t))
+
+		sub, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer sub.Close()
+
+		require.NoError(t, sub.Follow(t.Context(), "job_A"))
+
+		prg, _ := progress.NewProgress(100)
+		prg.End()
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+		require.Equal(t, progress.StatusEnd, receive(t, sub).Progress.Status)
+
+		// A stray update after the end is dropped
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+		requireNoUpdate(t, sub)
+	})
+
+	t.Run("retried job - follows the new attempt", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, mockLog.NewMockLogger(t))
+
+		prg, _ := progress.NewProgress(100)
+		prg.MarkAsError()
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+		require.NoError(t, streamer.Reset(t.Context(), "job_A"))
+
+		sub, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer sub.Close()
+
+		// Nothing is replayed, the job stays followed
+		require.NoError(t, sub.Follow(t.Context(), "job_A"))
+		requireNoUpdate(t, sub)
+
+		retry, _ := progress.NewProgress(100)
+		retry.UpdateCurrentFrames(10)
+		require.NoError(t, streamer.Push(t.Context(), "job_A", retry))
+
+		jp := receive(t, sub)
+		require.Equal(t, progress.StatusContinue, jp.Progress.Status)
+		require.Equal(t, int64(2), jp.Progress.Sequence)
+	})
+
+	t.Run("many subscriptions - share one pattern subscription", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, mockLog.NewMockLogger(t))
+
+		first, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		second, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, 1, s.PubSubNumPat())
+
+		require.NoError(t, first.Follow(t.Context(), "job_A"))
+		require.NoError(t, second.Follow(t.Context(), "job_A"))
+
+		prg, _ := progress.NewProgress(100)
+		prg.UpdateCurrentFrames(40)
+		require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+
+		require.Equal(t, 40, receive(t, first).Progress.Percentage)
+		require.Equal(t, 40, receive(t, second).Progress.Percentage)
+
+		require.NoError(t, first.Close())
+		require.Equal(t, 1, s.PubSubNumPat())
+		require.NoError(t, second.Close())
+		require.Eventually(t, func() bool { return s.PubSubNumPat() == 0 }, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("slow reader - does not hold back other subscriptions", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		logger := mockLog.NewMockLogger(t)
+		logger.EXPECT().Warnf(mock.Anything, mock.Anything, "job_A", mock.Anything, mock.Anything, mock.Anything).Maybe()
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, logger)
+
+		slow, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer slow.Close()
+		fast, err := streamer.Subscribe(t.Context())
+		require.NoError(t, err)
+		defer fast.Close()
+
+		require.NoError(t, slow.Follow(t.Context(), "job_A"))
+		require.NoError(t, fast.Follow(t.Context(), "job_A"))
+
+		prg, _ := progress.NewProgress(100)
+		for frames := 10; frames <= 50; frames += 10 {
+			prg.UpdateCurrentFrames(int64(frames))
+			require.NoError(t, streamer.Push(t.Context(), "job_A", prg))
+			require.Equal(t, frames, receive(t, fast).Progress.Percentage)
+		}
+
+		// The slow reader gets at most the update it was handed, then skips to the latest progress
+		received := 1
+		for receive(t, slow).Progress.Percentage != 50 {
+			received++
+		}
+		require.LessOrEqual(t, received, 2)
+	})
+
+	t.Run("context cancellation - closes the progress channel", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		client, _ := redis.NewClient([]string{s.Addr()}, "")
+		streamer := redisprogressstream.NewRedisProgressStreamer(client, mockLog.NewMockLogger(t))
+
+		ctx, cancel := context.WithCancel(t.Context())
+		sub, err := streamer.Subscribe(ctx)
+		require.NoError(t, err)
+
+		cancel()
+
+		select {
+		case _, ok := <-sub.Progress():
+			require.False(t, ok, "Channel should be closed after context cancellation")
+		case <-time.After(time.Second):
+			t.Fatal("Channel was not closed in time")
+		}
+		require.NoError(t, sub.Close())
+	})
+}
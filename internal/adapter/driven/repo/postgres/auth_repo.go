@@ -2,9 +2,13 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 	"github.com/st-ember/streaming-api/internal/domain/user"
 
 	"database/sql"
@@ -75,30 +79,57 @@ func (ar *PostgresAuthRepo) FindPermissionsByRole(ctx context.Context, roleName
 	return permissions, nil
 }
 
+// userQuery selects the columns read by scanUser
+const userQuery = `
+	SELECT u.id, u.email, u.username, u.password_hash,
+	u.email_verified_at, u.created_at, u.updated_at
+	FROM users u
+`
+
 func (ar *PostgresAuthRepo) FindUserByKey(ctx context.Context, key string) (*user.User, error) {
-	query := `
-		SELECT id, email, username, password_hash, 
-		created_at, updated_at
-		FROM users
-		WHERE LOWER(email) = LOWER($1) 
-		OR LOWER(username) = LOWER($1)
+	query := userQuery + `
+		WHERE LOWER(u.email) = LOWER($1)
+		OR LOWER(u.username) = LOWER($1)
 	`
 
+	u, err := scanUser(ar.q.QueryRowContext(ctx, query, key))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("find user by key %s: %w", key, err)
+	}
+
+	return u, nil
+}
+
+func (ar *PostgresAuthRepo) FindUserByID(ctx context.Context, id string) (*user.User, error) {
+	query := userQuery + `WHERE u.id = $1`
+
+	u, err := scanUser(ar.q.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("find user by id %s: %w", id, err)
+	}
+
+	return u, nil
+}
+
+func scanUser(row rowScanner) (*user.User, error) {
 	u := &user.User{}
-	err := ar.q.QueryRowContext(ctx, query, key).Scan(
+	err := row.Scan(
 		&u.ID,
 		&u.Email,
 		&u.Username,
 		&u.PasswordHash,
+		&u.EmailVerifiedAt,
 		&u.CreatedAt,
 		&u.UpdatedAt,
 	)
-
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("user not found with key %s", key)
-		}
-		return nil, fmt.Errorf("find user by key %s: %w", key, err)
+		return nil, err
 	}
 
 	return u, nil
@@ -137,18 +168,19 @@ func (ar *PostgresAuthRepo) FindPermissionsByUserID(ctx context.Context, userID
 
 func (ar *PostgresAuthRepo) SaveUser(ctx context.Context, u *user.User) error {
 	query := `
-		INSERT INTO users (id, email, username, password_hash, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO users (id, email, username, password_hash, email_verified_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		ON CONFLICT (id) DO UPDATE SET
 			email = EXCLUDED.email,
 			username = EXCLUDED.username,
 			password_hash = EXCLUDED.password_hash,
+			email_verified_at = EXCLUDED.email_verified_at,
 			updated_at = EXCLUDED.updated_at
 	`
 
 	_, err := ar.q.ExecContext(
 		ctx, query, u.ID, u.Email, u.Username,
-		u.PasswordHash, u.CreatedAt, u.UpdatedAt,
+		u.PasswordHash, u.EmailVerifiedAt, u.CreatedAt, u.UpdatedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("save user %s: %w", u.Email, err)
@@ -175,3 +207,552 @@ func (ar *PostgresAuthRepo) SaveUserRole(ctx context.Context, userID, roleName s
 
 	return nil
 }
+
+func (ar *PostgresAuthRepo) DeleteUserRole(ctx context.Context, userID, roleName string) error {
+	query := `
+		DELETE FROM user_roles
+		WHERE user_id = $1
+		AND role_id = (SELECT id FROM roles WHERE name = $2)
+	`
+
+	if _, err := ar.q.ExecContext(ctx, query, userID, roleName); err != nil {
+		return fmt.Errorf("remove role %s from user %s: %w", roleName, userID, err)
+	}
+
+	return nil
+}
+
+func (ar *PostgresAuthRepo) SeedRole(ctx context.Context, r *auth.Role) error {
+	// The role keeps its id and the changes made to it later: a permission is only granted
+	// the first time it is seeded, so one removed afterwards stays removed
+	query := `
+		WITH seeded AS (
+			INSERT INTO roles (id, name)
+			VALUES ($1, $2)
+			ON CONFLICT (name) DO UPDATE SET
+				name = EXCLUDED.name
+			RETURNING id
+		), first_seeded AS (
+			INSERT INTO role_permission_seeds (role_id, permission_id)
+			SELECT seeded.id, p.id
+			FROM seeded
+			JOIN permissions p ON p.slug = ANY($3::text[])
+			ON CONFLICT DO NOTHING
+			RETURNING role_id, permission_id
+		)
+		INSERT INTO role_permissions (role_id, permission_id)
+		SELECT role_id, permission_id
+		FROM first_seeded
+		ON CONFLICT DO NOTHING
+	`
+
+	if _, err := ar.q.ExecContext(ctx, query, r.ID, r.Name, r.Permissions); err != nil {
+		return fmt.Errorf("seed role %s: %w", r.Name, err)
+	}
+
+	return nil
+}
+
+func (ar *PostgresAuthRepo) SaveRole(ctx context.Context, r *auth.Role) error {
+	query := `
+		INSERT INTO roles (id, name)
+		VALUES ($1, $2)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name
+	`
+
+	if _, err := ar.q.ExecContext(ctx, query, r.ID, r.Name); err != nil {
+		return fmt.Errorf("save role %s: %w", r.Name, err)
+	}
+
+	query = `
+		DELETE FROM role_permissions rp
+		USING permissions p
+		WHERE rp.permission_id = p.id
+		AND rp.role_id = $1
+		AND NOT p.slug = ANY(COALESCE($2::text[], '{}'))
+	`
+
+	if _, err := ar.q.ExecContext(ctx, query, r.ID, r.Permissions); err != nil {
+		return fmt.Errorf("remove permissions of role %s: %w", r.Name, err)
+	}
+
+	query = `
+		INSERT INTO role_permissions (role_id, permission_id)
+		SELECT $1, id FROM permissions WHERE slug = ANY($2::text[])
+		ON CONFLICT (role_id, permission_id) DO NOTHING
+	`
+
+	if _, err := ar.q.ExecContext(ctx, query, r.ID, r.Permissions); err != nil {
+		return fmt.Errorf("grant permissions of role %s: %w", r.Name, err)
+	}
+
+	return nil
+}
+
+// roleQuery selects roles with their permissions joined by commas, as read by scanRole
+const roleQuery = `
+	SELECT r.id, r.name, COALESCE(string_agg(p.slug, ',' ORDER BY p.slug), '')
+	FROM roles r
+	LEFT JOIN role_permissions rp ON rp.role_id = r.id
+	LEFT JOIN permissions p ON p.id = rp.permission_id
+`
+
+func (ar *PostgresAuthRepo) FindRoleByName(ctx context.Context, name string) (*auth.Role, error) {
+	query := roleQuery + `
+		WHERE r.name = $1
+		GROUP BY r.id, r.name
+	`
+
+	r, err := scanRole(ar.q.QueryRowContext(ctx, query, name))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("find role %s: %w", name, err)
+	}
+
+	return r, nil
+}
+
+func (ar *PostgresAuthRepo) ListRoles(ctx context.Context) ([]*auth.Role, error) {
+	query := roleQuery + `
+		GROUP BY r.id, r.name
+		ORDER BY r.name
+	`
+
+	rows, err := ar.q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query roles: %w", err)
+	}
+	defer rows.Close()
+
+	rs := []*auth.Role{}
+	for rows.Next() {
+		r, err := scanRole(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan roles: %w", err)
+		}
+		rs = append(rs, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return rs, nil
+}
+
+func scanRole(row rowScanner) (*auth.Role, error) {
+	r := &auth.Role{}
+	var permissions string
+	if err := row.Scan(&r.ID, &r.Name, &permissions); err != nil {
+		return nil, err
+	}
+
+	r.Permissions = []string{}
+	if permissions != "" {
+		r.Permissions = strings.Split(permissions, ",")
+	}
+
+	return r, nil
+}
+
+func (ar *PostgresAuthRepo) SaveRefreshFamily(ctx context.Context, f *auth.RefreshFamily) error {
+	query := `
+		INSERT INTO refresh_families (id, user_id, current_token_id, rotations, revoked_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO UPDATE SET
+			current_token_id = EXCLUDED.current_token_id,
+			rotations = EXCLUDED.rotations,
+			revoked_at = EXCLUDED.revoked_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := ar.q.ExecContext(
+		ctx, query, f.ID, f.UserID, f.CurrentTokenID,
+		f.Rotations, f.RevokedAt, f.CreatedAt, f.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save refresh family %s: %w", f.ID, err)
+	}
+
+	return nil
+}
+
+func (ar *PostgresAuthRepo) LockRefreshFamily(ctx context.Context, id string) (*auth.RefreshFamily, error) {
+	query := `
+		SELECT id, user_id, current_token_id, rotations, revoked_at, created_at, updated_at
+		FROM refresh_families
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	f := &auth.RefreshFamily{}
+	err := ar.q.QueryRowContext(ctx, query, id).Scan(
+		&f.ID,
+		&f.UserID,
+		&f.CurrentTokenID,
+		&f.Rotations,
+		&f.RevokedAt,
+		&f.CreatedAt,
+		&f.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("lock refresh family %s: %w", id, err)
+	}
+
+	return f, nil
+}
+
+func (ar *PostgresAuthRepo) FindActiveRefreshFamilies(ctx context.Context, userID string, since time.Time) ([]*auth.RefreshFamily, error) {
+	query := `
+		SELECT id, user_id, current_token_id, rotations, revoked_at, created_at, updated_at
+		FROM refresh_families
+		WHERE user_id = $1 AND revoked_at IS NULL AND updated_at > $2
+		ORDER BY updated_at DESC
+	`
+
+	rows, err := ar.q.QueryContext(ctx, query, userID, since)
+	if err != nil {
+		return nil, fmt.Errorf("query refresh families of user %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	fs := []*auth.RefreshFamily{}
+	for rows.Next() {
+		f := &auth.RefreshFamily{}
+		err := rows.Scan(
+			&f.ID,
+			&f.UserID,
+			&f.CurrentTokenID,
+			&f.Rotations,
+			&f.RevokedAt,
+			&f.CreatedAt,
+			&f.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan refresh families: %w", err)
+		}
+		fs = append(fs, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return fs, nil
+}
+
+func (ar *PostgresAuthRepo) SaveAPIKey(ctx context.Context, k *auth.APIKey) error {
+	query := `
+		INSERT INTO api_keys (id, user_id, name, prefix, key_hash, permissions, expires_at, last_used_at, revoked_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			permissions = EXCLUDED.permissions,
+			expires_at = EXCLUDED.expires_at,
+			revoked_at = EXCLUDED.revoked_at
+	`
+
+	_, err := ar.q.ExecContext(
+		ctx, query, k.ID, k.UserID, k.Name, k.Prefix, k.Hash, strings.Join(k.Permissions, ","),
+		k.ExpiresAt, k.LastUsedAt, k.RevokedAt, k.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save api key %s: %w", k.ID, err)
+	}
+
+	return nil
+}
+
+const apiKeyQuery = `
+	SELECT id, user_id, name, prefix, key_hash, permissions, expires_at, last_used_at, revoked_at, created_at
+	FROM api_keys
+`
+
+func (ar *PostgresAuthRepo) FindAPIKeyByID(ctx context.Context, id string) (*auth.APIKey, error) {
+	k, err := scanAPIKey(ar.q.QueryRowContext(ctx, apiKeyQuery+"WHERE id = $1", id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("find api key %s: %w", id, err)
+	}
+
+	return k, nil
+}
+
+func (ar *PostgresAuthRepo) FindAPIKeyByPrefix(ctx context.Context, prefix string) (*auth.APIKey, error) {
+	k, err := scanAPIKey(ar.q.QueryRowContext(ctx, apiKeyQuery+"WHERE prefix = $1", prefix))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("find api key %s: %w", prefix, err)
+	}
+
+	return k, nil
+}
+
+func (ar *PostgresAuthRepo) FindAPIKeysByUserID(ctx context.Context, userID string) ([]*auth.APIKey, error) {
+	rows, err := ar.q.QueryContext(ctx, apiKeyQuery+"WHERE user_id = $1 ORDER BY created_at DESC", userID)
+	if err != nil {
+		return nil, fmt.Errorf("query api keys of user %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	ks := []*auth.APIKey{}
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan api keys: %w", err)
+		}
+		ks = append(ks, k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return ks, nil
+}
+
+func (ar *PostgresAuthRepo) TouchAPIKey(ctx context.Context, id string, usedAt time.Time) error {
+	query := `UPDATE api_keys SET last_used_at = $2 WHERE id = $1`
+
+	if _, err := ar.q.ExecContext(ctx, query, id, usedAt); err != nil {
+		return fmt.Errorf("touch api key %s: %w", id, err)
+	}
+
+	return nil
+}
+
+func scanAPIKey(row rowScanner) (*auth.APIKey, error) {
+	k := &auth.APIKey{Permissions: []string{}}
+	var permissions string
+	err := row.Scan(
+		&k.ID,
+		&k.UserID,
+		&k.Name,
+		&k.Prefix,
+		&k.Hash,
+		&permissions,
+		&k.ExpiresAt,
+		&k.LastUsedAt,
+		&k.RevokedAt,
+		&k.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if permissions != "" {
+		k.Permissions = strings.Split(permissions, ",")
+	}
+
+	return k, nil
+}
+
+func (ar *PostgresAuthRepo) SaveOIDCLogin(ctx context.Context, l *auth.OIDCLogin) error {
+	query := `
+		INSERT INTO oidc_logins (state, nonce, code_verifier, created_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	if _, err := ar.q.ExecContext(ctx, query, l.State, l.Nonce, l.CodeVerifier, l.CreatedAt); err != nil {
+		return fmt.Errorf("save oidc login: %w", err)
+	}
+
+	return nil
+}
+
+func (ar *PostgresAuthRepo) TakeOIDCLogin(ctx context.Context, state string) (*auth.OIDCLogin, error) {
+	query := `
+		DELETE FROM oidc_logins
+		WHERE state = $1
+		RETURNING state, nonce, code_verifier, created_at
+	`
+
+	l := &auth.OIDCLogin{}
+	err := ar.q.QueryRowContext(ctx, query, state).Scan(
+		&l.State,
+		&l.Nonce,
+		&l.CodeVerifier,
+		&l.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("take oidc login: %w", err)
+	}
+
+	return l, nil
+}
+
+func (ar *PostgresAuthRepo) DeleteExpiredOIDCLogins(ctx context.Context, before time.Time) error {
+	query := `DELETE FROM oidc_logins WHERE created_at < $1`
+
+	if _, err := ar.q.ExecContext(ctx, query, before); err != nil {
+		return fmt.Errorf("delete expired oidc logins: %w", err)
+	}
+
+	return nil
+}
+
+func (ar *PostgresAuthRepo) FindUserByIdentity(ctx context.Context, issuer, subject string) (*user.User, error) {
+	query := userQuery + `
+		JOIN user_identities i ON i.user_id = u.id
+		WHERE i.issuer = $1 AND i.subject = $2
+	`
+
+	u, err := scanUser(ar.q.QueryRowContext(ctx, query, issuer, subject))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("find user by identity %s of %s: %w", subject, issuer, err)
+	}
+
+	return u, nil
+}
+
+func (ar *PostgresAuthRepo) SaveUserIdentity(ctx context.Context, issuer, subject, userID string) error {
+	query := `
+		INSERT INTO user_identities (issuer, subject, user_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (issuer, subject) DO UPDATE SET
+			user_id = EXCLUDED.user_id
+	`
+
+	if _, err := ar.q.ExecContext(ctx, query, issuer, subject, userID); err != nil {
+		return fmt.Errorf("save identity %s of %s for user %s: %w", subject, issuer, userID, err)
+	}
+
+	return nil
+}
+
+func (ar *PostgresAuthRepo) SaveUserToken(ctx context.Context, t *auth.UserToken) error {
+	query := `
+		INSERT INTO user_tokens (hash, user_id, purpose, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	if _, err := ar.q.ExecContext(ctx, query, t.Hash, t.UserID, t.Purpose, t.ExpiresAt, t.CreatedAt); err != nil {
+		return fmt.Errorf("save %s token of user %s: %w", t.Purpose, t.UserID, err)
+	}
+
+	return nil
+}
+
+func (ar *PostgresAuthRepo) TakeUserToken(ctx context.Context, hash string, purpose auth.UserTokenPurpose) (*auth.UserToken, error) {
+	query := `
+		DELETE FROM user_tokens
+		WHERE hash = $1 AND purpose = $2
+		RETURNING hash, user_id, purpose, expires_at, created_at
+	`
+
+	t := &auth.UserToken{}
+	err := ar.q.QueryRowContext(ctx, query, hash, purpose).Scan(
+		&t.Hash,
+		&t.UserID,
+		&t.Purpose,
+		&t.ExpiresAt,
+		&t.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("take %s token: %w", purpose, err)
+	}
+
+	return t, nil
+}
+
+func (ar *PostgresAuthRepo) DeleteUserTokens(ctx context.Context, userID string, purpose auth.UserTokenPurpose) error {
+	query := `
+		DELETE FROM user_tokens
+		WHERE (user_id = $1 AND purpose = $2) OR expires_at <= NOW()
+	`
+
+	if _, err := ar.q.ExecContext(ctx, query, userID, purpose); err != nil {
+		return fmt.Errorf("delete %s tokens of user %s: %w", purpose, userID, err)
+	}
+
+	return nil
+}
+
+// signingKeysLock is the transaction level advisory lock serializing key rotations
+const signingKeysLock = 7_460_301
+
+func (ar *PostgresAuthRepo) LockSigningKeys(ctx context.Context) ([]*auth.SigningKey, error) {
+	// Rows cannot be locked before the first key exists, the advisory lock can
+	if _, err := ar.q.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", signingKeysLock); err != nil {
+		return nil, fmt.Errorf("lock signing keys: %w", err)
+	}
+
+	query := `
+		SELECT id, algorithm, private_key, created_at, active_from, expires_at
+		FROM signing_keys
+		ORDER BY active_from
+	`
+
+	rows, err := ar.q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query signing keys: %w", err)
+	}
+	defer rows.Close()
+
+	ks := []*auth.SigningKey{}
+	for rows.Next() {
+		k := &auth.SigningKey{}
+		err := rows.Scan(
+			&k.ID,
+			&k.Algorithm,
+			&k.PrivateKey,
+			&k.CreatedAt,
+			&k.ActiveFrom,
+			&k.ExpiresAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan signing keys: %w", err)
+		}
+		ks = append(ks, k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return ks, nil
+}
+
+func (ar *PostgresAuthRepo) SaveSigningKey(ctx context.Context, k *auth.SigningKey) error {
+	query := `
+		INSERT INTO signing_keys (id, algorithm, private_key, created_at, active_from, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO UPDATE SET
+			expires_at = EXCLUDED.expires_at
+	`
+
+	_, err := ar.q.ExecContext(ctx, query, k.ID, k.Algorithm, k.PrivateKey, k.CreatedAt, k.ActiveFrom, k.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("save signing key %s: %w", k.ID, err)
+	}
+
+	return nil
+}
+
+func (ar *PostgresAuthRepo) DeleteExpiredSigningKeys(ctx context.Context, now time.Time) error {
+	query := `DELETE FROM signing_keys WHERE expires_at <= $1`
+
+	if _, err := ar.q.ExecContext(ctx, query, now); err != nil {
+		return fmt.Errorf("delete expired signing keys: %w", err)
+	}
+
+	return nil
+}
@@ -1,9 +1,12 @@
 package postgres_test
 
 import (
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/st-ember/streaming-api/internal/adapter/driven/repo/postgres"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 	"github.com/st-ember/streaming-api/internal/domain/user"
 	"github.com/stretchr/testify/require"
 )
@@ -91,7 +94,7 @@ func TestPostgresAuthRepo_SaveUserAndFindUserByKey(t *testing.T) {
 		truncateAll(t)
 
 		found, err := repo.FindUserByKey(t.Context(), "non-existent")
-		require.Error(t, err)
+		require.ErrorIs(t, err, sql.ErrNoRows)
 		require.Nil(t, found)
 	})
 }
@@ -198,3 +201,438 @@ func TestPostgresAuthRepo_FindPermissionsByUserID(t *testing.T) {
 		require.Empty(t, perms)
 	})
 }
+
+func TestPostgresAuthRepo_DeleteUserRole(t *testing.T) {
+	repo := postgres.NewPostgresAuthRepo(TestDB)
+
+	t.Run("should remove the permissions of the role from the user", func(t *testing.T) {
+		truncateAll(t)
+
+		require.NoError(t, repo.SyncPermissions(t.Context(), []string{auth.PermissionVideoUpload}))
+		r, _ := auth.NewRole("r1", "creator", []string{auth.PermissionVideoUpload})
+		require.NoError(t, repo.SaveRole(t.Context(), r))
+
+		u, _ := user.NewUser("user-1", "user@test.com", "user", "hash")
+		_ = repo.SaveUser(t.Context(), u)
+		require.NoError(t, repo.SaveUserRole(t.Context(), u.ID, "creator"))
+
+		require.NoError(t, repo.DeleteUserRole(t.Context(), u.ID, "creator"))
+
+		perms, err := repo.FindPermissionsByUserID(t.Context(), u.ID)
+		require.NoError(t, err)
+		require.Empty(t, perms)
+
+		// Removing it again has no effect
+		require.NoError(t, repo.DeleteUserRole(t.Context(), u.ID, "creator"))
+	})
+}
+
+func TestPostgresAuthRepo_Roles(t *testing.T) {
+	repo := postgres.NewPostgresAuthRepo(TestDB)
+
+	t.Run("should save a role and replace its permissions", func(t *testing.T) {
+		truncateAll(t)
+
+		require.NoError(t, repo.SyncPermissions(t.Context(), auth.AllPermissions()))
+
+		r, _ := auth.NewRole("r1", "editor", []string{auth.PermissionVideoUpdate, auth.PermissionVideoArchive})
+		require.NoError(t, repo.SaveRole(t.Context(), r))
+
+		found, err := repo.FindRoleByName(t.Context(), "editor")
+		require.NoError(t, err)
+		require.Equal(t, "r1", found.ID)
+		require.ElementsMatch(t, r.Permissions, found.Permissions)
+
+		found.RemovePermission(auth.PermissionVideoArchive)
+		require.NoError(t, found.AddPermission(auth.PermissionJobAdmin))
+		require.NoError(t, repo.SaveRole(t.Context(), found))
+
+		found, err = repo.FindRoleByName(t.Context(), "editor")
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{auth.PermissionVideoUpdate, auth.PermissionJobAdmin}, found.Permissions)
+	})
+
+	t.Run("should return sql.ErrNoRows for an unknown role", func(t *testing.T) {
+		truncateAll(t)
+
+		_, err := repo.FindRoleByName(t.Context(), "ghost")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("should grant only the permissions a role was never seeded with", func(t *testing.T) {
+		truncateAll(t)
+
+		require.NoError(t, repo.SyncPermissions(t.Context(), auth.AllPermissions()))
+
+		r, _ := auth.NewRole("r1", "uploader", []string{auth.PermissionVideoUpload, auth.PermissionVideoUpdate})
+		require.NoError(t, repo.SeedRole(t.Context(), r))
+
+		found, err := repo.FindRoleByName(t.Context(), "uploader")
+		require.NoError(t, err)
+		found.RemovePermission(auth.PermissionVideoUpload)
+		require.NoError(t, found.AddPermission(auth.PermissionVideoAdmin))
+		require.NoError(t, repo.SaveRole(t.Context(), found))
+
+		// Seeding again, as on the next startup, with a permission added to the code since
+		again, _ := auth.NewRole("r2", "uploader", []string{
+			auth.PermissionVideoUpload,
+			auth.PermissionVideoUpdate,
+			auth.PermissionVideoArchive,
+		})
+		require.NoError(t, repo.SeedRole(t.Context(), again))
+
+		found, err = repo.FindRoleByName(t.Context(), "uploader")
+		require.NoError(t, err)
+		require.Equal(t, "r1", found.ID)
+		// The removed permission stays removed, the added one is kept
+		require.ElementsMatch(t, []string{
+			auth.PermissionVideoUpdate,
+			auth.PermissionVideoAdmin,
+			auth.PermissionVideoArchive,
+		}, found.Permissions)
+	})
+
+	t.Run("should list roles by name with their permissions", func(t *testing.T) {
+		truncateAll(t)
+
+		require.NoError(t, repo.SyncPermissions(t.Context(), auth.AllPermissions()))
+
+		viewer, _ := auth.NewRole("r1", "viewer", nil)
+		admin, _ := auth.NewRole("r2", "admin", []string{auth.PermissionRoleAdmin})
+		require.NoError(t, repo.SaveRole(t.Context(), viewer))
+		require.NoError(t, repo.SaveRole(t.Context(), admin))
+
+		rs, err := repo.ListRoles(t.Context())
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+		require.Equal(t, "admin", rs[0].Name)
+		require.Equal(t, []string{auth.PermissionRoleAdmin}, rs[0].Permissions)
+		require.Equal(t, "viewer", rs[1].Name)
+		require.Empty(t, rs[1].Permissions)
+	})
+}
+
+func TestPostgresAuthRepo_FindUserByID(t *testing.T) {
+	repo := postgres.NewPostgresAuthRepo(TestDB)
+
+	t.Run("should find a saved user", func(t *testing.T) {
+		truncateAll(t)
+
+		u, _ := user.NewUser("user-1", "user@test.com", "user", "hash")
+		require.NoError(t, repo.SaveUser(t.Context(), u))
+
+		found, err := repo.FindUserByID(t.Context(), u.ID)
+		require.NoError(t, err)
+		require.Equal(t, u.Username, found.Username)
+	})
+
+	t.Run("should return sql.ErrNoRows for a missing user", func(t *testing.T) {
+		truncateAll(t)
+
+		_, err := repo.FindUserByID(t.Context(), "ghost")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestPostgresAuthRepo_RefreshFamily(t *testing.T) {
+	t.Run("should save, lock and update a family", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+		u, _ := user.NewUser("user-1", "user@test.com", "user", "hash")
+		require.NoError(t, repo.SaveUser(t.Context(), u))
+
+		f, _ := auth.NewRefreshFamily("family-1", u.ID, "token-1")
+		require.NoError(t, repo.SaveRefreshFamily(t.Context(), f))
+
+		locked, err := repo.LockRefreshFamily(t.Context(), f.ID)
+		require.NoError(t, err)
+		require.Equal(t, "token-1", locked.CurrentTokenID)
+
+		require.NoError(t, locked.Rotate("token-1", "token-2"))
+		locked.Revoke()
+		require.NoError(t, repo.SaveRefreshFamily(t.Context(), locked))
+
+		found, err := repo.LockRefreshFamily(t.Context(), f.ID)
+		require.NoError(t, err)
+		require.Equal(t, "token-2", found.CurrentTokenID)
+		require.Equal(t, 1, found.Rotations)
+		require.True(t, found.IsRevoked())
+	})
+
+	t.Run("should return sql.ErrNoRows for a missing family", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+		_, err := repo.LockRefreshFamily(t.Context(), "ghost")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestPostgresAuthRepo_FindActiveRefreshFamilies(t *testing.T) {
+	t.Run("should find the unrevoked families used since the given time", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+		u, _ := user.NewUser("user-1", "user@test.com", "user", "hash")
+		require.NoError(t, repo.SaveUser(t.Context(), u))
+		other, _ := user.NewUser("user-2", "other@test.com", "other", "hash")
+		require.NoError(t, repo.SaveUser(t.Context(), other))
+
+		older, _ := auth.NewRefreshFamily("family-older", u.ID, "token-1")
+		older.UpdatedAt = older.UpdatedAt.Add(-time.Hour)
+		recent, _ := auth.NewRefreshFamily("family-recent", u.ID, "token-2")
+		revoked, _ := auth.NewRefreshFamily("family-revoked", u.ID, "token-3")
+		revoked.Revoke()
+		stale, _ := auth.NewRefreshFamily("family-stale", u.ID, "token-4")
+		stale.UpdatedAt = stale.UpdatedAt.Add(-48 * time.Hour)
+		otherUser, _ := auth.NewRefreshFamily("family-other", other.ID, "token-5")
+
+		for _, f := range []*auth.RefreshFamily{older, recent, revoked, stale, otherUser} {
+			require.NoError(t, repo.SaveRefreshFamily(t.Context(), f))
+		}
+
+		found, err := repo.FindActiveRefreshFamilies(t.Context(), u.ID, time.Now().Add(-24*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		require.Equal(t, "family-recent", found[0].ID)
+		require.Equal(t, "family-older", found[1].ID)
+	})
+}
+
+func TestPostgresAuthRepo_APIKeys(t *testing.T) {
+	t.Run("should save, find, touch and revoke a key", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+		u, _ := user.NewUser("user-1", "user@test.com", "user", "hash")
+		require.NoError(t, repo.SaveUser(t.Context(), u))
+
+		expiresAt := time.Now().Add(time.Hour)
+		k, _ := auth.NewAPIKey("key-1", u.ID, "ingest", "sak_abc", "hash", []string{auth.PermissionVideoUpload, auth.PermissionVideoUpdate}, &expiresAt)
+		require.NoError(t, repo.SaveAPIKey(t.Context(), k))
+
+		found, err := repo.FindAPIKeyByPrefix(t.Context(), "sak_abc")
+		require.NoError(t, err)
+		require.Equal(t, k.ID, found.ID)
+		require.Equal(t, k.Permissions, found.Permissions)
+		require.Nil(t, found.LastUsedAt)
+
+		usedAt := time.Now().UTC().Truncate(time.Microsecond)
+		require.NoError(t, repo.TouchAPIKey(t.Context(), k.ID, usedAt))
+
+		// Saving keeps the last use recorded by TouchAPIKey
+		found.Revoke()
+		require.NoError(t, repo.SaveAPIKey(t.Context(), found))
+
+		found, err = repo.FindAPIKeyByID(t.Context(), k.ID)
+		require.NoError(t, err)
+		require.True(t, found.IsRevoked())
+		require.True(t, usedAt.Equal(*found.LastUsedAt))
+	})
+
+	t.Run("should find the keys of a user, newest first", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+		u, _ := user.NewUser("user-1", "user@test.com", "user", "hash")
+		require.NoError(t, repo.SaveUser(t.Context(), u))
+		other, _ := user.NewUser("user-2", "other@test.com", "other", "hash")
+		require.NoError(t, repo.SaveUser(t.Context(), other))
+
+		older, _ := auth.NewAPIKey("key-older", u.ID, "older", "sak_older", "hash", nil, nil)
+		older.CreatedAt = older.CreatedAt.Add(-time.Hour)
+		newer, _ := auth.NewAPIKey("key-newer", u.ID, "newer", "sak_newer", "hash", nil, nil)
+		otherUser, _ := auth.NewAPIKey("key-other", other.ID, "other", "sak_other", "hash", nil, nil)
+
+		for _, k := range []*auth.APIKey{older, newer, otherUser} {
+			require.NoError(t, repo.SaveAPIKey(t.Context(), k))
+		}
+
+		found, err := repo.FindAPIKeysByUserID(t.Context(), u.ID)
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		require.Equal(t, "key-newer", found[0].ID)
+		require.Equal(t, "key-older", found[1].ID)
+		require.Empty(t, found[1].Permissions)
+	})
+
+	t.Run("should return sql.ErrNoRows for a missing key", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+		_, err := repo.FindAPIKeyByPrefix(t.Context(), "sak_ghost")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestPostgresAuthRepo_OIDCLogins(t *testing.T) {
+	t.Run("should take a login once", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+		l, _ := auth.NewOIDCLogin("state-1", "nonce-1", "verifier-1")
+		require.NoError(t, repo.SaveOIDCLogin(t.Context(), l))
+
+		taken, err := repo.TakeOIDCLogin(t.Context(), "state-1")
+		require.NoError(t, err)
+		require.Equal(t, "nonce-1", taken.Nonce)
+		require.Equal(t, "verifier-1", taken.CodeVerifier)
+
+		_, err = repo.TakeOIDCLogin(t.Context(), "state-1")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("should delete expired logins", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+		expired, _ := auth.NewOIDCLogin("state-expired", "nonce", "verifier")
+		expired.CreatedAt = expired.CreatedAt.Add(-time.Hour)
+		pending, _ := auth.NewOIDCLogin("state-pending", "nonce", "verifier")
+		require.NoError(t, repo.SaveOIDCLogin(t.Context(), expired))
+		require.NoError(t, repo.SaveOIDCLogin(t.Context(), pending))
+
+		require.NoError(t, repo.DeleteExpiredOIDCLogins(t.Context(), time.Now().Add(-auth.OIDCLoginLifetime)))
+
+		_, err := repo.TakeOIDCLogin(t.Context(), "state-expired")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		_, err = repo.TakeOIDCLogin(t.Context(), "state-pending")
+		require.NoError(t, err)
+	})
+}
+
+func TestPostgresAuthRepo_UserIdentities(t *testing.T) {
+	t.Run("should find a user by identity", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+		u, _ := user.NewUser("user-1", "user@test.com", "user", "hash")
+		require.NoError(t, repo.SaveUser(t.Context(), u))
+		require.NoError(t, repo.SaveUserIdentity(t.Context(), "https://idp.test", "subject-1", u.ID))
+
+		found, err := repo.FindUserByIdentity(t.Context(), "https://idp.test", "subject-1")
+		require.NoError(t, err)
+		require.Equal(t, u.ID, found.ID)
+
+		_, err = repo.FindUserByIdentity(t.Context(), "https://other.test", "subject-1")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestPostgresAuthRepo_EmailVerified(t *testing.T) {
+	tx := beginTx(t)
+	repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+	u, _ := user.NewUser("user-1", "user@test.com", "user", "hash")
+	require.NoError(t, repo.SaveUser(t.Context(), u))
+
+	found, err := repo.FindUserByID(t.Context(), u.ID)
+	require.NoError(t, err)
+	require.False(t, found.IsEmailVerified())
+
+	u.VerifyEmail(time.Now())
+	require.NoError(t, repo.SaveUser(t.Context(), u))
+
+	found, err = repo.FindUserByKey(t.Context(), "user@test.com")
+	require.NoError(t, err)
+	require.True(t, found.IsEmailVerified())
+}
+
+func TestPostgresAuthRepo_UserTokens(t *testing.T) {
+	t.Run("should take a token once, for its purpose only", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+		u, _ := user.NewUser("user-1", "user@test.com", "user", "hash")
+		require.NoError(t, repo.SaveUser(t.Context(), u))
+
+		tk, _ := auth.NewUserToken("hash-1", u.ID, auth.PurposeResetPassword)
+		require.NoError(t, repo.SaveUserToken(t.Context(), tk))
+
+		_, err := repo.TakeUserToken(t.Context(), "hash-1", auth.PurposeVerifyEmail)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		taken, err := repo.TakeUserToken(t.Context(), "hash-1", auth.PurposeResetPassword)
+		require.NoError(t, err)
+		require.Equal(t, u.ID, taken.UserID)
+		require.WithinDuration(t, tk.ExpiresAt, taken.ExpiresAt, time.Millisecond)
+
+		_, err = repo.TakeUserToken(t.Context(), "hash-1", auth.PurposeResetPassword)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("should delete the tokens of a user for a purpose", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+
+		u, _ := user.NewUser("user-1", "user@test.com", "user", "hash")
+		require.NoError(t, repo.SaveUser(t.Context(), u))
+
+		reset, _ := auth.NewUserToken("hash-reset", u.ID, auth.PurposeResetPassword)
+		verify, _ := auth.NewUserToken("hash-verify", u.ID, auth.PurposeVerifyEmail)
+		require.NoError(t, repo.SaveUserToken(t.Context(), reset))
+		require.NoError(t, repo.SaveUserToken(t.Context(), verify))
+
+		require.NoError(t, repo.DeleteUserTokens(t.Context(), u.ID, auth.PurposeResetPassword))
+
+		_, err := repo.TakeUserToken(t.Context(), "hash-reset", auth.PurposeResetPassword)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		_, err = repo.TakeUserToken(t.Context(), "hash-verify", auth.PurposeVerifyEmail)
+		require.NoError(t, err)
+	})
+}
+
+func TestPostgresAuthRepo_SigningKeys(t *testing.T) {
+	t.Run("should save, lock and retire keys", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+		now := time.Now()
+
+		// Locking works before any key exists
+		keys, err := repo.LockSigningKeys(t.Context())
+		require.NoError(t, err)
+		require.Empty(t, keys)
+
+		older, _ := auth.NewSigningKey("key-1", auth.SigningAlgorithmRS256, []byte("der-1"), now.Add(-time.Hour))
+		newer, _ := auth.NewSigningKey("key-2", auth.SigningAlgorithmEdDSA, []byte("der-2"), now)
+		require.NoError(t, repo.SaveSigningKey(t.Context(), newer))
+		require.NoError(t, repo.SaveSigningKey(t.Context(), older))
+
+		older.Retire(now.Add(time.Minute))
+		require.NoError(t, repo.SaveSigningKey(t.Context(), older))
+
+		keys, err = repo.LockSigningKeys(t.Context())
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		require.Equal(t, "key-1", keys[0].ID)
+		require.Equal(t, []byte("der-1"), keys[0].PrivateKey)
+		require.True(t, keys[0].IsRetired())
+		require.Equal(t, auth.SigningAlgorithmEdDSA, keys[1].Algorithm)
+		require.False(t, keys[1].IsRetired())
+	})
+
+	t.Run("should delete expired keys only", func(t *testing.T) {
+		tx := beginTx(t)
+		repo := postgres.NewPostgresAuthRepoWithTransaction(tx)
+		now := time.Now()
+
+		expired, _ := auth.NewSigningKey("key-1", auth.SigningAlgorithmRS256, []byte("der-1"), now.Add(-time.Hour))
+		expired.Retire(now.Add(-time.Minute))
+		retired, _ := auth.NewSigningKey("key-2", auth.SigningAlgorithmRS256, []byte("der-2"), now.Add(-time.Hour))
+		retired.Retire(now.Add(time.Minute))
+		current, _ := auth.NewSigningKey("key-3", auth.SigningAlgorithmRS256, []byte("der-3"), now)
+
+		for _, k := range []*auth.SigningKey{expired, retired, current} {
+			require.NoError(t, repo.SaveSigningKey(t.Context(), k))
+		}
+
+		require.NoError(t, repo.DeleteExpiredSigningKeys(t.Context(), now))
+
+		keys, err := repo.LockSigningKeys(t.Context())
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		require.Equal(t, "key-2", keys[0].ID)
+		require.Equal(t, "key-3", keys[1].ID)
+	})
+}
@@ -5,10 +5,35 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 )
 
+const jobPageSize = 20
+
+// jobColumns lists the columns read by scanJob, in scan order.
+// Dependencies are aggregated into a comma separated list of job ids.
+const jobColumns = `
+	id, video_id, type, COALESCE(step, ''), optional, COALESCE(payload, ''),
+	COALESCE((
+		SELECT string_agg(d.depends_on_id, ',' ORDER BY d.depends_on_id)
+		FROM job_dependencies d
+		WHERE d.job_id = jobs.id
+	), ''),
+	status, COALESCE(result, ''), COALESCE(error_msg, ''),
+	priority, attempts, COALESCE(worker_id, ''), cost_cpu, cost_memory, cost_work,
+	started_at, finished_at, created_at, updated_at
+`
+
+// attemptColumns lists the columns read by scanAttempt, in scan order
+const attemptColumns = `
+	job_id, number, worker_id, status, exit_code, error_msg,
+	stderr_tail, started_at, finished_at
+`
+
 type PostgresJobRepo struct {
 	tx *sql.Tx
 }
@@ -17,51 +42,124 @@ func NewPostgresJobRepo(tx *sql.Tx) *PostgresJobRepo {
 	return &PostgresJobRepo{tx}
 }
 
-// Save upserts the specified job
+// Save upserts the specified job along with its dependencies.
+// Dependencies are only ever added, as the assemble job does once the chunks are known.
 func (r *PostgresJobRepo) Save(ctx context.Context, job *job.Job) error {
 	query := `
-		INSERT INTO jobs (id, video_id, type, status, 
-		result, error_msg, created_at, updated_at)
-		VALUES($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO jobs (id, video_id, type, step, optional, payload, status,
+		result, error_msg, priority, attempts, worker_id,
+		started_at, finished_at, created_at, updated_at)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 		ON CONFLICT (id) DO UPDATE SET
+		payload = EXCLUDED.payload,
 		status = EXCLUDED.status,
 		result = EXCLUDED.result,
 		error_msg = EXCLUDED.error_msg,
+		priority = EXCLUDED.priority,
+		attempts = EXCLUDED.attempts,
+		worker_id = EXCLUDED.worker_id,
+		started_at = EXCLUDED.started_at,
+		finished_at = EXCLUDED.finished_at,
 		updated_at = EXCLUDED.updated_at;
 	`
 
 	_, err := r.tx.ExecContext(ctx, query,
-		job.ID, job.VideoID, job.Type, job.Status, job.Result,
-		job.ErrorMsg, job.CreatedAt, job.UpdatedAt,
+		job.ID, job.VideoID, job.Type, job.Step, job.Optional, job.Payload, job.Status,
+		job.Result, job.ErrorMsg, job.Priority, job.Attempts, job.WorkerID,
+		job.StartedAt, job.FinishedAt, job.CreatedAt, job.UpdatedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("save job %s: %w", job.ID, err)
 	}
 
+	depQuery := `
+		INSERT INTO job_dependencies (job_id, depends_on_id)
+		VALUES($1, $2)
+		ON CONFLICT DO NOTHING;
+	`
+
+	for _, dep := range job.DependsOn {
+		if _, err := r.tx.ExecContext(ctx, depQuery, job.ID, dep); err != nil {
+			return fmt.Errorf("save job %s dependency on %s: %w", job.ID, dep, err)
+		}
+	}
+
 	return nil
 }
 
-func (r *PostgresJobRepo) FindByVideoID(ctx context.Context, id string) (*job.Job, error) {
-	j := &job.Job{}
+// FindByID finds the job entity specified by the id param
+func (r *PostgresJobRepo) FindByID(ctx context.Context, id string) (*job.Job, error) {
+	query := `SELECT ` + jobColumns + `
+		FROM jobs
+		WHERE id = $1;
+	`
 
-	query := `
-		SELECT id, video_id, type, status, result, error_msg, created_at, updated_at
+	j, err := scanJob(r.tx.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("scan job %s data: %w", id, err)
+	}
+
+	return j, nil
+}
+
+// ListByVideoID finds every job of the video's pipeline, oldest first
+func (r *PostgresJobRepo) ListByVideoID(ctx context.Context, videoID string) ([]*job.Job, error) {
+	query := `SELECT ` + jobColumns + `
 		FROM jobs
 		WHERE video_id = $1
-		ORDER BY created_at DESC
-		LIMIT 1;
+		ORDER BY created_at, id;
 	`
 
-	err := r.tx.QueryRowContext(ctx, query, id).Scan(
-		&j.ID,
-		&j.VideoID,
-		&j.Type,
-		&j.Status,
-		&j.Result,
-		&j.ErrorMsg,
-		&j.CreatedAt,
-		&j.UpdatedAt,
-	)
+	rows, err := r.tx.QueryContext(ctx, query, videoID)
+	if err != nil {
+		return nil, fmt.Errorf("query video %s jobs: %w", videoID, err)
+	}
+	defer rows.Close()
+
+	js := []*job.Job{}
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan jobs: %w", err)
+		}
+		js = append(js, j)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return js, nil
+}
+
+// FindNextPendingTranscodeJob claims the job that should be transcoded next,
+// the highest priority job whose dependencies have all completed.
+// Jobs locked by another claim are skipped, so concurrent workers never claim the same job.
+func (r *PostgresJobRepo) FindNextPendingTranscodeJob(ctx context.Context, workerID string) (*job.Job, error) {
+	query := `UPDATE jobs
+		SET status = 'running', worker_id = $1, attempts = attempts + 1,
+			started_at = NOW(), finished_at = NULL, updated_at = NOW()
+		WHERE id = (
+			SELECT id
+			FROM jobs
+			WHERE status = 'pending'
+			AND type IN ('probe', 'thumbnail', 'transcode', 'split', 'chunk_encode', 'assemble')
+			AND NOT EXISTS (
+				SELECT 1
+				FROM job_dependencies d
+				JOIN jobs dep ON dep.id = d.depends_on_id
+				WHERE d.job_id = jobs.id AND dep.status <> 'completed'
+			)
+			ORDER BY priority DESC, created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + jobColumns + `;
+	`
+
+	j, err := scanJob(r.tx.QueryRowContext(ctx, query, workerID))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
@@ -72,32 +170,225 @@ func (r *PostgresJobRepo) FindByVideoID(ctx context.Context, id string) (*job.Jo
 	return j, nil
 }
 
-// FindNextPendingTranscodeJob finds the oldest job that can be transcoded
-func (r *PostgresJobRepo) FindNextPendingTranscodeJob(ctx context.Context) (*job.Job, error) {
-	j := &job.Job{}
+// List finds the jobs matching the filter, newest first
+func (r *PostgresJobRepo) List(ctx context.Context, filter repo.JobFilter) ([]*job.Job, error) {
+	var conds []string
+	var args []any
 
-	query := `
-		SELECT id, video_id, type, status, created_at, updated_at
+	addCond := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.Status != "" {
+		addCond("status = $%d", filter.Status)
+	}
+	if filter.Type != "" {
+		addCond("type = $%d", filter.Type)
+	}
+	if filter.VideoID != "" {
+		addCond("video_id = $%d", filter.VideoID)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		addCond("created_at >= $%d", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		addCond("created_at < $%d", filter.CreatedBefore)
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	page := max(filter.Page, 1)
+	args = append(args, (page-1)*jobPageSize)
+
+	query := fmt.Sprintf(`SELECT %s
 		FROM jobs
-		WHERE status = 'pending' AND type = 'transcode'
-		ORDER BY created_at
-		LIMIT 1;
+		%s
+		ORDER BY created_at DESC
+		LIMIT %d OFFSET $%d
+	`, jobColumns, where, jobPageSize, len(args))
+
+	rows, err := r.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query jobs: %w", err)
+	}
+	defer rows.Close()
+
+	js := make([]*job.Job, 0, jobPageSize)
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan jobs: %w", err)
+		}
+		js = append(js, j)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return js, nil
+}
+
+// SaveCost stores the estimated cost of the job
+func (r *PostgresJobRepo) SaveCost(ctx context.Context, jobID string, cost job.Cost) error {
+	query := `
+		UPDATE jobs SET cost_cpu = $2, cost_memory = $3, cost_work = $4
+		WHERE id = $1;
+	`
+
+	_, err := r.tx.ExecContext(ctx, query, jobID, cost.CPU, cost.Memory, int64(cost.Work))
+	if err != nil {
+		return fmt.Errorf("save job %s cost: %w", jobID, err)
+	}
+
+	return nil
+}
+
+// SaveAttempt upserts the specified job attempt
+func (r *PostgresJobRepo) SaveAttempt(ctx context.Context, attempt *job.Attempt) error {
+	query := `
+		INSERT INTO job_attempts (job_id, number, worker_id, status,
+		exit_code, error_msg, stderr_tail, started_at, finished_at)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (job_id, number) DO UPDATE SET
+		status = EXCLUDED.status,
+		exit_code = EXCLUDED.exit_code,
+		error_msg = EXCLUDED.error_msg,
+		stderr_tail = EXCLUDED.stderr_tail,
+		finished_at = EXCLUDED.finished_at;
+	`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		attempt.JobID, attempt.Number, attempt.WorkerID, attempt.Status,
+		attempt.ExitCode, attempt.ErrorMsg, attempt.StderrTail,
+		attempt.StartedAt, attempt.FinishedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save job %s attempt %d: %w", attempt.JobID, attempt.Number, err)
+	}
+
+	return nil
+}
+
+// FindAttempt finds the attempt of the job specified by its number
+func (r *PostgresJobRepo) FindAttempt(ctx context.Context, jobID string, number int) (*job.Attempt, error) {
+	query := `SELECT ` + attemptColumns + `
+		FROM job_attempts
+		WHERE job_id = $1 AND number = $2;
 	`
 
-	err := r.tx.QueryRowContext(ctx, query).Scan(
+	a, err := scanAttempt(r.tx.QueryRowContext(ctx, query, jobID, number))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("scan job %s attempt %d data: %w", jobID, number, err)
+	}
+
+	return a, nil
+}
+
+// ListAttempts finds all the attempts of a job, oldest first
+func (r *PostgresJobRepo) ListAttempts(ctx context.Context, jobID string) ([]*job.Attempt, error) {
+	query := `SELECT ` + attemptColumns + `
+		FROM job_attempts
+		WHERE job_id = $1
+		ORDER BY number;
+	`
+
+	rows, err := r.tx.QueryContext(ctx, query, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("query job %s attempts: %w", jobID, err)
+	}
+	defer rows.Close()
+
+	as := []*job.Attempt{}
+	for rows.Next() {
+		a, err := scanAttempt(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan job attempts: %w", err)
+		}
+		as = append(as, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return as, nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanJob(row rowScanner) (*job.Job, error) {
+	j := &job.Job{}
+	var dependsOn string
+	var costCPU sql.NullFloat64
+	var costMemory, costWork sql.NullInt64
+
+	err := row.Scan(
 		&j.ID,
 		&j.VideoID,
 		&j.Type,
+		&j.Step,
+		&j.Optional,
+		&j.Payload,
+		&dependsOn,
 		&j.Status,
+		&j.Result,
+		&j.ErrorMsg,
+		&j.Priority,
+		&j.Attempts,
+		&j.WorkerID,
+		&costCPU,
+		&costMemory,
+		&costWork,
+		&j.StartedAt,
+		&j.FinishedAt,
 		&j.CreatedAt,
 		&j.UpdatedAt,
 	)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, sql.ErrNoRows
+		return nil, err
+	}
+
+	if dependsOn != "" {
+		j.DependsOn = strings.Split(dependsOn, ",")
+	}
+
+	if costCPU.Valid {
+		j.Cost = &job.Cost{
+			CPU:    costCPU.Float64,
+			Memory: costMemory.Int64,
+			Work:   time.Duration(costWork.Int64),
 		}
-		return nil, fmt.Errorf("scan job data: %w", err)
 	}
 
 	return j, nil
 }
+
+func scanAttempt(row rowScanner) (*job.Attempt, error) {
+	a := &job.Attempt{}
+
+	err := row.Scan(
+		&a.JobID,
+		&a.Number,
+		&a.WorkerID,
+		&a.Status,
+		&a.ExitCode,
+		&a.ErrorMsg,
+		&a.StderrTail,
+		&a.StartedAt,
+		&a.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
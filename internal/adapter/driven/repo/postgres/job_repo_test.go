@@ -2,10 +2,12 @@ package postgres_test
 
 import (
 	"database/sql"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/st-ember/streaming-api/internal/adapter/driven/repo/postgres"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 	"github.com/stretchr/testify/require"
 )
@@ -90,18 +92,28 @@ func TestPostgresJobRepo_FindNextPendingTranscodeJob_Success(t *testing.T) {
 		VALUES ('job-3-newer', 'vid-3', 'transcode', 'pending', $1, $1)`, time.Now())
 	require.NoError(t, err)
 
-	// This job is pending, but not a transcode job, so it should NOT be picked.
+	// This job is older, but cancelled, so it should NOT be picked.
 	_, err = tx.Exec(`INSERT INTO jobs (id, video_id, type, status, created_at, updated_at) 
-		VALUES ('job-4-thumbnail', 'vid-4', 'thumbnail', 'pending', $1, $1)`, time.Now().Add(-1*time.Hour))
+		VALUES ('job-4-cancelled', 'vid-4', 'thumbnail', 'cancelled', $1, $1)`, time.Now().Add(-1*time.Hour))
 	require.NoError(t, err)
 
 	// ACT
-	foundJob, err := repo.FindNextPendingTranscodeJob(t.Context())
+	foundJob, err := repo.FindNextPendingTranscodeJob(t.Context(), "worker-1")
 
 	// require
 	require.NoError(t, err)
 	require.NotNil(t, foundJob)
 	require.Equal(t, "job-2-oldest", foundJob.ID) // Verify we found the correct job.
+
+	// The job is claimed by the worker, so the next call picks another one
+	require.Equal(t, job.StatusRunning, foundJob.Status)
+	require.Equal(t, "worker-1", foundJob.WorkerID)
+	require.Equal(t, 1, foundJob.Attempts)
+	require.NotNil(t, foundJob.StartedAt)
+
+	nextJob, err := repo.FindNextPendingTranscodeJob(t.Context(), "worker-2")
+	require.NoError(t, err)
+	require.Equal(t, "job-3-newer", nextJob.ID)
 }
 
 func TestPostgresJobRepo_FindNextPendingTranscodeJob_NotFound(t *testing.T) {
@@ -116,9 +128,315 @@ func TestPostgresJobRepo_FindNextPendingTranscodeJob_NotFound(t *testing.T) {
 	require.NoError(t, err)
 
 	// ACT
-	foundJob, err := repo.FindNextPendingTranscodeJob(t.Context())
+	foundJob, err := repo.FindNextPendingTranscodeJob(t.Context(), "worker-1")
 
 	// require
 	require.ErrorIs(t, err, sql.ErrNoRows)
 	require.Nil(t, foundJob)
 }
+
+func TestPostgresJobRepo_FindByID_Success(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresJobRepo(tx)
+	savedJob, err := job.NewJob("job-id-1", "video-id-1", job.TypeTranscode)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(t.Context(), savedJob))
+
+	// ACT
+	foundJob, err := repo.FindByID(t.Context(), "job-id-1")
+
+	// require
+	require.NoError(t, err)
+	require.Equal(t, savedJob.ID, foundJob.ID)
+	require.Equal(t, savedJob.VideoID, foundJob.VideoID)
+	require.Equal(t, job.StatusPending, foundJob.Status)
+}
+
+func TestPostgresJobRepo_FindByID_NotFound(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresJobRepo(tx)
+
+	// ACT
+	foundJob, err := repo.FindByID(t.Context(), "missing-job")
+
+	// require
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.Nil(t, foundJob)
+}
+
+func TestPostgresJobRepo_FindNextPendingTranscodeJob_HighestPriorityFirst(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresJobRepo(tx)
+	_, err := tx.Exec(`INSERT INTO jobs (id, video_id, type, status, priority, created_at, updated_at) 
+		VALUES ('job-old', 'vid-1', 'transcode', 'pending', 0, $1, $1),
+		('job-bumped', 'vid-2', 'transcode', 'pending', 5, $2, $2)`,
+		time.Now().Add(-1*time.Hour), time.Now())
+	require.NoError(t, err)
+
+	// ACT
+	foundJob, err := repo.FindNextPendingTranscodeJob(t.Context(), "worker-1")
+
+	// require
+	require.NoError(t, err)
+	require.Equal(t, "job-bumped", foundJob.ID)
+	require.Equal(t, 5, foundJob.Priority)
+}
+
+func TestPostgresJobRepo_Save_RoundTripsAttemptData(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresJobRepo(tx)
+	savedJob, err := job.NewJob("job-id-1", "video-id-1", job.TypeTranscode)
+	require.NoError(t, err)
+	require.NoError(t, savedJob.Start("worker-1"))
+	require.NoError(t, savedJob.MarkAsFailed("ffmpeg exited"))
+
+	// ACT
+	require.NoError(t, repo.Save(t.Context(), savedJob))
+	foundJob, err := repo.FindByID(t.Context(), "job-id-1")
+
+	// require
+	require.NoError(t, err)
+	require.Equal(t, 1, foundJob.Attempts)
+	require.Equal(t, "worker-1", foundJob.WorkerID)
+	require.Equal(t, "ffmpeg exited", foundJob.ErrorMsg)
+	require.NotNil(t, foundJob.StartedAt)
+	require.NotNil(t, foundJob.FinishedAt)
+}
+
+func TestPostgresJobRepo_List_Filters(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	jobRepo := postgres.NewPostgresJobRepo(tx)
+	now := time.Now()
+	_, err := tx.Exec(`INSERT INTO jobs (id, video_id, type, status, created_at, updated_at) 
+		VALUES ('job-1', 'vid-1', 'transcode', 'failed', $1, $1),
+		('job-2', 'vid-2', 'transcode', 'failed', $2, $2),
+		('job-3', 'vid-1', 'transcode', 'completed', $2, $2)`,
+		now.Add(-48*time.Hour), now)
+	require.NoError(t, err)
+
+	// ACT
+	failed, err := jobRepo.List(t.Context(), repo.JobFilter{Status: job.StatusFailed, Page: 1})
+	require.NoError(t, err)
+	recent, err := jobRepo.List(t.Context(), repo.JobFilter{
+		VideoID:      "vid-1",
+		CreatedAfter: now.Add(-1 * time.Hour),
+		Page:         1,
+	})
+	require.NoError(t, err)
+	secondPage, err := jobRepo.List(t.Context(), repo.JobFilter{Page: 2})
+	require.NoError(t, err)
+
+	// require
+	require.Len(t, failed, 2)
+	require.Equal(t, "job-2", failed[0].ID) // Newest first
+	require.Len(t, recent, 1)
+	require.Equal(t, "job-3", recent[0].ID)
+	require.Empty(t, secondPage)
+}
+
+func TestPostgresJobRepo_Attempts_RoundTrip(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	jobRepo := postgres.NewPostgresJobRepo(tx)
+	j, err := job.NewJob("job-id-1", "video-id-1", job.TypeTranscode)
+	require.NoError(t, err)
+
+	// Two runs, the first one failing
+	require.NoError(t, j.Start("worker-1"))
+	first, err := job.NewAttempt(j)
+	require.NoError(t, err)
+	exitCode := 1
+	require.NoError(t, first.Fail("ffmpeg exited", &exitCode, "Invalid data found"))
+	require.NoError(t, j.MarkAsFailed("ffmpeg exited"))
+
+	require.NoError(t, j.Start("worker-2"))
+	second, err := job.NewAttempt(j)
+	require.NoError(t, err)
+
+	require.NoError(t, jobRepo.Save(t.Context(), j))
+
+	// ACT
+	require.NoError(t, jobRepo.SaveAttempt(t.Context(), first))
+	require.NoError(t, jobRepo.SaveAttempt(t.Context(), second))
+	found, findErr := jobRepo.FindAttempt(t.Context(), "job-id-1", 2)
+	attempts, listErr := jobRepo.ListAttempts(t.Context(), "job-id-1")
+
+	// require
+	require.NoError(t, findErr)
+	require.Equal(t, "worker-2", found.WorkerID)
+	require.Equal(t, job.StatusRunning, found.Status)
+	require.Nil(t, found.ExitCode)
+
+	require.NoError(t, listErr)
+	require.Len(t, attempts, 2)
+	require.Equal(t, 1, attempts[0].Number)
+	require.Equal(t, 1, *attempts[0].ExitCode)
+	require.Equal(t, "Invalid data found", attempts[0].StderrTail)
+	require.NotNil(t, attempts[0].FinishedAt)
+}
+
+func TestPostgresJobRepo_FindAttempt_NotFound(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	jobRepo := postgres.NewPostgresJobRepo(tx)
+
+	// ACT
+	found, err := jobRepo.FindAttempt(t.Context(), "missing-job", 1)
+
+	// require
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.Nil(t, found)
+}
+
+func TestPostgresJobRepo_Pipeline_RoundTripsDependencies(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	jobRepo := postgres.NewPostgresJobRepo(tx)
+	n := 0
+	jobs, err := job.NewPipeline("video-id-1", []job.PipelineStep{
+		{Name: "probe", Type: job.TypeTranscode},
+		{Name: "transcode", Type: job.TypeTranscode, DependsOn: []string{"probe"}},
+		{Name: "thumbnails", Type: job.TypeTranscode, DependsOn: []string{"probe"}, Optional: true},
+	}, func() string { n++; return fmt.Sprintf("job-id-%d", n) })
+	require.NoError(t, err)
+
+	// ACT
+	for _, j := range jobs {
+		require.NoError(t, jobRepo.Save(t.Context(), j))
+	}
+	found, listErr := jobRepo.ListByVideoID(t.Context(), "video-id-1")
+
+	// require
+	require.NoError(t, listErr)
+	require.Len(t, found, 3)
+	byStep := make(map[string]*job.Job)
+	for _, j := range found {
+		byStep[j.Step] = j
+	}
+	require.Empty(t, byStep["probe"].DependsOn)
+	require.Equal(t, []string{byStep["probe"].ID}, byStep["transcode"].DependsOn)
+	require.True(t, byStep["thumbnails"].Optional)
+	require.False(t, byStep["transcode"].Optional)
+}
+
+func TestPostgresJobRepo_FindNextPendingTranscodeJob_WaitsForDependencies(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresJobRepo(tx)
+
+	// The oldest job waits on a dependency that is still running
+	_, err := tx.Exec(`INSERT INTO jobs (id, video_id, type, status, created_at, updated_at) 
+		VALUES ('job-1-probe', 'vid-1', 'transcode', 'running', $1, $1)`, time.Now().Add(-2*time.Hour))
+	require.NoError(t, err)
+	_, err = tx.Exec(`INSERT INTO jobs (id, video_id, type, status, created_at, updated_at) 
+		VALUES ('job-2-blocked', 'vid-1', 'transcode', 'pending', $1, $1)`, time.Now().Add(-1*time.Hour))
+	require.NoError(t, err)
+	_, err = tx.Exec(`INSERT INTO job_dependencies (job_id, depends_on_id) VALUES ('job-2-blocked', 'job-1-probe')`)
+	require.NoError(t, err)
+
+	// A newer job without dependencies can run
+	_, err = tx.Exec(`INSERT INTO jobs (id, video_id, type, status, created_at, updated_at) 
+		VALUES ('job-3-ready', 'vid-2', 'transcode', 'pending', $1, $1)`, time.Now())
+	require.NoError(t, err)
+
+	// ACT
+	foundJob, err := repo.FindNextPendingTranscodeJob(t.Context(), "worker-1")
+
+	// require
+	require.NoError(t, err)
+	require.Equal(t, "job-3-ready", foundJob.ID)
+}
+
+func TestPostgresJobRepo_ChunkJobs_RoundTripPayload(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	jobRepo := postgres.NewPostgresJobRepo(tx)
+	n := 0
+	newID := func() string { n++; return fmt.Sprintf("job-id-%d", n) }
+
+	pipeline, err := job.NewPipeline("video-id-1", []job.PipelineStep{
+		{Name: "split", Type: job.TypeSplit},
+		{Name: "assemble", Type: job.TypeAssemble, DependsOn: []string{"split"}},
+	}, newID)
+	require.NoError(t, err)
+	split, assemble := pipeline[0], pipeline[1]
+	split.Status = job.StatusCompleted
+	for _, j := range pipeline {
+		require.NoError(t, jobRepo.Save(t.Context(), j))
+	}
+
+	jobs, err := job.NewChunkJobs(split, assemble, []string{"chunks/0000.mp4", "chunks/0001.mp4"}, newID)
+	require.NoError(t, err)
+
+	// ACT
+	for _, j := range append(jobs, assemble) {
+		require.NoError(t, jobRepo.Save(t.Context(), j))
+	}
+	next, nextErr := jobRepo.FindNextPendingTranscodeJob(t.Context(), "worker-1")
+
+	// require
+	require.NoError(t, nextErr)
+	require.Equal(t, job.TypeChunkEncode, next.Type)
+	spec, err := next.ChunkSpec()
+	require.NoError(t, err)
+	require.Equal(t, 2, spec.Count)
+
+	// The assemble job now waits on the chunks and knows them
+	stored, err := jobRepo.FindByID(t.Context(), assemble.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.DependsOn, 3)
+	spec, err = stored.ChunkSpec()
+	require.NoError(t, err)
+	require.Equal(t, 2, spec.Count)
+}
+
+func TestPostgresJobRepo_SaveCost_RoundTrip(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresJobRepo(tx)
+	j, err := job.NewJob("job-cost-1", "video-cost-1", job.TypeTranscode)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(t.Context(), j))
+
+	unestimated, err := repo.FindByID(t.Context(), j.ID)
+	require.NoError(t, err)
+	require.Nil(t, unestimated.Cost)
+
+	cost := job.Cost{CPU: 2.25, Memory: 512 << 20, Work: 90 * time.Second}
+
+	// ACT
+	require.NoError(t, repo.SaveCost(t.Context(), j.ID, cost))
+	require.NoError(t, repo.Save(t.Context(), j)) // Saving the job keeps its cost
+
+	// ASSERT
+	found, err := repo.FindByID(t.Context(), j.ID)
+	require.NoError(t, err)
+	require.Equal(t, &cost, found.Cost)
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/event"
+)
+
+// eventColumns lists the columns read by scanEvent, in scan order
+const eventColumns = `
+	id, type, video_id, payload, sequence, created_at, published_at
+`
+
+type PostgresOutboxRepo struct {
+	tx *sql.Tx
+}
+
+func NewPostgresOutboxRepo(tx *sql.Tx) *PostgresOutboxRepo {
+	return &PostgresOutboxRepo{tx}
+}
+
+// Save upserts the specified event and reads back its sequence number.
+// New events of a video are written by one transaction at a time, so a sequence number
+// is only assigned once the events numbered before it are committed, and relays never see them out of order.
+func (r *PostgresOutboxRepo) Save(ctx context.Context, e *event.Event) error {
+	if e.PublishedAt == nil {
+		if _, err := r.tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1));`, e.VideoID); err != nil {
+			return fmt.Errorf("lock events of video %s: %w", e.VideoID, err)
+		}
+	}
+
+	query := `
+		INSERT INTO outbox_events (id, type, video_id, payload, created_at, published_at)
+		VALUES($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO UPDATE SET
+		published_at = EXCLUDED.published_at
+		RETURNING sequence;
+	`
+
+	err := r.tx.QueryRowContext(ctx, query,
+		e.ID, e.Type, e.VideoID, e.Payload, e.CreatedAt, e.PublishedAt,
+	).Scan(&e.Sequence)
+	if err != nil {
+		return fmt.Errorf("save event %s: %w", e.ID, err)
+	}
+
+	return nil
+}
+
+// ClaimPending locks the head of the pending events of each video until the transaction ends.
+// While a relay holds the head of a video, the next events of the video are not visible
+// to other relays, and SKIP LOCKED lets them claim the events of other videos.
+func (r *PostgresOutboxRepo) ClaimPending(ctx context.Context, limit int) ([]*event.Event, error) {
+	query := `SELECT ` + eventColumns + `
+		FROM outbox_events e
+		WHERE e.published_at IS NULL
+		AND NOT EXISTS (
+			SELECT 1 FROM outbox_events p
+			WHERE p.video_id = e.video_id
+			AND p.published_at IS NULL
+			AND p.sequence < e.sequence
+		)
+		ORDER BY e.sequence
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED;
+	`
+
+	rows, err := r.tx.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query pending events: %w", err)
+	}
+	defer rows.Close()
+
+	es := []*event.Event{}
+	for rows.Next() {
+		e, err := scanEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan events: %w", err)
+		}
+		es = append(es, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return es, nil
+}
+
+// DeletePublishedBefore deletes the events published before the given time,
+// the relay only reads the unpublished ones
+func (r *PostgresOutboxRepo) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM outbox_events WHERE published_at < $1`
+
+	res, err := r.tx.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("delete published events: %w", err)
+	}
+
+	return res.RowsAffected()
+}
+
+func scanEvent(row rowScanner) (*event.Event, error) {
+	e := &event.Event{}
+
+	err := row.Scan(
+		&e.ID,
+		&e.Type,
+		&e.VideoID,
+		&e.Payload,
+		&e.Sequence,
+		&e.CreatedAt,
+		&e.PublishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
@@ -0,0 +1,129 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/repo/postgres"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresOutboxRepo_Save_AssignsSequence(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresOutboxRepo(tx)
+	first, err := event.NewEvent("event-1", event.TypeVideoUploaded, "video-1", nil)
+	require.NoError(t, err)
+	second, err := event.NewEvent("event-2", event.TypeVideoProcessing, "video-1", nil)
+	require.NoError(t, err)
+
+	// ACT
+	require.NoError(t, repo.Save(t.Context(), first))
+	require.NoError(t, repo.Save(t.Context(), second))
+
+	// require
+	require.Greater(t, second.Sequence, first.Sequence)
+}
+
+func TestPostgresOutboxRepo_Save_WaitsForEarlierEventsOfVideo(t *testing.T) {
+	truncateAll(t)
+
+	// ARRANGE: two transactions writing events of the same video
+	firstTx, err := TestDB.BeginTx(t.Context(), nil)
+	require.NoError(t, err)
+	defer firstTx.Rollback()
+	secondTx, err := TestDB.BeginTx(t.Context(), nil)
+	require.NoError(t, err)
+	defer secondTx.Rollback()
+
+	first, _ := event.NewEvent("event-locked-1", event.TypeVideoUploaded, "video-locked", nil)
+	second, _ := event.NewEvent("event-locked-2", event.TypeVideoProcessing, "video-locked", nil)
+	require.NoError(t, postgres.NewPostgresOutboxRepo(firstTx).Save(t.Context(), first))
+
+	// ACT
+	saved := make(chan error, 1)
+	go func() {
+		saved <- postgres.NewPostgresOutboxRepo(secondTx).Save(t.Context(), second)
+	}()
+
+	// require: the second event waits until the first one is committed
+	select {
+	case <-saved:
+		t.Fatal("event saved while an earlier event of the video was not committed")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	require.NoError(t, firstTx.Commit())
+	require.NoError(t, <-saved)
+	require.NoError(t, secondTx.Commit())
+	require.Greater(t, second.Sequence, first.Sequence)
+
+	truncateAll(t)
+}
+
+func TestPostgresOutboxRepo_ClaimPending_InOrderPerVideo(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresOutboxRepo(tx)
+	uploaded, _ := event.NewEvent("event-1", event.TypeVideoUploaded, "video-1", nil)
+	other, _ := event.NewEvent("event-2", event.TypeVideoUploaded, "video-2", nil)
+	processing, _ := event.NewEvent("event-3", event.TypeVideoProcessing, "video-1", nil)
+
+	for _, e := range []*event.Event{uploaded, other, processing} {
+		require.NoError(t, repo.Save(t.Context(), e))
+	}
+
+	// ACT
+	es, err := repo.ClaimPending(t.Context(), 10)
+
+	// require: the second event of video-1 waits for the first one
+	require.NoError(t, err)
+	require.Len(t, es, 2)
+	require.Equal(t, "event-1", es[0].ID)
+	require.Equal(t, "event-2", es[1].ID)
+
+	// ACT: publish the head of video-1
+	require.NoError(t, uploaded.MarkAsPublished(time.Now()))
+	require.NoError(t, repo.Save(t.Context(), uploaded))
+	es, err = repo.ClaimPending(t.Context(), 10)
+
+	// require
+	require.NoError(t, err)
+	require.Len(t, es, 2)
+	require.Equal(t, "event-2", es[0].ID)
+	require.Equal(t, "event-3", es[1].ID)
+	require.Nil(t, es[1].PublishedAt)
+}
+
+func TestPostgresOutboxRepo_DeletePublishedBefore(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresOutboxRepo(tx)
+	now := time.Now().UTC()
+	old, _ := event.NewEvent("event-1", event.TypeVideoUploaded, "video-1", nil)
+	require.NoError(t, old.MarkAsPublished(now.Add(-48*time.Hour)))
+	recent, _ := event.NewEvent("event-2", event.TypeVideoProcessing, "video-1", nil)
+	require.NoError(t, recent.MarkAsPublished(now))
+	pending, _ := event.NewEvent("event-3", event.TypeVideoPublished, "video-1", nil)
+
+	for _, e := range []*event.Event{old, recent, pending} {
+		require.NoError(t, repo.Save(t.Context(), e))
+	}
+
+	// ACT
+	n, err := repo.DeletePublishedBefore(t.Context(), now.Add(-24*time.Hour))
+
+	// require
+	require.NoError(t, err)
+	require.EqualValues(t, 1, n)
+	var left int
+	require.NoError(t, tx.QueryRow("SELECT COUNT(*) FROM outbox_events WHERE video_id = 'video-1'").Scan(&left))
+	require.Equal(t, 2, left)
+}
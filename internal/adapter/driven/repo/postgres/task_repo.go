@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+// taskColumns lists the columns read by scanTask, in scan order
+const taskColumns = `
+	id, kind, payload, cron, run_at, status,
+	last_run_at, locked_until, last_error, created_at, updated_at
+`
+
+type PostgresTaskRepo struct {
+	tx *sql.Tx
+}
+
+func NewPostgresTaskRepo(tx *sql.Tx) *PostgresTaskRepo {
+	return &PostgresTaskRepo{tx}
+}
+
+// Save upserts the specified task
+func (r *PostgresTaskRepo) Save(ctx context.Context, task *schedule.Task) error {
+	query := `
+		INSERT INTO scheduled_tasks (id, kind, payload, cron, run_at, status,
+		last_run_at, locked_until, last_error, created_at, updated_at)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (id) DO UPDATE SET
+		cron = EXCLUDED.cron,
+		run_at = EXCLUDED.run_at,
+		status = EXCLUDED.status,
+		last_run_at = EXCLUDED.last_run_at,
+		locked_until = EXCLUDED.locked_until,
+		last_error = EXCLUDED.last_error,
+		updated_at = EXCLUDED.updated_at;
+	`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		task.ID, task.Kind, task.Payload, task.Cron, task.RunAt, task.Status,
+		task.LastRunAt, task.LockedUntil, task.LastError, task.CreatedAt, task.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save task %s: %w", task.ID, err)
+	}
+
+	return nil
+}
+
+// FindByID finds the task specified by the id param
+func (r *PostgresTaskRepo) FindByID(ctx context.Context, id string) (*schedule.Task, error) {
+	query := `SELECT ` + taskColumns + `
+		FROM scheduled_tasks
+		WHERE id = $1;
+	`
+
+	t, err := scanTask(r.tx.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("scan task %s data: %w", id, err)
+	}
+
+	return t, nil
+}
+
+// LockByID finds the task specified by the id param and locks its row until the transaction ends
+func (r *PostgresTaskRepo) LockByID(ctx context.Context, id string) (*schedule.Task, error) {
+	query := `SELECT ` + taskColumns + `
+		FROM scheduled_tasks
+		WHERE id = $1
+		FOR UPDATE;
+	`
+
+	t, err := scanTask(r.tx.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("lock task %s: %w", id, err)
+	}
+
+	return t, nil
+}
+
+// ClaimDue locks the due tasks until the transaction ends, along with the running tasks
+// whose lease expired. SKIP LOCKED lets several scheduler instances claim different tasks concurrently.
+func (r *PostgresTaskRepo) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*schedule.Task, error) {
+	query := `SELECT ` + taskColumns + `
+		FROM scheduled_tasks
+		WHERE (status = 'scheduled' AND run_at <= $1)
+		OR (status = 'running' AND locked_until <= $1)
+		ORDER BY run_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED;
+	`
+
+	rows, err := r.tx.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query due tasks: %w", err)
+	}
+	defer rows.Close()
+
+	ts := []*schedule.Task{}
+	for rows.Next() {
+		t, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan tasks: %w", err)
+		}
+		ts = append(ts, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return ts, nil
+}
+
+// DeleteFinishedBefore deletes the tasks completed, failed or cancelled before the given time,
+// recurring tasks only finish when cancelled
+func (r *PostgresTaskRepo) DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	query := `
+		DELETE FROM scheduled_tasks
+		WHERE status IN ('completed', 'failed', 'cancelled')
+		AND updated_at < $1
+	`
+
+	res, err := r.tx.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("delete finished tasks: %w", err)
+	}
+
+	return res.RowsAffected()
+}
+
+func scanTask(row rowScanner) (*schedule.Task, error) {
+	t := &schedule.Task{}
+
+	err := row.Scan(
+		&t.ID,
+		&t.Kind,
+		&t.Payload,
+		&t.Cron,
+		&t.RunAt,
+		&t.Status,
+		&t.LastRunAt,
+		&t.LockedUntil,
+		&t.LastError,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
@@ -0,0 +1,115 @@
+package postgres_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/repo/postgres"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresTaskRepo_Save_RoundTrip(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresTaskRepo(tx)
+	task, err := schedule.NewRecurringTask("task-1", "gc", "", "0 3 * * *", time.Now())
+	require.NoError(t, err)
+
+	// ACT
+	require.NoError(t, repo.Save(t.Context(), task))
+	found, err := repo.FindByID(t.Context(), "task-1")
+
+	// require
+	require.NoError(t, err)
+	require.Equal(t, schedule.TaskKind("gc"), found.Kind)
+	require.Equal(t, "0 3 * * *", found.Cron)
+	require.Equal(t, schedule.StatusScheduled, found.Status)
+	require.WithinDuration(t, task.RunAt, found.RunAt, time.Second)
+	require.Nil(t, found.LastRunAt)
+}
+
+func TestPostgresTaskRepo_FindByID_NotFound(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	repo := postgres.NewPostgresTaskRepo(tx)
+
+	found, err := repo.FindByID(t.Context(), "missing")
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.Nil(t, found)
+}
+
+func TestPostgresTaskRepo_ClaimDue_OnlyDueAndExpiredLeases(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresTaskRepo(tx)
+	now := time.Now().UTC()
+
+	due, err := schedule.NewTask("task-due", "video.publish", "video-1", now.Add(-time.Minute))
+	require.NoError(t, err)
+	later, err := schedule.NewTask("task-later", "video.publish", "video-2", now.Add(time.Hour))
+	require.NoError(t, err)
+	cancelled, err := schedule.NewTask("task-cancelled", "video.publish", "video-3", now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, cancelled.Cancel())
+
+	running, err := schedule.NewTask("task-running", "video.publish", "video-4", now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, running.Fire(now, time.Hour))
+	interrupted, err := schedule.NewTask("task-interrupted", "video.publish", "video-5", now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, interrupted.Fire(now.Add(-time.Hour), time.Minute))
+
+	for _, task := range []*schedule.Task{due, later, cancelled, running, interrupted} {
+		require.NoError(t, repo.Save(t.Context(), task))
+	}
+
+	// ACT
+	ts, err := repo.ClaimDue(t.Context(), now, 10)
+
+	// require
+	require.NoError(t, err)
+	require.Len(t, ts, 2)
+	require.ElementsMatch(t, []string{"task-due", "task-interrupted"}, []string{ts[0].ID, ts[1].ID})
+}
+
+func TestPostgresTaskRepo_DeleteFinishedBefore(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresTaskRepo(tx)
+	now := time.Now().UTC()
+
+	finished, err := schedule.NewTask("task-finished", "video.publish", "video-1", now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, finished.Fire(now, time.Minute))
+	require.NoError(t, finished.Complete())
+	finished.UpdatedAt = now.Add(-48 * time.Hour)
+	scheduled, err := schedule.NewTask("task-scheduled", "video.publish", "video-2", now.Add(time.Hour))
+	require.NoError(t, err)
+	scheduled.UpdatedAt = now.Add(-48 * time.Hour)
+	recurring, err := schedule.NewRecurringTask("task-recurring", "gc", "", "0 3 * * *", now)
+	require.NoError(t, err)
+	recurring.UpdatedAt = now.Add(-48 * time.Hour)
+
+	for _, task := range []*schedule.Task{finished, scheduled, recurring} {
+		require.NoError(t, repo.Save(t.Context(), task))
+	}
+
+	// ACT
+	n, err := repo.DeleteFinishedBefore(t.Context(), now.Add(-24*time.Hour))
+
+	// require
+	require.NoError(t, err)
+	require.EqualValues(t, 1, n)
+	_, err = repo.FindByID(t.Context(), "task-finished")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
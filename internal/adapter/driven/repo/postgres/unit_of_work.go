@@ -50,6 +50,21 @@ func (u *PostgresUnitOfWork) AuthRepo() repo.AuthRepo {
 	return NewPostgresAuthRepoWithTransaction(u.tx)
 }
 
+// TaskRepo returns a new PostgresTaskRepo that uses the UoW's transaction.
+func (u *PostgresUnitOfWork) TaskRepo() repo.TaskRepo {
+	return NewPostgresTaskRepo(u.tx)
+}
+
+// OutboxRepo returns a new PostgresOutboxRepo that uses the UoW's transaction.
+func (u *PostgresUnitOfWork) OutboxRepo() repo.OutboxRepo {
+	return NewPostgresOutboxRepo(u.tx)
+}
+
+// WebhookRepo returns a new PostgresWebhookRepo that uses the UoW's transaction.
+func (u *PostgresUnitOfWork) WebhookRepo() repo.WebhookRepo {
+	return NewPostgresWebhookRepo(u.tx)
+}
+
 // Commit finalizes the transaction
 func (u *PostgresUnitOfWork) Commit(ctx context.Context) error {
 	return u.tx.Commit()
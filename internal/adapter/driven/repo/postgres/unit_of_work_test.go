@@ -18,7 +18,7 @@ func TestUnitOfWork_Commit(t *testing.T) {
 	uowFactory := postgres.NewPostgresUnitOfWorkFactory(TestDB)
 
 	// Create a video entity to save.
-	newVideo, err := video.NewVideo("uow-video-1", "Commit Test", "Desc", "commit.mp4", "uow-resource-1")
+	newVideo, err := video.NewVideo("uow-video-1", "owner-id", "Commit Test", "Desc", "commit.mp4", "uow-resource-1")
 	require.NoError(t, err)
 
 	// ACT
@@ -50,7 +50,7 @@ func TestUnitOfWork_Rollback(t *testing.T) {
 	uowFactory := postgres.NewPostgresUnitOfWorkFactory(TestDB)
 	ctx := t.Context() // Use t.Context() for calls within the test logic.
 
-	newVideo, err := video.NewVideo("uow-video-2", "Rollback Test", "Desc", "rollback.mp4", "uow-resource-2")
+	newVideo, err := video.NewVideo("uow-video-2", "owner-id", "Rollback Test", "Desc", "rollback.mp4", "uow-resource-2")
 	require.NoError(t, err)
 
 	// ACT
@@ -80,7 +80,7 @@ func TestUnitOfWork_RepoIsTransactional(t *testing.T) {
 	uowFactory := postgres.NewPostgresUnitOfWorkFactory(TestDB)
 	ctx := t.Context()
 
-	newVideo, err := video.NewVideo("uow-video-3", "TX Test", "Desc", "tx.mp4", "uow-resource-3")
+	newVideo, err := video.NewVideo("uow-video-3", "owner-id", "TX Test", "Desc", "tx.mp4", "uow-resource-3")
 	require.NoError(t, err)
 	newJob, err := job.NewJob("uow-job-3", newVideo.ID, job.TypeTranscode)
 	require.NoError(t, err)
@@ -6,9 +6,12 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
 	"github.com/st-ember/streaming-api/internal/domain/video"
 )
 
+const videoPageSize = 10
+
 type PostgresVideoRepo struct {
 	tx *sql.Tx
 }
@@ -20,9 +23,9 @@ func NewPostgresVideoRepo(tx *sql.Tx) *PostgresVideoRepo {
 // Save upserts the specified video
 func (r *PostgresVideoRepo) Save(ctx context.Context, video *video.Video) error {
 	query := `
-		INSERT INTO videos (id, title, description, duration, filename, 
-		resource_id, status, created_at, updated_at)
-		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO videos (id, owner_id, title, description, duration, filename,
+		resource_id, status, publish_at, progress_job_id, created_at, updated_at)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		ON CONFLICT (id) DO UPDATE SET
 		title = EXCLUDED.title,
 		description = EXCLUDED.description,
@@ -30,12 +33,15 @@ func (r *PostgresVideoRepo) Save(ctx context.Context, video *video.Video) error
 		filename = EXCLUDED.filename,
 		resource_id = EXCLUDED.resource_id,
 		status = EXCLUDED.status,
+		publish_at = EXCLUDED.publish_at,
+		progress_job_id = EXCLUDED.progress_job_id,
 		updated_at = EXCLUDED.updated_at;
 	`
 
 	_, err := r.tx.ExecContext(ctx, query,
-		video.ID, video.Title, video.Description, video.Duration,
-		video.Filename, video.ResourceID, video.Status, video.CreatedAt, video.UpdatedAt,
+		video.ID, video.OwnerID, video.Title, video.Description, video.Duration,
+		video.Filename, video.ResourceID, video.Status, video.PublishAt,
+		video.ProgressJobID, video.CreatedAt, video.UpdatedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("save video %s: %w", video.ID, err)
@@ -46,23 +52,36 @@ func (r *PostgresVideoRepo) Save(ctx context.Context, video *video.Video) error
 
 // FindByID finds the video entity specified by the id param
 func (r *PostgresVideoRepo) FindByID(ctx context.Context, id string) (*video.Video, error) {
+	return r.findByID(ctx, id, "")
+}
+
+// LockByID finds the video entity specified by the id param and locks its row until the transaction ends
+func (r *PostgresVideoRepo) LockByID(ctx context.Context, id string) (*video.Video, error) {
+	return r.findByID(ctx, id, "FOR UPDATE")
+}
+
+func (r *PostgresVideoRepo) findByID(ctx context.Context, id string, lock string) (*video.Video, error) {
 	v := &video.Video{}
 
 	query := `
-		SELECT id, title, description, duration, filename,
-		resource_id, status, created_at, updated_at
+		SELECT id, owner_id, title, description, duration, filename,
+		resource_id, status, publish_at, COALESCE(progress_job_id, ''), created_at, updated_at
 		FROM videos
-		WHERE id = $1;
+		WHERE id = $1
+		` + lock + `;
 	`
 
 	err := r.tx.QueryRowContext(ctx, query, id).Scan(
 		&v.ID,
+		&v.OwnerID,
 		&v.Title,
 		&v.Description,
 		&v.Duration,
 		&v.Filename,
 		&v.ResourceID,
 		&v.Status,
+		&v.PublishAt,
+		&v.ProgressJobID,
 		&v.CreatedAt,
 		&v.UpdatedAt,
 	)
@@ -76,32 +95,47 @@ func (r *PostgresVideoRepo) FindByID(ctx context.Context, id string) (*video.Vid
 	return v, nil
 }
 
-func (r *PostgresVideoRepo) List(ctx context.Context, page int) ([]*video.Video, error) {
-	offset := (page - 1) * 10
-	query := `
-		SELECT id, title, description, duration, filename,
-		resource_id, status, created_at, updated_at
+// List finds a page of videos, newest first, narrowed down by the filter
+func (r *PostgresVideoRepo) List(ctx context.Context, filter repo.VideoFilter) ([]*video.Video, error) {
+	var args []any
+	where := ""
+	if filter.OwnerID != "" {
+		args = append(args, filter.OwnerID)
+		where = "WHERE owner_id = $1"
+	}
+
+	page := max(filter.Page, 1)
+	args = append(args, (page-1)*videoPageSize)
+
+	query := fmt.Sprintf(`
+		SELECT id, owner_id, title, description, duration, filename,
+		resource_id, status, publish_at, COALESCE(progress_job_id, ''), created_at, updated_at
 		FROM videos
-		WHERE status = 'published'
-		LIMIT 10 OFFSET $1
-	`
-	rows, err := r.tx.QueryContext(ctx, query, offset)
-	if err != nil { // TODO: fix this along with comment reformat
-		return nil, fmt.Errorf("failed to get riddles at storage layer: %w", err)
+		%s
+		ORDER BY created_at DESC
+		LIMIT %d OFFSET $%d
+	`, where, videoPageSize, len(args))
+
+	rows, err := r.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query videos: %w", err)
 	}
 	defer rows.Close()
 
-	vs := make([]*video.Video, 0, 10)
+	vs := make([]*video.Video, 0, videoPageSize)
 	for rows.Next() {
 		v := &video.Video{}
 		err := rows.Scan(
 			&v.ID,
+			&v.OwnerID,
 			&v.Title,
 			&v.Description,
 			&v.Duration,
 			&v.Filename,
 			&v.ResourceID,
 			&v.Status,
+			&v.PublishAt,
+			&v.ProgressJobID,
 			&v.CreatedAt,
 			&v.UpdatedAt,
 		)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/st-ember/streaming-api/internal/adapter/driven/repo/postgres"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
 	"github.com/st-ember/streaming-api/internal/domain/video"
 	"github.com/stretchr/testify/require"
 )
@@ -16,7 +17,7 @@ func TestPostgresVideoRepo_Save_Insert(t *testing.T) {
 
 	// ARRANGE
 	repo := postgres.NewPostgresVideoRepo(tx)
-	newVideo, err := video.NewVideo("video-id-1", "Test Title", "Test Desc", "test.mp4", "resource-1")
+	newVideo, err := video.NewVideo("video-id-1", "owner-id", "Test Title", "Test Desc", "test.mp4", "resource-1")
 	require.NoError(t, err)
 
 	// ACT
@@ -40,7 +41,7 @@ func TestPostgresVideoRepo_Save_Update(t *testing.T) {
 	repo := postgres.NewPostgresVideoRepo(tx)
 
 	// First, insert a video.
-	originalVideo, err := video.NewVideo("video-id-1", "Original Title", "Original Desc", "test.mp4", "resource-1")
+	originalVideo, err := video.NewVideo("video-id-1", "owner-id", "Original Title", "Original Desc", "test.mp4", "resource-1")
 	require.NoError(t, err)
 	err = repo.Save(t.Context(), originalVideo)
 	require.NoError(t, err)
@@ -74,8 +75,11 @@ func TestPostgresVideoRepo_FindByID_Success(t *testing.T) {
 	// ARRANGE
 	repo := postgres.NewPostgresVideoRepo(tx)
 	// Insert a video to be found.
-	videoToFind, err := video.NewVideo("video-id-2", "Find Me", "Desc", "find.mp4", "resource-2")
+	videoToFind, err := video.NewVideo("video-id-2", "owner-id", "Find Me", "Desc", "find.mp4", "resource-2")
 	require.NoError(t, err)
+	publishAt := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	require.NoError(t, videoToFind.SchedulePublish(publishAt))
+	require.NoError(t, videoToFind.TrackProgress("job-id"))
 	err = repo.Save(t.Context(), videoToFind)
 	require.NoError(t, err)
 
@@ -86,7 +90,10 @@ func TestPostgresVideoRepo_FindByID_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, foundVideo)
 	require.Equal(t, videoToFind.ID, foundVideo.ID)
+	require.Equal(t, videoToFind.OwnerID, foundVideo.OwnerID)
 	require.Equal(t, videoToFind.Title, foundVideo.Title)
+	require.True(t, publishAt.Equal(*foundVideo.PublishAt))
+	require.Equal(t, "job-id", foundVideo.ProgressJobID)
 }
 
 func TestPostgresVideoRepo_FindByID_NotFound(t *testing.T) {
@@ -104,3 +111,83 @@ func TestPostgresVideoRepo_FindByID_NotFound(t *testing.T) {
 	require.ErrorIs(t, err, sql.ErrNoRows) // Verify the specific "not found" error is returned.
 	require.Nil(t, foundVideo)
 }
+
+func TestPostgresVideoRepo_LockByID_WaitsForOtherLock(t *testing.T) {
+	truncateAll(t)
+
+	// ARRANGE: a video and two transactions locking it
+	v, err := video.NewVideo("video-locked", "owner-id", "Locked", "Desc", "locked.mp4", "resource-locked")
+	require.NoError(t, err)
+	saveTx, err := TestDB.BeginTx(t.Context(), nil)
+	require.NoError(t, err)
+	require.NoError(t, postgres.NewPostgresVideoRepo(saveTx).Save(t.Context(), v))
+	require.NoError(t, saveTx.Commit())
+
+	firstTx, err := TestDB.BeginTx(t.Context(), nil)
+	require.NoError(t, err)
+	defer firstTx.Rollback()
+	secondTx, err := TestDB.BeginTx(t.Context(), nil)
+	require.NoError(t, err)
+	defer secondTx.Rollback()
+
+	_, err = postgres.NewPostgresVideoRepo(firstTx).LockByID(t.Context(), "video-locked")
+	require.NoError(t, err)
+
+	// ACT
+	locked := make(chan error, 1)
+	go func() {
+		_, err := postgres.NewPostgresVideoRepo(secondTx).LockByID(t.Context(), "video-locked")
+		locked <- err
+	}()
+
+	// ASSERT: the second lock waits until the first transaction ends
+	select {
+	case <-locked:
+		t.Fatal("video locked twice at once")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	require.NoError(t, firstTx.Commit())
+	require.NoError(t, <-locked)
+	require.NoError(t, secondTx.Commit())
+
+	truncateAll(t)
+}
+
+func TestPostgresVideoRepo_List(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	videoRepo := postgres.NewPostgresVideoRepo(tx)
+	for _, v := range []struct{ id, owner string }{
+		{"video-mine-1", "owner-1"},
+		{"video-mine-2", "owner-1"},
+		{"video-other", "owner-2"},
+	} {
+		newVideo, err := video.NewVideo(v.id, v.owner, "Title", "Desc", "list.mp4", "resource-"+v.id)
+		require.NoError(t, err)
+		require.NoError(t, videoRepo.Save(t.Context(), newVideo))
+	}
+
+	t.Run("should list only the videos of the owner", func(t *testing.T) {
+		// ACT
+		vs, err := videoRepo.List(t.Context(), repo.VideoFilter{OwnerID: "owner-1", Page: 1})
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Len(t, vs, 2)
+		for _, v := range vs {
+			require.Equal(t, "owner-1", v.OwnerID)
+		}
+	})
+
+	t.Run("should list every video without an owner filter", func(t *testing.T) {
+		// ACT
+		vs, err := videoRepo.List(t.Context(), repo.VideoFilter{Page: 1})
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Len(t, vs, 3)
+	})
+}
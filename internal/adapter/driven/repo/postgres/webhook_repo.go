@@ -0,0 +1,302 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+// subscriptionColumns lists the columns read by scanSubscription, in scan order
+const subscriptionColumns = `
+	id, url, secret, event_types, active, created_at, updated_at
+`
+
+// deliveryColumns lists the columns read by scanDelivery, in scan order
+const deliveryColumns = `
+	id, subscription_id, event_id, event_type, payload, status, attempts,
+	next_attempt_at, last_status_code, last_error, delivered_at, created_at, updated_at
+`
+
+// deliveriesPerPage is the page size of the delivery log
+const deliveriesPerPage = 20
+
+type PostgresWebhookRepo struct {
+	tx *sql.Tx
+}
+
+func NewPostgresWebhookRepo(tx *sql.Tx) *PostgresWebhookRepo {
+	return &PostgresWebhookRepo{tx}
+}
+
+// SaveSubscription upserts the specified subscription
+func (r *PostgresWebhookRepo) SaveSubscription(ctx context.Context, s *webhook.Subscription) error {
+	query := `
+		INSERT INTO webhook_subscriptions (id, url, secret, event_types, active, created_at, updated_at)
+		VALUES($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO UPDATE SET
+		url = EXCLUDED.url,
+		secret = EXCLUDED.secret,
+		event_types = EXCLUDED.event_types,
+		active = EXCLUDED.active,
+		updated_at = EXCLUDED.updated_at;
+	`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		s.ID, s.URL, s.Secret, joinEventTypes(s.EventTypes), s.Active, s.CreatedAt, s.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save subscription %s: %w", s.ID, err)
+	}
+
+	return nil
+}
+
+// FindSubscriptionByID finds the subscription specified by the id param
+func (r *PostgresWebhookRepo) FindSubscriptionByID(ctx context.Context, id string) (*webhook.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE id = $1;
+	`
+
+	s, err := scanSubscription(r.tx.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("scan subscription %s data: %w", id, err)
+	}
+
+	return s, nil
+}
+
+// ListSubscriptions finds every subscription, oldest first
+func (r *PostgresWebhookRepo) ListSubscriptions(ctx context.Context) ([]*webhook.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + `
+		FROM webhook_subscriptions
+		ORDER BY created_at;
+	`
+
+	return r.querySubscriptions(ctx, query)
+}
+
+// ListActiveSubscriptions finds the subscriptions receiving new events
+func (r *PostgresWebhookRepo) ListActiveSubscriptions(ctx context.Context) ([]*webhook.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE active
+		ORDER BY created_at;
+	`
+
+	return r.querySubscriptions(ctx, query)
+}
+
+// DeleteSubscription deletes the subscription, its deliveries are deleted in cascade
+func (r *PostgresWebhookRepo) DeleteSubscription(ctx context.Context, id string) error {
+	res, err := r.tx.ExecContext(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1;`, id)
+	if err != nil {
+		return fmt.Errorf("delete subscription %s: %w", id, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("count deleted subscriptions: %w", err)
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// SaveDelivery upserts the specified delivery
+func (r *PostgresWebhookRepo) SaveDelivery(ctx context.Context, d *webhook.Delivery) error {
+	query := `
+		INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, payload, status, attempts,
+		next_attempt_at, last_status_code, last_error, delivered_at, created_at, updated_at)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (id) DO UPDATE SET
+		status = EXCLUDED.status,
+		attempts = EXCLUDED.attempts,
+		next_attempt_at = EXCLUDED.next_attempt_at,
+		last_status_code = EXCLUDED.last_status_code,
+		last_error = EXCLUDED.last_error,
+		delivered_at = EXCLUDED.delivered_at,
+		updated_at = EXCLUDED.updated_at;
+	`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		d.ID, d.SubscriptionID, d.EventID, d.EventType, d.Payload, d.Status, d.Attempts,
+		d.NextAttemptAt, d.LastStatusCode, d.LastError, d.DeliveredAt, d.CreatedAt, d.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save delivery %s: %w", d.ID, err)
+	}
+
+	return nil
+}
+
+// FindDeliveryByID finds the delivery specified by the id param
+func (r *PostgresWebhookRepo) FindDeliveryByID(ctx context.Context, id string) (*webhook.Delivery, error) {
+	query := `SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries
+		WHERE id = $1;
+	`
+
+	d, err := scanDelivery(r.tx.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("scan delivery %s data: %w", id, err)
+	}
+
+	return d, nil
+}
+
+// ListDeliveries finds a page of the deliveries of a subscription, newest first
+func (r *PostgresWebhookRepo) ListDeliveries(ctx context.Context, subscriptionID string, page int) ([]*webhook.Delivery, error) {
+	offset := (page - 1) * deliveriesPerPage
+
+	query := `SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries
+		WHERE subscription_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3;
+	`
+
+	return r.queryDeliveries(ctx, query, subscriptionID, deliveriesPerPage, offset)
+}
+
+// ClaimDueDeliveries locks the due deliveries until the transaction ends.
+// SKIP LOCKED lets several dispatchers claim different deliveries concurrently.
+func (r *PostgresWebhookRepo) ClaimDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error) {
+	query := `SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries
+		WHERE status = 'pending' AND next_attempt_at <= $1
+		ORDER BY next_attempt_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED;
+	`
+
+	return r.queryDeliveries(ctx, query, now, limit)
+}
+
+func (r *PostgresWebhookRepo) querySubscriptions(ctx context.Context, query string, args ...any) ([]*webhook.Subscription, error) {
+	rows, err := r.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	ss := []*webhook.Subscription{}
+	for rows.Next() {
+		s, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan subscriptions: %w", err)
+		}
+		ss = append(ss, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return ss, nil
+}
+
+func (r *PostgresWebhookRepo) queryDeliveries(ctx context.Context, query string, args ...any) ([]*webhook.Delivery, error) {
+	rows, err := r.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	ds := []*webhook.Delivery{}
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan deliveries: %w", err)
+		}
+		ds = append(ds, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return ds, nil
+}
+
+func scanSubscription(row rowScanner) (*webhook.Subscription, error) {
+	s := &webhook.Subscription{}
+	var eventTypes string
+
+	err := row.Scan(
+		&s.ID,
+		&s.URL,
+		&s.Secret,
+		&eventTypes,
+		&s.Active,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.EventTypes = splitEventTypes(eventTypes)
+
+	return s, nil
+}
+
+func scanDelivery(row rowScanner) (*webhook.Delivery, error) {
+	d := &webhook.Delivery{}
+
+	err := row.Scan(
+		&d.ID,
+		&d.SubscriptionID,
+		&d.EventID,
+		&d.EventType,
+		&d.Payload,
+		&d.Status,
+		&d.Attempts,
+		&d.NextAttemptAt,
+		&d.LastStatusCode,
+		&d.LastError,
+		&d.DeliveredAt,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Event filters are stored as a comma separated list, empty for every event
+func joinEventTypes(ts []event.EventType) string {
+	ss := make([]string, len(ts))
+	for i, t := range ts {
+		ss[i] = string(t)
+	}
+
+	return strings.Join(ss, ",")
+}
+
+func splitEventTypes(s string) []event.EventType {
+	if s == "" {
+		return nil
+	}
+
+	parts := strings.Split(s, ",")
+	ts := make([]event.EventType, len(parts))
+	for i, p := range parts {
+		ts[i] = event.EventType(p)
+	}
+
+	return ts
+}
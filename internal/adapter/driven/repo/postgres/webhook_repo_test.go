@@ -0,0 +1,136 @@
+package postgres_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/repo/postgres"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresWebhookRepo_SaveAndFindSubscription(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresWebhookRepo(tx)
+	s, err := webhook.NewSubscription("sub-1", "https://example.com/hook", "secret",
+		[]event.EventType{event.TypeVideoPublished, event.TypeVideoFailed})
+	require.NoError(t, err)
+
+	// ACT
+	require.NoError(t, repo.SaveSubscription(t.Context(), s))
+	found, err := repo.FindSubscriptionByID(t.Context(), "sub-1")
+
+	// require
+	require.NoError(t, err)
+	require.Equal(t, s.URL, found.URL)
+	require.Equal(t, s.EventTypes, found.EventTypes)
+	require.True(t, found.Active)
+}
+
+func TestPostgresWebhookRepo_ListActiveSubscriptions(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresWebhookRepo(tx)
+	active, _ := webhook.NewSubscription("sub-1", "https://example.com/a", "secret", nil)
+	paused, _ := webhook.NewSubscription("sub-2", "https://example.com/b", "secret", nil)
+	paused.SetActive(false)
+	require.NoError(t, repo.SaveSubscription(t.Context(), active))
+	require.NoError(t, repo.SaveSubscription(t.Context(), paused))
+
+	// ACT
+	ss, err := repo.ListActiveSubscriptions(t.Context())
+
+	// require
+	require.NoError(t, err)
+	require.Len(t, ss, 1)
+	require.Equal(t, "sub-1", ss[0].ID)
+	require.Empty(t, ss[0].EventTypes)
+}
+
+func TestPostgresWebhookRepo_DeleteSubscription_CascadesDeliveries(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresWebhookRepo(tx)
+	s, _ := webhook.NewSubscription("sub-1", "https://example.com/hook", "secret", nil)
+	e, _ := event.NewEvent("event-1", event.TypeVideoUploaded, "video-1", nil)
+	d, _ := webhook.NewDelivery("delivery-1", s, e)
+	require.NoError(t, repo.SaveSubscription(t.Context(), s))
+	require.NoError(t, repo.SaveDelivery(t.Context(), d))
+
+	// ACT
+	require.NoError(t, repo.DeleteSubscription(t.Context(), "sub-1"))
+
+	// require
+	_, err := repo.FindDeliveryByID(t.Context(), "delivery-1")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.ErrorIs(t, repo.DeleteSubscription(t.Context(), "sub-1"), sql.ErrNoRows)
+}
+
+func TestPostgresWebhookRepo_ClaimDueDeliveries(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresWebhookRepo(tx)
+	s, _ := webhook.NewSubscription("sub-1", "https://example.com/hook", "secret", nil)
+	require.NoError(t, repo.SaveSubscription(t.Context(), s))
+
+	e, _ := event.NewEvent("event-1", event.TypeVideoUploaded, "video-1", nil)
+	due, _ := webhook.NewDelivery("delivery-1", s, e)
+	later, _ := webhook.NewDelivery("delivery-2", s, e)
+	done, _ := webhook.NewDelivery("delivery-3", s, e)
+
+	now := time.Now()
+	require.NoError(t, later.Claim(now, time.Minute))
+	require.NoError(t, done.Claim(now, time.Minute))
+	require.NoError(t, done.Succeed(200, now))
+
+	for _, d := range []*webhook.Delivery{due, later, done} {
+		require.NoError(t, repo.SaveDelivery(t.Context(), d))
+	}
+
+	// ACT
+	ds, err := repo.ClaimDueDeliveries(t.Context(), now, 10)
+
+	// require
+	require.NoError(t, err)
+	require.Len(t, ds, 1)
+	require.Equal(t, "delivery-1", ds[0].ID)
+	require.Equal(t, e.ID, ds[0].EventID)
+	require.Equal(t, due.Payload, ds[0].Payload)
+}
+
+func TestPostgresWebhookRepo_ListDeliveries_NewestFirst(t *testing.T) {
+	t.Parallel()
+	tx := beginTx(t)
+
+	// ARRANGE
+	repo := postgres.NewPostgresWebhookRepo(tx)
+	s, _ := webhook.NewSubscription("sub-1", "https://example.com/hook", "secret", nil)
+	require.NoError(t, repo.SaveSubscription(t.Context(), s))
+
+	e, _ := event.NewEvent("event-1", event.TypeVideoUploaded, "video-1", nil)
+	older, _ := webhook.NewDelivery("delivery-1", s, e)
+	older.CreatedAt = older.CreatedAt.Add(-time.Minute)
+	newer, _ := webhook.NewDelivery("delivery-2", s, e)
+	require.NoError(t, repo.SaveDelivery(t.Context(), older))
+	require.NoError(t, repo.SaveDelivery(t.Context(), newer))
+
+	// ACT
+	ds, err := repo.ListDeliveries(t.Context(), "sub-1", 1)
+
+	// require
+	require.NoError(t, err)
+	require.Len(t, ds, 2)
+	require.Equal(t, "delivery-2", ds[0].ID)
+	require.Equal(t, "delivery-1", ds[1].ID)
+}
@@ -54,6 +54,18 @@ func (s *LocalAssetStorer) Save(ctx context.Context, resourceID, assetPath strin
 	return nil
 }
 
+// Delete deletes the asset, or folder of assets, at `assetPath` within the `resourceID` folder
+func (s *LocalAssetStorer) Delete(ctx context.Context, resourceID, assetPath string) error {
+	// Assemble full asset path
+	fullPath := filepath.Join(s.basePath, resourceID, assetPath)
+
+	if err := os.RemoveAll(fullPath); err != nil {
+		return fmt.Errorf("delete asset %s for resource %s: %w", assetPath, resourceID, err)
+	}
+
+	return nil
+}
+
 // DeleteAll deletes all the content within the folder specified by the `resourceID`
 func (s *LocalAssetStorer) DeleteAll(ctx context.Context, resourceID string) error {
 	// Assemble resource root path
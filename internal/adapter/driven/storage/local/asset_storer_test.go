@@ -54,6 +54,33 @@ func TestSave(t *testing.T) {
 	require.Equal(t, string(savedContent), content)
 }
 
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	tempDir := t.TempDir()
+	storer, err := local.NewLocalAssetStorer(tempDir)
+	require.NoError(t, err)
+
+	resourceID := "test-resource"
+	err = storer.Save(t.Context(), resourceID, "chunks/0000.mp4", strings.NewReader("chunk"))
+	require.NoError(t, err)
+	err = storer.Save(t.Context(), resourceID, "manifest.mpd", strings.NewReader("keep me"))
+	require.NoError(t, err)
+
+	// --- ACT ---
+	err = storer.Delete(t.Context(), resourceID, "chunks")
+
+	// --- require ---
+	require.NoError(t, err)
+
+	// Only the specified folder is removed
+	_, err = os.Stat(filepath.Join(tempDir, resourceID, "chunks"))
+	require.True(t, os.IsNotExist(err), "expected chunks directory to be deleted")
+	_, err = os.Stat(filepath.Join(tempDir, resourceID, "manifest.mpd"))
+	require.NoError(t, err)
+}
+
 func TestDeleteAll(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,10 @@ package token
 import "errors"
 
 var (
-	ErrInvalidSigningMethod = errors.New("unexpected signing method")
-	ErrInvalidToken         = errors.New("invalid token")
+	ErrInvalidSigningMethod    = errors.New("unexpected signing method")
+	ErrInvalidToken            = errors.New("invalid token")
+	ErrUnknownKey              = errors.New("unknown signing key")
+	ErrNoSigningKey            = errors.New("no active signing key")
+	ErrInvalidKeyEncryptionKey = errors.New("invalid key encryption key")
+	ErrSealedKeyInvalid        = errors.New("sealed private key cannot be opened")
 )
@@ -2,40 +2,59 @@ package token
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 )
 
 type JwtToken struct {
-	accessSecret  []byte
 	refreshSecret []byte
+	sealer        *KeySealer
+
+	mu   sync.RWMutex
+	keys map[string]*signingKey // by kid
 }
 
-func NewJwtToken(accessSecret, refreshSecret []byte) token.Token {
-	return &JwtToken{accessSecret, refreshSecret}
+// NewJwtToken creates a token without signing keys,
+// access tokens can be issued once keys are set with SetSigningKeys.
+// The private keys of signing keys are opened with the sealer.
+func NewJwtToken(refreshSecret []byte, sealer *KeySealer) token.Token {
+	return &JwtToken{refreshSecret: refreshSecret, sealer: sealer, keys: make(map[string]*signingKey)}
 }
 
-func (tg *JwtToken) GenerateAccess(userID, username string, permissions []string) (string, error) {
+func (tg *JwtToken) GenerateAccess(userID, username, sessionID string, permissions []string) (string, error) {
+	k, err := tg.currentKey()
+	if err != nil {
+		return "", err
+	}
+
 	claims := token.AccessClaims{
 		UserID:      userID,
 		Username:    username,
+		SessionID:   sessionID,
 		Permissions: permissions,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(15 * time.Minute)),
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(token.AccessLifetime)),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(tg.accessSecret)
+	t := jwt.NewWithClaims(k.method, claims)
+	t.Header["kid"] = k.ID
+	return t.SignedString(k.private)
 }
 
-func (tg *JwtToken) GenerateRefresh(userID string) (string, error) {
+func (tg *JwtToken) GenerateRefresh(userID, familyID, tokenID string) (string, error) {
 	claims := token.RefreshClaims{
-		UserID: userID,
+		UserID:   userID,
+		FamilyID: familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(7 * 24 * time.Hour)),
+			ID:        tokenID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(token.RefreshLifetime)),
 		},
 	}
 
@@ -45,11 +64,23 @@ func (tg *JwtToken) GenerateRefresh(userID string) (string, error) {
 
 func (tg *JwtToken) ParseAccess(tokenStr string) (*token.AccessClaims, error) {
 	t, err := jwt.ParseWithClaims(tokenStr, &token.AccessClaims{}, func(t *jwt.Token) (any, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+		alg := t.Method.Alg()
+		if alg != auth.SigningAlgorithmRS256 && alg != auth.SigningAlgorithmEdDSA {
 			return nil, fmt.Errorf("%w: %v", ErrInvalidSigningMethod, t.Header["alg"])
 		}
 
-		return tg.accessSecret, nil
+		kid, _ := t.Header["kid"].(string)
+		k, err := tg.verifyingKey(kid)
+		if err != nil {
+			return nil, err
+		}
+
+		// A key only verifies the algorithm it was made for
+		if alg != k.Algorithm {
+			return nil, fmt.Errorf("%w: %s for key %s", ErrInvalidSigningMethod, alg, kid)
+		}
+
+		return k.public, nil
 	})
 
 	if err != nil {
@@ -88,3 +119,69 @@ func (tg *JwtToken) ParseRefresh(tokenStr string) (*token.RefreshClaims, error)
 
 	return claims, nil
 }
+
+// SetSigningKeys decodes every key before replacing the current ones,
+// so a key that cannot be used leaves the previous keys in place
+func (tg *JwtToken) SetSigningKeys(keys []*auth.SigningKey) error {
+	decoded := make(map[string]*signingKey, len(keys))
+	for _, k := range keys {
+		sk, err := decodeSigningKey(k, tg.sealer)
+		if err != nil {
+			return fmt.Errorf("decode signing key %s: %w", k.ID, err)
+		}
+		decoded[k.ID] = sk
+	}
+
+	tg.mu.Lock()
+	defer tg.mu.Unlock()
+
+	tg.keys = decoded
+
+	return nil
+}
+
+func (tg *JwtToken) PublicKeys() []token.JWK {
+	tg.mu.RLock()
+	defer tg.mu.RUnlock()
+
+	now := time.Now()
+	jwks := make([]token.JWK, 0, len(tg.keys))
+	for _, k := range tg.keys {
+		if k.Verifies(now) {
+			jwks = append(jwks, k.jwk())
+		}
+	}
+
+	return jwks
+}
+
+// currentKey returns the key signing new access tokens
+func (tg *JwtToken) currentKey() (*signingKey, error) {
+	tg.mu.RLock()
+	defer tg.mu.RUnlock()
+
+	keys := make([]*auth.SigningKey, 0, len(tg.keys))
+	for _, k := range tg.keys {
+		keys = append(keys, k.SigningKey)
+	}
+
+	current := auth.CurrentSigningKey(keys, time.Now())
+	if current == nil {
+		return nil, ErrNoSigningKey
+	}
+
+	return tg.keys[current.ID], nil
+}
+
+// verifyingKey returns the key named by a token, as long as it verifies tokens
+func (tg *JwtToken) verifyingKey(kid string) (*signingKey, error) {
+	tg.mu.RLock()
+	defer tg.mu.RUnlock()
+
+	k, ok := tg.keys[kid]
+	if !ok || !k.Verifies(time.Now()) {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, kid)
+	}
+
+	return k, nil
+}
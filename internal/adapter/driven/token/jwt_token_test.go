@@ -1,100 +1,243 @@
 package token_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/st-ember/streaming-api/internal/adapter/driven/token"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 	"github.com/stretchr/testify/require"
 )
 
 type jwtTokenTestHelper struct {
-	accessSecret  []byte
 	refreshSecret []byte
+	sealer        *token.KeySealer
 	require       *require.Assertions
 }
 
 func setupJwtTokenTestHelper(t *testing.T) *jwtTokenTestHelper {
+	sealer, err := token.NewKeySealer(bytes.Repeat([]byte("k"), 32))
+	require.NoError(t, err)
+
 	return &jwtTokenTestHelper{
-		accessSecret:  []byte("access-secret"),
 		refreshSecret: []byte("refresh-secret"),
+		sealer:        sealer,
 		require:       require.New(t),
 	}
 }
 
+// newSigningKey generates a key for the algorithm, signing from activeFrom
+func (h *jwtTokenTestHelper) newSigningKey(id, algorithm string, activeFrom time.Time) *auth.SigningKey {
+	der, err := token.NewKeyGenerator(h.sealer).Generate(algorithm)
+	h.require.NoError(err)
+
+	k, err := auth.NewSigningKey(id, algorithm, der, activeFrom)
+	h.require.NoError(err)
+
+	return k
+}
+
+// newJwtToken builds a token signing with the keys
+func (h *jwtTokenTestHelper) newJwtToken(keys ...*auth.SigningKey) tokenport.Token {
+	jwtToken := token.NewJwtToken(h.refreshSecret, h.sealer)
+	h.require.NoError(jwtToken.SetSigningKeys(keys))
+
+	return jwtToken
+}
+
 func TestAccessSuite(t *testing.T) {
 	h := setupJwtTokenTestHelper(t)
-	jwtToken := token.NewJwtToken(h.accessSecret, h.refreshSecret)
+	now := time.Now()
+	jwtToken := h.newJwtToken(h.newSigningKey("key-1", auth.SigningAlgorithmEdDSA, now))
 
-	t.Run("should generate and parse access token", func(t *testing.T) {
-		userID := "user-123"
-		username := "tester"
-		permissions := []string{"video:upload", "video:delete"}
+	for _, algorithm := range []string{auth.SigningAlgorithmRS256, auth.SigningAlgorithmEdDSA} {
+		t.Run("should generate and parse access token with "+algorithm, func(t *testing.T) {
+			jwtToken := h.newJwtToken(h.newSigningKey("key-"+algorithm, algorithm, now))
 
-		tokenStr, err := jwtToken.GenerateAccess(userID, username, permissions)
-		h.require.NoError(err)
-		h.require.NotEmpty(tokenStr)
+			userID := "user-123"
+			username := "tester"
+			permissions := []string{"video:upload", "video:delete"}
+
+			tokenStr, err := jwtToken.GenerateAccess(userID, username, "session-1", permissions)
+			h.require.NoError(err)
+			h.require.NotEmpty(tokenStr)
+
+			claims, err := jwtToken.ParseAccess(tokenStr)
+			h.require.NoError(err)
+			h.require.Equal(userID, claims.UserID)
+			h.require.Equal(username, claims.Username)
+			h.require.Equal("session-1", claims.SessionID)
+			h.require.NotEmpty(claims.ID)
+			h.require.ElementsMatch(permissions, claims.Permissions)
 
-		claims, err := jwtToken.ParseAccess(tokenStr)
+			// The key is named in the header
+			parsed, _, err := jwt.NewParser().ParseUnverified(tokenStr, jwt.MapClaims{})
+			h.require.NoError(err)
+			h.require.Equal("key-"+algorithm, parsed.Header["kid"])
+			h.require.Equal(algorithm, parsed.Header["alg"])
+		})
+	}
+
+	t.Run("should give every access token its own id", func(t *testing.T) {
+		first, _ := jwtToken.GenerateAccess("id", "user", "session-1", nil)
+		second, _ := jwtToken.GenerateAccess("id", "user", "session-1", nil)
+
+		firstClaims, err := jwtToken.ParseAccess(first)
 		h.require.NoError(err)
-		h.require.Equal(userID, claims.UserID)
-		h.require.Equal(username, claims.Username)
-		h.require.ElementsMatch(permissions, claims.Permissions)
+		secondClaims, err := jwtToken.ParseAccess(second)
+		h.require.NoError(err)
+
+		h.require.NotEqual(firstClaims.ID, secondClaims.ID)
 	})
 
-	t.Run("should fail to parse access token with wrong secret", func(t *testing.T) {
-		tgWrong := token.NewJwtToken([]byte("wrong-secret"), h.refreshSecret)
+	t.Run("should fail without a session", func(t *testing.T) {
+		tokenStr, _ := jwtToken.GenerateAccess("id", "user", "", nil)
+
+		_, err := jwtToken.ParseAccess(tokenStr)
 
-		tokenStr, _ := jwtToken.GenerateAccess("id", "user", nil)
-		_, err := tgWrong.ParseAccess(tokenStr)
 		h.require.ErrorIs(err, token.ErrInvalidToken)
+		h.require.ErrorContains(err, "missing session id")
 	})
 
-	t.Run("should fail with invalid token string", func(t *testing.T) {
-		_, err := jwtToken.ParseAccess("invalid-token")
+	t.Run("should fail to generate without an active key", func(t *testing.T) {
+		pending := h.newJwtToken(h.newSigningKey("key-pending", auth.SigningAlgorithmEdDSA, now.Add(time.Hour)))
+
+		_, err := pending.GenerateAccess("id", "user", "session-1", nil)
+
+		h.require.ErrorIs(err, token.ErrNoSigningKey)
+	})
+
+	t.Run("should fail to parse access token signed with an unknown key", func(t *testing.T) {
+		other := h.newJwtToken(h.newSigningKey("key-other", auth.SigningAlgorithmEdDSA, now))
+
+		tokenStr, _ := other.GenerateAccess("id", "user", "session-1", nil)
+		_, err := jwtToken.ParseAccess(tokenStr)
+
 		h.require.ErrorIs(err, token.ErrInvalidToken)
+		h.require.ErrorIs(err, token.ErrUnknownKey)
 	})
 
-	t.Run("should fail with mismatched payload", func(t *testing.T) {
-		wrongToken := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-			"wrong_field": "data",
-		})
-		signedStr, _ := wrongToken.SignedString(h.accessSecret)
+	t.Run("should fail to parse access token forged under a known kid", func(t *testing.T) {
+		// Same kid, different key pair
+		forger := h.newJwtToken(h.newSigningKey("key-1", auth.SigningAlgorithmEdDSA, now))
 
-		_, err := jwtToken.ParseAccess(signedStr)
+		tokenStr, _ := forger.GenerateAccess("id", "user", "session-1", nil)
+		_, err := jwtToken.ParseAccess(tokenStr)
 
 		h.require.ErrorIs(err, token.ErrInvalidToken)
-		h.require.ErrorContains(err, "missing user id")
+	})
+
+	t.Run("should fail with invalid token string", func(t *testing.T) {
+		_, err := jwtToken.ParseAccess("invalid-token")
+		h.require.ErrorIs(err, token.ErrInvalidToken)
 	})
 
 	t.Run("should fail with invalid signing method", func(t *testing.T) {
-		invalidAlgToken := "eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiJ1c2VyLTEyMyJ9.fake-sig"
-		_, err := jwtToken.ParseAccess(invalidAlgToken)
+		// A verifier of public keys must never accept a token signed with a shared secret
+		hmacToken := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"UserID": "user-123"})
+		hmacToken.Header["kid"] = "key-1"
+		signedStr, _ := hmacToken.SignedString([]byte("secret"))
+
+		_, err := jwtToken.ParseAccess(signedStr)
 
 		h.require.ErrorIs(err, token.ErrInvalidSigningMethod)
 	})
 }
 
+func TestKeyRotation(t *testing.T) {
+	h := setupJwtTokenTestHelper(t)
+	now := time.Now()
+
+	t.Run("should keep verifying tokens of a replaced key until it expires", func(t *testing.T) {
+		oldKey := h.newSigningKey("key-old", auth.SigningAlgorithmRS256, now.Add(-time.Hour))
+		jwtToken := h.newJwtToken(oldKey)
+		oldToken, err := jwtToken.GenerateAccess("id", "user", "session-1", nil)
+		h.require.NoError(err)
+
+		// Rotate to a new key
+		newKey := h.newSigningKey("key-new", auth.SigningAlgorithmEdDSA, now)
+		oldKey.Retire(now.Add(tokenport.AccessLifetime))
+		h.require.NoError(jwtToken.SetSigningKeys([]*auth.SigningKey{oldKey, newKey}))
+
+		_, err = jwtToken.ParseAccess(oldToken)
+		h.require.NoError(err)
+
+		newToken, err := jwtToken.GenerateAccess("id", "user", "session-1", nil)
+		h.require.NoError(err)
+		parsed, _, _ := jwt.NewParser().ParseUnverified(newToken, jwt.MapClaims{})
+		h.require.Equal("key-new", parsed.Header["kid"])
+
+		// Once expired the old key is dropped
+		oldKey.ExpiresAt = &now
+		h.require.NoError(jwtToken.SetSigningKeys([]*auth.SigningKey{oldKey, newKey}))
+
+		_, err = jwtToken.ParseAccess(oldToken)
+		h.require.ErrorIs(err, token.ErrUnknownKey)
+	})
+
+	t.Run("should publish every verifying key", func(t *testing.T) {
+		current := h.newSigningKey("key-current", auth.SigningAlgorithmRS256, now)
+		pending := h.newSigningKey("key-pending", auth.SigningAlgorithmEdDSA, now.Add(time.Hour))
+		expired := h.newSigningKey("key-expired", auth.SigningAlgorithmEdDSA, now.Add(-time.Hour))
+		expired.Retire(now)
+
+		jwks := h.newJwtToken(current, pending, expired).PublicKeys()
+
+		h.require.Len(jwks, 2)
+		byKid := map[string]tokenport.JWK{}
+		for _, jwk := range jwks {
+			byKid[jwk.Kid] = jwk
+		}
+
+		h.require.Equal("RSA", byKid["key-current"].Kty)
+		h.require.Equal("RS256", byKid["key-current"].Alg)
+		h.require.Equal("sig", byKid["key-current"].Use)
+		h.require.Equal("AQAB", byKid["key-current"].E)
+		h.require.NotEmpty(byKid["key-current"].N)
+
+		h.require.Equal("OKP", byKid["key-pending"].Kty)
+		h.require.Equal("Ed25519", byKid["key-pending"].Crv)
+		h.require.NotEmpty(byKid["key-pending"].X)
+	})
+
+	t.Run("should keep the previous keys when a key cannot be decoded", func(t *testing.T) {
+		valid := h.newSigningKey("key-valid", auth.SigningAlgorithmEdDSA, now)
+		jwtToken := h.newJwtToken(valid)
+
+		// An RSA key stored as EdDSA
+		der, _ := token.NewKeyGenerator(h.sealer).Generate(auth.SigningAlgorithmRS256)
+		mismatched, _ := auth.NewSigningKey("key-mismatched", auth.SigningAlgorithmEdDSA, der, now)
+
+		h.require.Error(jwtToken.SetSigningKeys([]*auth.SigningKey{mismatched}))
+		h.require.Len(jwtToken.PublicKeys(), 1)
+	})
+}
+
 func TestRefreshSuite(t *testing.T) {
 	h := setupJwtTokenTestHelper(t)
-	jwtToken := token.NewJwtToken(h.accessSecret, h.refreshSecret)
+	jwtToken := token.NewJwtToken(h.refreshSecret, h.sealer)
 
 	t.Run("should generate and parse refresh token", func(t *testing.T) {
 		userID := "user-123"
 
-		tokenStr, err := jwtToken.GenerateRefresh(userID)
+		tokenStr, err := jwtToken.GenerateRefresh(userID, "family-1", "token-1")
 		h.require.NoError(err)
 		h.require.NotEmpty(tokenStr)
 
 		claims, err := jwtToken.ParseRefresh(tokenStr)
 		h.require.NoError(err)
 		h.require.Equal(userID, claims.UserID)
+		h.require.Equal("family-1", claims.FamilyID)
+		h.require.Equal("token-1", claims.ID)
 	})
 
 	t.Run("should fail to parse refresh token with wrong secret", func(t *testing.T) {
-		tgWrong := token.NewJwtToken(h.accessSecret, []byte("wrong-secret"))
+		tgWrong := token.NewJwtToken([]byte("wrong-secret"), h.sealer)
 
-		tokenStr, _ := jwtToken.GenerateRefresh("id")
+		tokenStr, _ := jwtToken.GenerateRefresh("id", "family-1", "token-1")
 		_, err := tgWrong.ParseRefresh(tokenStr)
 		h.require.ErrorIs(err, token.ErrInvalidToken)
 	})
@@ -116,6 +259,19 @@ func TestRefreshSuite(t *testing.T) {
 		h.require.ErrorContains(err, "missing user id")
 	})
 
+	t.Run("should fail without a family", func(t *testing.T) {
+		noFamilyToken := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"UserID": "user-123",
+			"jti":    "token-1",
+		})
+		signedStr, _ := noFamilyToken.SignedString(h.refreshSecret)
+
+		_, err := jwtToken.ParseRefresh(signedStr)
+
+		h.require.ErrorIs(err, token.ErrInvalidToken)
+		h.require.ErrorContains(err, "missing family id")
+	})
+
 	t.Run("should fail with invalid signing method", func(t *testing.T) {
 		invalidAlgToken := "eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiJ1c2VyLTEyMyJ9.fake-sig"
 		_, err := jwtToken.ParseRefresh(invalidAlgToken)
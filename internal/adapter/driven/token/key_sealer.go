@@ -0,0 +1,69 @@
+package token
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+)
+
+// sealedKeyMagic starts the private keys sealed by a KeySealer.
+// Keys stored before sealing was introduced start with a DER sequence instead, and are read as is.
+var sealedKeyMagic = []byte("sk1:")
+
+// KeySealer encrypts the private keys of signing keys with AES-256-GCM under a key encryption key,
+// so the keys stored in the db cannot sign tokens without it
+type KeySealer struct {
+	aead cipher.AEAD
+}
+
+// NewKeySealer creates a sealer from a 32 byte key encryption key
+func NewKeySealer(kek []byte) (*KeySealer, error) {
+	if len(kek) != 32 {
+		return nil, fmt.Errorf("%w: got %d bytes, want 32", ErrInvalidKeyEncryptionKey, len(kek))
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create gcm: %w", err)
+	}
+
+	return &KeySealer{aead}, nil
+}
+
+// Seal encrypts a private key, prefixing it with its nonce
+func (s *KeySealer) Seal(private []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	sealed := append(bytes.Clone(sealedKeyMagic), nonce...)
+	return s.aead.Seal(sealed, nonce, private, sealedKeyMagic), nil
+}
+
+// Open decrypts a private key sealed by Seal
+func (s *KeySealer) Open(sealed []byte) ([]byte, error) {
+	rest, ok := bytes.CutPrefix(sealed, sealedKeyMagic)
+	if !ok {
+		return sealed, nil
+	}
+
+	if len(rest) < s.aead.NonceSize() {
+		return nil, ErrSealedKeyInvalid
+	}
+
+	nonce, ciphertext := rest[:s.aead.NonceSize()], rest[s.aead.NonceSize():]
+	private, err := s.aead.Open(nil, nonce, ciphertext, sealedKeyMagic)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSealedKeyInvalid, err)
+	}
+
+	return private, nil
+}
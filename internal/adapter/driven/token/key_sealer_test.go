@@ -0,0 +1,57 @@
+package token_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeySealer(t *testing.T) {
+	sealer, err := token.NewKeySealer(bytes.Repeat([]byte("k"), 32))
+	require.NoError(t, err)
+
+	t.Run("should open what it sealed", func(t *testing.T) {
+		sealed, err := sealer.Seal([]byte("private"))
+		require.NoError(t, err)
+		require.NotContains(t, string(sealed), "private")
+
+		opened, err := sealer.Open(sealed)
+		require.NoError(t, err)
+		require.Equal(t, []byte("private"), opened)
+	})
+
+	t.Run("should not open a key sealed under another key encryption key", func(t *testing.T) {
+		other, _ := token.NewKeySealer(bytes.Repeat([]byte("o"), 32))
+		sealed, _ := other.Seal([]byte("private"))
+
+		_, err := sealer.Open(sealed)
+		require.ErrorIs(t, err, token.ErrSealedKeyInvalid)
+	})
+
+	t.Run("should read keys stored before sealing as they are", func(t *testing.T) {
+		legacy := []byte{0x30, 0x2e}
+
+		opened, err := sealer.Open(legacy)
+		require.NoError(t, err)
+		require.Equal(t, legacy, opened)
+	})
+
+	t.Run("should reject a key encryption key of the wrong size", func(t *testing.T) {
+		_, err := token.NewKeySealer([]byte("short"))
+		require.ErrorIs(t, err, token.ErrInvalidKeyEncryptionKey)
+	})
+
+	t.Run("should sign with generated keys only once opened", func(t *testing.T) {
+		sealed, err := token.NewKeyGenerator(sealer).Generate(auth.SigningAlgorithmEdDSA)
+		require.NoError(t, err)
+		k, _ := auth.NewSigningKey("key-1", auth.SigningAlgorithmEdDSA, sealed, time.Now())
+
+		other, _ := token.NewKeySealer(bytes.Repeat([]byte("o"), 32))
+		require.Error(t, token.NewJwtToken(nil, other).SetSigningKeys([]*auth.SigningKey{k}))
+		require.NoError(t, token.NewJwtToken(nil, sealer).SetSigningKeys([]*auth.SigningKey{k}))
+	})
+}
@@ -0,0 +1,113 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// rsaKeyBits is the size of generated RSA keys
+const rsaKeyBits = 2048
+
+// signingKey is a signing key decoded for use with jwt
+type signingKey struct {
+	*auth.SigningKey
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+func decodeSigningKey(k *auth.SigningKey, sealer *KeySealer) (*signingKey, error) {
+	der, err := sealer.Open(k.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("open private key: %w", err)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+
+	switch private := parsed.(type) {
+	case *rsa.PrivateKey:
+		if k.Algorithm != auth.SigningAlgorithmRS256 {
+			break
+		}
+		return &signingKey{k, jwt.SigningMethodRS256, private, private.Public()}, nil
+	case ed25519.PrivateKey:
+		if k.Algorithm != auth.SigningAlgorithmEdDSA {
+			break
+		}
+		return &signingKey{k, jwt.SigningMethodEdDSA, private, private.Public()}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %T key for %s", ErrInvalidSigningMethod, parsed, k.Algorithm)
+}
+
+// jwk describes the public key as a JSON Web Key (RFC 7517, RFC 8037)
+func (k *signingKey) jwk() token.JWK {
+	jwk := token.JWK{Kid: k.ID, Use: "sig", Alg: k.Algorithm}
+
+	switch public := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+
+	return jwk
+}
+
+// KeyGenerator creates private keys sealed by the sealer, ready to be stored
+type KeyGenerator struct {
+	sealer *KeySealer
+}
+
+func NewKeyGenerator(sealer *KeySealer) token.KeyGenerator {
+	return &KeyGenerator{sealer}
+}
+
+func (g *KeyGenerator) Generate(algorithm string) ([]byte, error) {
+	var private any
+	switch algorithm {
+	case auth.SigningAlgorithmRS256:
+		k, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, fmt.Errorf("generate rsa key: %w", err)
+		}
+		private = k
+	case auth.SigningAlgorithmEdDSA:
+		_, k, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("generate ed25519 key: %w", err)
+		}
+		private = k
+	default:
+		return nil, fmt.Errorf("%w: %q", auth.ErrSigningAlgorithm, algorithm)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, fmt.Errorf("encode private key: %w", err)
+	}
+
+	sealed, err := g.sealer.Seal(der)
+	if err != nil {
+		return nil, fmt.Errorf("seal private key: %w", err)
+	}
+
+	return sealed, nil
+}
@@ -0,0 +1,278 @@
+package ffmpeg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+)
+
+// encodedDir holds the encoded chunks, within the chunks folder of a resource
+const encodedDir = "encoded"
+
+// encodedChunkPath is the path of an encoded chunk, relative to the resource
+func encodedChunkPath(index int, r rendition) string {
+	return filepath.Join(transcode.ChunksDir, encodedDir, fmt.Sprintf("%04d_%s.mp4", index, r.name))
+}
+
+// Split cuts the video stream of the source into chunks of about the configured duration.
+// Stream copy can only cut on keyframes, so every chunk can be encoded on its own.
+func (t *FFMPEGTranscoder) Split(ctx context.Context, resourceID, sourceFilename, jobID string) (*transcode.TranscodeOutput, error) {
+	if t.opts.ChunkDuration <= 0 {
+		return nil, errors.New("chunk duration is not configured")
+	}
+
+	// Assemble full path
+	sourcePath := filepath.Join(t.basePath, resourceID, sourceFilename)
+
+	// Create temp dir for the chunks
+	// The worker will move the files for permanent storage
+	outputDir, err := os.MkdirTemp("", "split-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temporary directory for output: %w", err)
+	}
+
+	chunksDir := filepath.Join(outputDir, transcode.ChunksDir)
+	if err := os.MkdirAll(chunksDir, 0755); err != nil {
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("create chunks directory: %w", err)
+	}
+
+	args := []string{
+		// Set input
+		"-i", sourcePath,
+
+		// Keep the video only, the audio is encoded in one piece when assembling
+		"-map", "0:v:0",
+		"-c", "copy",
+
+		// Cut into numbered chunks, each starting at timestamp zero
+		"-f", "segment",
+		"-segment_time", strconv.FormatFloat(t.opts.ChunkDuration.Seconds(), 'f', -1, 64),
+		"-reset_timestamps", "1",
+		filepath.Join(chunksDir, "%04d.mp4"),
+	}
+
+	if err := t.runCommand(ctx, args); err != nil {
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("ffmpeg execution: %w", err)
+	}
+
+	// Chunk names are zero padded, so the walk lists them in playback order
+	outputFiles, err := listOutput(outputDir)
+	if err != nil {
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("assemble chunk files: %w", err)
+	}
+
+	return &transcode.TranscodeOutput{
+		OutputDir:   outputDir,
+		OutputFiles: outputFiles,
+	}, nil
+}
+
+// EncodeChunk encodes a chunk into every rendition of the stream.
+// Its progress is pushed as part of the progress of all the chunks.
+func (t *FFMPEGTranscoder) EncodeChunk(ctx context.Context, resourceID string, spec job.ChunkSpec, jobID string) (*transcode.TranscodeOutput, error) {
+	// Assemble full path
+	chunkPath := filepath.Join(t.basePath, resourceID, spec.Path)
+
+	// Get frames of the chunk
+	_, frames, err := t.GetDuration(ctx, chunkPath)
+	if err != nil {
+		return nil, fmt.Errorf("get chunk duration: %w", err)
+	}
+
+	// Create temp dir for the encoded chunk
+	// The worker will move the files for permanent storage
+	outputDir, err := os.MkdirTemp("", "chunk-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temporary directory for output: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(outputDir, transcode.ChunksDir, encodedDir), 0755); err != nil {
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("create encoded chunks directory: %w", err)
+	}
+
+	args := []string{
+		// Set input
+		"-i", chunkPath,
+
+		// Output progress to stdout
+		"-progress", "pipe:1",
+	}
+
+	// One output per rendition, without audio
+	for _, r := range renditions {
+		args = append(args, r.encodeArgs()...)
+		args = append(args, t.threadArgs()...)
+		args = append(args, filepath.Join(outputDir, encodedChunkPath(spec.Index, r)))
+	}
+
+	prg, err := progress.NewProgress(frames)
+	if err != nil {
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("start progress: %w", err)
+	}
+
+	// Progress of the chunk is reported as the progress of every chunk, under the assemble job
+	push := func(ctx context.Context, prg *progress.Progress) error {
+		aggregated, err := t.chunks.Record(ctx, spec.GroupID, spec.Index, spec.Count, prg)
+		if err != nil {
+			return err
+		}
+		return t.streamer.Push(ctx, spec.GroupID, aggregated)
+	}
+	if err := t.runWithProgress(ctx, jobID, args, prg, push); err != nil {
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("ffmpeg execution: %w", err)
+	}
+
+	outputFiles, err := listOutput(outputDir)
+	if err != nil {
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("assemble encoded chunk files: %w", err)
+	}
+
+	return &transcode.TranscodeOutput{
+		OutputDir:   outputDir,
+		OutputFiles: outputFiles,
+	}, nil
+}
+
+// Assemble joins the encoded chunks of each rendition and packages them with the audio of the source.
+// The progress of the chunked transcode goes through the package stage,
+// then is left at the store stage for the worker to report.
+func (t *FFMPEGTranscoder) Assemble(ctx context.Context, resourceID, sourceFilename string, spec job.ChunkSpec, jobID string) (*transcode.TranscodeOutput, error) {
+	push := func(ctx context.Context, prg *progress.Progress) error {
+		return t.streamer.Push(ctx, spec.GroupID, prg)
+	}
+
+	// Every chunk has been encoded before the assemble job runs
+	prg := progress.NewStagedProgress(progress.ChunkedTranscodeStages...)
+	t.startStage(ctx, jobID, prg, progress.StagePackage, push)
+
+	out, err := t.assemble(ctx, resourceID, sourceFilename, spec, jobID)
+	if err != nil {
+		t.failProgress(ctx, jobID, prg, push)
+		return nil, err
+	}
+	t.startStage(ctx, jobID, prg, progress.StageStore, push)
+	out.Progress = prg
+
+	return out, nil
+}
+
+func (t *FFMPEGTranscoder) assemble(ctx context.Context, resourceID, sourceFilename string, spec job.ChunkSpec, jobID string) (*transcode.TranscodeOutput, error) {
+	// Assemble full paths, the concat lists need them absolute
+	resourcePath, err := filepath.Abs(filepath.Join(t.basePath, resourceID))
+	if err != nil {
+		return nil, fmt.Errorf("resolve resource path: %w", err)
+	}
+	sourcePath := filepath.Join(resourcePath, sourceFilename)
+
+	// Create temp dir for the joined renditions, dropped once packaged
+	workDir, err := os.MkdirTemp("", "assemble-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temporary directory for renditions: %w", err)
+	}
+	defer t.removeOutput(ctx, jobID, workDir)
+
+	// Join the chunks of every rendition without encoding them again
+	joined := make([]string, len(renditions))
+	for i, r := range renditions {
+		var list strings.Builder
+		for index := range spec.Count {
+			fmt.Fprintf(&list, "file '%s'\n", filepath.Join(resourcePath, encodedChunkPath(index, r)))
+		}
+
+		listPath := filepath.Join(workDir, r.name+".txt")
+		if err := os.WriteFile(listPath, []byte(list.String()), 0644); err != nil {
+			return nil, fmt.Errorf("write %s chunk list: %w", r.name, err)
+		}
+
+		joined[i] = filepath.Join(workDir, r.name+".mp4")
+		args := []string{"-f", "concat", "-safe", "0", "-i", listPath, "-c", "copy", joined[i]}
+		if err := t.runCommand(ctx, args); err != nil {
+			return nil, fmt.Errorf("join %s chunks: %w", r.name, err)
+		}
+	}
+
+	// Get duration
+	duration, err := t.probeDuration(ctx, joined[0])
+	if err != nil {
+		return nil, fmt.Errorf("get duration: %w", err)
+	}
+
+	// Create temp dir for transcode output
+	// The worker will move the files for permanent storage
+	outputDir, err := os.MkdirTemp("", "transcode-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temporary directory for output: %w", err)
+	}
+
+	manifestPath := filepath.Join(outputDir, "manifest.mpd")
+
+	args := packageArgs(joined, sourcePath, manifestPath)
+
+	if err := t.runCommand(ctx, args); err != nil {
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("ffmpeg execution: %w", err)
+	}
+
+	outputFiles, err := listOutput(outputDir)
+	if err != nil {
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("assemble transcoded files: %w", err)
+	}
+
+	return &transcode.TranscodeOutput{
+		Duration:     duration,
+		ManifestPath: manifestPath,
+		OutputDir:    outputDir,
+		OutputFiles:  outputFiles,
+	}, nil
+}
+
+// probeDuration gets the duration of a video file from its container,
+// without counting frames as GetDuration does
+func (t *FFMPEGTranscoder) probeDuration(ctx context.Context, path string) (time.Duration, error) {
+	args := []string{
+		"-v", "quiet",
+		"-print_format", "json",
+		"-show_format",
+		path,
+	}
+
+	cmd := t.commander.CommandContext(ctx, "ffprobe", args...)
+	var out bytes.Buffer
+	cmd.SetStdout(&out)
+	cmd.SetStderr(os.Stderr)
+
+	if err := cmd.Run(); err != nil {
+		return 0, fmt.Errorf("run ffprobe: %w", err)
+	}
+
+	var result probeResult
+	if err := json.Unmarshal(out.Bytes(), &result); err != nil {
+		return 0, fmt.Errorf("parse ffprobe output: %w", err)
+	}
+
+	durationFloat, err := strconv.ParseFloat(result.Format.Duration, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse duration from ffprobe output: %w", err)
+	}
+
+	return time.Duration(durationFloat * float64(time.Second)), nil
+}
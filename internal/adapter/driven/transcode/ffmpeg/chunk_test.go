@@ -0,0 +1,238 @@
+package ffmpeg_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/transcode/ffmpeg"
+	execmocks "github.com/st-ember/streaming-api/internal/application/ports/exec/mocks"
+	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	streamermocks "github.com/st-ember/streaming-api/internal/application/ports/progressstream/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// writeOutput creates the file ffmpeg would write at the last argument,
+// replacing the segment number pattern with the specified chunk names
+func writeOutput(t *testing.T, args []string, names ...string) {
+	t.Helper()
+	path := args[len(args)-1]
+	if len(names) == 0 {
+		require.NoError(t, os.WriteFile(path, []byte("data"), 0644))
+		return
+	}
+	for _, name := range names {
+		require.NoError(t, os.WriteFile(filepath.Join(filepath.Dir(path), name), []byte("data"), 0644))
+	}
+}
+
+func TestSplit_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	var args []string
+	mockCommander.EXPECT().
+		CommandContext(mock.Anything, "ffmpeg", mock.Anything).
+		Run(func(_ context.Context, _ string, a ...string) { args = a }).
+		Return(mockCmd).
+		Once()
+	mockCmd.EXPECT().SetStderr(mock.Anything).Once()
+	mockCmd.EXPECT().Run().RunAndReturn(func() error {
+		writeOutput(t, args, "0001.mp4", "0000.mp4")
+		return nil
+	}).Once()
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: 90 * time.Second})
+	output, err := transcoder.Split(t.Context(), "resource-id", "source.mp4", "job-id")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	defer os.RemoveAll(output.OutputDir)
+	require.Equal(t, []string{"chunks/0000.mp4", "chunks/0001.mp4"}, output.OutputFiles)
+	require.Contains(t, strings.Join(args, " "), "-segment_time 90")
+}
+
+func TestSplit_FailsOnFFmpegRun(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffmpeg", mock.Anything).Return(mockCmd).Once()
+	mockCmd.EXPECT().SetStderr(mock.Anything).Run(func(w io.Writer) {
+		w.Write([]byte("no keyframes"))
+	}).Once()
+	mockCmd.EXPECT().Run().Return(exitError{code: 1}).Once()
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
+	_, err := transcoder.Split(t.Context(), "resource-id", "source.mp4", "job-id")
+
+	// --- ASSERT ---
+	var processErr *transcode.ProcessError
+	require.ErrorAs(t, err, &processErr)
+	require.Equal(t, 1, processErr.ExitCode)
+	require.Equal(t, "no keyframes", processErr.StderrTail)
+}
+
+func TestEncodeChunk_PushesChunkProgress(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockProbeCmd := execmocks.NewMockCmd(t)
+	mockFFmpegCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockChunks := streamermocks.NewMockChunkProgressStore(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	spec := job.ChunkSpec{Index: 3, Count: 8, Path: "chunks/0003.mp4", GroupID: "assemble-id"}
+
+	// ffprobe setup
+	ffprobeOutput := `{"format":{"duration":"60.0"}, "streams":{"nb_read_frames":"200"}}`
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffprobe", mock.Anything).Return(mockProbeCmd).Once()
+	mockProbeCmd.EXPECT().SetStdout(mock.Anything).Run(func(w io.Writer) { w.Write([]byte(ffprobeOutput)) }).Once()
+	mockProbeCmd.EXPECT().SetStderr(os.Stderr).Once()
+	mockProbeCmd.EXPECT().Run().Return(nil).Once()
+
+	// ffmpeg setup
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffmpeg", mock.Anything).Return(mockFFmpegCmd).Once()
+	mockFFmpegCmd.EXPECT().SetStderr(mock.Anything).Once()
+	mockFFmpegCmd.EXPECT().StdoutPipe().Return(io.NopCloser(strings.NewReader("frame=100\n")), nil).Once()
+	mockFFmpegCmd.EXPECT().Start().Return(nil).Once()
+	mockFFmpegCmd.EXPECT().Wait().Return(nil).Once()
+
+	// Progress is recorded for the chunk, the progress of every chunk is pushed under the assemble job
+	aggregated, _ := progress.NewProgress(1600)
+	mockChunks.EXPECT().Record(mock.Anything, "assemble-id", 3, 8, mock.MatchedBy(func(p *progress.Progress) bool {
+		return p.Percentage == 50 && p.Status == progress.StatusContinue
+	})).Return(aggregated, nil).Once()
+	mockChunks.EXPECT().Record(mock.Anything, "assemble-id", 3, 8, mock.MatchedBy(func(p *progress.Progress) bool {
+		return p.Status == progress.StatusEnd
+	})).Return(aggregated, nil).Once()
+	mockStreamer.EXPECT().Push(mock.Anything, "assemble-id", aggregated).Return(nil).Twice()
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, mockChunks, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
+	output, err := transcoder.EncodeChunk(t.Context(), "resource-id", spec, "job-id")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	defer os.RemoveAll(output.OutputDir)
+	require.Empty(t, output.ManifestPath)
+}
+
+func TestAssemble_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockCmd := execmocks.NewMockCmd(t)
+	mockProbeCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	spec := job.ChunkSpec{Count: 2, GroupID: "assemble-id"}
+
+	// Every ffmpeg run writes its output: two joined renditions, then the manifest
+	var lists []string
+	var args []string
+	mockCommander.EXPECT().
+		CommandContext(mock.Anything, "ffmpeg", mock.Anything).
+		Run(func(_ context.Context, _ string, a ...string) {
+			args = a
+			if a[0] == "-f" && a[1] == "concat" {
+				data, err := os.ReadFile(a[5])
+				require.NoError(t, err)
+				lists = append(lists, string(data))
+			}
+		}).
+		Return(mockCmd).
+		Times(3)
+	mockCmd.EXPECT().SetStderr(mock.Anything).Times(3)
+	mockCmd.EXPECT().Run().RunAndReturn(func() error {
+		writeOutput(t, args)
+		return nil
+	}).Times(3)
+
+	ffprobeOutput := `{"format":{"duration":"125.5"}}`
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffprobe", mock.Anything).Return(mockProbeCmd).Once()
+	mockProbeCmd.EXPECT().SetStdout(mock.Anything).Run(func(w io.Writer) { w.Write([]byte(ffprobeOutput)) }).Once()
+	mockProbeCmd.EXPECT().SetStderr(os.Stderr).Once()
+	mockProbeCmd.EXPECT().Run().Return(nil).Once()
+
+	// The package stage follows the encoding of the chunks, the store stage is left for the worker to report
+	for _, stage := range []progress.Stage{progress.StagePackage, progress.StageStore} {
+		mockStreamer.EXPECT().Push(mock.Anything, "assemble-id", mock.MatchedBy(func(p *progress.Progress) bool {
+			return p.Stage == stage && p.Status == progress.StatusContinue
+		})).Return(nil).Once()
+	}
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
+	output, err := transcoder.Assemble(t.Context(), "resource-id", "source.mp4", spec, "assemble-id")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	defer os.RemoveAll(output.OutputDir)
+	require.Equal(t, time.Duration(125.5*float64(time.Second)), output.Duration)
+	require.Equal(t, []string{"manifest.mpd"}, output.OutputFiles)
+	require.Equal(t, progress.StageStore, output.Progress.Stage)
+	require.Equal(t, 100, output.Progress.Stages[0].Percentage)
+
+	// Chunks are joined in order for every rendition
+	require.Len(t, lists, 2)
+	require.Equal(t,
+		"file '/tmp/resource-id/chunks/encoded/0000_480p.mp4'\nfile '/tmp/resource-id/chunks/encoded/0001_480p.mp4'\n",
+		lists[0],
+	)
+	require.Contains(t, lists[1], "0001_720p.mp4")
+}
+
+func TestAssemble_FailsOnJoin(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+	expectedErr := errors.New("missing chunk")
+
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffmpeg", mock.Anything).Return(mockCmd).Once()
+	mockCmd.EXPECT().SetStderr(mock.Anything).Once()
+	mockCmd.EXPECT().Run().Return(expectedErr).Once()
+
+	// Clients following the progress learn about the failure
+	mockStreamer.EXPECT().Push(mock.Anything, "assemble-id", mock.MatchedBy(func(p *progress.Progress) bool {
+		return p.Stage == progress.StagePackage && p.Status == progress.StatusContinue
+	})).Return(nil).Once()
+	mockStreamer.EXPECT().Push(mock.Anything, "assemble-id", mock.MatchedBy(func(p *progress.Progress) bool {
+		return p.Stage == progress.StagePackage && p.Status == progress.StatusError
+	})).Return(nil).Once()
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
+	_, err := transcoder.Assemble(t.Context(), "resource-id", "source.mp4", job.ChunkSpec{Count: 1, GroupID: "assemble-id"}, "assemble-id")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, expectedErr)
+}
@@ -0,0 +1,48 @@
+package ffmpeg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
+)
+
+// thumbnailWidth is the width of the thumbnail, its height keeps the aspect ratio of the source
+const thumbnailWidth = 640
+
+// Thumbnail picks a representative frame among the first ones of the source,
+// skipping the black or blurred frames a video often starts with.
+func (t *FFMPEGTranscoder) Thumbnail(ctx context.Context, resourceID, sourceFilename, jobID string) (*transcode.TranscodeOutput, error) {
+	// Assemble full path
+	sourcePath := filepath.Join(t.basePath, resourceID, sourceFilename)
+
+	// Create temp dir for the thumbnail
+	// The worker will move the file for permanent storage
+	outputDir, err := os.MkdirTemp("", "thumbnail-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temporary directory for output: %w", err)
+	}
+
+	args := []string{
+		// Set input
+		"-i", sourcePath,
+
+		// The thumbnail filter keeps the most representative of each batch of frames
+		"-vf", fmt.Sprintf("thumbnail,scale=%d:-2", thumbnailWidth),
+		"-frames:v", "1",
+		"-an",
+		filepath.Join(outputDir, transcode.ThumbnailFilename),
+	}
+
+	if err := t.runCommand(ctx, args); err != nil {
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("ffmpeg execution: %w", err)
+	}
+
+	return &transcode.TranscodeOutput{
+		OutputDir:   outputDir,
+		OutputFiles: []string{transcode.ThumbnailFilename},
+	}, nil
+}
@@ -0,0 +1,75 @@
+package ffmpeg_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/transcode/ffmpeg"
+	execmocks "github.com/st-ember/streaming-api/internal/application/ports/exec/mocks"
+	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	streamermocks "github.com/st-ember/streaming-api/internal/application/ports/progressstream/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThumbnail_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	var args []string
+	mockCommander.EXPECT().
+		CommandContext(mock.Anything, "ffmpeg", mock.Anything).
+		Run(func(_ context.Context, _ string, a ...string) { args = a }).
+		Return(mockCmd).
+		Once()
+	mockCmd.EXPECT().SetStderr(mock.Anything).Once()
+	mockCmd.EXPECT().Run().RunAndReturn(func() error {
+		writeOutput(t, args)
+		return nil
+	}).Once()
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{})
+	output, err := transcoder.Thumbnail(t.Context(), "resource-id", "source.mp4", "job-id")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	defer os.RemoveAll(output.OutputDir)
+	require.Equal(t, []string{transcode.ThumbnailFilename}, output.OutputFiles)
+	require.FileExists(t, filepath.Join(output.OutputDir, transcode.ThumbnailFilename))
+	require.Contains(t, strings.Join(args, " "), "-i /tmp/resource-id/source.mp4")
+	require.Contains(t, strings.Join(args, " "), "-frames:v 1")
+}
+
+func TestThumbnail_FailsOnFFmpegRun(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffmpeg", mock.Anything).Return(mockCmd).Once()
+	mockCmd.EXPECT().SetStderr(mock.Anything).Once()
+	mockCmd.EXPECT().Run().Return(errors.New("exit status 1")).Once()
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{})
+	output, err := transcoder.Thumbnail(t.Context(), "resource-id", "source.mp4", "job-id")
+
+	// --- ASSERT ---
+	var processErr *transcode.ProcessError
+	require.ErrorAs(t, err, &processErr)
+	require.Nil(t, output)
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -13,6 +14,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"time"
+	"unicode/utf8"
 
 	"github.com/st-ember/streaming-api/internal/application/ports/exec"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
@@ -21,19 +23,79 @@ import (
 	"github.com/st-ember/streaming-api/internal/domain/progress"
 )
 
+const (
+	stderrTailLines = 20
+	stderrTailBytes = 4096
+)
+
+// Options limits the resources taken by ffmpeg and sets up chunked transcoding
+type Options struct {
+	ChunkDuration time.Duration // Target length of the chunks cut by Split
+	Nice          int           // Scheduling priority of ffmpeg, 0 keeps the one of the worker
+	Threads       int           // Encoding threads per job, 0 lets ffmpeg pick one per core
+}
+
 type FFMPEGTranscoder struct {
 	basePath  string
 	commander exec.Commander
 	streamer  progressstream.ProgressStreamer
+	chunks    progressstream.ChunkProgressStore
 	logger    log.Logger
+	opts      Options
 }
 
 func NewFFMPEGTranscoder(
 	basePath string,
 	commander exec.Commander,
 	streamer progressstream.ProgressStreamer,
-	logger log.Logger) *FFMPEGTranscoder {
-	return &FFMPEGTranscoder{basePath, commander, streamer, logger}
+	chunks progressstream.ChunkProgressStore,
+	logger log.Logger,
+	opts Options) *FFMPEGTranscoder {
+	return &FFMPEGTranscoder{basePath, commander, streamer, chunks, logger, opts}
+}
+
+// rendition is one video quality of the stream
+type rendition struct {
+	name    string
+	crf     string
+	maxrate string
+	bufsize string
+	size    string
+}
+
+var renditions = []rendition{
+	{name: "480p", crf: "23", maxrate: "1500k", bufsize: "3000k", size: "854x480"},
+	{name: "720p", crf: "22", maxrate: "3000k", bufsize: "6000k", size: "1280x720"},
+}
+
+// encodeArgs builds the ffmpeg arguments of an output encoding the video stream into the rendition,
+// without audio
+func (r rendition) encodeArgs() []string {
+	return []string{
+		"-map", "0:v:0",
+		"-c:v", "libx264", // Use the standard H.264 video codec
+		"-crf", r.crf, // Constant Rate Factor (quality)
+		"-preset", "medium", // Transcode speed
+		"-maxrate", r.maxrate, // Maximum allowed bitrate
+		"-bufsize", r.bufsize, // Set buffer size to twice of bitrate
+		"-s", r.size, // Output size (resolution)
+		"-an",
+	}
+}
+
+// renditionNames lists the names of the renditions, in encoding order
+func renditionNames() []string {
+	names := make([]string, len(renditions))
+	for i, r := range renditions {
+		names[i] = r.name
+	}
+
+	return names
+}
+
+// Renditions returns the number of video renditions in the stream
+func (t *FFMPEGTranscoder) Renditions() int {
+	return len(renditions)
 }
 
 // probeResult is used to unmarshal the json result from ffprobe
@@ -46,6 +108,60 @@ type probeResult struct {
 	} `json:"streams"`
 }
 
+// streamsProbeResult is used to unmarshal the streams listed by ffprobe
+type streamsProbeResult struct {
+	Format struct {
+		Duration string `json:"duration"`
+	} `json:"format"`
+	Streams []struct {
+		Width  int `json:"width"`
+		Height int `json:"height"`
+	} `json:"streams"`
+}
+
+// Probe reads the resolution and duration of the video stream of an asset
+func (t *FFMPEGTranscoder) Probe(ctx context.Context, resourceID, assetPath string) (*transcode.SourceInfo, error) {
+	args := []string{
+		"-v", "quiet",
+		"-print_format", "json",
+		"-show_format",
+		"-show_streams",
+		"-select_streams", "v:0", // limit to first video stream
+		filepath.Join(t.basePath, resourceID, assetPath),
+	}
+
+	cmd := t.commander.CommandContext(ctx, "ffprobe", args...)
+	var out bytes.Buffer
+	cmd.SetStdout(&out)
+	cmd.SetStderr(os.Stderr)
+
+	// Run ffprobe
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("run ffprobe: %w", err)
+	}
+
+	// Unmarshal result
+	var result streamsProbeResult
+	if err := json.Unmarshal(out.Bytes(), &result); err != nil {
+		return nil, fmt.Errorf("parse ffprobe output: %w", err)
+	}
+	if len(result.Streams) == 0 {
+		return nil, errors.New("no video stream found")
+	}
+
+	// Convert duration to float
+	durationFloat, err := strconv.ParseFloat(result.Format.Duration, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse duration from ffprobe output: %w", err)
+	}
+
+	return &transcode.SourceInfo{
+		Width:    result.Streams[0].Width,
+		Height:   result.Streams[0].Height,
+		Duration: time.Duration(durationFloat * float64(time.Second)),
+	}, nil
+}
+
 // GetDuration gets the duration of a video file in seconds.
 func (t *FFMPEGTranscoder) GetDuration(ctx context.Context, sourcePath string) (time.Duration, int64, error) {
 	args := []string{
@@ -91,82 +207,127 @@ func (t *FFMPEGTranscoder) GetDuration(ctx context.Context, sourcePath string) (
 	return time.Duration(durationFloat * float64(time.Second)), framesInt, nil
 }
 
+// Transcode encodes every rendition of a source, one after another, and packages them for DASH.
+// Its progress goes through the probe stage, a stage per rendition and the package stage,
+// then is left at the store stage for the worker to report.
 func (t *FFMPEGTranscoder) Transcode(ctx context.Context, resourceID, sourceFilename, jobID string) (*transcode.TranscodeOutput, error) {
 	// Assemble full path
 	sourcePath := filepath.Join(t.basePath, resourceID, sourceFilename)
 
+	push := func(ctx context.Context, prg *progress.Progress) error {
+		return t.streamer.Push(ctx, jobID, prg)
+	}
+
+	prg := progress.NewStagedProgress(progress.TranscodeStages(renditionNames()...)...)
+	t.startStage(ctx, jobID, prg, progress.StageProbe, push)
+
 	// Get duration
 	duration, frames, err := t.GetDuration(ctx, sourcePath)
 	if err != nil {
+		t.failProgress(ctx, jobID, prg, push)
 		return nil, fmt.Errorf("get duration: %w", err)
 	}
+	if err := prg.SetTotalFrames(frames); err != nil {
+		t.failProgress(ctx, jobID, prg, push)
+		return nil, fmt.Errorf("start progress: %w", err)
+	}
+
+	// Create temp dir for the encoded renditions, dropped once packaged
+	workDir, err := os.MkdirTemp("", "renditions-*")
+	if err != nil {
+		t.failProgress(ctx, jobID, prg, push)
+		return nil, fmt.Errorf("create temporary directory for renditions: %w", err)
+	}
+	defer t.removeOutput(ctx, jobID, workDir)
+
+	// Encode one rendition per run, each run advancing the progress to the next stage
+	t.startStage(ctx, jobID, prg, progress.RenditionStage(renditions[0].name), push)
+	encoded := make([]string, len(renditions))
+	for i, r := range renditions {
+		encoded[i] = filepath.Join(workDir, r.name+".mp4")
+
+		args := []string{
+			// Set input
+			"-i", sourcePath,
+
+			// Output progress to stdout
+			"-progress", "pipe:1",
+		}
+		args = append(args, r.encodeArgs()...)
+		args = append(args, t.threadArgs()...)
+		args = append(args, encoded[i])
+
+		if err := t.runWithProgress(ctx, jobID, args, prg, push); err != nil {
+			t.failProgress(ctx, jobID, prg, push)
+			return nil, fmt.Errorf("encode %s rendition: %w", r.name, err)
+		}
+	}
 
 	// Create temp dir for transcode output
 	// The worker will move the files for permanent storage
 	outputDir, err := os.MkdirTemp("", "transcode-*")
 	if err != nil {
+		t.failProgress(ctx, jobID, prg, push)
 		return nil, fmt.Errorf("create temporary directory for output: %w", err)
 	}
 
 	manifestPath := filepath.Join(outputDir, "manifest.mpd")
 
-	args := []string{
-		// Set input
-		"-i", sourcePath,
+	if err := t.runCommand(ctx, packageArgs(encoded, sourcePath, manifestPath)); err != nil {
+		t.failProgress(ctx, jobID, prg, push)
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("package stream: %w", err)
+	}
+
+	// Walk temp dir to assemble all the transcoded files
+	outputFiles, err := listOutput(outputDir)
+	if err != nil {
+		t.failProgress(ctx, jobID, prg, push)
+		t.removeOutput(ctx, jobID, outputDir)
+		return nil, fmt.Errorf("assemble transcoded files: %w", err)
+	}
+	t.startStage(ctx, jobID, prg, progress.StageStore, push)
+
+	return &transcode.TranscodeOutput{
+		Duration:     duration,
+		ManifestPath: manifestPath,
+		OutputDir:    outputDir,
+		OutputFiles:  outputFiles,
+		Progress:     prg,
+	}, nil
+}
+
+// packageArgs builds the ffmpeg arguments packaging encoded renditions
+// and the audio of the source into a DASH stream, without encoding the video again
+func packageArgs(renditionPaths []string, sourcePath, manifestPath string) []string {
+	// Inputs: every rendition, then the source for its audio
+	var args []string
+	for _, path := range renditionPaths {
+		args = append(args, "-i", path)
+	}
+	args = append(args, "-i", sourcePath)
+	for i := range renditionPaths {
+		args = append(args, "-map", fmt.Sprintf("%d:v:0", i))
+	}
+
+	return append(args,
+		"-map", fmt.Sprintf("%d:a:0", len(renditionPaths)),
 
+		"-c:v", "copy", // The video is already encoded
 		"-c:a", "aac", // Use aac audio codec
 		"-ac", "2", // Set audio channel to 2
 
-		// Select first video and audio files
-		"-map", "0:v:0", "-map", "0:a:0",
-
-		// First video rendition (480p)
-		"-c:v:0", "libx264", // Use the standard H.264 video codec
-		"-crf:v:0", "23", // Constant Rate Factor (quality)
-		"-preset:v:0", "medium", // Transcode speed
-		"-maxrate:v:0", "1500k", // Maximum allowed bitrate
-		"-bufsize:v:0", "3000k", // Set buffer size to twice of bitrate
-		"-s:v:0", "854x480", // Output size (resolution)
-
-		// Second video rendition (720p)
-		"-c:v:1", "libx264",
-		"-crf:v:1", "22",
-		"-preset:v:1", "medium",
-		"-maxrate:v:1", "3000k",
-		"-bufsize:v:1", "6000k",
-		"-s:v:1", "1280x720",
-
 		// Groups the video and audio streams in the manifest
 		"-adaptation_sets", "id=0,streams=v id=1,streams=a",
-
-		// Output progress to stdout
-		"-progress", "pipe:1",
 		"-f", "dash", // Output format DASH
 		manifestPath,
-	}
-
-	// Build command
-	cmd := t.commander.CommandContext(ctx, "ffmpeg", args...)
-
-	// Capture standard errors to track progress and error details from ffmpeg
-	var stdErr bytes.Buffer
-	cmd.SetStderr(&stdErr)
-	pipe, err := cmd.StdoutPipe()
-
-	// Execute command with non-blocking Start
-	if err := cmd.Start(); err != nil {
-		return nil, fmt.Errorf("ffmpeg execution: %w\noutput:\n%s", err, stdErr.String())
-	}
-
-	go t.PipeProgress(ctx, jobID, frames, pipe)
-
-	if err := cmd.Wait(); err != nil {
-		return nil, fmt.Errorf("ffmpeg execution: %w\noutput:\n%s", err, stdErr.String())
-	}
+	)
+}
 
-	// Walk temp dir to assemble all the transcoded files
+// listOutput lists the files written under outputDir, relative to it
+func listOutput(outputDir string) ([]string, error) {
 	var outputFiles []string
-	err = filepath.WalkDir(outputDir, func(path string, d os.DirEntry, err error) error {
+	err := filepath.WalkDir(outputDir, func(path string, d os.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
@@ -182,30 +343,55 @@ func (t *FFMPEGTranscoder) Transcode(ctx context.Context, resourceID, sourceFile
 
 		return nil
 	})
-	if err != nil {
-		return nil, fmt.Errorf("assemble transcoded files: %w", err)
-	}
 
-	return &transcode.TranscodeOutput{
-		Duration:     duration,
-		ManifestPath: manifestPath,
-		OutputFiles:  outputFiles,
-	}, nil
+	return outputFiles, err
 }
 
 func (w *FFMPEGTranscoder) PipeProgress(ctx context.Context, jobID string, totalFrames int64, progressPipe io.ReadCloser) {
-	defer progressPipe.Close()
 	prg, err := progress.NewProgress(totalFrames)
 	if err != nil {
+		progressPipe.Close()
 		w.logger.Errorf(ctx, log.CategoryJob, jobID, "start new progress: %v", err)
 		return
 	}
 
+	w.pipeProgress(ctx, jobID, prg, progressPipe, func(ctx context.Context, prg *progress.Progress) error {
+		return w.streamer.Push(ctx, jobID, prg)
+	})
+}
+
+// pipeProgress reads ffmpeg's progress output and hands every update to push.
+// Once ffmpeg is done the progress advances past its running stage,
+// ending it when it has no stage left.
+func (w *FFMPEGTranscoder) pipeProgress(
+	ctx context.Context,
+	jobID string,
+	prg *progress.Progress,
+	progressPipe io.ReadCloser,
+	push func(ctx context.Context, prg *progress.Progress) error,
+) {
+	defer progressPipe.Close()
+
+	var fps float64
 	scanner := bufio.NewScanner(progressPipe)
+scan:
 	for scanner.Scan() {
-		line := scanner.Text()
-		if frameStr, ok := strings.CutPrefix(strings.TrimSpace(line), "frame="); ok {
-			frameInt, err := strconv.ParseInt(frameStr, 10, 64)
+		key, value, ok := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "fps":
+			// ffmpeg prints fps and speed after the frame count of the same update,
+			// so they reach the clients with the next one
+			fps = parseRate(value, "")
+		case "speed":
+			if err := prg.UpdateThroughput(fps, parseRate(value, "x")); err != nil {
+				w.logger.Errorf(ctx, log.CategoryJob, jobID, "update throughput: %v", err)
+			}
+		case "frame":
+			frameInt, err := strconv.ParseInt(value, 10, 64)
 			if err != nil {
 				w.logger.Errorf(ctx, log.CategoryJob, jobID, "parse current frames: %v", err)
 				continue
@@ -215,25 +401,203 @@ func (w *FFMPEGTranscoder) PipeProgress(ctx context.Context, jobID string, total
 				w.logger.Errorf(ctx, log.CategoryJob, jobID, "update current frames: %v", err)
 				return
 			}
-			if err := w.streamer.Push(ctx, jobID, prg); err != nil {
+			if err := push(ctx, prg); err != nil {
+				if ctx.Err() != nil { // job cancelled, report it below
+					break scan
+				}
 				w.logger.Errorf(ctx, log.CategoryJob, jobID, "push progress %v", err)
 				return
 			}
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
+	switch {
+	case ctx.Err() != nil:
+		if err := prg.Cancel(); err != nil {
+			w.logger.Errorf(ctx, log.CategoryJob, jobID, "mark progress as cancelled: %v", err)
+		}
+	case scanner.Err() != nil:
 		if err := prg.MarkAsError(); err != nil {
 			w.logger.Errorf(ctx, log.CategoryJob, jobID, "mark progress as error: %v", err)
 		}
-	} else {
-		if err := prg.End(); err != nil {
-			w.logger.Errorf(ctx, log.CategoryJob, jobID, "mark progress as ended: %v", err)
+	default:
+		if err := prg.Advance(); err != nil {
+			w.logger.Errorf(ctx, log.CategoryJob, jobID, "advance progress: %v", err)
 		}
 	}
 
-	if err := w.streamer.Push(ctx, jobID, prg); err != nil {
+	// Deliver the final state even when the job context has been cancelled
+	if err := push(context.WithoutCancel(ctx), prg); err != nil {
 		w.logger.Errorf(ctx, log.CategoryJob, jobID, "push progress %v", err)
 		return
 	}
 }
+
+// parseRate parses a rate printed by ffmpeg such as "24.5" or "1.5x",
+// returning 0 when it is not known yet ("N/A")
+func parseRate(value, unit string) float64 {
+	rate, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(value), unit), 64)
+	if err != nil {
+		return 0
+	}
+
+	return rate
+}
+
+// startStage moves a staged progress to its next stage and reports it.
+// A failed push only loses an update, so it is logged and processing goes on.
+func (t *FFMPEGTranscoder) startStage(
+	ctx context.Context,
+	jobID string,
+	prg *progress.Progress,
+	stage progress.Stage,
+	push func(ctx context.Context, prg *progress.Progress) error,
+) {
+	if err := prg.StartStage(stage); err != nil {
+		t.logger.Errorf(ctx, log.CategoryJob, jobID, "start %s stage: %v", stage, err)
+		return
+	}
+
+	if err := push(ctx, prg); err != nil {
+		t.logger.Errorf(ctx, log.CategoryJob, jobID, "push progress %v", err)
+	}
+}
+
+// failProgress reports that a job stopped, unless its progress already did
+func (t *FFMPEGTranscoder) failProgress(
+	ctx context.Context,
+	jobID string,
+	prg *progress.Progress,
+	push func(ctx context.Context, prg *progress.Progress) error,
+) {
+	if prg.IsFinished() {
+		return
+	}
+
+	mark := prg.MarkAsError
+	if ctx.Err() != nil {
+		mark = prg.Cancel
+	}
+	if err := mark(); err != nil {
+		t.logger.Errorf(ctx, log.CategoryJob, jobID, "mark progress as failed: %v", err)
+		return
+	}
+
+	if err := push(context.WithoutCancel(ctx), prg); err != nil {
+		t.logger.Errorf(ctx, log.CategoryJob, jobID, "push progress %v", err)
+	}
+}
+
+// runWithProgress runs ffmpeg with its progress written to stdout,
+// handing every progress update to push while the process runs
+func (t *FFMPEGTranscoder) runWithProgress(
+	ctx context.Context,
+	jobID string,
+	args []string,
+	prg *progress.Progress,
+	push func(ctx context.Context, prg *progress.Progress) error,
+) error {
+	// Build command
+	cmd := t.command(ctx, args)
+
+	// Capture standard errors to track progress and error details from ffmpeg
+	var stdErr bytes.Buffer
+	cmd.SetStderr(&stdErr)
+	pipe, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("open progress pipe: %w", err)
+	}
+
+	// Execute command with non-blocking Start
+	if err := cmd.Start(); err != nil {
+		return newProcessError(err, stdErr.String())
+	}
+
+	// Progress must be fully read before Wait closes the pipe
+	t.pipeProgress(ctx, jobID, prg, pipe, push)
+
+	// Wait reports an error when ctx is cancelled, as the process gets killed
+	if err := cmd.Wait(); err != nil {
+		return newProcessError(err, stdErr.String())
+	}
+
+	return nil
+}
+
+// runCommand runs ffmpeg to completion, for steps too short to report progress
+func (t *FFMPEGTranscoder) runCommand(ctx context.Context, args []string) error {
+	cmd := t.command(ctx, args)
+
+	var stdErr bytes.Buffer
+	cmd.SetStderr(&stdErr)
+
+	if err := cmd.Run(); err != nil {
+		return newProcessError(err, stdErr.String())
+	}
+
+	return nil
+}
+
+// command builds an ffmpeg command, run at a lower priority when configured
+// so that transcoding does not starve the rest of the node
+func (t *FFMPEGTranscoder) command(ctx context.Context, args []string) exec.Cmd {
+	if t.opts.Nice == 0 {
+		return t.commander.CommandContext(ctx, "ffmpeg", args...)
+	}
+
+	niceArgs := append([]string{"-n", strconv.Itoa(t.opts.Nice), "ffmpeg"}, args...)
+	return t.commander.CommandContext(ctx, "nice", niceArgs...)
+}
+
+// threadArgs limits the encoding threads of the next output, if configured
+func (t *FFMPEGTranscoder) threadArgs() []string {
+	if t.opts.Threads <= 0 {
+		return nil
+	}
+
+	return []string{"-threads", strconv.Itoa(t.opts.Threads)}
+}
+
+// newProcessError keeps the exit code and the end of ffmpeg's stderr,
+// where the reason of a failure is printed
+func newProcessError(err error, stderr string) *transcode.ProcessError {
+	exitCode := -1
+	var exitErr interface{ ExitCode() int }
+	if errors.As(err, &exitErr) {
+		exitCode = exitErr.ExitCode()
+	}
+
+	return &transcode.ProcessError{
+		ExitCode:   exitCode,
+		StderrTail: tail(stderr, stderrTailLines, stderrTailBytes),
+		Err:        err,
+	}
+}
+
+// tail returns at most the last maxLines lines of s, capped at maxBytes.
+// The result is valid UTF-8, it is stored as text.
+func tail(s string, maxLines, maxBytes int) string {
+	lines := strings.Split(strings.TrimRight(strings.ToValidUTF8(s, "\uFFFD"), "\n"), "\n")
+	if len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+	}
+
+	out := strings.Join(lines, "\n")
+	if len(out) > maxBytes {
+		// Cut forward to the next character rather than through one
+		cut := len(out) - maxBytes
+		for cut < len(out) && !utf8.RuneStart(out[cut]) {
+			cut++
+		}
+		out = out[cut:]
+	}
+
+	return out
+}
+
+// removeOutput deletes the temporary output of a transcode that did not finish
+func (t *FFMPEGTranscoder) removeOutput(ctx context.Context, jobID, outputDir string) {
+	if err := os.RemoveAll(outputDir); err != nil {
+		t.logger.Errorf(ctx, log.CategoryJob, jobID, "clean up temporary directory %s: %v", outputDir, err)
+	}
+}
@@ -1,18 +1,23 @@
 package ffmpeg_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/st-ember/streaming-api/internal/adapter/driven/transcode/ffmpeg"
 	execmocks "github.com/st-ember/streaming-api/internal/application/ports/exec/mocks"
 	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
 	streamermocks "github.com/st-ember/streaming-api/internal/application/ports/progressstream/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
 	"github.com/st-ember/streaming-api/internal/domain/progress"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -45,7 +50,7 @@ func TestGetDuration_SuccessCase(t *testing.T) {
 	mockCmd.EXPECT().Run().Return(nil).Once()
 
 	// --- ACT ---
-	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, mockLogger)
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
 	duration, frames, err := transcoder.GetDuration(t.Context(), "/tmp/some/path.mp4")
 
 	// --- ASSERT ---
@@ -69,7 +74,7 @@ func TestGetDuration_FailsOnCommandRun(t *testing.T) {
 	mockCmd.EXPECT().SetStderr(os.Stderr).Once()
 	mockCmd.EXPECT().Run().Return(expectedErr).Once() // Simulate ffprobe failing to run
 
-	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, mockLogger)
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
 	_, _, err := transcoder.GetDuration(t.Context(), "/tmp/some/path.mp4")
 
 	require.Error(t, err)
@@ -110,7 +115,7 @@ func TestPipeProgress_SuccessCase(t *testing.T) {
 	})).Return(nil).Once()
 
 	// --- ACT ---
-	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, mockLogger)
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
 	transcoder.PipeProgress(t.Context(), jobID, totalFrames, progressPipe)
 }
 
@@ -142,7 +147,7 @@ func TestPipeProgress_ScannerError(t *testing.T) {
 	})).Return(nil).Once()
 
 	// --- ACT ---
-	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, mockLogger)
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
 	transcoder.PipeProgress(t.Context(), jobID, totalFrames, errReader)
 }
 
@@ -175,7 +180,7 @@ func TestPipeProgress_InvalidFrameFormat(t *testing.T) {
 	})).Return(nil).Once()
 
 	// --- ACT ---
-	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, mockLogger)
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
 	transcoder.PipeProgress(t.Context(), jobID, totalFrames, progressPipe)
 }
 
@@ -196,7 +201,7 @@ func TestPipeProgress_NewProgressError(t *testing.T) {
 	mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, "start new progress: %v", mock.Anything).Once()
 
 	// --- ACT ---
-	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, mockLogger)
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
 	transcoder.PipeProgress(t.Context(), jobID, totalFrames, progressPipe)
 }
 
@@ -220,7 +225,7 @@ func TestPipeProgress_PushError(t *testing.T) {
 	mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 
 	// --- ACT ---
-	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, mockLogger)
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
 	transcoder.PipeProgress(t.Context(), jobID, totalFrames, progressPipe)
 }
 
@@ -230,6 +235,7 @@ func TestTranscode_SuccessCase(t *testing.T) {
 	// --- ARRANGE ---
 	mockProbeCmd := execmocks.NewMockCmd(t)
 	mockFFmpegCmd := execmocks.NewMockCmd(t)
+	mockPackageCmd := execmocks.NewMockCmd(t)
 	mockCommander := execmocks.NewMockCommander(t)
 	mockStreamer := streamermocks.NewMockProgressStreamer(t)
 	mockLogger := logmocks.NewMockLogger(t)
@@ -244,21 +250,43 @@ func TestTranscode_SuccessCase(t *testing.T) {
 	mockProbeCmd.EXPECT().SetStderr(os.Stderr).Once()
 	mockProbeCmd.EXPECT().Run().Return(nil).Once()
 
-	// ffmpeg setup
+	// ffmpeg setup, one encoding run per rendition
+	var encodeArgs [][]string
 	mockCommander.EXPECT().
 		CommandContext(mock.Anything, "ffmpeg", mock.Anything).
+		Run(func(_ context.Context, _ string, a ...string) { encodeArgs = append(encodeArgs, a) }).
 		Return(mockFFmpegCmd).
+		Times(2)
+	mockFFmpegCmd.EXPECT().SetStderr(mock.Anything).Times(2)
+	progressData := "frame=400\nfps=50.0\nspeed=2.5x\nprogress=continue\nframe=500\nfps=50.0\nspeed=2.5x\nprogress=end\n"
+	mockFFmpegCmd.EXPECT().StdoutPipe().RunAndReturn(func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(progressData)), nil
+	}).Times(2)
+	mockFFmpegCmd.EXPECT().Start().Return(nil).Times(2)
+	mockFFmpegCmd.EXPECT().Wait().Return(nil).Times(2)
+
+	// then a packaging run, copying the renditions
+	var packageArgs []string
+	mockCommander.EXPECT().
+		CommandContext(mock.Anything, "ffmpeg", mock.Anything).
+		Run(func(_ context.Context, _ string, a ...string) { packageArgs = a }).
+		Return(mockPackageCmd).
 		Once()
-	mockFFmpegCmd.EXPECT().SetStderr(mock.Anything).Once()
-	mockFFmpegCmd.EXPECT().StdoutPipe().Return(io.NopCloser(strings.NewReader("")), nil).Once()
-	mockFFmpegCmd.EXPECT().Start().Return(nil).Once()
-	mockFFmpegCmd.EXPECT().Wait().Return(nil).Once()
-
-	// streamer setup
-	mockStreamer.EXPECT().Push(mock.Anything, "job-id", mock.Anything).Return(nil)
+	mockPackageCmd.EXPECT().SetStderr(mock.Anything).Once()
+	mockPackageCmd.EXPECT().Run().Return(nil).Once()
+
+	// streamer setup, copying every update as the progress is pushed by pointer
+	var pushed []progress.Progress
+	mockStreamer.EXPECT().Push(mock.Anything, "job-id", mock.Anything).
+		Run(func(_ context.Context, _ string, p *progress.Progress) {
+			update := *p
+			update.Stages = slices.Clone(p.Stages)
+			pushed = append(pushed, update)
+		}).
+		Return(nil)
 
 	// --- ACT ---
-	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, mockLogger)
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
 	// We need to create a temporary source file for ffprobe to not fail on missing file
 	tmpFile, err := os.CreateTemp("", "source-*.mp4")
 	require.NoError(t, err)
@@ -274,6 +302,41 @@ func TestTranscode_SuccessCase(t *testing.T) {
 	require.Equal(t, 120*time.Second, output.Duration)
 	require.Contains(t, output.ManifestPath, "manifest.mpd")
 
+	// Every rendition is encoded on its own, then packaged without encoding again
+	require.Len(t, encodeArgs, 2)
+	require.Contains(t, strings.Join(encodeArgs[0], " "), "-s 854x480 -an")
+	require.Contains(t, strings.Join(encodeArgs[1], " "), "-s 1280x720 -an")
+	require.Contains(t, strings.Join(packageArgs, " "), "-c:v copy")
+	require.Equal(t, encodeArgs[0][len(encodeArgs[0])-1], packageArgs[1])
+	require.Equal(t, encodeArgs[1][len(encodeArgs[1])-1], packageArgs[3])
+
+	// Stages go from probe through every rendition to store, which is left for the worker to report
+	var stages []progress.Stage
+	for _, p := range pushed {
+		stages = append(stages, p.Stage)
+		require.Equal(t, progress.StatusContinue, p.Status)
+	}
+	require.Equal(t, []progress.Stage{
+		progress.StageProbe,
+		progress.RenditionStage("480p"),
+		progress.RenditionStage("480p"),
+		progress.RenditionStage("480p"),
+		progress.RenditionStage("720p"),
+		progress.RenditionStage("720p"),
+		progress.RenditionStage("720p"),
+		progress.StagePackage,
+		progress.StageStore,
+	}, stages)
+	require.Equal(t, progress.StageStore, output.Progress.Stage)
+
+	// Throughput of the first update reaches clients with the second one
+	require.Zero(t, pushed[2].FPS)
+	require.Equal(t, 50.0, pushed[3].FPS)
+	require.Equal(t, 2.5, pushed[3].Speed)
+	require.Equal(t, 10.0, pushed[3].RemainingSeconds)
+	require.Equal(t, 50, pushed[3].Stages[1].Percentage)
+	require.Equal(t, 25, pushed[3].Percentage)
+
 	// Clean up the temporary directory created by the function
 	if output != nil {
 		os.RemoveAll(filepath.Dir(output.ManifestPath))
@@ -296,8 +359,16 @@ func TestTranscode_FailsOnGetDuration(t *testing.T) {
 	mockProbeCmd.EXPECT().SetStderr(os.Stderr).Once()
 	mockProbeCmd.EXPECT().Run().Return(expectedErr).Once()
 
+	// The probe stage is reported, then the failure
+	mockStreamer.EXPECT().Push(mock.Anything, "job-id", mock.MatchedBy(func(p *progress.Progress) bool {
+		return p.Stage == progress.StageProbe && p.Status == progress.StatusContinue
+	})).Return(nil).Once()
+	mockStreamer.EXPECT().Push(mock.Anything, "job-id", mock.MatchedBy(func(p *progress.Progress) bool {
+		return p.Stage == progress.StageProbe && p.Status == progress.StatusError
+	})).Return(nil).Once()
+
 	// --- ACT ---
-	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, mockLogger)
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
 	_, err := transcoder.Transcode(t.Context(), "resource-id", "source.mp4", "job-id")
 
 	// --- ASSERT ---
@@ -334,8 +405,16 @@ func TestTranscode_FailsOnFFmpegRun(t *testing.T) {
 	mockFFmpegCmd.EXPECT().StdoutPipe().Return(io.NopCloser(strings.NewReader("")), nil).Once()
 	mockFFmpegCmd.EXPECT().Start().Return(expectedErr).Once() // ffmpeg fails
 
+	// The failure is reported at the stage of the first rendition
+	mockStreamer.EXPECT().Push(mock.Anything, "job-id", mock.MatchedBy(func(p *progress.Progress) bool {
+		return p.Status == progress.StatusContinue
+	})).Return(nil).Twice()
+	mockStreamer.EXPECT().Push(mock.Anything, "job-id", mock.MatchedBy(func(p *progress.Progress) bool {
+		return p.Stage == progress.RenditionStage("480p") && p.Status == progress.StatusError
+	})).Return(nil).Once()
+
 	// --- ACT ---
-	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, mockLogger)
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
 	tmpFile, err := os.CreateTemp("", "source-*.mp4")
 	require.NoError(t, err)
 	defer os.Remove(tmpFile.Name())
@@ -345,5 +424,224 @@ func TestTranscode_FailsOnFFmpegRun(t *testing.T) {
 	// --- ASSERT ---
 	require.Error(t, err)
 	require.ErrorIs(t, err, expectedErr)
-	require.ErrorContains(t, err, "ffmpeg error: something went wrong")
+
+	// stderr is kept apart from the error message
+	var processErr *transcode.ProcessError
+	require.ErrorAs(t, err, &processErr)
+	require.Equal(t, -1, processErr.ExitCode) // ffmpeg never ran
+	require.Equal(t, "ffmpeg error: something went wrong", processErr.StderrTail)
+}
+
+// exitError mimics *exec.ExitError for a process that exited with a code
+type exitError struct{ code int }
+
+func (e exitError) Error() string { return fmt.Sprintf("exit status %d", e.code) }
+func (e exitError) ExitCode() int { return e.code }
+
+func TestTranscode_FailsOnFFmpegExitKeepsStderrTail(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockProbeCmd := execmocks.NewMockCmd(t)
+	mockFFmpegCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	ffprobeOutput := `{"format":{"duration":"123.45"}, "streams":{"nb_read_frames":"2962"}}`
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffprobe", mock.Anything).Return(mockProbeCmd).Once()
+	mockProbeCmd.EXPECT().SetStdout(mock.Anything).Run(func(w io.Writer) { w.Write([]byte(ffprobeOutput)) }).Once()
+	mockProbeCmd.EXPECT().SetStderr(os.Stderr).Once()
+	mockProbeCmd.EXPECT().Run().Return(nil).Once()
+
+	// ffmpeg writes a long log and exits with code 1
+	var stderrLines []string
+	for i := range 50 {
+		stderrLines = append(stderrLines, fmt.Sprintf("line %d", i))
+	}
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffmpeg", mock.Anything).Return(mockFFmpegCmd).Once()
+	mockFFmpegCmd.EXPECT().SetStderr(mock.Anything).Run(func(w io.Writer) {
+		w.Write([]byte(strings.Join(stderrLines, "\n") + "\n"))
+	}).Once()
+	mockFFmpegCmd.EXPECT().StdoutPipe().Return(io.NopCloser(strings.NewReader("")), nil).Once()
+	mockFFmpegCmd.EXPECT().Start().Return(nil).Once()
+	mockFFmpegCmd.EXPECT().Wait().Return(exitError{code: 1}).Once()
+	mockStreamer.EXPECT().Push(mock.Anything, "job-id", mock.MatchedBy(func(p *progress.Progress) bool {
+		return p.Status == progress.StatusContinue
+	})).Return(nil).Times(3)
+	mockStreamer.EXPECT().Push(mock.Anything, "job-id", mock.MatchedBy(func(p *progress.Progress) bool {
+		return p.Status == progress.StatusError
+	})).Return(nil).Once()
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
+	_, err := transcoder.Transcode(t.Context(), "resource-id", "source.mp4", "job-id")
+
+	// --- ASSERT ---
+	var processErr *transcode.ProcessError
+	require.ErrorAs(t, err, &processErr)
+	require.Equal(t, 1, processErr.ExitCode)
+	require.Equal(t, strings.Join(stderrLines[30:], "\n"), processErr.StderrTail)
+}
+
+func TestTranscode_FailsOnFFmpegExitCutsStderrOnCharacters(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockProbeCmd := execmocks.NewMockCmd(t)
+	mockFFmpegCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	ffprobeOutput := `{"format":{"duration":"123.45"}, "streams":{"nb_read_frames":"2962"}}`
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffprobe", mock.Anything).Return(mockProbeCmd).Once()
+	mockProbeCmd.EXPECT().SetStdout(mock.Anything).Run(func(w io.Writer) { w.Write([]byte(ffprobeOutput)) }).Once()
+	mockProbeCmd.EXPECT().SetStderr(os.Stderr).Once()
+	mockProbeCmd.EXPECT().Run().Return(nil).Once()
+
+	// A line of two-byte characters, the byte cap falls in the middle of one
+	stderr := strings.Repeat("é", 3000) + "x"
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffmpeg", mock.Anything).Return(mockFFmpegCmd).Once()
+	mockFFmpegCmd.EXPECT().SetStderr(mock.Anything).Run(func(w io.Writer) {
+		w.Write([]byte(stderr + "\n"))
+	}).Once()
+	mockFFmpegCmd.EXPECT().StdoutPipe().Return(io.NopCloser(strings.NewReader("")), nil).Once()
+	mockFFmpegCmd.EXPECT().Start().Return(nil).Once()
+	mockFFmpegCmd.EXPECT().Wait().Return(exitError{code: 1}).Once()
+	mockStreamer.EXPECT().Push(mock.Anything, "job-id", mock.Anything).Return(nil)
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
+	_, err := transcoder.Transcode(t.Context(), "resource-id", "source.mp4", "job-id")
+
+	// --- ASSERT ---
+	var processErr *transcode.ProcessError
+	require.ErrorAs(t, err, &processErr)
+	require.True(t, utf8.ValidString(processErr.StderrTail))
+	require.LessOrEqual(t, len(processErr.StderrTail), 4096)
+	require.Equal(t, strings.Repeat("é", 2047)+"x", processErr.StderrTail)
+}
+
+func TestPipeProgress_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	jobID := "test-job-id"
+	totalFrames := int64(300)
+
+	// The job was cancelled and ffmpeg killed, closing the pipe early
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	progressPipe := io.NopCloser(strings.NewReader(""))
+
+	// Final push should have "cancelled" status
+	mockStreamer.EXPECT().Push(mock.Anything, jobID, mock.MatchedBy(func(p *progress.Progress) bool {
+		return p.Status == progress.StatusCancelled
+	})).Return(nil).Once()
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{ChunkDuration: time.Minute})
+	transcoder.PipeProgress(ctx, jobID, totalFrames, progressPipe)
+}
+
+func TestProbe_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	ffprobeOutput := `{"format":{"duration":"61.5"}, "streams":[{"width":3840,"height":2160}]}`
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffprobe", mock.Anything).Return(mockCmd).Once()
+	mockCmd.EXPECT().SetStdout(mock.Anything).Run(func(w io.Writer) { w.Write([]byte(ffprobeOutput)) }).Once()
+	mockCmd.EXPECT().SetStderr(os.Stderr).Once()
+	mockCmd.EXPECT().Run().Return(nil).Once()
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{})
+	info, err := transcoder.Probe(t.Context(), "resource-id", "source.mp4")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, &transcode.SourceInfo{
+		Width:    3840,
+		Height:   2160,
+		Duration: time.Duration(61.5 * float64(time.Second)),
+	}, info)
+}
+
+func TestProbe_FailsWithoutVideoStream(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffprobe", mock.Anything).Return(mockCmd).Once()
+	mockCmd.EXPECT().SetStdout(mock.Anything).Run(func(w io.Writer) { w.Write([]byte(`{"format":{"duration":"1.0"}, "streams":[]}`)) }).Once()
+	mockCmd.EXPECT().SetStderr(os.Stderr).Once()
+	mockCmd.EXPECT().Run().Return(nil).Once()
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{})
+	info, err := transcoder.Probe(t.Context(), "resource-id", "audio-only.mp4")
+
+	// --- ASSERT ---
+	require.Nil(t, info)
+	require.ErrorContains(t, err, "no video stream")
+}
+
+func TestTranscode_AppliesNiceAndThreadLimit(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockProbeCmd := execmocks.NewMockCmd(t)
+	mockFFmpegCmd := execmocks.NewMockCmd(t)
+	mockCommander := execmocks.NewMockCommander(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	ffprobeOutput := `{"format":{"duration":"120.0"}, "streams":{"nb_read_frames":"1000"}}`
+	mockCommander.EXPECT().CommandContext(mock.Anything, "ffprobe", mock.Anything).Return(mockProbeCmd).Once()
+	mockProbeCmd.EXPECT().SetStdout(mock.Anything).Run(func(w io.Writer) { w.Write([]byte(ffprobeOutput)) }).Once()
+	mockProbeCmd.EXPECT().SetStderr(os.Stderr).Once()
+	mockProbeCmd.EXPECT().Run().Return(nil).Once()
+
+	// Every ffmpeg run goes through nice, the encoding runs with their threads limited
+	var args [][]string
+	mockCommander.EXPECT().
+		CommandContext(mock.Anything, "nice", mock.Anything).
+		Run(func(_ context.Context, _ string, a ...string) { args = append(args, a) }).
+		Return(mockFFmpegCmd).
+		Times(3)
+	mockFFmpegCmd.EXPECT().SetStderr(mock.Anything).Times(3)
+	mockFFmpegCmd.EXPECT().StdoutPipe().RunAndReturn(func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("")), nil
+	}).Times(2)
+	mockFFmpegCmd.EXPECT().Start().Return(nil).Times(2)
+	mockFFmpegCmd.EXPECT().Wait().Return(nil).Times(2)
+	mockFFmpegCmd.EXPECT().Run().Return(nil).Once()
+	mockStreamer.EXPECT().Push(mock.Anything, "job-id", mock.Anything).Return(nil)
+
+	// --- ACT ---
+	transcoder := ffmpeg.NewFFMPEGTranscoder("/tmp", mockCommander, mockStreamer, nil, mockLogger, ffmpeg.Options{Nice: 10, Threads: 2})
+	output, err := transcoder.Transcode(t.Context(), "resource-id", "source.mp4", "job-id")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	defer os.RemoveAll(output.OutputDir)
+	for _, a := range args {
+		require.Equal(t, []string{"-n", "10", "ffmpeg"}, a[:3])
+	}
+	require.Contains(t, strings.Join(args[0], " "), "-an -threads 2")
+	require.Contains(t, strings.Join(args[1], " "), "-an -threads 2")
 }
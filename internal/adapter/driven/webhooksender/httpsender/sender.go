@@ -0,0 +1,46 @@
+package httpsender
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/webhooksender"
+)
+
+// maxDrainBytes bounds how much of a response body is read before closing it
+const maxDrainBytes = 64 << 10
+
+type HTTPSender struct {
+	Client *http.Client
+}
+
+// NewHTTPSender initializes the HTTPSender struct, requests time out after timeout
+func NewHTTPSender(timeout time.Duration) webhooksender.Sender {
+	return &HTTPSender{Client: &http.Client{Timeout: timeout}}
+}
+
+// Send posts the request body to the subscription URL
+func (s *HTTPSender) Send(ctx context.Context, req webhooksender.Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, fmt.Errorf("build webhook request: %w", err)
+	}
+	for k, v := range req.Headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	res, err := s.Client.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("post webhook to %s: %w", req.URL, err)
+	}
+	defer res.Body.Close()
+
+	// Drain the body so the connection is reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxDrainBytes))
+
+	return res.StatusCode, nil
+}
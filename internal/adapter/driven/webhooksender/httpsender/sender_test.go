@@ -0,0 +1,64 @@
+package httpsender_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/webhooksender/httpsender"
+	"github.com/st-ember/streaming-api/internal/application/ports/webhooksender"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	var gotBody, gotSignature, gotMethod string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		gotSignature = r.Header.Get("X-Webhook-Signature")
+		gotMethod = r.Method
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sender := httpsender.NewHTTPSender(time.Second)
+
+	code, err := sender.Send(t.Context(), webhooksender.Request{
+		URL:     server.URL,
+		Headers: map[string]string{"X-Webhook-Signature": "sha256=abc"},
+		Body:    []byte(`{"id":"event-1"}`),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, code)
+	require.Equal(t, http.MethodPost, gotMethod)
+	require.Equal(t, `{"id":"event-1"}`, gotBody)
+	require.Equal(t, "sha256=abc", gotSignature)
+}
+
+func TestHTTPSender_Send_ReturnsErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sender := httpsender.NewHTTPSender(time.Second)
+
+	code, err := sender.Send(t.Context(), webhooksender.Request{URL: server.URL})
+
+	require.NoError(t, err)
+	require.Equal(t, http.StatusInternalServerError, code)
+}
+
+func TestHTTPSender_Send_FailsWithoutResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	sender := httpsender.NewHTTPSender(time.Second)
+
+	_, err := sender.Send(t.Context(), webhooksender.Request{URL: server.URL})
+
+	require.Error(t, err)
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/st-ember/streaming-api/internal/application/apikeyapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+type APIKeyHandler struct {
+	apiKeyUC apikeyapp.APIKeyUsecase
+	logger   log.Logger
+}
+
+func NewAPIKeyHandler(
+	apiKeyUC apikeyapp.APIKeyUsecase,
+	logger log.Logger,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyUC,
+		logger,
+	}
+}
+
+// isInvalidAPIKey reports whether the error comes from invalid api key fields
+func isInvalidAPIKey(err error) bool {
+	return errors.Is(err, auth.ErrAPIKeyNameEmpty) ||
+		errors.Is(err, auth.ErrAPIKeyExpiryPast) ||
+		errors.Is(err, auth.ErrUnknownPermission)
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// APIKeyResponse leaves the key out, it is only returned on creation
+type APIKeyResponse struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"prefix"`
+	Permissions []string   `json:"permissions"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	RevokedAt   *time.Time `json:"revoked_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func newAPIKeyResponse(k *auth.APIKey) APIKeyResponse {
+	return APIKeyResponse{
+		ID:          k.ID,
+		Name:        k.Name,
+		Prefix:      k.Prefix,
+		Permissions: k.Permissions,
+		ExpiresAt:   k.ExpiresAt,
+		LastUsedAt:  k.LastUsedAt,
+		RevokedAt:   k.RevokedAt,
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
@@ -1,21 +1,48 @@
 package handler
 
 import (
+	"database/sql"
+	"errors"
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/videoapp"
 )
 
+// Archive archives a video of the authenticated user, or any video for video admins.
+// It must be chained after the Auth middleware.
 func (h *VideoHandler) Archive(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
 	// Parse id param
 	vars := mux.Vars(r)
 	id := vars["id"]
 
+	// Assemble input
+	input := videoapp.ArchiveVideoInput{
+		ID:          id,
+		UserID:      claims.UserID,
+		Permissions: claims.Permissions,
+	}
+
 	// Execute usecase
-	if err := h.videoUC.Archive.Execute(r.Context(), id); err != nil {
-		h.logger.Errorf(r.Context(), log.CategoryVideo, id, "archive video %s: %v", err)
-		http.Error(w, "internal error", http.StatusInternalServerError)
+	if err := h.videoUC.Archive.Execute(r.Context(), input); err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			http.Error(w, "video not found", http.StatusNotFound)
+		case errors.Is(err, videoapp.ErrVideoForbidden):
+			http.Error(w, "forbidden", http.StatusForbidden)
+		default:
+			h.logger.Errorf(r.Context(), log.CategoryVideo, id, "archive video %s: %v", id, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
 		return
 	}
 
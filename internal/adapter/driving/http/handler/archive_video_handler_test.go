@@ -25,9 +25,10 @@ func TestVideoHandler_Archive(t *testing.T) {
 		h := handler.NewVideoHandler(videoUC, mockLogger)
 
 		videoID := "video-123"
+		input := videoapp.ArchiveVideoInput{ID: videoID, UserID: "user-123"}
 
 		mockArchiveUC.EXPECT().
-			Execute(mock.Anything, videoID).
+			Execute(mock.Anything, input).
 			Return(nil).
 			Once()
 
@@ -36,10 +37,7 @@ func TestVideoHandler_Archive(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/api/video/"+videoID, nil)
 		// Manually set gorilla/mux vars
 		req = mux.SetURLVars(req, map[string]string{"id": videoID})
-
-		rr := httptest.NewRecorder()
-
-		h.Archive(rr, req)
+		rr := serveAuthenticated(t, h.Archive, req, mockLogger)
 
 		require.Equal(t, http.StatusOK, rr.Code)
 	})
@@ -53,8 +51,9 @@ func TestVideoHandler_Archive(t *testing.T) {
 		h := handler.NewVideoHandler(videoUC, mockLogger)
 
 		videoID := "video-123"
+		input := videoapp.ArchiveVideoInput{ID: videoID, UserID: "user-123"}
 		mockArchiveUC.EXPECT().
-			Execute(mock.Anything, videoID).
+			Execute(mock.Anything, input).
 			Return(errors.New("db failure")).
 			Once()
 
@@ -62,10 +61,30 @@ func TestVideoHandler_Archive(t *testing.T) {
 
 		req := httptest.NewRequest(http.MethodGet, "/api/video/"+videoID, nil)
 		req = mux.SetURLVars(req, map[string]string{"id": videoID})
-		rr := httptest.NewRecorder()
-
-		h.Archive(rr, req)
+		rr := serveAuthenticated(t, h.Archive, req, mockLogger)
 
 		require.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
+
+	t.Run("should return 403 Forbidden for a video of another user", func(t *testing.T) {
+		mockArchiveUC := mockvideo.NewMockArchiveVideoUsecase(t)
+		videoUC := videoapp.VideoUsecase{
+			Archive: mockArchiveUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewVideoHandler(videoUC, mockLogger)
+
+		videoID := "video-123"
+		input := videoapp.ArchiveVideoInput{ID: videoID, UserID: "user-123"}
+		mockArchiveUC.EXPECT().
+			Execute(mock.Anything, input).
+			Return(videoapp.ErrVideoForbidden).
+			Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/video/"+videoID, nil)
+		req = mux.SetURLVars(req, map[string]string{"id": videoID})
+		rr := serveAuthenticated(t, h.Archive, req, mockLogger)
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
 }
@@ -1,20 +1,51 @@
 package handler
 
 import (
+	"net/http"
+
 	"github.com/st-ember/streaming-api/internal/application/authapp"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
 )
 
+const (
+	// refreshCookie holds the refresh token, only sent to the auth routes
+	refreshCookie     = "refresh_token"
+	refreshCookiePath = "/api/auth"
+)
+
 type AuthHandler struct {
-	loginUC  authapp.LoginUsecase
-	signupUC authapp.SignupUsecase
-	logger   log.Logger
+	authUC authapp.AuthUsecase
+	logger log.Logger
 }
 
 func NewAuthHandler(
-	loginUC authapp.LoginUsecase,
-	signupUC authapp.SignupUsecase,
+	authUC authapp.AuthUsecase,
 	logger log.Logger,
 ) *AuthHandler {
-	return &AuthHandler{loginUC, signupUC, logger}
+	return &AuthHandler{authUC, logger}
+}
+
+// setRefreshCookie hands the refresh token to the client, out of reach of scripts
+func setRefreshCookie(w http.ResponseWriter, refreshToken string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     refreshCookie,
+		Value:    refreshToken,
+		HttpOnly: true,
+		Path:     refreshCookiePath,
+		Secure:   false, // temp for testing
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// clearRefreshCookie removes a refresh token that can no longer be used
+func clearRefreshCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     refreshCookie,
+		Value:    "",
+		HttpOnly: true,
+		Path:     refreshCookiePath,
+		MaxAge:   -1,
+		Secure:   false, // temp for testing
+		SameSite: http.SameSiteStrictMode,
+	})
 }
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+// Cancel cancels a job of a video of the authenticated user, or any job for admins.
+// It must be chained after the Auth middleware.
+func (h *JobHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse id param
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Assemble input
+	input := jobapp.CancelJobInput{
+		ID:          id,
+		UserID:      claims.UserID,
+		Permissions: claims.Permissions,
+	}
+
+	// Execute usecase
+	if err := h.jobUC.Cancel.Execute(r.Context(), input); err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			http.Error(w, "job not found", http.StatusNotFound)
+		case errors.Is(err, jobapp.ErrJobForbidden):
+			http.Error(w, "forbidden", http.StatusForbidden)
+		case errors.Is(err, job.ErrCannotBeCancelled):
+			http.Error(w, "job cannot be cancelled", http.StatusConflict)
+		default:
+			h.logger.Errorf(r.Context(), log.CategoryJob, id, "cancel job %s: %v", id, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Send OK response
+	w.WriteHeader(http.StatusOK)
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryJob, id, "cancelled job %s", id)
+}
@@ -0,0 +1,89 @@
+package handler_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	mockjob "github.com/st-ember/streaming-api/internal/application/jobapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobHandler_Cancel(t *testing.T) {
+	jobID := "job-123"
+
+	tests := []struct {
+		name       string
+		ucErr      error
+		expectLog  func(l *mocklog.MockLogger)
+		wantStatus int
+	}{
+		{
+			name:  "should return 200 OK on success",
+			ucErr: nil,
+			expectLog: func(l *mocklog.MockLogger) {
+				l.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "should return 404 Not Found if job does not exist",
+			ucErr:      fmt.Errorf("find job %s: %w", jobID, sql.ErrNoRows),
+			expectLog:  func(l *mocklog.MockLogger) {},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "should return 403 Forbidden if the user does not own the video",
+			ucErr:      fmt.Errorf("cancel job %s: %w", jobID, jobapp.ErrJobForbidden),
+			expectLog:  func(l *mocklog.MockLogger) {},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "should return 409 Conflict if job is already finished",
+			ucErr:      fmt.Errorf("cancel job %s: %w", jobID, job.ErrCannotBeCancelled),
+			expectLog:  func(l *mocklog.MockLogger) {},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:  "should return 500 Internal Server Error if usecase fails",
+			ucErr: errors.New("db failure"),
+			expectLog: func(l *mocklog.MockLogger) {
+				l.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCancelUC := mockjob.NewMockCancelJobUsecase(t)
+			jobUC := jobapp.JobUsecase{
+				Cancel: mockCancelUC,
+			}
+			mockLogger := mocklog.NewMockLogger(t)
+			h := handler.NewJobHandler(jobUC, mockLogger)
+
+			mockCancelUC.EXPECT().
+				Execute(mock.Anything, jobapp.CancelJobInput{ID: jobID, UserID: "user-123"}).
+				Return(tc.ucErr).
+				Once()
+			tc.expectLog(mockLogger)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/jobs/"+jobID, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": jobID})
+
+			rr := serveAuthenticated(t, h.Cancel, req, mockLogger)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
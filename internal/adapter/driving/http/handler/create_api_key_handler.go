@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/apikeyapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// Create issues an api key for the authenticated user, returning the key once.
+// Keys cannot create keys, so a leaked key cannot outlive its revocation.
+// It must be chained after the Auth middleware.
+func (h *APIKeyHandler) Create(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if claims.APIKeyID != "" {
+		http.Error(w, "api keys cannot create api keys", http.StatusForbidden)
+		return
+	}
+
+	// Decode request
+	var req CreateAPIKeyRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	// Execute usecase
+	k, key, err := h.apiKeyUC.Create.Execute(r.Context(), apikeyapp.CreateAPIKeyInput{
+		UserID:          claims.UserID,
+		Name:            req.Name,
+		Permissions:     req.Permissions,
+		ExpiresAt:       req.ExpiresAt,
+		HeldPermissions: claims.Permissions,
+	})
+	if err != nil {
+		if errors.Is(err, apikeyapp.ErrPermissionNotHeld) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		if isInvalidAPIKey(err) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryAuth, claims.UserID, "create api key: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	// Send response
+	res := CreateAPIKeyResponse{APIKeyResponse: newAPIKeyResponse(k), Key: key}
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, claims.UserID, "encode api key %s: %v", k.ID, err)
+	}
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryAuth, claims.UserID, "created api key %s (%s)", k.ID, k.Prefix)
+}
@@ -0,0 +1,96 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/memorydenylist"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/apikeyapp"
+	mockapikey "github.com/st-ember/streaming-api/internal/application/apikeyapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	mocktoken "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyHandler_Create(t *testing.T) {
+	t.Run("should return 201 Created with the key", func(t *testing.T) {
+		mockCreateUC := mockapikey.NewMockCreateAPIKeyUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAPIKeyHandler(apikeyapp.APIKeyUsecase{Create: mockCreateUC}, mockLogger)
+
+		k, _ := auth.NewAPIKey("key-1", "user-123", "ingest", "sak_abc", "hash", nil, nil)
+		mockCreateUC.EXPECT().
+			Execute(mock.Anything, apikeyapp.CreateAPIKeyInput{UserID: "user-123", Name: "ingest"}).
+			Return(k, "sak_abc_secret", nil).
+			Once()
+		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/api-keys", strings.NewReader(`{"name": "ingest"}`))
+		rr := serveAuthenticated(t, h.Create, req, mockLogger)
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		var res handler.CreateAPIKeyResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Equal(t, "key-1", res.ID)
+		require.Equal(t, "sak_abc", res.Prefix)
+		require.Equal(t, "sak_abc_secret", res.Key)
+	})
+
+	t.Run("should return 403 Forbidden for a permission the user lacks", func(t *testing.T) {
+		mockCreateUC := mockapikey.NewMockCreateAPIKeyUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAPIKeyHandler(apikeyapp.APIKeyUsecase{Create: mockCreateUC}, mockLogger)
+
+		mockCreateUC.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, "", fmt.Errorf("grant job:admin: %w", apikeyapp.ErrPermissionNotHeld)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/api-keys", strings.NewReader(`{"name": "ingest", "permissions": ["job:admin"]}`))
+		rr := serveAuthenticated(t, h.Create, req, mockLogger)
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should return 400 Bad Request without a name", func(t *testing.T) {
+		mockCreateUC := mockapikey.NewMockCreateAPIKeyUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAPIKeyHandler(apikeyapp.APIKeyUsecase{Create: mockCreateUC}, mockLogger)
+
+		mockCreateUC.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, "", fmt.Errorf("create api key entity: %w", auth.ErrAPIKeyNameEmpty)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/api-keys", strings.NewReader(`{}`))
+		rr := serveAuthenticated(t, h.Create, req, mockLogger)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 403 Forbidden when authenticated with an api key", func(t *testing.T) {
+		mockKeys := mockapikey.NewMockAuthenticateAPIKeyUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAPIKeyHandler(apikeyapp.APIKeyUsecase{}, mockLogger)
+
+		claims := &tokenport.AccessClaims{UserID: "user-123", APIKeyID: "key-1"}
+		mockKeys.EXPECT().Execute(mock.Anything, "sak_abc_secret").Return(claims, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/api-keys", strings.NewReader(`{"name": "ingest"}`))
+		req.Header.Set(middleware.APIKeyHeader, "sak_abc_secret")
+		rr := httptest.NewRecorder()
+		middleware.Auth(mocktoken.NewMockToken(t), memorydenylist.NewMemoryDenylist(), mockKeys, mockLogger)(http.HandlerFunc(h.Create)).ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
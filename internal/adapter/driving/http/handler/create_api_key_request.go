@@ -0,0 +1,9 @@
+package handler
+
+import "time"
+
+type CreateAPIKeyRequest struct {
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+}
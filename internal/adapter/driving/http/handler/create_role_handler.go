@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/roleapp"
+)
+
+func (h *RoleHandler) Create(w http.ResponseWriter, r *http.Request) {
+	// Decode request
+	var req CreateRoleRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	// Execute usecase
+	role, err := h.roleUC.Create.Execute(r.Context(), roleapp.CreateRoleInput{
+		Name:        req.Name,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		if isInvalidRole(err) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, roleapp.ErrRoleExists) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "create role: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(newRoleResponse(role)); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, role.ID, "encode role %s: %v", role.Name, err)
+	}
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryAuth, role.ID, "created role %s", role.Name)
+}
@@ -0,0 +1,85 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/roleapp"
+	mockrole "github.com/st-ember/streaming-api/internal/application/roleapp/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleHandler_Create(t *testing.T) {
+	t.Run("should return 201 Created with the role", func(t *testing.T) {
+		mockCreateUC := mockrole.NewMockCreateRoleUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewRoleHandler(roleapp.RoleUsecase{Create: mockCreateUC}, mockLogger)
+
+		r, _ := auth.NewRole("role-1", "editor", []string{auth.PermissionVideoUpdate})
+		mockCreateUC.EXPECT().
+			Execute(mock.Anything, roleapp.CreateRoleInput{
+				Name:        "editor",
+				Permissions: []string{auth.PermissionVideoUpdate},
+			}).
+			Return(r, nil).
+			Once()
+		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		body := `{"name": "editor", "permissions": ["video:update"]}`
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/roles", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		h.Create(rr, req)
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		var res handler.RoleResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Equal(t, "editor", res.Name)
+		require.Equal(t, []string{auth.PermissionVideoUpdate}, res.Permissions)
+	})
+
+	t.Run("should return 400 Bad Request on an unknown permission", func(t *testing.T) {
+		mockCreateUC := mockrole.NewMockCreateRoleUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewRoleHandler(roleapp.RoleUsecase{Create: mockCreateUC}, mockLogger)
+
+		mockCreateUC.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("create role entity: %w", auth.ErrUnknownPermission)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/roles", strings.NewReader(`{"name": "editor", "permissions": ["video:delete"]}`))
+		rr := httptest.NewRecorder()
+
+		h.Create(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 409 Conflict if the role exists", func(t *testing.T) {
+		mockCreateUC := mockrole.NewMockCreateRoleUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewRoleHandler(roleapp.RoleUsecase{Create: mockCreateUC}, mockLogger)
+
+		mockCreateUC.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, roleapp.ErrRoleExists).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/roles", strings.NewReader(`{"name": "admin"}`))
+		rr := httptest.NewRecorder()
+
+		h.Create(rr, req)
+
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
@@ -0,0 +1,6 @@
+package handler
+
+type CreateRoleRequest struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+)
+
+// Create registers a subscription, a secret is generated when none is provided
+func (h *WebhookHandler) Create(w http.ResponseWriter, r *http.Request) {
+	// Decode request
+	var req CreateSubscriptionRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	// Execute usecase
+	s, err := h.webhookUC.Create.Execute(r.Context(), webhookapp.CreateSubscriptionInput{
+		URL:        req.URL,
+		Secret:     req.Secret,
+		EventTypes: toEventTypes(req.EventTypes),
+	})
+	if err != nil {
+		if isInvalidSubscription(err) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, "", "create subscription: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	// Send response
+	res := CreateSubscriptionResponse{SubscriptionResponse: newSubscriptionResponse(s), Secret: s.Secret}
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, s.ID, "encode subscription %s: %v", s.ID, err)
+	}
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryWebhook, s.ID, "created subscription %s to %s", s.ID, s.URL)
+}
@@ -0,0 +1,87 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	mockwebhook "github.com/st-ember/streaming-api/internal/application/webhookapp/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookHandler_Create(t *testing.T) {
+	t.Run("should return 201 Created with the secret", func(t *testing.T) {
+		mockCreateUC := mockwebhook.NewMockCreateSubscriptionUsecase(t)
+		webhookUC := webhookapp.WebhookUsecase{
+			Create: mockCreateUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewWebhookHandler(webhookUC, mockLogger)
+
+		s, _ := webhook.NewSubscription("sub-1", "https://example.com/hook", "generated", []event.EventType{event.TypeVideoPublished})
+		mockCreateUC.EXPECT().
+			Execute(mock.Anything, webhookapp.CreateSubscriptionInput{
+				URL:        "https://example.com/hook",
+				EventTypes: []event.EventType{event.TypeVideoPublished},
+			}).
+			Return(s, nil).
+			Once()
+		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		body := `{"url": "https://example.com/hook", "event_types": ["video.published"]}`
+		req := httptest.NewRequest(http.MethodPost, "/api/webhooks", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		h.Create(rr, req)
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		var res handler.CreateSubscriptionResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Equal(t, "sub-1", res.ID)
+		require.Equal(t, "generated", res.Secret)
+		require.Equal(t, []string{"video.published"}, res.EventTypes)
+	})
+
+	t.Run("should return 400 Bad Request if the url is invalid", func(t *testing.T) {
+		mockCreateUC := mockwebhook.NewMockCreateSubscriptionUsecase(t)
+		webhookUC := webhookapp.WebhookUsecase{
+			Create: mockCreateUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewWebhookHandler(webhookUC, mockLogger)
+
+		mockCreateUC.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("create subscription entity: %w", webhook.ErrURLInvalid)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/webhooks", strings.NewReader(`{"url": "not a url"}`))
+		rr := httptest.NewRecorder()
+
+		h.Create(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 400 Bad Request on invalid json", func(t *testing.T) {
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewWebhookHandler(webhookapp.WebhookUsecase{}, mockLogger)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/webhooks", strings.NewReader(`{`))
+		rr := httptest.NewRecorder()
+
+		h.Create(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
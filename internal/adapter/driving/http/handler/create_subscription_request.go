@@ -0,0 +1,7 @@
+package handler
+
+type CreateSubscriptionRequest struct {
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types"`
+}
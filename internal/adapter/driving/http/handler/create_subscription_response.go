@@ -0,0 +1,6 @@
+package handler
+
+type CreateSubscriptionResponse struct {
+	SubscriptionResponse
+	Secret string `json:"secret"`
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+func (h *WebhookHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	// Parse id param
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Execute usecase
+	if err := h.webhookUC.Delete.Execute(r.Context(), id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "subscription not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, id, "delete subscription %s: %v", id, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Send No Content response
+	w.WriteHeader(http.StatusNoContent)
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryWebhook, id, "deleted subscription %s", id)
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+type DeliveryResponse struct {
+	ID             string     `json:"id"`
+	SubscriptionID string     `json:"subscription_id"`
+	EventID        string     `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	LastStatusCode int        `json:"last_status_code,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func newDeliveryResponse(d *webhook.Delivery) DeliveryResponse {
+	res := DeliveryResponse{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      string(d.EventType),
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+	}
+
+	// The next attempt is only meaningful while the delivery is pending
+	if d.IsPending() {
+		next := d.NextAttemptAt
+		res.NextAttemptAt = &next
+	}
+
+	return res
+}
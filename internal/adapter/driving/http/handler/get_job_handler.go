@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+func (h *JobHandler) Get(w http.ResponseWriter, r *http.Request) {
+	// Parse id param
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Execute usecase
+	j, err := h.jobUC.Get.Execute(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "job not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryJob, id, "find job %s: %v", id, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(newJobResponse(j)); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryJob, id, "encode job %s: %v", id, err)
+	}
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+func (h *WebhookHandler) Get(w http.ResponseWriter, r *http.Request) {
+	// Parse id param
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Execute usecase
+	s, err := h.webhookUC.Get.Execute(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "subscription not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, id, "find subscription %s: %v", id, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(newSubscriptionResponse(s)); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, id, "encode subscription %s: %v", id, err)
+	}
+}
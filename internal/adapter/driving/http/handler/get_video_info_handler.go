@@ -29,6 +29,7 @@ func (h *VideoHandler) Get(w http.ResponseWriter, r *http.Request) {
 		SourceFilename: info.Video.Filename,
 		ResourceID:     info.Video.ResourceID,
 		Status:         string(info.Video.Status),
+		PublishAt:      info.Video.PublishAt,
 		Duration:       info.Video.Duration.Seconds(),
 		ManifestPath:   info.ManifestPath,
 		ErrorMsg:       info.ErrorMsg,
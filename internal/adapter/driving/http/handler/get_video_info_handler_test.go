@@ -29,7 +29,7 @@ func TestVideoHandler_Get(t *testing.T) {
 
 		videoID := "video-123"
 		resourceID := "resource-123"
-		v, _ := video.NewVideo(videoID, "Test Video", "Description", "test.mp4", resourceID)
+		v, _ := video.NewVideo(videoID, "owner-id", "Test Video", "Description", "test.mp4", resourceID)
 		v.Duration = 120 * time.Second
 
 		usecaseResult := &videoapp.GetVideoInfoResult{
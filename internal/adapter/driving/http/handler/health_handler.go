@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// Pinger checks that a dependency is reachable, e.g. *sql.DB
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+type HealthHandler struct {
+	role   string
+	db     Pinger
+	logger log.Logger
+}
+
+type HealthResponse struct {
+	Status string `json:"status"`
+	Role   string `json:"role"`
+}
+
+func NewHealthHandler(role string, db Pinger, logger log.Logger) *HealthHandler {
+	return &HealthHandler{
+		role,
+		db,
+		logger,
+	}
+}
+
+// Health reports whether the node can reach its database
+func (h *HealthHandler) Health(w http.ResponseWriter, r *http.Request) {
+	res := HealthResponse{Status: "ok", Role: h.role}
+	code := http.StatusOK
+
+	if err := h.db.PingContext(r.Context()); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryDefault, "", "health check: ping db: %v", err)
+		res.Status = "unavailable"
+		code = http.StatusServiceUnavailable
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryDefault, "", "encode health response: %v", err)
+	}
+}
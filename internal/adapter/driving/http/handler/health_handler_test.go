@@ -0,0 +1,58 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePinger struct {
+	err error
+}
+
+func (p fakePinger) PingContext(ctx context.Context) error {
+	return p.err
+}
+
+func TestHealthHandler_Health(t *testing.T) {
+	t.Run("should return 200 OK when the db is reachable", func(t *testing.T) {
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewHealthHandler("worker", fakePinger{}, mockLogger)
+
+		req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+		rr := httptest.NewRecorder()
+
+		h.Health(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var res handler.HealthResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Equal(t, "ok", res.Status)
+		require.Equal(t, "worker", res.Role)
+	})
+
+	t.Run("should return 503 Service Unavailable when the db is down", func(t *testing.T) {
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewHealthHandler("worker", fakePinger{err: errors.New("connection refused")}, mockLogger)
+
+		mockLogger.EXPECT().
+			Errorf(mock.Anything, mock.Anything, "", "health check: ping db: %v", mock.Anything).
+			Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+		rr := httptest.NewRecorder()
+
+		h.Health(rr, req)
+
+		require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+}
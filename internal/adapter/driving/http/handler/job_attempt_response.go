@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+type JobAttemptResponse struct {
+	Number     int        `json:"number"`
+	WorkerID   string     `json:"worker_id"`
+	Status     string     `json:"status"`
+	ExitCode   *int       `json:"exit_code,omitempty"`
+	ErrorMsg   string     `json:"error_message,omitempty"`
+	StderrTail string     `json:"stderr_tail,omitempty"`
+	Duration   float64    `json:"duration_seconds"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+func newJobAttemptResponse(a *job.Attempt) JobAttemptResponse {
+	return JobAttemptResponse{
+		Number:     a.Number,
+		WorkerID:   a.WorkerID,
+		Status:     string(a.Status),
+		ExitCode:   a.ExitCode,
+		ErrorMsg:   a.ErrorMsg,
+		StderrTail: a.StderrTail,
+		Duration:   a.Duration().Seconds(),
+		StartedAt:  a.StartedAt,
+		FinishedAt: a.FinishedAt,
+	}
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+type JobHandler struct {
+	jobUC  jobapp.JobUsecase
+	logger log.Logger
+}
+
+func NewJobHandler(
+	jobUC jobapp.JobUsecase,
+	logger log.Logger,
+) *JobHandler {
+	return &JobHandler{
+		jobUC,
+		logger,
+	}
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+type JobResponse struct {
+	ID         string     `json:"id"`
+	VideoID    string     `json:"video_id"`
+	Type       string     `json:"type"`
+	Step       string     `json:"step,omitempty"`
+	DependsOn  []string   `json:"depends_on,omitempty"`
+	Optional   bool       `json:"optional"`
+	Status     string     `json:"status"`
+	Result     string     `json:"result,omitempty"`
+	ErrorMsg   string     `json:"error_message,omitempty"`
+	Priority   int        `json:"priority"`
+	Attempts   int        `json:"attempts"`
+	WorkerID   string     `json:"worker_id,omitempty"`
+	Duration   float64    `json:"duration_seconds"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+func newJobResponse(j *job.Job) JobResponse {
+	return JobResponse{
+		ID:         j.ID,
+		VideoID:    j.VideoID,
+		Type:       string(j.Type),
+		Step:       j.Step,
+		DependsOn:  j.DependsOn,
+		Optional:   j.Optional,
+		Status:     string(j.Status),
+		Result:     j.Result,
+		ErrorMsg:   j.ErrorMsg,
+		Priority:   j.Priority,
+		Attempts:   j.Attempts,
+		WorkerID:   j.WorkerID,
+		Duration:   j.Duration().Seconds(),
+		StartedAt:  j.StartedAt,
+		FinishedAt: j.FinishedAt,
+		CreatedAt:  j.CreatedAt,
+		UpdatedAt:  j.UpdatedAt,
+	}
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+)
+
+// jwksMaxAge lets verifiers cache the keys for 5 minutes,
+// well within the time a new key is published before it signs
+const jwksMaxAge = "public, max-age=300"
+
+// JWKSResponse is a JSON Web Key Set (RFC 7517)
+type JWKSResponse struct {
+	Keys []token.JWK `json:"keys"`
+}
+
+// JWKS publishes the public keys verifying access tokens
+func (ah *AuthHandler) JWKS(w http.ResponseWriter, r *http.Request) {
+	res := JWKSResponse{Keys: ah.authUC.PublicKeys.Execute(r.Context())}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", jwksMaxAge)
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "encode jwks: %v", err)
+	}
+}
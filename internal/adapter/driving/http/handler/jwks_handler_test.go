@@ -0,0 +1,51 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	mockauth "github.com/st-ember/streaming-api/internal/application/authapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthHandler_JWKS(t *testing.T) {
+	t.Run("should return 200 OK with the key set", func(t *testing.T) {
+		mockPublicKeysUC := mockauth.NewMockListPublicKeysUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{PublicKeys: mockPublicKeysUC}, mockLogger)
+
+		jwk := token.JWK{Kty: "OKP", Kid: "key-1", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "x"}
+		mockPublicKeysUC.EXPECT().Execute(mock.Anything).Return([]token.JWK{jwk}).Once()
+
+		rr := httptest.NewRecorder()
+		h.JWKS(rr, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Contains(t, rr.Header().Get("Cache-Control"), "max-age")
+
+		var body handler.JWKSResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		require.Equal(t, []token.JWK{jwk}, body.Keys)
+	})
+
+	t.Run("should return an empty key set rather than null", func(t *testing.T) {
+		mockPublicKeysUC := mockauth.NewMockListPublicKeysUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{PublicKeys: mockPublicKeysUC}, mockLogger)
+
+		mockPublicKeysUC.EXPECT().Execute(mock.Anything).Return([]token.JWK{}).Once()
+
+		rr := httptest.NewRecorder()
+		h.JWKS(rr, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `{"keys": []}`, rr.Body.String())
+	})
+}
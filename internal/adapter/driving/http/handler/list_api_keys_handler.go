@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// List lists the api keys of the authenticated user.
+// It must be chained after the Auth middleware.
+func (h *APIKeyHandler) List(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Execute usecase
+	ks, err := h.apiKeyUC.List.Execute(r.Context(), claims.UserID)
+	if err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, claims.UserID, "list api keys: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Assemble response
+	res := make([]APIKeyResponse, 0, len(ks))
+	for _, k := range ks {
+		res = append(res, newAPIKeyResponse(k))
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, claims.UserID, "encode api key list: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// ListDeliveries returns the delivery log of a subscription, newest first, paginated with the page query param
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	// Parse id and page params
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	page := 1
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		p, err := strconv.Atoi(pageStr)
+		if err != nil || p < 1 {
+			http.Error(w, "invalid page param", http.StatusBadRequest)
+			return
+		}
+		page = p
+	}
+
+	// Execute usecase
+	ds, err := h.webhookUC.ListDeliveries.Execute(r.Context(), id, page)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "subscription not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, id, "list deliveries of subscription %s: %v", id, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Assemble response
+	res := make([]DeliveryResponse, 0, len(ds))
+	for _, d := range ds {
+		res = append(res, newDeliveryResponse(d))
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, id, "encode delivery list: %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package handler_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	mockwebhook "github.com/st-ember/streaming-api/internal/application/webhookapp/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookHandler_ListDeliveries(t *testing.T) {
+	subID := "sub-1"
+
+	t.Run("should return 200 OK with the requested page", func(t *testing.T) {
+		mockListUC := mockwebhook.NewMockListDeliveriesUsecase(t)
+		webhookUC := webhookapp.WebhookUsecase{
+			ListDeliveries: mockListUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewWebhookHandler(webhookUC, mockLogger)
+
+		ds := []*webhook.Delivery{
+			{ID: "delivery-2", SubscriptionID: subID, Status: webhook.DeliveryStatusSucceeded, Attempts: 1, LastStatusCode: 200},
+			{ID: "delivery-1", SubscriptionID: subID, Status: webhook.DeliveryStatusPending, Attempts: 2, LastStatusCode: 500},
+		}
+		mockListUC.EXPECT().Execute(mock.Anything, subID, 2).Return(ds, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/webhooks/"+subID+"/deliveries?page=2", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": subID})
+		rr := httptest.NewRecorder()
+
+		h.ListDeliveries(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var res []handler.DeliveryResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Len(t, res, 2)
+		require.Nil(t, res[0].NextAttemptAt)
+		require.NotNil(t, res[1].NextAttemptAt)
+	})
+
+	t.Run("should return 400 Bad Request if page is invalid", func(t *testing.T) {
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewWebhookHandler(webhookapp.WebhookUsecase{}, mockLogger)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/webhooks/"+subID+"/deliveries?page=0", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": subID})
+		rr := httptest.NewRecorder()
+
+		h.ListDeliveries(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 404 Not Found if the subscription does not exist", func(t *testing.T) {
+		mockListUC := mockwebhook.NewMockListDeliveriesUsecase(t)
+		webhookUC := webhookapp.WebhookUsecase{
+			ListDeliveries: mockListUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewWebhookHandler(webhookUC, mockLogger)
+
+		mockListUC.EXPECT().
+			Execute(mock.Anything, subID, 1).
+			Return(nil, fmt.Errorf("find subscription %s: %w", subID, sql.ErrNoRows)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/webhooks/"+subID+"/deliveries", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": subID})
+		rr := httptest.NewRecorder()
+
+		h.ListDeliveries(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+func (h *JobHandler) ListAttempts(w http.ResponseWriter, r *http.Request) {
+	// Parse id param
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Execute usecase
+	as, err := h.jobUC.ListAttempts.Execute(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "job not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryJob, id, "list job %s attempts: %v", id, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Assemble response
+	res := make([]JobAttemptResponse, 0, len(as))
+	for _, a := range as {
+		res = append(res, newJobAttemptResponse(a))
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryJob, id, "encode job %s attempts: %v", id, err)
+	}
+}
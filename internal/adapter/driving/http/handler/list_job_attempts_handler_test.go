@@ -0,0 +1,89 @@
+package handler_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	mockjob "github.com/st-ember/streaming-api/internal/application/jobapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobHandler_ListAttempts(t *testing.T) {
+	jobID := "job-123"
+
+	t.Run("should return 200 OK with the attempt history", func(t *testing.T) {
+		mockAttemptsUC := mockjob.NewMockListJobAttemptsUsecase(t)
+		jobUC := jobapp.JobUsecase{
+			ListAttempts: mockAttemptsUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewJobHandler(jobUC, mockLogger)
+
+		exitCode := 1
+		startedAt := time.Now().UTC()
+		finishedAt := startedAt.Add(30 * time.Second)
+		attempts := []*job.Attempt{{
+			JobID:      jobID,
+			Number:     1,
+			WorkerID:   "host-0",
+			Status:     job.StatusFailed,
+			ExitCode:   &exitCode,
+			StderrTail: "Invalid data found",
+			StartedAt:  startedAt,
+			FinishedAt: &finishedAt,
+		}}
+
+		mockAttemptsUC.EXPECT().
+			Execute(mock.Anything, jobID).
+			Return(attempts, nil).
+			Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/jobs/"+jobID+"/attempts", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": jobID})
+		rr := httptest.NewRecorder()
+
+		h.ListAttempts(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var res []handler.JobAttemptResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Len(t, res, 1)
+		require.Equal(t, "host-0", res[0].WorkerID)
+		require.Equal(t, 1, *res[0].ExitCode)
+		require.Equal(t, 30.0, res[0].Duration)
+	})
+
+	t.Run("should return 404 Not Found if job does not exist", func(t *testing.T) {
+		mockAttemptsUC := mockjob.NewMockListJobAttemptsUsecase(t)
+		jobUC := jobapp.JobUsecase{
+			ListAttempts: mockAttemptsUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewJobHandler(jobUC, mockLogger)
+
+		mockAttemptsUC.EXPECT().
+			Execute(mock.Anything, jobID).
+			Return(nil, fmt.Errorf("find job %s: %w", jobID, sql.ErrNoRows)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/jobs/"+jobID+"/attempts", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": jobID})
+		rr := httptest.NewRecorder()
+
+		h.ListAttempts(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
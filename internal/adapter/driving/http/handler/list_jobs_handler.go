@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// List filters jobs with the status, type, video_id, from and to query params.
+// from and to are RFC 3339 timestamps bounding the creation date.
+func (h *JobHandler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// Assemble input
+	input := jobapp.ListJobsInput{
+		Status:  query.Get("status"),
+		Type:    query.Get("type"),
+		VideoID: query.Get("video_id"),
+		Page:    1,
+	}
+
+	if pageStr := query.Get("page"); pageStr != "" {
+		page, err := strconv.Atoi(pageStr)
+		if err != nil {
+			http.Error(w, "invalid page param", http.StatusBadRequest)
+			return
+		}
+		input.Page = page
+	}
+
+	for param, dst := range map[string]*time.Time{
+		"from": &input.CreatedAfter,
+		"to":   &input.CreatedBefore,
+	} {
+		if v := query.Get(param); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				http.Error(w, "invalid "+param+" param", http.StatusBadRequest)
+				return
+			}
+			*dst = t
+		}
+	}
+
+	// Execute usecase
+	js, err := h.jobUC.List.Execute(r.Context(), input)
+	if err != nil {
+		if errors.Is(err, jobapp.ErrInvalidJobFilter) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryJob, "", "list jobs: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Assemble response
+	res := make([]JobResponse, 0, len(js))
+	for _, j := range js {
+		res = append(res, newJobResponse(j))
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryJob, "", "encode job list: %v", err)
+	}
+}
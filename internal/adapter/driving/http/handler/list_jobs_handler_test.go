@@ -0,0 +1,91 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	mockjob "github.com/st-ember/streaming-api/internal/application/jobapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobHandler_List(t *testing.T) {
+	t.Run("should return 200 OK with filtered jobs", func(t *testing.T) {
+		mockListUC := mockjob.NewMockListJobsUsecase(t)
+		jobUC := jobapp.JobUsecase{
+			List: mockListUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewJobHandler(jobUC, mockLogger)
+
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		expectedInput := jobapp.ListJobsInput{
+			Status:       "failed",
+			VideoID:      "video-123",
+			CreatedAfter: from,
+			Page:         2,
+		}
+		jobs := []*job.Job{{ID: "job-1", Status: job.StatusFailed, ErrorMsg: "ffmpeg exited"}}
+
+		mockListUC.EXPECT().
+			Execute(mock.Anything, expectedInput).
+			Return(jobs, nil).
+			Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/jobs?status=failed&video_id=video-123&from=2025-01-01T00:00:00Z&page=2", nil)
+		rr := httptest.NewRecorder()
+
+		h.List(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var res []handler.JobResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Len(t, res, 1)
+		require.Equal(t, "job-1", res[0].ID)
+		require.Equal(t, "ffmpeg exited", res[0].ErrorMsg)
+	})
+
+	t.Run("should return 400 Bad Request on malformed params", func(t *testing.T) {
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewJobHandler(jobapp.JobUsecase{}, mockLogger)
+
+		for _, query := range []string{"page=abc", "from=yesterday", "to=2025-13-01"} {
+			req := httptest.NewRequest(http.MethodGet, "/api/admin/jobs?"+query, nil)
+			rr := httptest.NewRecorder()
+
+			h.List(rr, req)
+
+			require.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+	})
+
+	t.Run("should return 400 Bad Request on invalid filter", func(t *testing.T) {
+		mockListUC := mockjob.NewMockListJobsUsecase(t)
+		jobUC := jobapp.JobUsecase{
+			List: mockListUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewJobHandler(jobUC, mockLogger)
+
+		mockListUC.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("status unknown: %w", jobapp.ErrInvalidJobFilter)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/jobs?status=unknown", nil)
+		rr := httptest.NewRecorder()
+
+		h.List(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
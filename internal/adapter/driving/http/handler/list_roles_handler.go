@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+func (h *RoleHandler) List(w http.ResponseWriter, r *http.Request) {
+	// Execute usecase
+	roles, err := h.roleUC.List.Execute(r.Context())
+	if err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "list roles: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Assemble response
+	res := make([]RoleResponse, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, newRoleResponse(role))
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "encode role list: %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// ListSessions lists the sessions of the authenticated user.
+// It must be chained after the Auth middleware.
+func (ah *AuthHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Execute usecase
+	fs, err := ah.authUC.ListSessions.Execute(r.Context(), claims.UserID)
+	if err != nil {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, claims.UserID, "list sessions: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Assemble response
+	res := make([]SessionResponse, 0, len(fs))
+	for _, f := range fs {
+		res = append(res, newSessionResponse(f, claims.SessionID))
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, claims.UserID, "encode session list: %v", err)
+	}
+}
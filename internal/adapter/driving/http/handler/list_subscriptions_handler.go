@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+func (h *WebhookHandler) List(w http.ResponseWriter, r *http.Request) {
+	// Execute usecase
+	ss, err := h.webhookUC.List.Execute(r.Context())
+	if err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, "", "list subscriptions: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Assemble response
+	res := make([]SubscriptionResponse, 0, len(ss))
+	for _, s := range ss {
+		res = append(res, newSubscriptionResponse(s))
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, "", "encode subscription list: %v", err)
+	}
+}
@@ -6,10 +6,21 @@ import (
 	"strconv"
 
 	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/videoapp"
 )
 
+// List lists the videos of the authenticated user. Video admins list every video,
+// unless the mine query param is set. It must be chained after the Auth middleware.
 func (h *VideoHandler) List(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
 	// Parse page param
 	vars := mux.Vars(r)
 	pageStr := vars["page"]
@@ -20,8 +31,26 @@ func (h *VideoHandler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Parse mine query param
+	mine := false
+	if v := r.URL.Query().Get("mine"); v != "" {
+		mine, err = strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, "invalid mine param", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Assemble input
+	input := videoapp.ListVideosInput{
+		Page:        page,
+		UserID:      claims.UserID,
+		Permissions: claims.Permissions,
+		Mine:        mine,
+	}
+
 	// Execute usecase
-	vs, err := h.videoUC.List.Execute(r.Context(), page)
+	vs, err := h.videoUC.List.Execute(r.Context(), input)
 	if err != nil {
 		h.logger.Errorf(r.Context(), log.CategoryDefault, "", "list videos: %v", err)
 		http.Error(w, "internal error", http.StatusInternalServerError)
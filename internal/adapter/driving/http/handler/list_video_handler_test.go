@@ -31,14 +31,13 @@ func TestVideoHandler_List(t *testing.T) {
 			{ID: "video-2", Title: "Second"},
 		}
 
-		mockListUC.EXPECT().Execute(mock.Anything, page).Return(expectedVideos, nil).Once()
+		input := videoapp.ListVideosInput{Page: page, UserID: "user-123"}
+		mockListUC.EXPECT().Execute(mock.Anything, input).Return(expectedVideos, nil).Once()
 		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/api/video/list/1", nil)
 		req = mux.SetURLVars(req, map[string]string{"page": "1"})
-		rr := httptest.NewRecorder()
-
-		h.List(rr, req)
+		rr := serveAuthenticated(t, h.List, req, mockLogger)
 
 		require.Equal(t, http.StatusOK, rr.Code)
 
@@ -55,13 +54,29 @@ func TestVideoHandler_List(t *testing.T) {
 		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/api/video/?page=abc", nil)
-		rr := httptest.NewRecorder()
-
-		h.List(rr, req)
+		rr := serveAuthenticated(t, h.List, req, mockLogger)
 
 		require.Equal(t, http.StatusBadRequest, rr.Code)
 	})
 
+	t.Run("should only list the videos of the user when mine is set", func(t *testing.T) {
+		mockListUC := mockvideo.NewMockListVideosUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+
+		videoUCs := videoapp.VideoUsecase{List: mockListUC}
+		h := handler.NewVideoHandler(videoUCs, mockLogger)
+
+		input := videoapp.ListVideosInput{Page: 1, UserID: "user-123", Mine: true}
+		mockListUC.EXPECT().Execute(mock.Anything, input).Return([]*video.Video{}, nil).Once()
+		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/video/list/1?mine=true", nil)
+		req = mux.SetURLVars(req, map[string]string{"page": "1"})
+		rr := serveAuthenticated(t, h.List, req, mockLogger)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
 	t.Run("should return 500 Internal Server Error if usecase fails", func(t *testing.T) {
 		mockListUC := mockvideo.NewMockListVideosUsecase(t)
 		mockLogger := mocklog.NewMockLogger(t)
@@ -69,14 +84,13 @@ func TestVideoHandler_List(t *testing.T) {
 		videoUCs := videoapp.VideoUsecase{List: mockListUC}
 		h := handler.NewVideoHandler(videoUCs, mockLogger)
 
-		mockListUC.EXPECT().Execute(mock.Anything, 1).Return(nil, errors.New("db fail")).Once()
+		input := videoapp.ListVideosInput{Page: 1, UserID: "user-123"}
+		mockListUC.EXPECT().Execute(mock.Anything, input).Return(nil, errors.New("db fail")).Once()
 		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/api/video/list/1", nil)
 		req = mux.SetURLVars(req, map[string]string{"page": "1"})
-		rr := httptest.NewRecorder()
-
-		h.List(rr, req)
+		rr := serveAuthenticated(t, h.List, req, mockLogger)
 
 		require.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
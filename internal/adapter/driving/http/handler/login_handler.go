@@ -2,8 +2,13 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"math"
+	"net"
 	"net/http"
+	"strconv"
 
+	"github.com/st-ember/streaming-api/internal/application/authapp"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
 )
 
@@ -16,24 +21,42 @@ func (ah *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	at, rt, err := ah.loginUC.Execute(r.Context(), req.Key, req.Password)
+	at, rt, err := ah.authUC.Login.Execute(r.Context(), authapp.LoginInput{
+		Login:    req.Key,
+		Password: req.Password,
+		IP:       clientIP(r),
+	})
 	if err != nil {
-		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "login: %v", err)
-		http.Error(w, "username, email or password was incorrect", http.StatusUnauthorized)
+		var throttled *authapp.LoginThrottledError
+		switch {
+		case errors.As(err, &throttled):
+			ah.logger.Warnf(r.Context(), log.CategoryAuth, "", "login: %v", err)
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+			http.Error(w, "too many failed logins, try again later", http.StatusTooManyRequests)
+		case errors.Is(err, authapp.ErrInvalidCredentials):
+			ah.logger.Warnf(r.Context(), log.CategoryAuth, "", "login: %v", err)
+			http.Error(w, "username, email or password was incorrect", http.StatusUnauthorized)
+		default:
+			ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "login: %v", err)
+			http.Error(w, "unexpected error", http.StatusInternalServerError)
+		}
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    rt,
-		HttpOnly: true,
-		Path:     "/refresh",
-		Secure:   false, // temp for testing
-		SameSite: http.SameSiteStrictMode,
-	})
+	setRefreshCookie(w, rt)
 
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]string{
 		"access_token": at,
 	})
 }
+
+// clientIP is the address of the peer, proxies in front of the api are not trusted to forward the client's
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
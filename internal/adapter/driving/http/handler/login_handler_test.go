@@ -0,0 +1,73 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	mockauth "github.com/st-ember/streaming-api/internal/application/authapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func loginRequest() *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", strings.NewReader(`{"key": "jane", "password": "guess"}`))
+	req.RemoteAddr = "203.0.113.7:51234"
+	return req
+}
+
+func TestAuthHandler_Login(t *testing.T) {
+	t.Run("should pass the client address to the usecase", func(t *testing.T) {
+		mockLoginUC := mockauth.NewMockLoginUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{Login: mockLoginUC}, mockLogger)
+
+		mockLoginUC.EXPECT().
+			Execute(mock.Anything, authapp.LoginInput{Login: "jane", Password: "guess", IP: "203.0.113.7"}).
+			Return("access-1", "refresh-1", nil).
+			Once()
+
+		rr := httptest.NewRecorder()
+		h.Login(rr, loginRequest())
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "refresh-1", responseCookie(t, rr).Value)
+	})
+
+	t.Run("should return 401 Unauthorized for invalid credentials", func(t *testing.T) {
+		mockLoginUC := mockauth.NewMockLoginUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{Login: mockLoginUC}, mockLogger)
+
+		mockLoginUC.EXPECT().Execute(mock.Anything, mock.Anything).Return("", "", authapp.ErrInvalidCredentials).Once()
+		mockLogger.EXPECT().Warnf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		rr := httptest.NewRecorder()
+		h.Login(rr, loginRequest())
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return 429 Too Many Requests with Retry-After while throttled", func(t *testing.T) {
+		mockLoginUC := mockauth.NewMockLoginUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{Login: mockLoginUC}, mockLogger)
+
+		mockLoginUC.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return("", "", &authapp.LoginThrottledError{RetryAfter: 1500 * time.Millisecond}).
+			Once()
+		mockLogger.EXPECT().Warnf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		rr := httptest.NewRecorder()
+		h.Login(rr, loginRequest())
+
+		require.Equal(t, http.StatusTooManyRequests, rr.Code)
+		require.Equal(t, "2", rr.Header().Get("Retry-After"))
+	})
+}
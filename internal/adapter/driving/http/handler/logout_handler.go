@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// Logout revokes the session of the access token and clears the refresh cookie.
+// It must be chained after the Auth middleware.
+func (ah *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := ah.authUC.RevokeSession.Execute(r.Context(), claims.UserID, claims.SessionID); err != nil {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, claims.UserID, "logout: %v", err)
+		http.Error(w, "unexpected error", http.StatusInternalServerError)
+		return
+	}
+
+	clearRefreshCookie(w)
+	w.WriteHeader(http.StatusNoContent)
+
+	ah.logger.Infof(r.Context(), log.CategoryAuth, claims.UserID, "logged out of session %s", claims.SessionID)
+}
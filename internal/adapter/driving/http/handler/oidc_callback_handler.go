@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/ports/oidc"
+)
+
+// OIDCCallback completes a sign in when the identity provider redirects back, responding like Login
+func (ah *AuthHandler) OIDCCallback(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	// The state must be the one handed to this browser, or another sign in is being slipped in
+	cookie, err := r.Cookie(oidcStateCookie)
+	if err != nil || cookie.Value == "" || cookie.Value != q.Get("state") {
+		http.Error(w, "invalid state", http.StatusBadRequest)
+		return
+	}
+
+	clearOIDCStateCookie(w)
+
+	// The user declined, or the provider failed to sign them in
+	if e := q.Get("error"); e != "" {
+		ah.logger.Warnf(r.Context(), log.CategoryAuth, "", "oidc callback: provider returned %s: %s", e, q.Get("error_description"))
+		http.Error(w, "sign in at the identity provider failed", http.StatusUnauthorized)
+		return
+	}
+
+	code := q.Get("code")
+	if code == "" {
+		http.Error(w, "missing code", http.StatusBadRequest)
+		return
+	}
+
+	at, rt, err := ah.authUC.OIDCCallback.Execute(r.Context(), cookie.Value, code)
+	if err != nil {
+		switch {
+		case errors.Is(err, authapp.ErrInvalidOIDCLogin), errors.Is(err, oidc.ErrRejected):
+			ah.logger.Warnf(r.Context(), log.CategoryAuth, "", "oidc callback: %v", err)
+			http.Error(w, "sign in at the identity provider failed", http.StatusUnauthorized)
+		case errors.Is(err, authapp.ErrOIDCEmailMissing):
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		case errors.Is(err, authapp.ErrOIDCAccountConflict):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "oidc callback: %v", err)
+			http.Error(w, "unexpected error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	setRefreshCookie(w, rt)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"access_token": at,
+	})
+}
@@ -0,0 +1,123 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	mockauth "github.com/st-ember/streaming-api/internal/application/authapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/oidc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// callbackRequest builds the redirect back from the provider, carrying the state cookie unless empty
+func callbackRequest(query, stateCookie string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/api/auth/oidc/callback?"+query, nil)
+	if stateCookie != "" {
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: stateCookie})
+	}
+	return req
+}
+
+func TestAuthHandler_OIDCLogin(t *testing.T) {
+	mockLoginUC := mockauth.NewMockOIDCLoginUsecase(t)
+	mockLogger := mocklog.NewMockLogger(t)
+	h := handler.NewAuthHandler(authapp.AuthUsecase{OIDCLogin: mockLoginUC}, mockLogger)
+
+	mockLoginUC.EXPECT().Execute(mock.Anything).Return("https://idp.test/authorize?state=state-1", "state-1", nil).Once()
+
+	rr := httptest.NewRecorder()
+	h.OIDCLogin(rr, httptest.NewRequest(http.MethodGet, "/api/auth/oidc/login", nil))
+
+	require.Equal(t, http.StatusFound, rr.Code)
+	require.Equal(t, "https://idp.test/authorize?state=state-1", rr.Header().Get("Location"))
+
+	cookies := rr.Result().Cookies()
+	require.Len(t, cookies, 1)
+	require.Equal(t, "oidc_state", cookies[0].Name)
+	require.Equal(t, "state-1", cookies[0].Value)
+	require.True(t, cookies[0].HttpOnly)
+	require.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
+}
+
+func TestAuthHandler_OIDCCallback(t *testing.T) {
+	t.Run("should return 200 OK with tokens", func(t *testing.T) {
+		mockCallbackUC := mockauth.NewMockOIDCCallbackUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{OIDCCallback: mockCallbackUC}, mockLogger)
+
+		mockCallbackUC.EXPECT().Execute(mock.Anything, "state-1", "code-1").Return("access-1", "refresh-1", nil).Once()
+
+		rr := httptest.NewRecorder()
+		h.OIDCCallback(rr, callbackRequest("state=state-1&code=code-1", "state-1"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var res map[string]string
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Equal(t, "access-1", res["access_token"])
+		require.Equal(t, "refresh-1", responseCookie(t, rr).Value)
+	})
+
+	t.Run("should return 400 Bad Request when the state is not the browser's", func(t *testing.T) {
+		mockCallbackUC := mockauth.NewMockOIDCCallbackUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{OIDCCallback: mockCallbackUC}, mockLogger)
+
+		for _, cookie := range []string{"", "state-2"} {
+			rr := httptest.NewRecorder()
+			h.OIDCCallback(rr, callbackRequest("state=state-1&code=code-1", cookie))
+
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+		}
+	})
+
+	t.Run("should return 401 Unauthorized when the user declined", func(t *testing.T) {
+		mockCallbackUC := mockauth.NewMockOIDCCallbackUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{OIDCCallback: mockCallbackUC}, mockLogger)
+
+		mockLogger.EXPECT().Warnf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		rr := httptest.NewRecorder()
+		h.OIDCCallback(rr, callbackRequest("state=state-1&error=access_denied", "state-1"))
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return 401 Unauthorized when the provider rejects the code", func(t *testing.T) {
+		mockCallbackUC := mockauth.NewMockOIDCCallbackUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{OIDCCallback: mockCallbackUC}, mockLogger)
+
+		mockCallbackUC.EXPECT().
+			Execute(mock.Anything, "state-1", "code-1").
+			Return("", "", fmt.Errorf("exchange code: %w", oidc.ErrRejected)).
+			Once()
+		mockLogger.EXPECT().Warnf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		rr := httptest.NewRecorder()
+		h.OIDCCallback(rr, callbackRequest("state=state-1&code=code-1", "state-1"))
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return 409 Conflict when the email belongs to another account", func(t *testing.T) {
+		mockCallbackUC := mockauth.NewMockOIDCCallbackUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{OIDCCallback: mockCallbackUC}, mockLogger)
+
+		mockCallbackUC.EXPECT().Execute(mock.Anything, "state-1", "code-1").Return("", "", authapp.ErrOIDCAccountConflict).Once()
+
+		rr := httptest.NewRecorder()
+		h.OIDCCallback(rr, callbackRequest("state=state-1&code=code-1", "state-1"))
+
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
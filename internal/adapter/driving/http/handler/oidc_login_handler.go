@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+const (
+	// oidcStateCookie binds a sign in at the identity provider to the browser that started it.
+	// It is Lax so the provider's redirect back carries it.
+	oidcStateCookie     = "oidc_state"
+	oidcStateCookiePath = "/api/auth/oidc"
+)
+
+// OIDCLogin sends the browser to the sign in page of the identity provider
+func (ah *AuthHandler) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+	authURL, state, err := ah.authUC.OIDCLogin.Execute(r.Context())
+	if err != nil {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "oidc login: %v", err)
+		http.Error(w, "unexpected error", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state,
+		HttpOnly: true,
+		Path:     oidcStateCookiePath,
+		MaxAge:   int(auth.OIDCLoginLifetime.Seconds()),
+		Secure:   false, // temp for testing
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// clearOIDCStateCookie removes the state of a completed sign in
+func clearOIDCStateCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    "",
+		HttpOnly: true,
+		Path:     oidcStateCookiePath,
+		MaxAge:   -1,
+		Secure:   false, // temp for testing
+		SameSite: http.SameSiteLaxMode,
+	})
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// ForgotPassword mails a reset link to the email. It is accepted whether the email has an account or not,
+// and whether the link could be issued or not, so the answer never tells which emails have an account.
+func (ah *AuthHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req ForgotPasswordRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "parse forgot password request body: %v", err)
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := ah.authUC.RequestPasswordReset.Execute(r.Context(), req.Email); err != nil {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "request password reset: %v", err)
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ResetPassword sets the new password with the mailed token. Every session of the user is revoked.
+func (ah *AuthHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req ResetPasswordRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "parse reset password request body: %v", err)
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := ah.authUC.ResetPassword.Execute(r.Context(), req.Token, req.Password); err != nil {
+		if errors.Is(err, authapp.ErrInvalidUserToken) || errors.Is(err, authapp.ErrPasswordEmpty) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "reset password: %v", err)
+		http.Error(w, "unexpected error", http.StatusInternalServerError)
+		return
+	}
+
+	// A refresh token of this browser was revoked along
+	clearRefreshCookie(w)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,109 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	mockauth "github.com/st-ember/streaming-api/internal/application/authapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthHandler_ForgotPassword(t *testing.T) {
+	t.Run("should return 202 Accepted", func(t *testing.T) {
+		mockForgotUC := mockauth.NewMockRequestPasswordResetUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{RequestPasswordReset: mockForgotUC}, mockLogger)
+
+		mockForgotUC.EXPECT().
+			Execute(mock.Anything, "jane@example.com").
+			Return(nil).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/password/forgot", strings.NewReader(`{"email":"jane@example.com"}`))
+		rr := httptest.NewRecorder()
+		h.ForgotPassword(rr, req)
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("should return 202 Accepted when the usecase fails", func(t *testing.T) {
+		mockForgotUC := mockauth.NewMockRequestPasswordResetUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{RequestPasswordReset: mockForgotUC}, mockLogger)
+
+		mockForgotUC.EXPECT().
+			Execute(mock.Anything, "jane@example.com").
+			Return(errors.New("db down")).
+			Once()
+		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/password/forgot", strings.NewReader(`{"email":"jane@example.com"}`))
+		rr := httptest.NewRecorder()
+		h.ForgotPassword(rr, req)
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+	})
+}
+
+func TestAuthHandler_ResetPassword(t *testing.T) {
+	t.Run("should return 204 No Content and clear the cookie", func(t *testing.T) {
+		mockResetUC := mockauth.NewMockResetPasswordUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{ResetPassword: mockResetUC}, mockLogger)
+
+		mockResetUC.EXPECT().
+			Execute(mock.Anything, "reset-1", "new-password").
+			Return(nil).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/password/reset", strings.NewReader(`{"token":"reset-1","password":"new-password"}`))
+		rr := httptest.NewRecorder()
+		h.ResetPassword(rr, req)
+
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		require.Negative(t, responseCookie(t, rr).MaxAge)
+	})
+
+	t.Run("should return 400 Bad Request for an invalid token", func(t *testing.T) {
+		mockResetUC := mockauth.NewMockResetPasswordUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{ResetPassword: mockResetUC}, mockLogger)
+
+		mockResetUC.EXPECT().
+			Execute(mock.Anything, "used", "new-password").
+			Return(authapp.ErrInvalidUserToken).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/password/reset", strings.NewReader(`{"token":"used","password":"new-password"}`))
+		rr := httptest.NewRecorder()
+		h.ResetPassword(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 500 Internal Server Error on unexpected errors", func(t *testing.T) {
+		mockResetUC := mockauth.NewMockResetPasswordUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{ResetPassword: mockResetUC}, mockLogger)
+
+		mockResetUC.EXPECT().
+			Execute(mock.Anything, "reset-1", "new-password").
+			Return(errors.New("db error")).
+			Once()
+
+		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/password/reset", strings.NewReader(`{"token":"reset-1","password":"new-password"}`))
+		rr := httptest.NewRecorder()
+		h.ResetPassword(rr, req)
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
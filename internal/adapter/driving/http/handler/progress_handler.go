@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/progressapp"
+)
+
+// ProgressHandler streams progress as Server-Sent Events,
+// for clients that cannot open WebSockets
+type ProgressHandler struct {
+	videoProgressUC progressapp.VideoProgressUsecase
+	logger          log.Logger
+	heartbeat       time.Duration
+}
+
+func NewProgressHandler(
+	videoProgressUC progressapp.VideoProgressUsecase,
+	logger log.Logger,
+	heartbeat time.Duration,
+) *ProgressHandler {
+	return &ProgressHandler{
+		videoProgressUC,
+		logger,
+		heartbeat,
+	}
+}
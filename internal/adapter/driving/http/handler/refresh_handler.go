@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+func (ah *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	cookie, err := r.Cookie(refreshCookie)
+	if err != nil || cookie.Value == "" {
+		http.Error(w, "missing refresh token", http.StatusUnauthorized)
+		return
+	}
+
+	at, rt, err := ah.authUC.Refresh.Execute(r.Context(), cookie.Value)
+	if err != nil {
+		if errors.Is(err, authapp.ErrInvalidRefreshToken) {
+			ah.logger.Warnf(r.Context(), log.CategoryAuth, "", "refresh: %v", err)
+			clearRefreshCookie(w)
+			http.Error(w, "invalid refresh token", http.StatusUnauthorized)
+			return
+		}
+
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "refresh: %v", err)
+		http.Error(w, "unexpected error", http.StatusInternalServerError)
+		return
+	}
+
+	setRefreshCookie(w, rt)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"access_token": at,
+	})
+}
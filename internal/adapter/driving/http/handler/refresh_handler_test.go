@@ -0,0 +1,114 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	mockauth "github.com/st-ember/streaming-api/internal/application/authapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// refreshRequest builds a refresh request carrying the cookie unless empty
+func refreshRequest(refreshToken string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/refresh", nil)
+	if refreshToken != "" {
+		req.AddCookie(&http.Cookie{Name: "refresh_token", Value: refreshToken})
+	}
+	return req
+}
+
+// responseCookie finds the refresh cookie set by the response
+func responseCookie(t *testing.T, rr *httptest.ResponseRecorder) *http.Cookie {
+	t.Helper()
+
+	for _, c := range rr.Result().Cookies() {
+		if c.Name == "refresh_token" {
+			return c
+		}
+	}
+	t.Fatal("refresh cookie not set")
+	return nil
+}
+
+func TestAuthHandler_Refresh(t *testing.T) {
+	t.Run("should return 200 OK with rotated tokens", func(t *testing.T) {
+		mockRefreshUC := mockauth.NewMockRefreshUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{Refresh: mockRefreshUC}, mockLogger)
+
+		mockRefreshUC.EXPECT().
+			Execute(mock.Anything, "refresh-1").
+			Return("access-2", "refresh-2", nil).
+			Once()
+
+		rr := httptest.NewRecorder()
+		h.Refresh(rr, refreshRequest("refresh-1"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		require.Equal(t, "access-2", body["access_token"])
+
+		cookie := responseCookie(t, rr)
+		require.Equal(t, "refresh-2", cookie.Value)
+		require.Equal(t, "/api/auth", cookie.Path)
+		require.True(t, cookie.HttpOnly)
+	})
+
+	t.Run("should return 401 Unauthorized without a cookie", func(t *testing.T) {
+		mockRefreshUC := mockauth.NewMockRefreshUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{Refresh: mockRefreshUC}, mockLogger)
+
+		rr := httptest.NewRecorder()
+		h.Refresh(rr, refreshRequest(""))
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return 401 Unauthorized and clear the cookie for an invalid token", func(t *testing.T) {
+		mockRefreshUC := mockauth.NewMockRefreshUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{Refresh: mockRefreshUC}, mockLogger)
+
+		mockRefreshUC.EXPECT().
+			Execute(mock.Anything, "refresh-1").
+			Return("", "", fmt.Errorf("%w: replayed", authapp.ErrInvalidRefreshToken)).
+			Once()
+
+		mockLogger.EXPECT().Warnf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		rr := httptest.NewRecorder()
+		h.Refresh(rr, refreshRequest("refresh-1"))
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+		require.Negative(t, responseCookie(t, rr).MaxAge)
+	})
+
+	t.Run("should return 500 Internal Server Error if usecase fails", func(t *testing.T) {
+		mockRefreshUC := mockauth.NewMockRefreshUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{Refresh: mockRefreshUC}, mockLogger)
+
+		mockRefreshUC.EXPECT().
+			Execute(mock.Anything, "refresh-1").
+			Return("", "", errors.New("db failure")).
+			Once()
+
+		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		rr := httptest.NewRecorder()
+		h.Refresh(rr, refreshRequest("refresh-1"))
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
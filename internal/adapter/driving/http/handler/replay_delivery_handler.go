@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+// Replay sends a delivery again, whatever its outcome
+func (h *WebhookHandler) Replay(w http.ResponseWriter, r *http.Request) {
+	// Parse id params
+	vars := mux.Vars(r)
+	id := vars["id"]
+	deliveryID := vars["deliveryID"]
+
+	// Execute usecase
+	d, err := h.webhookUC.Replay.Execute(r.Context(), id, deliveryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, webhook.ErrDeliveryOfOtherSubscription) {
+			http.Error(w, "delivery not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, deliveryID, "replay delivery %s: %v", deliveryID, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(newDeliveryResponse(d)); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, deliveryID, "encode delivery %s: %v", deliveryID, err)
+	}
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryWebhook, deliveryID, "replaying delivery %s", deliveryID)
+}
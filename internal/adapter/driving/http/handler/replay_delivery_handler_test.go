@@ -0,0 +1,67 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	mockwebhook "github.com/st-ember/streaming-api/internal/application/webhookapp/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookHandler_Replay(t *testing.T) {
+	subID := "sub-1"
+	deliveryID := "delivery-1"
+	vars := map[string]string{"id": subID, "deliveryID": deliveryID}
+
+	t.Run("should return 202 Accepted with the pending delivery", func(t *testing.T) {
+		mockReplayUC := mockwebhook.NewMockReplayDeliveryUsecase(t)
+		webhookUC := webhookapp.WebhookUsecase{
+			Replay: mockReplayUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewWebhookHandler(webhookUC, mockLogger)
+
+		d := &webhook.Delivery{ID: deliveryID, SubscriptionID: subID, Status: webhook.DeliveryStatusPending, NextAttemptAt: time.Now()}
+		mockReplayUC.EXPECT().Execute(mock.Anything, subID, deliveryID).Return(d, nil).Once()
+		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/webhooks/"+subID+"/deliveries/"+deliveryID+"/replay", nil)
+		req = mux.SetURLVars(req, vars)
+		rr := httptest.NewRecorder()
+
+		h.Replay(rr, req)
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("should return 404 Not Found if the delivery belongs to another subscription", func(t *testing.T) {
+		mockReplayUC := mockwebhook.NewMockReplayDeliveryUsecase(t)
+		webhookUC := webhookapp.WebhookUsecase{
+			Replay: mockReplayUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewWebhookHandler(webhookUC, mockLogger)
+
+		mockReplayUC.EXPECT().
+			Execute(mock.Anything, subID, deliveryID).
+			Return(nil, fmt.Errorf("replay delivery %s: %w", deliveryID, webhook.ErrDeliveryOfOtherSubscription)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/webhooks/"+subID+"/deliveries/"+deliveryID+"/replay", nil)
+		req = mux.SetURLVars(req, vars)
+		rr := httptest.NewRecorder()
+
+		h.Replay(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+)
+
+func (h *JobHandler) Retry(w http.ResponseWriter, r *http.Request) {
+	// Parse id param
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Execute usecase
+	if err := h.jobUC.Retry.Execute(r.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			http.Error(w, "job not found", http.StatusNotFound)
+		case errors.Is(err, job.ErrCannotBeRetried),
+			errors.Is(err, video.ErrCannotBeMarkedAsProcessing):
+			http.Error(w, "job cannot be retried", http.StatusConflict)
+		default:
+			h.logger.Errorf(r.Context(), log.CategoryJob, id, "retry job %s: %v", id, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Send OK response
+	w.WriteHeader(http.StatusOK)
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryJob, id, "queued job %s for retry", id)
+}
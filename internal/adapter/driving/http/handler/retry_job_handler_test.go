@@ -0,0 +1,91 @@
+package handler_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	mockjob "github.com/st-ember/streaming-api/internal/application/jobapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobHandler_Retry(t *testing.T) {
+	jobID := "job-123"
+
+	tests := []struct {
+		name       string
+		ucErr      error
+		expectLog  func(l *mocklog.MockLogger)
+		wantStatus int
+	}{
+		{
+			name:  "should return 200 OK on success",
+			ucErr: nil,
+			expectLog: func(l *mocklog.MockLogger) {
+				l.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "should return 404 Not Found if job does not exist",
+			ucErr:      fmt.Errorf("find job %s: %w", jobID, sql.ErrNoRows),
+			expectLog:  func(l *mocklog.MockLogger) {},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "should return 409 Conflict if job has not failed",
+			ucErr:      fmt.Errorf("retry job %s: %w", jobID, job.ErrCannotBeRetried),
+			expectLog:  func(l *mocklog.MockLogger) {},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "should return 409 Conflict if video cannot be processed",
+			ucErr:      fmt.Errorf("retry job %s: %w", jobID, video.ErrCannotBeMarkedAsProcessing),
+			expectLog:  func(l *mocklog.MockLogger) {},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:  "should return 500 Internal Server Error if usecase fails",
+			ucErr: errors.New("db failure"),
+			expectLog: func(l *mocklog.MockLogger) {
+				l.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRetryUC := mockjob.NewMockRetryJobUsecase(t)
+			jobUC := jobapp.JobUsecase{
+				Retry: mockRetryUC,
+			}
+			mockLogger := mocklog.NewMockLogger(t)
+			h := handler.NewJobHandler(jobUC, mockLogger)
+
+			mockRetryUC.EXPECT().
+				Execute(mock.Anything, jobID).
+				Return(tc.ucErr).
+				Once()
+			tc.expectLog(mockLogger)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/admin/jobs/"+jobID+"/retry", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": jobID})
+			rr := httptest.NewRecorder()
+
+			h.Retry(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// Revoke stops an api key of the authenticated user from authenticating.
+// It must be chained after the Auth middleware.
+func (h *APIKeyHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse id param
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Execute usecase
+	if err := h.apiKeyUC.Revoke.Execute(r.Context(), claims.UserID, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "api key not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryAuth, claims.UserID, "revoke api key %s: %v", id, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Send No Content response
+	w.WriteHeader(http.StatusNoContent)
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryAuth, claims.UserID, "revoked api key %s", id)
+}
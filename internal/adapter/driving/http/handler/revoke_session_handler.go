@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// RevokeSession ends one of the sessions of the authenticated user, such as a lost device.
+// It must be chained after the Auth middleware.
+func (ah *AuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse id param
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Execute usecase
+	if err := ah.authUC.RevokeSession.Execute(r.Context(), claims.UserID, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "session not found", http.StatusNotFound)
+			return
+		}
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, claims.UserID, "revoke session %s: %v", id, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// The refresh cookie of the current session is now useless
+	if id == claims.SessionID {
+		clearRefreshCookie(w)
+	}
+
+	// Send No Content response
+	w.WriteHeader(http.StatusNoContent)
+
+	// Log success
+	ah.logger.Infof(r.Context(), log.CategoryAuth, claims.UserID, "revoked session %s", id)
+}
@@ -0,0 +1,96 @@
+package handler_test
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/memorydenylist"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	apikeyappmocks "github.com/st-ember/streaming-api/internal/application/apikeyapp/mocks"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	mockauth "github.com/st-ember/streaming-api/internal/application/authapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	mocktoken "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// serveAuthenticated runs the handler behind the Auth middleware, as the session of user-123
+func serveAuthenticated(t *testing.T, h http.HandlerFunc, req *http.Request, logger *mocklog.MockLogger) *httptest.ResponseRecorder {
+	t.Helper()
+
+	mockToken := mocktoken.NewMockToken(t)
+	claims := &tokenport.AccessClaims{UserID: "user-123", SessionID: "session-current"}
+	mockToken.EXPECT().ParseAccess("valid-token").Return(claims, nil).Once()
+
+	req.Header.Set("Authorization", "Bearer valid-token")
+	rr := httptest.NewRecorder()
+	middleware.Auth(mockToken, memorydenylist.NewMemoryDenylist(), apikeyappmocks.NewMockAuthenticateAPIKeyUsecase(t), logger)(h).ServeHTTP(rr, req)
+
+	return rr
+}
+
+func TestAuthHandler_Logout(t *testing.T) {
+	t.Run("should return 204 No Content and clear the cookie", func(t *testing.T) {
+		mockRevokeUC := mockauth.NewMockRevokeSessionUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{RevokeSession: mockRevokeUC}, mockLogger)
+
+		mockRevokeUC.EXPECT().
+			Execute(mock.Anything, "user-123", "session-current").
+			Return(nil).
+			Once()
+
+		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/logout", nil)
+		rr := serveAuthenticated(t, h.Logout, req, mockLogger)
+
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		require.Negative(t, responseCookie(t, rr).MaxAge)
+	})
+}
+
+func TestAuthHandler_RevokeSession(t *testing.T) {
+	t.Run("should return 204 No Content and keep the cookie of the current session", func(t *testing.T) {
+		mockRevokeUC := mockauth.NewMockRevokeSessionUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{RevokeSession: mockRevokeUC}, mockLogger)
+
+		mockRevokeUC.EXPECT().
+			Execute(mock.Anything, "user-123", "session-other").
+			Return(nil).
+			Once()
+
+		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/auth/sessions/session-other", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "session-other"})
+		rr := serveAuthenticated(t, h.RevokeSession, req, mockLogger)
+
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		require.Empty(t, rr.Result().Cookies())
+	})
+
+	t.Run("should return 404 Not Found for a session of another user", func(t *testing.T) {
+		mockRevokeUC := mockauth.NewMockRevokeSessionUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{RevokeSession: mockRevokeUC}, mockLogger)
+
+		mockRevokeUC.EXPECT().
+			Execute(mock.Anything, "user-123", "session-foreign").
+			Return(sql.ErrNoRows).
+			Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/auth/sessions/session-foreign", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "session-foreign"})
+		rr := serveAuthenticated(t, h.RevokeSession, req, mockLogger)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/roleapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+type RoleHandler struct {
+	roleUC roleapp.RoleUsecase
+	logger log.Logger
+}
+
+func NewRoleHandler(
+	roleUC roleapp.RoleUsecase,
+	logger log.Logger,
+) *RoleHandler {
+	return &RoleHandler{
+		roleUC,
+		logger,
+	}
+}
+
+// isInvalidRole reports whether the error comes from an invalid role name or permission
+func isInvalidRole(err error) bool {
+	return errors.Is(err, auth.ErrRoleNameInvalid) ||
+		errors.Is(err, auth.ErrUnknownPermission)
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// AddPermission grants a permission to a role, users holding it get it from their next access token
+func (h *RoleHandler) AddPermission(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	h.changePermission(w, r, "add", vars["name"], vars["permission"], h.roleUC.AddPermission.Execute)
+}
+
+// RemovePermission takes a permission from a role, users holding it lose it from their next access token
+func (h *RoleHandler) RemovePermission(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	h.changePermission(w, r, "remove", vars["name"], vars["permission"], h.roleUC.RemovePermission.Execute)
+}
+
+func (h *RoleHandler) changePermission(
+	w http.ResponseWriter,
+	r *http.Request,
+	action, name, permission string,
+	execute func(ctx context.Context, roleName, permission string) (*auth.Role, error),
+) {
+	// Execute usecase
+	role, err := execute(r.Context(), name, permission)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "role not found", http.StatusNotFound)
+			return
+		}
+		if isInvalidRole(err) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "%s permission %s of role %s: %v", action, permission, name, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(newRoleResponse(role)); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, role.ID, "encode role %s: %v", role.Name, err)
+	}
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryAuth, role.ID, "%s permission %s of role %s", action, permission, role.Name)
+}
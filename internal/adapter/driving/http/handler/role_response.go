@@ -0,0 +1,15 @@
+package handler
+
+import "github.com/st-ember/streaming-api/internal/domain/auth"
+
+type RoleResponse struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+func newRoleResponse(r *auth.Role) RoleResponse {
+	return RoleResponse{
+		Name:        r.Name,
+		Permissions: r.Permissions,
+	}
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/videoapp"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+)
+
+// SchedulePublish holds a video of the authenticated user until publish_at, or any video for video admins.
+// It must be chained after the Auth middleware.
+func (h *VideoHandler) SchedulePublish(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Access id param
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Decode request
+	var req SchedulePublishRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryVideo, id, "parse publish-at request body: %v", err)
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	// Assemble input
+	input := videoapp.SchedulePublishInput{
+		ID:          id,
+		UserID:      claims.UserID,
+		Permissions: claims.Permissions,
+		PublishAt:   req.PublishAt,
+	}
+
+	// Execute usecase
+	v, err := h.videoUC.SchedulePublish.Execute(r.Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			http.Error(w, "video not found", http.StatusNotFound)
+		case errors.Is(err, videoapp.ErrVideoForbidden):
+			http.Error(w, "forbidden", http.StatusForbidden)
+		case errors.Is(err, video.ErrPublishAtEmpty):
+			http.Error(w, "publish_at is required", http.StatusBadRequest)
+		case errors.Is(err, video.ErrCannotBeScheduled):
+			http.Error(w, "video is already published or archived", http.StatusConflict)
+		default:
+			h.logger.Errorf(r.Context(), log.CategoryVideo, id, "schedule publish of video %s: %v", id, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryVideo, id, "encode video %s: %v", id, err)
+	}
+
+	// Log Success
+	h.logger.Infof(r.Context(), log.CategoryVideo, id, "scheduled video %s to publish at %s", v.ID, req.PublishAt)
+}
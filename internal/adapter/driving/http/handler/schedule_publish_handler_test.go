@@ -0,0 +1,69 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/videoapp"
+	mockvideo "github.com/st-ember/streaming-api/internal/application/videoapp/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVideoHandler_SchedulePublish(t *testing.T) {
+	videoID := "video-123"
+	publishAt := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	body := `{"publish_at": "2030-01-01T12:00:00Z"}`
+	input := videoapp.SchedulePublishInput{ID: videoID, UserID: "user-123", PublishAt: publishAt}
+
+	t.Run("should return 200 OK with the video on success", func(t *testing.T) {
+		mockScheduleUC := mockvideo.NewMockSchedulePublishUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewVideoHandler(videoapp.VideoUsecase{SchedulePublish: mockScheduleUC}, mockLogger)
+
+		v, err := video.NewVideo(videoID, "user-123", "Title", "Description", "test.mp4", "resource-123")
+		require.NoError(t, err)
+		require.NoError(t, v.SchedulePublish(publishAt))
+
+		mockScheduleUC.EXPECT().Execute(mock.Anything, input).Return(v, nil).Once()
+		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodPut, "/api/video/"+videoID+"/publish-at", strings.NewReader(body))
+		req = mux.SetURLVars(req, map[string]string{"id": videoID})
+		rr := serveAuthenticated(t, h.SchedulePublish, req, mockLogger)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	cases := []struct {
+		err  error
+		code int
+	}{
+		{videoapp.ErrVideoForbidden, http.StatusForbidden},
+		{fmt.Errorf("schedule video: %w", video.ErrCannotBeScheduled), http.StatusConflict},
+		{fmt.Errorf("schedule video: %w", video.ErrPublishAtEmpty), http.StatusBadRequest},
+	}
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("should return %d on %v", c.code, c.err), func(t *testing.T) {
+			mockScheduleUC := mockvideo.NewMockSchedulePublishUsecase(t)
+			mockLogger := mocklog.NewMockLogger(t)
+			h := handler.NewVideoHandler(videoapp.VideoUsecase{SchedulePublish: mockScheduleUC}, mockLogger)
+
+			mockScheduleUC.EXPECT().Execute(mock.Anything, input).Return(nil, c.err).Once()
+
+			req := httptest.NewRequest(http.MethodPut, "/api/video/"+videoID+"/publish-at", strings.NewReader(body))
+			req = mux.SetURLVars(req, map[string]string{"id": videoID})
+			rr := serveAuthenticated(t, h.SchedulePublish, req, mockLogger)
+
+			require.Equal(t, c.code, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package handler
+
+import "time"
+
+type SchedulePublishRequest struct {
+	PublishAt time.Time `json:"publish_at"`
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+// Schedule queues a task for the task scheduler, once at run_at or on every match of cron
+func (h *TaskHandler) Schedule(w http.ResponseWriter, r *http.Request) {
+	// Decode request
+	var req ScheduleTaskRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	// Assemble input
+	input := scheduleapp.ScheduleTaskInput{
+		Kind:    schedule.TaskKind(req.Kind),
+		Payload: req.Payload,
+		RunAt:   req.RunAt,
+		Cron:    req.Cron,
+	}
+
+	// Execute usecase
+	t, err := h.taskUC.Schedule.Execute(r.Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, schedule.ErrTaskKindEmpty),
+			errors.Is(err, schedule.ErrTaskKindInvalid),
+			errors.Is(err, schedule.ErrRunAtEmpty),
+			errors.Is(err, schedule.ErrCronInvalid),
+			errors.Is(err, schedule.ErrCronNeverFires):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			h.logger.Errorf(r.Context(), log.CategoryTask, "", "schedule %s task: %v", req.Kind, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(newTaskResponse(t)); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryTask, t.ID, "encode task %s: %v", t.ID, err)
+	}
+
+	// Log Success
+	h.logger.Infof(r.Context(), log.CategoryTask, t.ID, "scheduled %s task %s at %s", t.Kind, t.ID, t.RunAt)
+}
@@ -0,0 +1,67 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	mockschedule "github.com/st-ember/streaming-api/internal/application/scheduleapp/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskHandler_Schedule(t *testing.T) {
+	runAt := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	body := `{"kind": "video.publish", "payload": "video-123", "run_at": "2030-01-01T12:00:00Z"}`
+	input := scheduleapp.ScheduleTaskInput{Kind: schedule.KindPublishVideo, Payload: "video-123", RunAt: runAt}
+
+	t.Run("should return 201 Created with the task", func(t *testing.T) {
+		mockScheduleUC := mockschedule.NewMockScheduleTaskUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewTaskHandler(scheduleapp.TaskUsecase{Schedule: mockScheduleUC}, mockLogger)
+
+		task, err := schedule.NewTask("task-123", schedule.KindPublishVideo, "video-123", runAt)
+		require.NoError(t, err)
+
+		mockScheduleUC.EXPECT().Execute(mock.Anything, input).Return(task, nil).Once()
+		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/tasks", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		h.Schedule(rr, req)
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		var res handler.TaskResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Equal(t, "task-123", res.ID)
+		require.Equal(t, "scheduled", res.Status)
+	})
+
+	t.Run("should return 400 Bad Request for a kind without handler", func(t *testing.T) {
+		mockScheduleUC := mockschedule.NewMockScheduleTaskUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewTaskHandler(scheduleapp.TaskUsecase{Schedule: mockScheduleUC}, mockLogger)
+
+		mockScheduleUC.EXPECT().
+			Execute(mock.Anything, input).
+			Return(nil, fmt.Errorf("create new task: %w", schedule.ErrTaskKindInvalid)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/tasks", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		h.Schedule(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
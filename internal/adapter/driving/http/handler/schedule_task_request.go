@@ -0,0 +1,10 @@
+package handler
+
+import "time"
+
+type ScheduleTaskRequest struct {
+	Kind    string    `json:"kind"`
+	Payload string    `json:"payload"`
+	RunAt   time.Time `json:"run_at"`
+	Cron    string    `json:"cron,omitempty"`
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// SessionResponse describes a login of the user, Current marks the one making the request
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	Current    bool      `json:"current"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+func newSessionResponse(f *auth.RefreshFamily, currentSessionID string) SessionResponse {
+	return SessionResponse{
+		ID:         f.ID,
+		Current:    f.ID == currentSessionID,
+		CreatedAt:  f.CreatedAt,
+		LastUsedAt: f.UpdatedAt,
+	}
+}
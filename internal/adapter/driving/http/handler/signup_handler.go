@@ -2,8 +2,10 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
+	"github.com/st-ember/streaming-api/internal/application/authapp"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
 )
 
@@ -16,21 +18,18 @@ func (ah *AuthHandler) Signup(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	at, rt, err := ah.signupUC.Execute(r.Context(), req.Username, req.Email, req.Password, req.RoleName)
+	at, rt, err := ah.authUC.Signup.Execute(r.Context(), req.Username, req.Email, req.Password, req.RoleName)
 	if err != nil {
+		if errors.Is(err, authapp.ErrSignupRoleNotAllowed) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "signup: %v", err)
 		http.Error(w, "unexpected error", http.StatusInternalServerError)
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    rt,
-		HttpOnly: true,
-		Path:     "/refresh",
-		Secure:   false, // temp for testing
-		SameSite: http.SameSiteStrictMode,
-	})
+	setRefreshCookie(w, rt)
 
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]string{
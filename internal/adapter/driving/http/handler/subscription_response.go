@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+// SubscriptionResponse leaves the secret out, it is only returned on creation
+type SubscriptionResponse struct {
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func newSubscriptionResponse(s *webhook.Subscription) SubscriptionResponse {
+	eventTypes := make([]string, len(s.EventTypes))
+	for i, t := range s.EventTypes {
+		eventTypes[i] = string(t)
+	}
+
+	return SubscriptionResponse{
+		ID:         s.ID,
+		URL:        s.URL,
+		EventTypes: eventTypes,
+		Active:     s.Active,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+)
+
+type TaskHandler struct {
+	taskUC scheduleapp.TaskUsecase
+	logger log.Logger
+}
+
+func NewTaskHandler(
+	taskUC scheduleapp.TaskUsecase,
+	logger log.Logger,
+) *TaskHandler {
+	return &TaskHandler{
+		taskUC,
+		logger,
+	}
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+type TaskResponse struct {
+	ID        string     `json:"id"`
+	Kind      string     `json:"kind"`
+	Payload   string     `json:"payload,omitempty"`
+	Cron      string     `json:"cron,omitempty"`
+	RunAt     time.Time  `json:"run_at"`
+	Status    string     `json:"status"`
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	LastError string     `json:"last_error,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+func newTaskResponse(t *schedule.Task) TaskResponse {
+	return TaskResponse{
+		ID:        t.ID,
+		Kind:      string(t.Kind),
+		Payload:   t.Payload,
+		Cron:      t.Cron,
+		RunAt:     t.RunAt,
+		Status:    string(t.Status),
+		LastRunAt: t.LastRunAt,
+		LastError: t.LastError,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+func (h *JobHandler) UpdatePriority(w http.ResponseWriter, r *http.Request) {
+	// Access id param
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Decode request
+	var req UpdateJobPriorityRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Priority == nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	// Execute usecase
+	j, err := h.jobUC.UpdatePriority.Execute(r.Context(), id, *req.Priority)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			http.Error(w, "job not found", http.StatusNotFound)
+		case errors.Is(err, job.ErrPriorityCannotBeChanged):
+			http.Error(w, "job priority can only be changed while pending", http.StatusConflict)
+		default:
+			h.logger.Errorf(r.Context(), log.CategoryJob, id, "update job %s priority: %v", id, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(newJobResponse(j)); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryJob, id, "encode job %s: %v", id, err)
+	}
+
+	// Log Success
+	h.logger.Infof(r.Context(), log.CategoryJob, id, "set job %s priority to %d", id, j.Priority)
+}
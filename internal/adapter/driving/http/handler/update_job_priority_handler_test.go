@@ -0,0 +1,85 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	mockjob "github.com/st-ember/streaming-api/internal/application/jobapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobHandler_UpdatePriority(t *testing.T) {
+	jobID := "job-123"
+
+	t.Run("should return 200 OK with the updated job", func(t *testing.T) {
+		mockPriorityUC := mockjob.NewMockUpdateJobPriorityUsecase(t)
+		jobUC := jobapp.JobUsecase{
+			UpdatePriority: mockPriorityUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewJobHandler(jobUC, mockLogger)
+
+		mockPriorityUC.EXPECT().
+			Execute(mock.Anything, jobID, 10).
+			Return(&job.Job{ID: jobID, Status: job.StatusPending, Priority: 10}, nil).
+			Once()
+		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/admin/jobs/"+jobID+"/priority", strings.NewReader(`{"priority": 10}`))
+		req = mux.SetURLVars(req, map[string]string{"id": jobID})
+		rr := httptest.NewRecorder()
+
+		h.UpdatePriority(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var res handler.JobResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Equal(t, 10, res.Priority)
+	})
+
+	t.Run("should return 400 Bad Request if priority is missing", func(t *testing.T) {
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewJobHandler(jobapp.JobUsecase{}, mockLogger)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/admin/jobs/"+jobID+"/priority", strings.NewReader(`{}`))
+		req = mux.SetURLVars(req, map[string]string{"id": jobID})
+		rr := httptest.NewRecorder()
+
+		h.UpdatePriority(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 409 Conflict if job is not pending", func(t *testing.T) {
+		mockPriorityUC := mockjob.NewMockUpdateJobPriorityUsecase(t)
+		jobUC := jobapp.JobUsecase{
+			UpdatePriority: mockPriorityUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewJobHandler(jobUC, mockLogger)
+
+		mockPriorityUC.EXPECT().
+			Execute(mock.Anything, jobID, 10).
+			Return(nil, fmt.Errorf("set job %s priority: %w", jobID, job.ErrPriorityCannotBeChanged)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/admin/jobs/"+jobID+"/priority", strings.NewReader(`{"priority": 10}`))
+		req = mux.SetURLVars(req, map[string]string{"id": jobID})
+		rr := httptest.NewRecorder()
+
+		h.UpdatePriority(rr, req)
+
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
@@ -0,0 +1,5 @@
+package handler
+
+type UpdateJobPriorityRequest struct {
+	Priority *int `json:"priority"`
+}
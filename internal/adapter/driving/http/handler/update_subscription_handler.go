@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+)
+
+func (h *WebhookHandler) Update(w http.ResponseWriter, r *http.Request) {
+	// Access id param
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Decode request
+	var req UpdateSubscriptionRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	// Assemble input
+	input := webhookapp.UpdateSubscriptionInput{
+		ID:     id,
+		URL:    req.URL,
+		Active: req.Active,
+	}
+	if req.EventTypes != nil {
+		eventTypes := toEventTypes(*req.EventTypes)
+		input.EventTypes = &eventTypes
+	}
+
+	// Execute usecase
+	s, err := h.webhookUC.Update.Execute(r.Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			http.Error(w, "subscription not found", http.StatusNotFound)
+		case isInvalidSubscription(err):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			h.logger.Errorf(r.Context(), log.CategoryWebhook, id, "update subscription %s: %v", id, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	// Send response
+	if err := json.NewEncoder(w).Encode(newSubscriptionResponse(s)); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryWebhook, id, "encode subscription %s: %v", id, err)
+	}
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryWebhook, id, "updated subscription %s", id)
+}
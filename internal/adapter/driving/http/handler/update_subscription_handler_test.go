@@ -0,0 +1,100 @@
+package handler_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	mockwebhook "github.com/st-ember/streaming-api/internal/application/webhookapp/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookHandler_Update(t *testing.T) {
+	subID := "sub-1"
+
+	t.Run("should return 200 OK with the updated subscription", func(t *testing.T) {
+		mockUpdateUC := mockwebhook.NewMockUpdateSubscriptionUsecase(t)
+		webhookUC := webhookapp.WebhookUsecase{
+			Update: mockUpdateUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewWebhookHandler(webhookUC, mockLogger)
+
+		s, _ := webhook.NewSubscription(subID, "https://example.com/hook", "secret", nil)
+		s.SetActive(false)
+		mockUpdateUC.EXPECT().
+			Execute(mock.Anything, mock.MatchedBy(func(input webhookapp.UpdateSubscriptionInput) bool {
+				return input.ID == subID && input.Active != nil && !*input.Active && input.URL == nil
+			})).
+			Return(s, nil).
+			Once()
+		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/webhooks/"+subID, strings.NewReader(`{"active": false}`))
+		req = mux.SetURLVars(req, map[string]string{"id": subID})
+		rr := httptest.NewRecorder()
+
+		h.Update(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var res handler.SubscriptionResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.False(t, res.Active)
+		require.NotContains(t, rr.Body.String(), "secret")
+	})
+
+	t.Run("should return 404 Not Found if the subscription does not exist", func(t *testing.T) {
+		mockUpdateUC := mockwebhook.NewMockUpdateSubscriptionUsecase(t)
+		webhookUC := webhookapp.WebhookUsecase{
+			Update: mockUpdateUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewWebhookHandler(webhookUC, mockLogger)
+
+		mockUpdateUC.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("find subscription %s: %w", subID, sql.ErrNoRows)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/webhooks/"+subID, strings.NewReader(`{"active": true}`))
+		req = mux.SetURLVars(req, map[string]string{"id": subID})
+		rr := httptest.NewRecorder()
+
+		h.Update(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("should return 400 Bad Request if an event type is invalid", func(t *testing.T) {
+		mockUpdateUC := mockwebhook.NewMockUpdateSubscriptionUsecase(t)
+		webhookUC := webhookapp.WebhookUsecase{
+			Update: mockUpdateUC,
+		}
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewWebhookHandler(webhookUC, mockLogger)
+
+		mockUpdateUC.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("update subscription entity: %w", webhook.ErrEventTypeInvalid)).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/webhooks/"+subID, strings.NewReader(`{"event_types": ["video.unknown"]}`))
+		req = mux.SetURLVars(req, map[string]string{"id": subID})
+		rr := httptest.NewRecorder()
+
+		h.Update(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
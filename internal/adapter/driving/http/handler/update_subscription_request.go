@@ -0,0 +1,7 @@
+package handler
+
+type UpdateSubscriptionRequest struct {
+	URL        *string   `json:"url"`
+	EventTypes *[]string `json:"event_types"`
+	Active     *bool     `json:"active"`
+}
@@ -1,15 +1,27 @@
 package handler
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
 	"github.com/st-ember/streaming-api/internal/application/videoapp"
 )
 
+// Update edits a video of the authenticated user, or any video for video admins.
+// It must be chained after the Auth middleware.
 func (h *VideoHandler) Update(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
 	// Access id param
 	vars := mux.Vars(r)
 	id := vars["id"]
@@ -26,6 +38,8 @@ func (h *VideoHandler) Update(w http.ResponseWriter, r *http.Request) {
 	// Assemble input
 	input := videoapp.UpdateVideoInput{
 		ID:          id,
+		UserID:      claims.UserID,
+		Permissions: claims.Permissions,
 		Title:       req.Title,
 		Description: req.Description,
 	}
@@ -33,8 +47,15 @@ func (h *VideoHandler) Update(w http.ResponseWriter, r *http.Request) {
 	// Execute usecase
 	v, err := h.videoUC.Update.Execute(r.Context(), input)
 	if err != nil {
-		h.logger.Errorf(r.Context(), log.CategoryVideo, id, "update video %s: %v", id, err)
-		http.Error(w, "internal error", http.StatusInternalServerError)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			http.Error(w, "video not found", http.StatusNotFound)
+		case errors.Is(err, videoapp.ErrVideoForbidden):
+			http.Error(w, "forbidden", http.StatusForbidden)
+		default:
+			h.logger.Errorf(r.Context(), log.CategoryVideo, id, "update video %s: %v", id, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
 		return
 	}
 
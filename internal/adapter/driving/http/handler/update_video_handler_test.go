@@ -33,12 +33,13 @@ func TestVideoHandler_Update(t *testing.T) {
 		resourceID := "resource-123"
 
 		newTitle := "new_title"
-		updatedVideo, err := video.NewVideo(videoID, newTitle, "Description", "test.mp4", resourceID)
+		updatedVideo, err := video.NewVideo(videoID, "owner-id", newTitle, "Description", "test.mp4", resourceID)
 		require.NoError(t, err)
 
 		updateInput := videoapp.UpdateVideoInput{
-			ID:    videoID,
-			Title: &newTitle,
+			ID:     videoID,
+			UserID: "user-123",
+			Title:  &newTitle,
 		}
 
 		mockUpdateUC.EXPECT().
@@ -58,10 +59,7 @@ func TestVideoHandler_Update(t *testing.T) {
 
 		// Manually set gorilla/mux vars
 		req = mux.SetURLVars(req, map[string]string{"id": videoID})
-
-		rr := httptest.NewRecorder()
-
-		h.Update(rr, req)
+		rr := serveAuthenticated(t, h.Update, req, mockLogger)
 
 		require.Equal(t, http.StatusOK, rr.Code)
 		require.Equal(t, "application/json", rr.Header().Get("Content-Type"))
@@ -85,7 +83,8 @@ func TestVideoHandler_Update(t *testing.T) {
 
 		videoID := "video-123"
 		updateInput := videoapp.UpdateVideoInput{
-			ID: videoID,
+			ID:     videoID,
+			UserID: "user-123",
 		}
 		mockUpdateUC.EXPECT().
 			Execute(mock.Anything, updateInput).
@@ -101,10 +100,34 @@ func TestVideoHandler_Update(t *testing.T) {
 
 		req := httptest.NewRequest(http.MethodPatch, "/api/video/"+videoID, bytes.NewReader(body))
 		req = mux.SetURLVars(req, map[string]string{"id": videoID})
-		rr := httptest.NewRecorder()
-
-		h.Update(rr, req)
+		rr := serveAuthenticated(t, h.Update, req, mockLogger)
 
 		require.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
+
+	t.Run("should return 403 Forbidden for a video of another user", func(t *testing.T) {
+		mockUpdateUC := mockvideo.NewMockUpdateVideoUsecase(t)
+		videoUC := videoapp.VideoUsecase{
+			Update: mockUpdateUC,
+		}
+
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewVideoHandler(videoUC, mockLogger)
+
+		videoID := "video-123"
+		updateInput := videoapp.UpdateVideoInput{
+			ID:     videoID,
+			UserID: "user-123",
+		}
+		mockUpdateUC.EXPECT().
+			Execute(mock.Anything, updateInput).
+			Return(nil, videoapp.ErrVideoForbidden).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/video/"+videoID, bytes.NewReader([]byte("{}")))
+		req = mux.SetURLVars(req, map[string]string{"id": videoID})
+		rr := serveAuthenticated(t, h.Update, req, mockLogger)
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
 }
@@ -4,11 +4,21 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
 	"github.com/st-ember/streaming-api/internal/application/videoapp"
 )
 
+// Upload stores a video owned by the authenticated user.
+// It must be chained after the Auth middleware.
 func (h *VideoHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
 	// Limit request at 1 GB
 	r.Body = http.MaxBytesReader(w, r.Body, 1024*1024*1024)
 
@@ -32,6 +42,7 @@ func (h *VideoHandler) Upload(w http.ResponseWriter, r *http.Request) {
 
 	// Assemble usecase input
 	input := videoapp.UploadVideoInput{
+		OwnerID:      claims.UserID,
 		Title:        title,
 		Description:  description,
 		FileName:     header.Filename,
@@ -47,9 +58,15 @@ func (h *VideoHandler) Upload(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Assemble response
+	jobIDs := make([]string, 0, len(result.Jobs))
+	for _, j := range result.Jobs {
+		jobIDs = append(jobIDs, j.ID)
+	}
+
 	response := UploadVideoResponse{
 		VideoID:    result.Video.ID,
-		JobID:      result.Job.ID,
+		JobID:      jobIDs[0],
+		JobIDs:     jobIDs,
 		Status:     string(result.Video.Status),
 		ResourceID: result.Video.ResourceID,
 	}
@@ -35,28 +35,28 @@ func TestVideoHandler_Upload(t *testing.T) {
 		_, _ = part.Write([]byte("fake-video-content"))
 		_ = writer.Close()
 
-		v, _ := video.NewVideo("vid-1", "Test Video", "Desc", "test.mp4", "res-1")
+		v, _ := video.NewVideo("vid-1", "owner-id", "Test Video", "Desc", "test.mp4", "res-1")
 		j, _ := job.NewJob("job-1", "vid-1", job.TypeTranscode)
 
 		mockUploadUC.EXPECT().
 			Execute(mock.Anything, mock.MatchedBy(func(in videoapp.UploadVideoInput) bool {
-				return in.Title == "Test Video" && in.FileName == "test.mp4"
+				return in.OwnerID == "user-123" && in.Title == "Test Video" && in.FileName == "test.mp4"
 			})).
-			Return(&videoapp.UploadVideoResult{Video: v, Job: j}, nil).
+			Return(&videoapp.UploadVideoResult{Video: v, Jobs: []*job.Job{j}}, nil).
 			Once()
 		mockLogger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 
 		req := httptest.NewRequest(http.MethodPost, "/api/video/", body)
 		req.Header.Set("Content-Type", writer.FormDataContentType())
 
-		w := httptest.NewRecorder()
-		h.Upload(w, req)
+		w := serveAuthenticated(t, h.Upload, req, mockLogger)
 
 		require.Equal(t, http.StatusCreated, w.Code)
 		var resp handler.UploadVideoResponse
 		_ = json.NewDecoder(w.Body).Decode(&resp)
 		require.Equal(t, "vid-1", resp.VideoID)
 		require.Equal(t, "job-1", resp.JobID)
+		require.Equal(t, []string{"job-1"}, resp.JobIDs)
 	})
 
 	t.Run("should return 400 Bad Request if multipart form is invalid", func(t *testing.T) {
@@ -73,8 +73,7 @@ func TestVideoHandler_Upload(t *testing.T) {
 		// We set the header but the body is garbage
 		req.Header.Set("Content-Type", "multipart/form-data; boundary=invalid")
 
-		w := httptest.NewRecorder()
-		h.Upload(w, req)
+		w := serveAuthenticated(t, h.Upload, req, mockLogger)
 
 		require.Equal(t, http.StatusBadRequest, w.Code)
 	})
@@ -94,8 +93,7 @@ func TestVideoHandler_Upload(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/api/video/", body)
 		req.Header.Set("Content-Type", writer.FormDataContentType())
 
-		w := httptest.NewRecorder()
-		h.Upload(w, req)
+		w := serveAuthenticated(t, h.Upload, req, mockLogger)
 
 		require.Equal(t, http.StatusBadRequest, w.Code)
 	})
@@ -123,8 +121,7 @@ func TestVideoHandler_Upload(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/api/video/", body)
 		req.Header.Set("Content-Type", writer.FormDataContentType())
 
-		w := httptest.NewRecorder()
-		h.Upload(w, req)
+		w := serveAuthenticated(t, h.Upload, req, mockLogger)
 
 		require.Equal(t, http.StatusInternalServerError, w.Code)
 	})
@@ -1,8 +1,9 @@
 package handler
 
 type UploadVideoResponse struct {
-	VideoID    string `json:"video_id"`
-	JobID      string `json:"job_id"`
-	Status     string `json:"status"`
-	ResourceID string `json:"resource_id"`
+	VideoID    string   `json:"video_id"`
+	JobID      string   `json:"job_id"`
+	JobIDs     []string `json:"job_ids"`
+	Status     string   `json:"status"`
+	ResourceID string   `json:"resource_id"`
 }
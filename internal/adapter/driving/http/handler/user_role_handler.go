@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// Assign gives a role to a user, who gets its permissions from their next access token
+func (h *RoleHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	// Parse params
+	vars := mux.Vars(r)
+	userID, name := vars["id"], vars["name"]
+
+	// Execute usecase
+	if err := h.roleUC.Assign.Execute(r.Context(), userID, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "user or role not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryAuth, userID, "assign role %s to user %s: %v", name, userID, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Send No Content response
+	w.WriteHeader(http.StatusNoContent)
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryAuth, userID, "assigned role %s to user %s", name, userID)
+}
+
+// Unassign takes a role from a user, who loses its permissions from their next access token
+func (h *RoleHandler) Unassign(w http.ResponseWriter, r *http.Request) {
+	// Parse params
+	vars := mux.Vars(r)
+	userID, name := vars["id"], vars["name"]
+
+	// Execute usecase
+	if err := h.roleUC.Unassign.Execute(r.Context(), userID, name); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, userID, "unassign role %s from user %s: %v", name, userID, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Send No Content response
+	w.WriteHeader(http.StatusNoContent)
+
+	// Log success
+	h.logger.Infof(r.Context(), log.CategoryAuth, userID, "unassigned role %s from user %s", name, userID)
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// RequestEmailVerification mails the authenticated user a new verification link.
+// It must be chained after the Auth middleware.
+func (ah *AuthHandler) RequestEmailVerification(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := ah.authUC.RequestEmailVerification.Execute(r.Context(), claims.UserID); err != nil {
+		if errors.Is(err, authapp.ErrEmailAlreadyVerified) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, claims.UserID, "request email verification: %v", err)
+		http.Error(w, "unexpected error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// VerifyEmail verifies the email of the user the token was mailed to
+func (ah *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req VerifyEmailRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "parse verify email request body: %v", err)
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := ah.authUC.VerifyEmail.Execute(r.Context(), req.Token); err != nil {
+		if errors.Is(err, authapp.ErrInvalidUserToken) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ah.logger.Errorf(r.Context(), log.CategoryAuth, "", "verify email: %v", err)
+		http.Error(w, "unexpected error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
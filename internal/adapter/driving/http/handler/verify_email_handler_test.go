@@ -0,0 +1,85 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	mockauth "github.com/st-ember/streaming-api/internal/application/authapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthHandler_RequestEmailVerification(t *testing.T) {
+	t.Run("should return 202 Accepted", func(t *testing.T) {
+		mockRequestUC := mockauth.NewMockRequestEmailVerificationUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{RequestEmailVerification: mockRequestUC}, mockLogger)
+
+		mockRequestUC.EXPECT().
+			Execute(mock.Anything, "user-123").
+			Return(nil).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/verify-email/request", nil)
+		rr := serveAuthenticated(t, h.RequestEmailVerification, req, mockLogger)
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("should return 409 Conflict when already verified", func(t *testing.T) {
+		mockRequestUC := mockauth.NewMockRequestEmailVerificationUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{RequestEmailVerification: mockRequestUC}, mockLogger)
+
+		mockRequestUC.EXPECT().
+			Execute(mock.Anything, "user-123").
+			Return(authapp.ErrEmailAlreadyVerified).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/verify-email/request", nil)
+		rr := serveAuthenticated(t, h.RequestEmailVerification, req, mockLogger)
+
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestAuthHandler_VerifyEmail(t *testing.T) {
+	t.Run("should return 204 No Content", func(t *testing.T) {
+		mockVerifyUC := mockauth.NewMockVerifyEmailUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{VerifyEmail: mockVerifyUC}, mockLogger)
+
+		mockVerifyUC.EXPECT().
+			Execute(mock.Anything, "verify-1").
+			Return(nil).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/verify-email", strings.NewReader(`{"token":"verify-1"}`))
+		rr := httptest.NewRecorder()
+		h.VerifyEmail(rr, req)
+
+		require.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("should return 400 Bad Request for an invalid token", func(t *testing.T) {
+		mockVerifyUC := mockauth.NewMockVerifyEmailUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewAuthHandler(authapp.AuthUsecase{VerifyEmail: mockVerifyUC}, mockLogger)
+
+		mockVerifyUC.EXPECT().
+			Execute(mock.Anything, "expired").
+			Return(authapp.ErrInvalidUserToken).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/verify-email", strings.NewReader(`{"token":"expired"}`))
+		rr := httptest.NewRecorder()
+		h.VerifyEmail(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
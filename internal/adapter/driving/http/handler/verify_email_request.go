@@ -0,0 +1,5 @@
+package handler
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/progressapp"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+)
+
+// VideoProgressEvents streams the progress of a video as Server-Sent Events.
+// Updates are "progress" events, the stream ends with an "end", "error" or "cancelled" event
+// that clients should close on. A client reconnecting with Last-Event-ID skips the updates it received.
+// It must be chained after the Auth middleware.
+func (h *ProgressHandler) VideoProgressEvents(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse id param and Last-Event-ID header
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	var lastEventID int64
+	if v := r.Header.Get("Last-Event-ID"); v != "" {
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid Last-Event-ID header", http.StatusBadRequest)
+			return
+		}
+		lastEventID = parsed
+	}
+
+	// Execute usecase
+	prgCh, err := h.videoProgressUC.Execute(r.Context(), progressapp.VideoProgressInput{
+		ID:          id,
+		UserID:      claims.UserID,
+		Permissions: claims.Permissions,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "video not found", http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, progressapp.ErrProgressForbidden) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		h.logger.Errorf(r.Context(), log.CategoryVideo, id, "execute video progress usecase: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// The stream outlives the write timeout of the server
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		h.logger.Errorf(r.Context(), log.CategoryVideo, id, "clear write deadline: %v", err)
+		return
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable proxy buffering
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryVideo, id, "flush event stream: %v", err)
+		return
+	}
+
+	// Comments keep idle connections open through proxies
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		case prg, ok := <-prgCh:
+			if !ok { // channel closed
+				return
+			}
+
+			// Skip updates received before reconnecting, a final state is always sent
+			if !prg.IsFinished() && prg.Sequence > 0 && prg.Sequence <= lastEventID {
+				continue
+			}
+
+			if err := writeProgressEvent(w, prg); err != nil {
+				h.logger.Errorf(r.Context(), log.CategoryVideo, id, "write progress event: %v", err)
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+
+			if prg.IsFinished() {
+				return
+			}
+		}
+	}
+}
+
+// writeProgressEvent writes a progress as an event named after its status
+func writeProgressEvent(w http.ResponseWriter, prg *progress.Progress) error {
+	data, err := json.Marshal(prg)
+	if err != nil {
+		return fmt.Errorf("marshal progress: %w", err)
+	}
+
+	name := "progress"
+	if prg.IsFinished() {
+		name = string(prg.Status)
+	}
+
+	if prg.Sequence > 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", prg.Sequence); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data)
+
+	return err
+}
@@ -0,0 +1,167 @@
+package handler_test
+
+import (
+	"bufio"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/memorydenylist"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	apikeyappmocks "github.com/st-ember/streaming-api/internal/application/apikeyapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	mocktoken "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
+	"github.com/st-ember/streaming-api/internal/application/progressapp"
+	mockprogress "github.com/st-ember/streaming-api/internal/application/progressapp/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressHandler_VideoProgressEvents(t *testing.T) {
+	videoID := "video-1"
+	input := progressapp.VideoProgressInput{ID: videoID, UserID: "user-123"}
+
+	newProgress := func(seq int64, frames int64, status progress.ProgressStatus) *progress.Progress {
+		prg, _ := progress.NewProgress(100)
+		_ = prg.UpdateCurrentFrames(frames)
+		prg.Status = status
+		prg.Sequence = seq
+		return prg
+	}
+
+	t.Run("should stream updates then the final event", func(t *testing.T) {
+		mockUC := mockprogress.NewMockVideoProgressUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewProgressHandler(mockUC, mockLogger, time.Minute)
+
+		prgCh := make(chan *progress.Progress, 2)
+		prgCh <- newProgress(1, 50, progress.StatusContinue)
+		prgCh <- newProgress(2, 100, progress.StatusEnd)
+		close(prgCh)
+		mockUC.EXPECT().Execute(mock.Anything, input).Return(prgCh, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/progress/video/"+videoID+"/events", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": videoID})
+		rr := serveAuthenticated(t, h.VideoProgressEvents, req, mockLogger)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+
+		body := rr.Body.String()
+		require.Contains(t, body, "id: 1\nevent: progress\ndata: {")
+		require.Contains(t, body, `"Percentage":50`)
+		require.Contains(t, body, "id: 2\nevent: end\ndata: {")
+	})
+
+	t.Run("should skip updates received before Last-Event-ID", func(t *testing.T) {
+		mockUC := mockprogress.NewMockVideoProgressUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewProgressHandler(mockUC, mockLogger, time.Minute)
+
+		prgCh := make(chan *progress.Progress, 3)
+		prgCh <- newProgress(4, 40, progress.StatusContinue)
+		prgCh <- newProgress(5, 50, progress.StatusContinue)
+		prgCh <- newProgress(6, 60, progress.StatusError)
+		close(prgCh)
+		mockUC.EXPECT().Execute(mock.Anything, input).Return(prgCh, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/progress/video/"+videoID+"/events", nil)
+		req.Header.Set("Last-Event-ID", "4")
+		req = mux.SetURLVars(req, map[string]string{"id": videoID})
+		rr := serveAuthenticated(t, h.VideoProgressEvents, req, mockLogger)
+
+		body := rr.Body.String()
+		require.NotContains(t, body, "id: 4\n")
+		require.Contains(t, body, "id: 5\nevent: progress\n")
+		require.Contains(t, body, "id: 6\nevent: error\n")
+	})
+
+	t.Run("should send heartbeat comments while idle", func(t *testing.T) {
+		mockUC := mockprogress.NewMockVideoProgressUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewProgressHandler(mockUC, mockLogger, 10*time.Millisecond)
+
+		prgCh := make(chan *progress.Progress)
+		mockUC.EXPECT().Execute(mock.Anything, input).Return(prgCh, nil).Once()
+
+		mockToken := mocktoken.NewMockToken(t)
+		claims := &tokenport.AccessClaims{UserID: "user-123", SessionID: "session-current"}
+		mockToken.EXPECT().ParseAccess("valid-token").Return(claims, nil).Once()
+
+		r := mux.NewRouter()
+		r.Use(middleware.Auth(mockToken, memorydenylist.NewMemoryDenylist(), apikeyappmocks.NewMockAuthenticateAPIKeyUsecase(t), mockLogger))
+		r.HandleFunc("/progress/video/{id}/events", h.VideoProgressEvents)
+		server := httptest.NewServer(r)
+		defer server.Close()
+
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/progress/video/"+videoID+"/events", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer valid-token")
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		lines := make(chan string)
+		go func() {
+			scanner := bufio.NewScanner(res.Body)
+			for scanner.Scan() {
+				lines <- scanner.Text()
+			}
+		}()
+
+		select {
+		case line := <-lines:
+			require.True(t, strings.HasPrefix(line, ": heartbeat"))
+		case <-time.After(time.Second):
+			t.Fatal("No heartbeat received")
+		}
+	})
+
+	t.Run("should return 404 Not Found if the video does not exist", func(t *testing.T) {
+		mockUC := mockprogress.NewMockVideoProgressUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewProgressHandler(mockUC, mockLogger, time.Minute)
+
+		mockUC.EXPECT().Execute(mock.Anything, input).Return(nil, sql.ErrNoRows).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/progress/video/"+videoID+"/events", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": videoID})
+		rr := serveAuthenticated(t, h.VideoProgressEvents, req, mockLogger)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("should return 403 Forbidden for a video of another user", func(t *testing.T) {
+		mockUC := mockprogress.NewMockVideoProgressUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewProgressHandler(mockUC, mockLogger, time.Minute)
+
+		mockUC.EXPECT().Execute(mock.Anything, input).Return(nil, progressapp.ErrProgressForbidden).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/progress/video/"+videoID+"/events", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": videoID})
+		rr := serveAuthenticated(t, h.VideoProgressEvents, req, mockLogger)
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should return 400 Bad Request on an invalid Last-Event-ID", func(t *testing.T) {
+		mockUC := mockprogress.NewMockVideoProgressUsecase(t)
+		mockLogger := mocklog.NewMockLogger(t)
+		h := handler.NewProgressHandler(mockUC, mockLogger, time.Minute)
+
+		req := httptest.NewRequest(http.MethodGet, "/progress/video/"+videoID+"/events", nil)
+		req.Header.Set("Last-Event-ID", "abc")
+		req = mux.SetURLVars(req, map[string]string{"id": videoID})
+		rr := serveAuthenticated(t, h.VideoProgressEvents, req, mockLogger)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
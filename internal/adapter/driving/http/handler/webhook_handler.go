@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+type WebhookHandler struct {
+	webhookUC webhookapp.WebhookUsecase
+	logger    log.Logger
+}
+
+func NewWebhookHandler(
+	webhookUC webhookapp.WebhookUsecase,
+	logger log.Logger,
+) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUC,
+		logger,
+	}
+}
+
+// isInvalidSubscription reports whether the error comes from invalid subscription fields
+func isInvalidSubscription(err error) bool {
+	return errors.Is(err, webhook.ErrURLInvalid) ||
+		errors.Is(err, webhook.ErrSecretEmpty) ||
+		errors.Is(err, webhook.ErrEventTypeInvalid)
+}
+
+func toEventTypes(ss []string) []event.EventType {
+	ts := make([]event.EventType, len(ss))
+	for i, s := range ss {
+		ts[i] = event.EventType(s)
+	}
+
+	return ts
+}
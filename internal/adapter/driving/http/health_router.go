@@ -0,0 +1,19 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// NewHealthRouter serves the health endpoint of nodes without the public API
+func NewHealthRouter(role string, db handler.Pinger, logger log.Logger) http.Handler {
+	r := mux.NewRouter()
+
+	healthH := handler.NewHealthHandler(role, db, logger)
+	r.HandleFunc("/healthz", healthH.Health).Methods(GET)
+
+	return r
+}
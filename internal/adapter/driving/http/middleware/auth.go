@@ -2,9 +2,12 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
+	"github.com/st-ember/streaming-api/internal/application/apikeyapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/denylist"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
 	"github.com/st-ember/streaming-api/internal/application/ports/token"
 )
@@ -13,9 +16,34 @@ type contextKey string
 
 const userClaimsKey contextKey = "user_claims"
 
-func Auth(t token.Token, logger log.Logger) func(http.Handler) http.Handler {
+// APIKeyHeader carries the api key of services, taking precedence over an access token
+const APIKeyHeader = "X-API-Key"
+
+// Auth rejects requests without a valid access token or api key with 401 and puts its claims in the context.
+// Tokens of revoked sessions are rejected as well, before they expire.
+// Permissions are checked by RequirePermission, which rejects with 403.
+func Auth(t token.Token, dl denylist.Denylist, keys apikeyapp.AuthenticateAPIKeyUsecase, logger log.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Api keys are checked against the db on every request, revoking them needs no denylist
+			if key := r.Header.Get(APIKeyHeader); key != "" {
+				claims, err := keys.Execute(r.Context(), key)
+				if err != nil {
+					if errors.Is(err, apikeyapp.ErrInvalidAPIKey) {
+						logger.Warnf(r.Context(), log.CategoryAuth, "", "invalid api key")
+						unauthorized(w, "invalid api key")
+						return
+					}
+					logger.Errorf(r.Context(), log.CategoryAuth, "", "authenticate api key: %v", err)
+					http.Error(w, "internal error", http.StatusInternalServerError)
+					return
+				}
+
+				ctx := context.WithValue(r.Context(), userClaimsKey, claims)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
 			var tokenStr string
 
 			// Primary for http requests
@@ -25,7 +53,7 @@ func Auth(t token.Token, logger log.Logger) func(http.Handler) http.Handler {
 				parts := strings.Split(authHeader, " ")
 				if len(parts) != 2 || parts[0] != "Bearer" {
 					logger.Errorf(r.Context(), log.CategoryAuth, "", "invalid auth header format")
-					http.Error(w, "invalid token format", http.StatusUnauthorized)
+					unauthorized(w, "invalid token format")
 					return
 				}
 
@@ -40,7 +68,7 @@ func Auth(t token.Token, logger log.Logger) func(http.Handler) http.Handler {
 			// Final validation
 			if tokenStr == "" {
 				logger.Errorf(r.Context(), log.CategoryAuth, "", "no token provided")
-				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				unauthorized(w, "unauthorized")
 				return
 			}
 
@@ -48,7 +76,20 @@ func Auth(t token.Token, logger log.Logger) func(http.Handler) http.Handler {
 			claims, err := t.ParseAccess(tokenStr)
 			if err != nil {
 				logger.Errorf(r.Context(), log.CategoryAuth, "", "parse token: %v", err)
-				http.Error(w, "invalid or expired token", http.StatusUnauthorized)
+				unauthorized(w, "invalid or expired token")
+				return
+			}
+
+			// Fail closed, a revoked session must not get through while the denylist is unreachable
+			denied, err := dl.IsDenied(r.Context(), claims.SessionID)
+			if err != nil {
+				logger.Errorf(r.Context(), log.CategoryAuth, claims.UserID, "check denylist: %v", err)
+				http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+				return
+			}
+			if denied {
+				logger.Warnf(r.Context(), log.CategoryAuth, claims.UserID, "token %s of revoked session %s", claims.ID, claims.SessionID)
+				unauthorized(w, "session revoked")
 				return
 			}
 
@@ -67,3 +108,10 @@ func ClaimsFromContext(ctx context.Context) (*token.AccessClaims, bool) {
 
 	return claims, true
 }
+
+// unauthorized rejects a request lacking valid credentials,
+// telling the client to authenticate with a bearer token
+func unauthorized(w http.ResponseWriter, msg string) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	http.Error(w, msg, http.StatusUnauthorized)
+}
@@ -1,12 +1,16 @@
 package middleware_test
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/st-ember/streaming-api/internal/adapter/driven/token"
 	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/apikeyapp"
+	apikeyappmocks "github.com/st-ember/streaming-api/internal/application/apikeyapp/mocks"
+	denylistmocks "github.com/st-ember/streaming-api/internal/application/ports/denylist/mocks"
 	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
 	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
 	tokenmocks "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
@@ -16,8 +20,10 @@ import (
 
 func TestAuthMiddleware(t *testing.T) {
 	mockToken := tokenmocks.NewMockToken(t)
+	mockDenylist := denylistmocks.NewMockDenylist(t)
+	mockKeys := apikeyappmocks.NewMockAuthenticateAPIKeyUsecase(t)
 	mockLogger := logmocks.NewMockLogger(t)
-	mw := middleware.Auth(mockToken, mockLogger)
+	mw := middleware.Auth(mockToken, mockDenylist, mockKeys, mockLogger)
 
 	// A simple final handler that verifies the context was set
 	finalHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -31,9 +37,10 @@ func TestAuthMiddleware(t *testing.T) {
 
 	t.Run("should succeed with valid bearer token", func(t *testing.T) {
 		tokenStr := "valid-token"
-		expectedClaims := &tokenport.AccessClaims{UserID: "user-123", Username: "tester"}
+		expectedClaims := &tokenport.AccessClaims{UserID: "user-123", Username: "tester", SessionID: "session-1"}
 
 		mockToken.EXPECT().ParseAccess(tokenStr).Return(expectedClaims, nil).Once()
+		mockDenylist.EXPECT().IsDenied(mock.Anything, "session-1").Return(false, nil).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		req.Header.Set("Authorization", "Bearer "+tokenStr)
@@ -46,9 +53,10 @@ func TestAuthMiddleware(t *testing.T) {
 
 	t.Run("should succeed with token in query parameter", func(t *testing.T) {
 		tokenStr := "valid-query-token"
-		expectedClaims := &tokenport.AccessClaims{UserID: "user-123", Username: "tester"}
+		expectedClaims := &tokenport.AccessClaims{UserID: "user-123", Username: "tester", SessionID: "session-1"}
 
 		mockToken.EXPECT().ParseAccess(tokenStr).Return(expectedClaims, nil).Once()
+		mockDenylist.EXPECT().IsDenied(mock.Anything, "session-1").Return(false, nil).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/?token="+tokenStr, nil)
 		rr := httptest.NewRecorder()
@@ -94,4 +102,63 @@ func TestAuthMiddleware(t *testing.T) {
 
 		require.Equal(t, http.StatusUnauthorized, rr.Code)
 	})
+
+	t.Run("should fail with a token of a revoked session", func(t *testing.T) {
+		tokenStr := "revoked-token"
+		claims := &tokenport.AccessClaims{UserID: "user-123", SessionID: "session-1"}
+		mockToken.EXPECT().ParseAccess(tokenStr).Return(claims, nil).Once()
+		mockDenylist.EXPECT().IsDenied(mock.Anything, "session-1").Return(true, nil).Once()
+		mockLogger.EXPECT().Warnf(mock.Anything, mock.Anything, "user-123", mock.Anything, mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+tokenStr)
+		rr := httptest.NewRecorder()
+
+		mw(finalHandler).ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+		require.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("should fail closed when the denylist is unreachable", func(t *testing.T) {
+		tokenStr := "valid-token"
+		claims := &tokenport.AccessClaims{UserID: "user-123", SessionID: "session-1"}
+		mockToken.EXPECT().ParseAccess(tokenStr).Return(claims, nil).Once()
+		mockDenylist.EXPECT().IsDenied(mock.Anything, "session-1").Return(false, errors.New("connection refused")).Once()
+		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, "user-123", "check denylist: %v", mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+tokenStr)
+		rr := httptest.NewRecorder()
+
+		mw(finalHandler).ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+
+	t.Run("should succeed with a valid api key, without checking the denylist", func(t *testing.T) {
+		claims := &tokenport.AccessClaims{UserID: "user-123", APIKeyID: "key-1"}
+		mockKeys.EXPECT().Execute(mock.Anything, "sak_abc_secret").Return(claims, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(middleware.APIKeyHeader, "sak_abc_secret")
+		rr := httptest.NewRecorder()
+
+		mw(finalHandler).ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should fail with an invalid api key", func(t *testing.T) {
+		mockKeys.EXPECT().Execute(mock.Anything, "sak_abc_wrong").Return(nil, apikeyapp.ErrInvalidAPIKey).Once()
+		mockLogger.EXPECT().Warnf(mock.Anything, mock.Anything, mock.Anything, "invalid api key").Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(middleware.APIKeyHeader, "sak_abc_wrong")
+		rr := httptest.NewRecorder()
+
+		mw(finalHandler).ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
 }
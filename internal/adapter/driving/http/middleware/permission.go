@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// RequirePermission rejects requests whose access token lacks the permission.
+// It must be chained after Auth, which puts the claims in the context.
+func RequirePermission(permission string, logger log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := ClaimsFromContext(r.Context())
+			if !ok {
+				logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+				unauthorized(w, "unauthorized")
+				return
+			}
+
+			if !slices.Contains(claims.Permissions, permission) {
+				logger.Warnf(r.Context(), log.CategoryAuth, claims.UserID, "user %s lacks permission %s", claims.UserID, permission)
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
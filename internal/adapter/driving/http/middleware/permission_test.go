@@ -0,0 +1,68 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/memorydenylist"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	apikeyappmocks "github.com/st-ember/streaming-api/internal/application/apikeyapp/mocks"
+	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	tokenmocks "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequirePermissionMiddleware(t *testing.T) {
+	mockToken := tokenmocks.NewMockToken(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	finalHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	// Auth runs first to inject the claims
+	chain := middleware.Auth(mockToken, memorydenylist.NewMemoryDenylist(), apikeyappmocks.NewMockAuthenticateAPIKeyUsecase(t), mockLogger)(
+		middleware.RequirePermission("job:admin", mockLogger)(finalHandler),
+	)
+
+	t.Run("should succeed if user has the permission", func(t *testing.T) {
+		claims := &tokenport.AccessClaims{UserID: "user-123", Permissions: []string{"video:upload", "job:admin"}}
+		mockToken.EXPECT().ParseAccess("admin-token").Return(claims, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer admin-token")
+		rr := httptest.NewRecorder()
+
+		chain.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should fail if user lacks the permission", func(t *testing.T) {
+		claims := &tokenport.AccessClaims{UserID: "user-123", Permissions: []string{"video:upload"}}
+		mockToken.EXPECT().ParseAccess("user-token").Return(claims, nil).Once()
+		mockLogger.EXPECT().Warnf(mock.Anything, mock.Anything, "user-123", mock.Anything, []any{"user-123", "job:admin"}).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer user-token")
+		rr := httptest.NewRecorder()
+
+		chain.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should fail if chained without auth", func(t *testing.T) {
+		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, "no claims in context").Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rr := httptest.NewRecorder()
+
+		middleware.RequirePermission("job:admin", mockLogger)(finalHandler).ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
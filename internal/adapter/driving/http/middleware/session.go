@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// RequireSession rejects requests authenticated with an api key.
+// It guards the credentials of the user, which a leaked key must not reach.
+// It must be chained after Auth, which puts the claims in the context.
+func RequireSession(logger log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := ClaimsFromContext(r.Context())
+			if !ok {
+				logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+				unauthorized(w, "unauthorized")
+				return
+			}
+
+			if claims.APIKeyID != "" {
+				logger.Warnf(r.Context(), log.CategoryAuth, claims.UserID, "api key %s used on a session route", claims.APIKeyID)
+				http.Error(w, "api keys cannot manage credentials", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
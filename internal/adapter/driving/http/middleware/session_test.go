@@ -0,0 +1,69 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/memorydenylist"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	apikeyappmocks "github.com/st-ember/streaming-api/internal/application/apikeyapp/mocks"
+	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	tokenmocks "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireSessionMiddleware(t *testing.T) {
+	mockToken := tokenmocks.NewMockToken(t)
+	mockAuthenticateKey := apikeyappmocks.NewMockAuthenticateAPIKeyUsecase(t)
+	mockLogger := logmocks.NewMockLogger(t)
+
+	finalHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	// Auth runs first to inject the claims
+	chain := middleware.Auth(mockToken, memorydenylist.NewMemoryDenylist(), mockAuthenticateKey, mockLogger)(
+		middleware.RequireSession(mockLogger)(finalHandler),
+	)
+
+	t.Run("should succeed with an access token", func(t *testing.T) {
+		claims := &tokenport.AccessClaims{UserID: "user-123", SessionID: "session-1"}
+		mockToken.EXPECT().ParseAccess("user-token").Return(claims, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer user-token")
+		rr := httptest.NewRecorder()
+
+		chain.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should fail with an api key", func(t *testing.T) {
+		claims := &tokenport.AccessClaims{UserID: "user-123", APIKeyID: "key-1"}
+		mockAuthenticateKey.EXPECT().Execute(mock.Anything, "sak_abc_secret").Return(claims, nil).Once()
+		mockLogger.EXPECT().Warnf(mock.Anything, mock.Anything, "user-123", mock.Anything, []any{"key-1"}).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-API-Key", "sak_abc_secret")
+		rr := httptest.NewRecorder()
+
+		chain.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should fail if chained without auth", func(t *testing.T) {
+		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, "no claims in context").Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rr := httptest.NewRecorder()
+
+		middleware.RequireSession(mockLogger)(finalHandler).ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
@@ -2,16 +2,25 @@ package http
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/st-ember/streaming-api/internal/adapter/driving/http/handler"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
 	wshandler "github.com/st-ember/streaming-api/internal/adapter/driving/websocket/handler"
+	"github.com/st-ember/streaming-api/internal/application/apikeyapp"
 	"github.com/st-ember/streaming-api/internal/application/authapp"
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/denylist"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
 	"github.com/st-ember/streaming-api/internal/application/ports/token"
 	"github.com/st-ember/streaming-api/internal/application/progressapp"
+	"github.com/st-ember/streaming-api/internal/application/roleapp"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
 	"github.com/st-ember/streaming-api/internal/application/videoapp"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 )
 
 type Router struct {
@@ -19,52 +28,153 @@ type Router struct {
 	Handler http.Handler
 }
 
+// sseHeartbeat is the interval of the comments keeping idle event streams open
+const sseHeartbeat = 15 * time.Second
+
 var (
 	GET    = "GET"
 	POST   = "POST"
+	PUT    = "PUT"
 	PATCH  = "PATCH"
 	DELETE = "DELETE"
 )
 
 func NewRouter(
 	videoUC videoapp.VideoUsecase,
-	videoProgressUC progressapp.VideoProgressUsecase,
-	loginUC authapp.LoginUsecase,
-	signupUC authapp.SignupUsecase,
+	progressUC progressapp.ProgressUsecase,
+	jobUC jobapp.JobUsecase,
+	taskUC scheduleapp.TaskUsecase,
+	webhookUC webhookapp.WebhookUsecase,
+	authUC authapp.AuthUsecase,
+	roleUC roleapp.RoleUsecase,
+	apiKeyUC apikeyapp.APIKeyUsecase,
 	storagePath string,
 	allowedCfg []string,
 	logger log.Logger,
 	token token.Token,
+	dl denylist.Denylist,
 ) *Router {
 	r := mux.NewRouter()
 
+	// Requests without a valid token or api key are rejected with 401, those lacking the permission with 403
+	authenticated := middleware.Auth(token, dl, apiKeyUC.Authenticate, logger)
+	authorized := func(permission string, h http.HandlerFunc) http.Handler {
+		return authenticated(middleware.RequirePermission(permission, logger)(h))
+	}
+	// Credentials of the user are only managed with an access token, never with an api key
+	withSession := func(h http.HandlerFunc) http.Handler {
+		return authenticated(middleware.RequireSession(logger)(h))
+	}
+
 	api := r.PathPrefix("/api").Subrouter()
 
 	// video
 	videoRouter := api.PathPrefix("/video").Subrouter()
 	videoH := handler.NewVideoHandler(videoUC, logger)
-	videoRouter.HandleFunc("/", videoH.Upload).Methods(POST)
+	videoRouter.Handle("/", authorized(auth.PermissionVideoUpload, videoH.Upload)).Methods(POST)
 	videoRouter.HandleFunc("/{id}", videoH.Get).Methods(GET)
-	videoRouter.HandleFunc("/{id}", videoH.Update).Methods(PATCH)
-	videoRouter.HandleFunc("/{id}", videoH.Archive).Methods(DELETE)
-	videoRouter.HandleFunc("/list/{page}", videoH.List).Methods(GET)
+	videoRouter.Handle("/{id}", authorized(auth.PermissionVideoUpdate, videoH.Update)).Methods(PATCH)
+	videoRouter.Handle("/{id}", authorized(auth.PermissionVideoArchive, videoH.Archive)).Methods(DELETE)
+	videoRouter.Handle("/{id}/publish-at", authorized(auth.PermissionVideoUpdate, videoH.SchedulePublish)).Methods(PUT)
+	videoRouter.Handle("/list/{page}", authenticated(http.HandlerFunc(videoH.List))).Methods(GET)
+
+	// job
+	jobRouter := api.PathPrefix("/jobs").Subrouter()
+	jobH := handler.NewJobHandler(jobUC, logger)
+	jobRouter.Handle("/{id}", authorized(auth.PermissionVideoUpdate, jobH.Cancel)).Methods(DELETE)
+
+	// admin
+	adminRouter := api.PathPrefix("/admin").Subrouter()
+	adminRouter.Use(authenticated)
+	adminJobRouter := adminRouter.PathPrefix("/jobs").Subrouter()
+	adminJobRouter.Use(middleware.RequirePermission(auth.PermissionJobAdmin, logger))
+	adminJobRouter.HandleFunc("", jobH.List).Methods(GET)
+	adminJobRouter.HandleFunc("/{id}", jobH.Get).Methods(GET)
+	adminJobRouter.HandleFunc("/{id}", jobH.Cancel).Methods(DELETE)
+	adminJobRouter.HandleFunc("/{id}/attempts", jobH.ListAttempts).Methods(GET)
+	adminJobRouter.HandleFunc("/{id}/retry", jobH.Retry).Methods(POST)
+	adminJobRouter.HandleFunc("/{id}/priority", jobH.UpdatePriority).Methods(PATCH)
+
+	// tasks, run by the task scheduler of the workers
+	taskH := handler.NewTaskHandler(taskUC, logger)
+	adminTaskRouter := adminRouter.PathPrefix("/tasks").Subrouter()
+	adminTaskRouter.Use(middleware.RequirePermission(auth.PermissionJobAdmin, logger))
+	adminTaskRouter.HandleFunc("", taskH.Schedule).Methods(POST)
+
+	// roles, changes apply to users from their next access token
+	roleH := handler.NewRoleHandler(roleUC, logger)
+	adminRoleRouter := adminRouter.PathPrefix("/roles").Subrouter()
+	adminRoleRouter.Use(middleware.RequirePermission(auth.PermissionRoleAdmin, logger))
+	adminRoleRouter.HandleFunc("", roleH.List).Methods(GET)
+	adminRoleRouter.HandleFunc("", roleH.Create).Methods(POST)
+	adminRoleRouter.HandleFunc("/{name}/permissions/{permission}", roleH.AddPermission).Methods(PUT)
+	adminRoleRouter.HandleFunc("/{name}/permissions/{permission}", roleH.RemovePermission).Methods(DELETE)
+	adminUserRouter := adminRouter.PathPrefix("/users").Subrouter()
+	adminUserRouter.Use(middleware.RequirePermission(auth.PermissionRoleAdmin, logger))
+	adminUserRouter.HandleFunc("/{id}/roles/{name}", roleH.Assign).Methods(PUT)
+	adminUserRouter.HandleFunc("/{id}/roles/{name}", roleH.Unassign).Methods(DELETE)
+
+	// webhook
+	webhookRouter := api.PathPrefix("/webhooks").Subrouter()
+	webhookRouter.Use(
+		authenticated,
+		middleware.RequirePermission(auth.PermissionWebhookAdmin, logger),
+	)
+	webhookH := handler.NewWebhookHandler(webhookUC, logger)
+	webhookRouter.HandleFunc("", webhookH.Create).Methods(POST)
+	webhookRouter.HandleFunc("", webhookH.List).Methods(GET)
+	webhookRouter.HandleFunc("/{id}", webhookH.Get).Methods(GET)
+	webhookRouter.HandleFunc("/{id}", webhookH.Update).Methods(PATCH)
+	webhookRouter.HandleFunc("/{id}", webhookH.Delete).Methods(DELETE)
+	webhookRouter.HandleFunc("/{id}/deliveries", webhookH.ListDeliveries).Methods(GET)
+	webhookRouter.HandleFunc("/{id}/deliveries/{deliveryID}/replay", webhookH.Replay).Methods(POST)
 
 	// streaming
 	streamingRouter := r.PathPrefix("/streaming").Subrouter()
 	streamingHandler := handler.NewStreamingHandler(storagePath, logger)
 	streamingRouter.HandleFunc("/{resourceID}/{filename}", streamingHandler.ServeFile).Methods(GET)
 
-	// progress
-	progressRouter := r.PathPrefix("progress").Subrouter()
-	progressHandler := wshandler.NewProgressHandler(videoProgressUC, logger)
+	// progress, owners of a video and admins may follow it
+	progressRouter := r.PathPrefix("/progress").Subrouter()
+	progressRouter.Use(authenticated)
+	progressHandler := wshandler.NewProgressHandler(progressUC.Video, logger)
 	progressRouter.HandleFunc("/video/{id}", progressHandler.VideoProgress).Methods(GET)
+	progressEventsHandler := handler.NewProgressHandler(progressUC.Video, logger, sseHeartbeat)
+	progressRouter.HandleFunc("/video/{id}/events", progressEventsHandler.VideoProgressEvents).Methods(GET)
+	subscriptionHandler := wshandler.NewSubscriptionHandler(progressUC.OpenSubscription, progressUC.Resolve, logger)
+	progressRouter.HandleFunc("/subscribe", subscriptionHandler.Subscribe).Methods(GET)
 	// later thumbnail generation progress handler may be added
 
 	// auth
 	authRouter := api.PathPrefix("/auth").Subrouter()
-	authH := handler.NewAuthHandler(loginUC, signupUC, logger)
+	authH := handler.NewAuthHandler(authUC, logger)
 	authRouter.HandleFunc("/login", authH.Login)
 	authRouter.HandleFunc("/signup", authH.Signup)
+	authRouter.HandleFunc("/refresh", authH.Refresh).Methods(POST)
+	authRouter.Handle("/logout", withSession(authH.Logout)).Methods(POST)
+	authRouter.Handle("/sessions", withSession(authH.ListSessions)).Methods(GET)
+	authRouter.Handle("/sessions/{id}", withSession(authH.RevokeSession)).Methods(DELETE)
+	authRouter.Handle("/verify-email/request", authenticated(http.HandlerFunc(authH.RequestEmailVerification))).Methods(POST)
+	authRouter.HandleFunc("/verify-email", authH.VerifyEmail).Methods(POST)
+	authRouter.HandleFunc("/password/forgot", authH.ForgotPassword).Methods(POST)
+	authRouter.HandleFunc("/password/reset", authH.ResetPassword).Methods(POST)
+
+	// sign in through the identity provider, when one is configured
+	if authUC.OIDCLogin != nil {
+		authRouter.HandleFunc("/oidc/login", authH.OIDCLogin).Methods(GET)
+		authRouter.HandleFunc("/oidc/callback", authH.OIDCCallback).Methods(GET)
+	}
+
+	// api keys of the user, for services that cannot log in
+	apiKeyRouter := authRouter.PathPrefix("/api-keys").Subrouter()
+	apiKeyRouter.Use(authenticated, middleware.RequireSession(logger))
+	apiKeyH := handler.NewAPIKeyHandler(apiKeyUC, logger)
+	apiKeyRouter.HandleFunc("", apiKeyH.Create).Methods(POST)
+	apiKeyRouter.HandleFunc("", apiKeyH.List).Methods(GET)
+	apiKeyRouter.HandleFunc("/{id}", apiKeyH.Revoke).Methods(DELETE)
+
+	// public keys verifying access tokens
+	r.HandleFunc("/.well-known/jwks.json", authH.JWKS).Methods(GET)
 
 	// cors config
 	allowedOrigins := handlers.AllowedOrigins(allowedCfg)
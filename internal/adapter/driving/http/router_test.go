@@ -0,0 +1,526 @@
+package http_test
+
+import (
+	"bytes"
+	"database/sql"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/memorydenylist"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/token"
+	adpHttp "github.com/st-ember/streaming-api/internal/adapter/driving/http"
+	"github.com/st-ember/streaming-api/internal/application/apikeyapp"
+	apikeyappmocks "github.com/st-ember/streaming-api/internal/application/apikeyapp/mocks"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	authappmocks "github.com/st-ember/streaming-api/internal/application/authapp/mocks"
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	jobappmocks "github.com/st-ember/streaming-api/internal/application/jobapp/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/denylist"
+	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/application/progressapp"
+	progressappmocks "github.com/st-ember/streaming-api/internal/application/progressapp/mocks"
+	"github.com/st-ember/streaming-api/internal/application/roleapp"
+	roleappmocks "github.com/st-ember/streaming-api/internal/application/roleapp/mocks"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	"github.com/st-ember/streaming-api/internal/application/videoapp"
+	videoappmocks "github.com/st-ember/streaming-api/internal/application/videoapp/mocks"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// routerMocks holds the usecases reached by the routes under test
+type routerMocks struct {
+	getInfo          *videoappmocks.MockGetVideoInfoUsecase
+	upload           *videoappmocks.MockUploadVideoUsecase
+	update           *videoappmocks.MockUpdateVideoUsecase
+	archive          *videoappmocks.MockArchiveVideoUsecase
+	list             *videoappmocks.MockListVideosUsecase
+	cancel           *jobappmocks.MockCancelJobUsecase
+	videoProgress    *progressappmocks.MockVideoProgressUsecase
+	openSubscription *progressappmocks.MockOpenProgressSubscriptionUsecase
+	listSessions     *authappmocks.MockListSessionsUsecase
+	revokeSession    *authappmocks.MockRevokeSessionUsecase
+	requestVerify    *authappmocks.MockRequestEmailVerificationUsecase
+	listRoles        *roleappmocks.MockListRolesUsecase
+	createRole       *roleappmocks.MockCreateRoleUsecase
+	addPermission    *roleappmocks.MockAddRolePermissionUsecase
+	assignRole       *roleappmocks.MockAssignRoleUsecase
+	createAPIKey     *apikeyappmocks.MockCreateAPIKeyUsecase
+	listAPIKeys      *apikeyappmocks.MockListAPIKeysUsecase
+	authenticateKey  *apikeyappmocks.MockAuthenticateAPIKeyUsecase
+	denylist         denylist.Denylist
+}
+
+// newTestRouter builds the router with a real token issuer, returning it with its usecase mocks
+func newTestRouter(t *testing.T) (*adpHttp.Router, *routerMocks, func(permissions ...string) string) {
+	t.Helper()
+
+	m := &routerMocks{
+		getInfo:          videoappmocks.NewMockGetVideoInfoUsecase(t),
+		upload:           videoappmocks.NewMockUploadVideoUsecase(t),
+		update:           videoappmocks.NewMockUpdateVideoUsecase(t),
+		archive:          videoappmocks.NewMockArchiveVideoUsecase(t),
+		list:             videoappmocks.NewMockListVideosUsecase(t),
+		cancel:           jobappmocks.NewMockCancelJobUsecase(t),
+		videoProgress:    progressappmocks.NewMockVideoProgressUsecase(t),
+		openSubscription: progressappmocks.NewMockOpenProgressSubscriptionUsecase(t),
+		listSessions:     authappmocks.NewMockListSessionsUsecase(t),
+		revokeSession:    authappmocks.NewMockRevokeSessionUsecase(t),
+		requestVerify:    authappmocks.NewMockRequestEmailVerificationUsecase(t),
+		listRoles:        roleappmocks.NewMockListRolesUsecase(t),
+		createRole:       roleappmocks.NewMockCreateRoleUsecase(t),
+		addPermission:    roleappmocks.NewMockAddRolePermissionUsecase(t),
+		assignRole:       roleappmocks.NewMockAssignRoleUsecase(t),
+		createAPIKey:     apikeyappmocks.NewMockCreateAPIKeyUsecase(t),
+		listAPIKeys:      apikeyappmocks.NewMockListAPIKeysUsecase(t),
+		authenticateKey:  apikeyappmocks.NewMockAuthenticateAPIKeyUsecase(t),
+		denylist:         memorydenylist.NewMemoryDenylist(),
+	}
+
+	logger := logmocks.NewMockLogger(t)
+	logger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	logger.EXPECT().Warnf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+	sealer, err := token.NewKeySealer(bytes.Repeat([]byte("k"), 32))
+	require.NoError(t, err)
+	tk := token.NewJwtToken([]byte("refresh-secret"), sealer)
+	der, err := token.NewKeyGenerator(sealer).Generate(auth.SigningAlgorithmEdDSA)
+	require.NoError(t, err)
+	key, err := auth.NewSigningKey("key-id", auth.SigningAlgorithmEdDSA, der, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, tk.SetSigningKeys([]*auth.SigningKey{key}))
+
+	router := adpHttp.NewRouter(
+		videoapp.VideoUsecase{GetInfo: m.getInfo, Upload: m.upload, Update: m.update, Archive: m.archive, List: m.list},
+		progressapp.ProgressUsecase{Video: m.videoProgress, OpenSubscription: m.openSubscription},
+		jobapp.JobUsecase{Cancel: m.cancel},
+		scheduleapp.TaskUsecase{},
+		webhookapp.WebhookUsecase{},
+		authapp.AuthUsecase{
+			ListSessions:             m.listSessions,
+			RevokeSession:            m.revokeSession,
+			RequestEmailVerification: m.requestVerify,
+			PublicKeys:               authapp.NewListPublicKeysUsecase(tk),
+		},
+		roleapp.RoleUsecase{List: m.listRoles, Create: m.createRole, AddPermission: m.addPermission, Assign: m.assignRole},
+		apikeyapp.APIKeyUsecase{Create: m.createAPIKey, List: m.listAPIKeys, Authenticate: m.authenticateKey},
+		t.TempDir(), []string{"*"},
+		logger, tk, m.denylist,
+	)
+
+	issue := func(permissions ...string) string {
+		accessToken, err := tk.GenerateAccess("user-id", "tester", "session-id", permissions)
+		require.NoError(t, err)
+		return accessToken
+	}
+
+	return router, m, issue
+}
+
+// uploadBody builds a multipart form with a video file
+func uploadBody(t *testing.T) (io.Reader, string) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	part, err := mw.CreateFormFile("video", "clip.mp4")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("video content"))
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+
+	return &buf, mw.FormDataContentType()
+}
+
+func TestRouterAuthorization(t *testing.T) {
+	routes := []struct {
+		name       string
+		method     string
+		path       string
+		permission string // empty when any authenticated user is allowed
+		body       func(t *testing.T) (io.Reader, string)
+		expect     func(m *routerMocks)
+		reached    int // status of the handler once authorized
+	}{
+		{
+			name:       "upload video",
+			method:     http.MethodPost,
+			path:       "/api/video/",
+			permission: auth.PermissionVideoUpload,
+			body:       uploadBody,
+			expect: func(m *routerMocks) {
+				m.upload.EXPECT().
+					Execute(mock.Anything, mock.MatchedBy(func(input videoapp.UploadVideoInput) bool {
+						return input.OwnerID == "user-id"
+					})).
+					Return(nil, sql.ErrConnDone).
+					Once()
+			},
+			reached: http.StatusInternalServerError,
+		},
+		{
+			name:       "update video",
+			method:     http.MethodPatch,
+			path:       "/api/video/video-id",
+			permission: auth.PermissionVideoUpdate,
+			body: func(t *testing.T) (io.Reader, string) {
+				return strings.NewReader(`{"title": "new title"}`), "application/json"
+			},
+			expect: func(m *routerMocks) {
+				m.update.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil, sql.ErrConnDone).Once()
+			},
+			reached: http.StatusInternalServerError,
+		},
+		{
+			name:       "archive video",
+			method:     http.MethodDelete,
+			path:       "/api/video/video-id",
+			permission: auth.PermissionVideoArchive,
+			expect: func(m *routerMocks) {
+				input := videoapp.ArchiveVideoInput{
+					ID:          "video-id",
+					UserID:      "user-id",
+					Permissions: []string{auth.PermissionVideoArchive},
+				}
+				m.archive.EXPECT().Execute(mock.Anything, input).Return(sql.ErrConnDone).Once()
+			},
+			reached: http.StatusInternalServerError,
+		},
+		{
+			name:   "list videos",
+			method: http.MethodGet,
+			path:   "/api/video/list/1",
+			expect: func(m *routerMocks) {
+				input := videoapp.ListVideosInput{Page: 1, UserID: "user-id"}
+				m.list.EXPECT().Execute(mock.Anything, input).Return(nil, sql.ErrConnDone).Once()
+			},
+			reached: http.StatusInternalServerError,
+		},
+		{
+			name:       "cancel job",
+			method:     http.MethodDelete,
+			path:       "/api/jobs/job-id",
+			permission: auth.PermissionVideoUpdate,
+			expect: func(m *routerMocks) {
+				input := jobapp.CancelJobInput{
+					ID:          "job-id",
+					UserID:      "user-id",
+					Permissions: []string{auth.PermissionVideoUpdate},
+				}
+				m.cancel.EXPECT().Execute(mock.Anything, input).Return(sql.ErrNoRows).Once()
+			},
+			reached: http.StatusNotFound,
+		},
+		{
+			name:   "video progress websocket",
+			method: http.MethodGet,
+			path:   "/progress/video/video-id",
+			expect: func(m *routerMocks) {
+				input := progressapp.VideoProgressInput{ID: "video-id", UserID: "user-id"}
+				m.videoProgress.EXPECT().Execute(mock.Anything, input).Return(nil, sql.ErrNoRows).Once()
+			},
+			reached: http.StatusNotFound,
+		},
+		{
+			name:   "video progress events",
+			method: http.MethodGet,
+			path:   "/progress/video/video-id/events",
+			expect: func(m *routerMocks) {
+				input := progressapp.VideoProgressInput{ID: "video-id", UserID: "user-id"}
+				m.videoProgress.EXPECT().Execute(mock.Anything, input).Return(nil, sql.ErrNoRows).Once()
+			},
+			reached: http.StatusNotFound,
+		},
+		{
+			name:   "progress subscription",
+			method: http.MethodGet,
+			path:   "/progress/subscribe",
+			expect: func(m *routerMocks) {
+				m.openSubscription.EXPECT().Execute(mock.Anything).Return(nil, sql.ErrConnDone).Once()
+			},
+			reached: http.StatusInternalServerError,
+		},
+		{
+			name:   "logout",
+			method: http.MethodPost,
+			path:   "/api/auth/logout",
+			expect: func(m *routerMocks) {
+				m.revokeSession.EXPECT().Execute(mock.Anything, "user-id", "session-id").Return(sql.ErrConnDone).Once()
+			},
+			reached: http.StatusInternalServerError,
+		},
+		{
+			name:   "list sessions",
+			method: http.MethodGet,
+			path:   "/api/auth/sessions",
+			expect: func(m *routerMocks) {
+				m.listSessions.EXPECT().Execute(mock.Anything, "user-id").Return(nil, sql.ErrConnDone).Once()
+			},
+			reached: http.StatusInternalServerError,
+		},
+		{
+			name:   "revoke session",
+			method: http.MethodDelete,
+			path:   "/api/auth/sessions/other-session",
+			expect: func(m *routerMocks) {
+				m.revokeSession.EXPECT().Execute(mock.Anything, "user-id", "other-session").Return(sql.ErrNoRows).Once()
+			},
+			reached: http.StatusNotFound,
+		},
+		{
+			name:   "request email verification",
+			method: http.MethodPost,
+			path:   "/api/auth/verify-email/request",
+			expect: func(m *routerMocks) {
+				m.requestVerify.EXPECT().Execute(mock.Anything, "user-id").Return(authapp.ErrEmailAlreadyVerified).Once()
+			},
+			reached: http.StatusConflict,
+		},
+		{
+			name:       "list roles",
+			method:     http.MethodGet,
+			path:       "/api/admin/roles",
+			permission: auth.PermissionRoleAdmin,
+			expect: func(m *routerMocks) {
+				m.listRoles.EXPECT().Execute(mock.Anything).Return(nil, sql.ErrConnDone).Once()
+			},
+			reached: http.StatusInternalServerError,
+		},
+		{
+			name:       "create role",
+			method:     http.MethodPost,
+			path:       "/api/admin/roles",
+			permission: auth.PermissionRoleAdmin,
+			body: func(t *testing.T) (io.Reader, string) {
+				return strings.NewReader(`{"name": "editor"}`), "application/json"
+			},
+			expect: func(m *routerMocks) {
+				input := roleapp.CreateRoleInput{Name: "editor"}
+				m.createRole.EXPECT().Execute(mock.Anything, input).Return(nil, roleapp.ErrRoleExists).Once()
+			},
+			reached: http.StatusConflict,
+		},
+		{
+			name:       "add role permission",
+			method:     http.MethodPut,
+			path:       "/api/admin/roles/editor/permissions/video:update",
+			permission: auth.PermissionRoleAdmin,
+			expect: func(m *routerMocks) {
+				m.addPermission.EXPECT().Execute(mock.Anything, "editor", auth.PermissionVideoUpdate).Return(nil, sql.ErrNoRows).Once()
+			},
+			reached: http.StatusNotFound,
+		},
+		{
+			name:       "assign role",
+			method:     http.MethodPut,
+			path:       "/api/admin/users/other-user/roles/editor",
+			permission: auth.PermissionRoleAdmin,
+			expect: func(m *routerMocks) {
+				m.assignRole.EXPECT().Execute(mock.Anything, "other-user", "editor").Return(nil).Once()
+			},
+			reached: http.StatusNoContent,
+		},
+		{
+			name:   "create api key",
+			method: http.MethodPost,
+			path:   "/api/auth/api-keys",
+			body: func(t *testing.T) (io.Reader, string) {
+				return strings.NewReader(`{"name": "ingest"}`), "application/json"
+			},
+			expect: func(m *routerMocks) {
+				m.createAPIKey.EXPECT().
+					Execute(mock.Anything, mock.MatchedBy(func(input apikeyapp.CreateAPIKeyInput) bool {
+						return input.UserID == "user-id" && input.Name == "ingest"
+					})).
+					Return(nil, "", sql.ErrConnDone).
+					Once()
+			},
+			reached: http.StatusInternalServerError,
+		},
+		{
+			name:   "list api keys",
+			method: http.MethodGet,
+			path:   "/api/auth/api-keys",
+			expect: func(m *routerMocks) {
+				m.listAPIKeys.EXPECT().Execute(mock.Anything, "user-id").Return(nil, sql.ErrConnDone).Once()
+			},
+			reached: http.StatusInternalServerError,
+		},
+	}
+
+	// serve sends a request to the route, authenticated with accessToken unless empty
+	serve := func(t *testing.T, router *adpHttp.Router, method, path string, body func(t *testing.T) (io.Reader, string), accessToken string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		var reader io.Reader
+		req := httptest.NewRequest(method, path, nil)
+		if body != nil {
+			var contentType string
+			reader, contentType = body(t)
+			req = httptest.NewRequest(method, path, reader)
+			req.Header.Set("Content-Type", contentType)
+		}
+		if accessToken != "" {
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+		}
+
+		rr := httptest.NewRecorder()
+		router.Handler.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	for _, route := range routes {
+		t.Run(route.name+" - should return 401 without a token", func(t *testing.T) {
+			router, _, _ := newTestRouter(t)
+
+			rr := serve(t, router, route.method, route.path, route.body, "")
+
+			require.Equal(t, http.StatusUnauthorized, rr.Code)
+			require.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+		})
+
+		t.Run(route.name+" - should return 401 with an invalid token", func(t *testing.T) {
+			router, _, _ := newTestRouter(t)
+
+			rr := serve(t, router, route.method, route.path, route.body, "not-a-jwt")
+
+			require.Equal(t, http.StatusUnauthorized, rr.Code)
+		})
+
+		if route.permission != "" {
+			t.Run(route.name+" - should return 403 without the permission", func(t *testing.T) {
+				router, _, issue := newTestRouter(t)
+
+				// Holding every other permission is not enough
+				var others []string
+				for _, p := range auth.AllPermissions() {
+					if p != route.permission {
+						others = append(others, p)
+					}
+				}
+
+				rr := serve(t, router, route.method, route.path, route.body, issue(others...))
+
+				require.Equal(t, http.StatusForbidden, rr.Code)
+			})
+		}
+
+		t.Run(route.name+" - should reach the handler when authorized", func(t *testing.T) {
+			router, m, issue := newTestRouter(t)
+			route.expect(m)
+
+			var permissions []string
+			if route.permission != "" {
+				permissions = append(permissions, route.permission)
+			}
+
+			rr := serve(t, router, route.method, route.path, route.body, issue(permissions...))
+
+			require.Equal(t, route.reached, rr.Code)
+		})
+	}
+}
+
+func TestRouterRevokedSession(t *testing.T) {
+	t.Run("should return 401 for a token of a revoked session", func(t *testing.T) {
+		router, m, issue := newTestRouter(t)
+		accessToken := issue(auth.PermissionJobAdmin)
+		require.NoError(t, m.denylist.Deny(t.Context(), "session-id", time.Minute))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/jobs", nil)
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		rr := httptest.NewRecorder()
+		router.Handler.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestRouterAPIKey(t *testing.T) {
+	t.Run("should authorize a request with the permissions of the api key", func(t *testing.T) {
+		router, m, _ := newTestRouter(t)
+		claims := &tokenport.AccessClaims{UserID: "user-id", APIKeyID: "key-id", Permissions: []string{auth.PermissionJobAdmin}}
+		m.authenticateKey.EXPECT().Execute(mock.Anything, "sak_abc_secret").Return(claims, nil).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/jobs/job-id", nil)
+		req.Header.Set("X-API-Key", "sak_abc_secret")
+		rr := httptest.NewRecorder()
+		router.Handler.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should reject an api key on the routes managing credentials", func(t *testing.T) {
+		for _, route := range []struct{ method, path string }{
+			{http.MethodPost, "/api/auth/logout"},
+			{http.MethodGet, "/api/auth/sessions"},
+			{http.MethodDelete, "/api/auth/sessions/session-id"},
+			{http.MethodGet, "/api/auth/api-keys"},
+			{http.MethodDelete, "/api/auth/api-keys/key-id"},
+		} {
+			router, m, _ := newTestRouter(t)
+			claims := &tokenport.AccessClaims{UserID: "user-id", APIKeyID: "key-id"}
+			m.authenticateKey.EXPECT().Execute(mock.Anything, "sak_abc_secret").Return(claims, nil).Once()
+
+			req := httptest.NewRequest(route.method, route.path, nil)
+			req.Header.Set("X-API-Key", "sak_abc_secret")
+			rr := httptest.NewRecorder()
+			router.Handler.ServeHTTP(rr, req)
+
+			require.Equal(t, http.StatusForbidden, rr.Code, route.path)
+		}
+	})
+
+	t.Run("should return 401 for an invalid api key", func(t *testing.T) {
+		router, m, _ := newTestRouter(t)
+		m.authenticateKey.EXPECT().Execute(mock.Anything, "sak_abc_wrong").Return(nil, apikeyapp.ErrInvalidAPIKey).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/video/list/1", nil)
+		req.Header.Set("X-API-Key", "sak_abc_wrong")
+		rr := httptest.NewRecorder()
+		router.Handler.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestRouterPublicRoutes(t *testing.T) {
+	t.Run("should get a video without a token", func(t *testing.T) {
+		router, m, _ := newTestRouter(t)
+		m.getInfo.EXPECT().Execute(mock.Anything, "video-id").Return(nil, sql.ErrConnDone).Once()
+
+		rr := httptest.NewRecorder()
+		router.Handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/video/video-id", nil))
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("should publish the key set without a token", func(t *testing.T) {
+		router, _, _ := newTestRouter(t)
+
+		rr := httptest.NewRecorder()
+		router.Handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Contains(t, rr.Body.String(), `"kid":"key-id"`)
+	})
+}
+
+func TestRouterOIDCDisabled(t *testing.T) {
+	router, _, _ := newTestRouter(t)
+
+	rr := httptest.NewRecorder()
+	router.Handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/auth/oidc/login", nil))
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -4,12 +4,13 @@ import (
 	"database/sql"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
 	"github.com/st-ember/streaming-api/internal/application/progressapp"
-	"github.com/st-ember/streaming-api/internal/domain/progress"
 )
 
 type ProgressHandler struct {
@@ -32,15 +33,30 @@ func NewProgressHandler(
 	}
 }
 
+// VideoProgress streams the progress of a video over a WebSocket.
+// It must be chained after the Auth middleware.
 func (h *ProgressHandler) VideoProgress(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
 	vars := mux.Vars(r)
 	id := vars["id"]
 
-	prgCh, err := h.videoProgressUC.Execute(r.Context(), id)
+	prgCh, err := h.videoProgressUC.Execute(r.Context(), progressapp.VideoProgressInput{
+		ID:          id,
+		UserID:      claims.UserID,
+		Permissions: claims.Permissions,
+	})
 	if err != nil {
 		h.logger.Errorf(r.Context(), log.CategoryDefault, "", "execute video progress usecase: %v", err)
 		if errors.Is(err, sql.ErrNoRows) {
 			http.Error(w, "video not found", http.StatusNotFound)
+		} else if errors.Is(err, progressapp.ErrProgressForbidden) {
+			http.Error(w, "forbidden", http.StatusForbidden)
 		} else {
 			http.Error(w, "internal error", http.StatusInternalServerError)
 		}
@@ -68,7 +84,10 @@ func (h *ProgressHandler) VideoProgress(w http.ResponseWriter, r *http.Request)
 				return
 			}
 
-			if prg.Status == progress.StatusEnd || prg.Status == progress.StatusError {
+			// Nothing follows a finished progress, close the connection cleanly
+			if prg.IsFinished() {
+				closeMsg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, string(prg.Status))
+				_ = conn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(time.Second))
 				return
 			}
 		}
@@ -10,14 +10,46 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/memorydenylist"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
 	"github.com/st-ember/streaming-api/internal/adapter/driving/websocket/handler"
+	apikeyappmocks "github.com/st-ember/streaming-api/internal/application/apikeyapp/mocks"
 	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	tokenmocks "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
+	"github.com/st-ember/streaming-api/internal/application/progressapp"
 	progressappmocks "github.com/st-ember/streaming-api/internal/application/progressapp/mocks"
 	"github.com/st-ember/streaming-api/internal/domain/progress"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+// dialProgress serves the handler behind the Auth middleware as user-id and connects to the progress of the video
+func dialProgress(t *testing.T, h *handler.ProgressHandler, logger *logmocks.MockLogger, videoID string) (*websocket.Conn, *http.Response, error) {
+	t.Helper()
+
+	mockToken := tokenmocks.NewMockToken(t)
+	mockToken.EXPECT().ParseAccess("valid-token").Return(&tokenport.AccessClaims{UserID: "user-id"}, nil).Once()
+
+	// Set up mux for gorilla/mux to parse {id}
+	r := mux.NewRouter()
+	r.Use(middleware.Auth(mockToken, memorydenylist.NewMemoryDenylist(), apikeyappmocks.NewMockAuthenticateAPIKeyUsecase(t), logger))
+	r.HandleFunc("/progress/video/{id}", h.VideoProgress)
+
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+
+	// Convert http URL to ws URL
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/progress/video/" + videoID + "?token=valid-token"
+
+	return websocket.DefaultDialer.Dial(wsURL, nil)
+}
+
+// progressInput is the input of the video progress usecase for user-id
+func progressInput(videoID string) progressapp.VideoProgressInput {
+	return progressapp.VideoProgressInput{ID: videoID, UserID: "user-id"}
+}
+
 func TestProgressHandler(t *testing.T) {
 	t.Run("success case", func(t *testing.T) {
 		t.Parallel()
@@ -43,19 +75,9 @@ func TestProgressHandler(t *testing.T) {
 		prgCh <- p2
 		close(prgCh)
 
-		mockUC.EXPECT().Execute(mock.Anything, videoID).Return(prgCh, nil).Once()
-
-		// Set up mux for gorilla/mux to parse {id}
-		r := mux.NewRouter()
-		r.HandleFunc("/progress/video/{id}", progressHandler.VideoProgress)
-
-		server := httptest.NewServer(r)
-		defer server.Close()
-
-		// Convert http URL to ws URL
-		wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/progress/video/" + videoID
+		mockUC.EXPECT().Execute(mock.Anything, progressInput(videoID)).Return(prgCh, nil).Once()
 		// --- ACT ---
-		conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		conn, _, err := dialProgress(t, progressHandler, mockLogger, videoID)
 		require.NoError(t, err)
 		defer conn.Close()
 
@@ -80,23 +102,35 @@ func TestProgressHandler(t *testing.T) {
 		progressHandler := handler.NewProgressHandler(mockUC, mockLogger)
 
 		videoID := "non-existent-id"
-		mockUC.EXPECT().Execute(mock.Anything, videoID).Return(nil, sql.ErrNoRows).Once()
+		mockUC.EXPECT().Execute(mock.Anything, progressInput(videoID)).Return(nil, sql.ErrNoRows).Once()
 		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 
-		r := mux.NewRouter()
-		r.HandleFunc("/videos/{id}/progress", progressHandler.VideoProgress)
+		// --- ACT ---
+		_, resp, err := dialProgress(t, progressHandler, mockLogger, videoID)
 
-		server := httptest.NewServer(r)
-		defer server.Close()
+		// --- ASSERT ---
+		require.Error(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
 
-		wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/videos/" + videoID + "/progress"
+	t.Run("video of another user", func(t *testing.T) {
+		t.Parallel()
+
+		// --- ARRANGE ---
+		mockUC := progressappmocks.NewMockVideoProgressUsecase(t)
+		mockLogger := logmocks.NewMockLogger(t)
+		progressHandler := handler.NewProgressHandler(mockUC, mockLogger)
+
+		videoID := "other-video-id"
+		mockUC.EXPECT().Execute(mock.Anything, progressInput(videoID)).Return(nil, progressapp.ErrProgressForbidden).Once()
+		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 
 		// --- ACT ---
-		_, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		_, resp, err := dialProgress(t, progressHandler, mockLogger, videoID)
 
 		// --- ASSERT ---
 		require.Error(t, err)
-		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
 	})
 
 	t.Run("internal server error", func(t *testing.T) {
@@ -108,19 +142,11 @@ func TestProgressHandler(t *testing.T) {
 		progressHandler := handler.NewProgressHandler(mockUC, mockLogger)
 
 		videoID := "error-id"
-		mockUC.EXPECT().Execute(mock.Anything, videoID).Return(nil, errors.New("db error")).Once()
+		mockUC.EXPECT().Execute(mock.Anything, progressInput(videoID)).Return(nil, errors.New("db error")).Once()
 		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 
-		r := mux.NewRouter()
-		r.HandleFunc("/videos/{id}/progress", progressHandler.VideoProgress)
-
-		server := httptest.NewServer(r)
-		defer server.Close()
-
-		wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/videos/" + videoID + "/progress"
-
 		// --- ACT ---
-		_, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		_, resp, err := dialProgress(t, progressHandler, mockLogger, videoID)
 
 		// --- ASSERT ---
 		require.Error(t, err)
@@ -142,18 +168,10 @@ func TestProgressHandler(t *testing.T) {
 		prgCh <- p
 		close(prgCh)
 
-		mockUC.EXPECT().Execute(mock.Anything, videoID).Return(prgCh, nil).Once()
-
-		r := mux.NewRouter()
-		r.HandleFunc("/videos/{id}/progress", progressHandler.VideoProgress)
-
-		server := httptest.NewServer(r)
-		defer server.Close()
-
-		wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/videos/" + videoID + "/progress"
+		mockUC.EXPECT().Execute(mock.Anything, progressInput(videoID)).Return(prgCh, nil).Once()
 
 		// --- ACT ---
-		conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		conn, _, err := dialProgress(t, progressHandler, mockLogger, videoID)
 		require.NoError(t, err)
 		defer conn.Close()
 
@@ -163,8 +181,8 @@ func TestProgressHandler(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, progress.StatusError, receivedP.Status)
 
-		// Verification that connection closes: the next read should return an error
+		// Verification that connection closes normally: the next read should return a close error
 		_, _, err = conn.ReadMessage()
-		require.Error(t, err)
+		require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
 	})
 }
@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/application/progressapp"
+)
+
+// maxSubscriptions caps the targets a single connection may follow
+const maxSubscriptions = 200
+
+type SubscriptionHandler struct {
+	openSubscriptionUC progressapp.OpenProgressSubscriptionUsecase
+	resolveProgressUC  progressapp.ResolveProgressUsecase
+	logger             log.Logger
+}
+
+func NewSubscriptionHandler(
+	openSubscriptionUC progressapp.OpenProgressSubscriptionUsecase,
+	resolveProgressUC progressapp.ResolveProgressUsecase,
+	logger log.Logger,
+) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		openSubscriptionUC: openSubscriptionUC,
+		resolveProgressUC:  resolveProgressUC,
+		logger:             logger,
+	}
+}
+
+// Subscribe streams the progress of many videos or jobs over one connection.
+// Clients send subscribe and unsubscribe requests, each authorized on its own,
+// and receive progress messages tagged with the target they subscribed to.
+// It must be chained after the Auth middleware.
+func (h *SubscriptionHandler) Subscribe(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		h.logger.Errorf(r.Context(), log.CategoryAuth, "", "no claims in context")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	sub, err := h.openSubscriptionUC.Execute(r.Context())
+	if err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryDefault, "", "execute open progress subscription usecase: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	defer sub.Close()
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.logger.Errorf(r.Context(), log.CategoryDefault, "", "upgrade to websocket connection: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	// The connection is done once the client stops reading or goes away
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	targets := newSubscriptionTargets()
+	replies := make(chan SubscriptionMessage)
+	go func() {
+		defer cancel()
+		h.readRequests(ctx, conn, sub, targets, claims, replies)
+	}()
+
+	// Only this loop writes to the connection
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-replies:
+			if err := conn.WriteJSON(msg); err != nil {
+				h.logger.Errorf(ctx, log.CategoryDefault, "", "write json to websocket connection: %v", err)
+				return
+			}
+		case jp, ok := <-sub.Progress():
+			if !ok { // subscription closed
+				return
+			}
+
+			for _, t := range targets.targets(jp.JobID) {
+				msg := SubscriptionMessage{
+					Type:     MessageProgress,
+					Target:   string(t.Target),
+					ID:       t.ID,
+					JobID:    jp.JobID,
+					Progress: jp.Progress,
+				}
+				if err := conn.WriteJSON(msg); err != nil {
+					h.logger.Errorf(ctx, log.CategoryDefault, "", "write json to websocket connection: %v", err)
+					return
+				}
+			}
+
+			// The subscription no longer follows a finished job
+			if jp.Progress.IsFinished() {
+				targets.removeJob(jp.JobID)
+			}
+		}
+	}
+}
+
+// readRequests applies the requests of the client until the connection is closed
+func (h *SubscriptionHandler) readRequests(
+	ctx context.Context,
+	conn *websocket.Conn,
+	sub progressstream.Subscription,
+	targets *subscriptionTargets,
+	claims *token.AccessClaims,
+	replies chan<- SubscriptionMessage,
+) {
+	reply := func(msg SubscriptionMessage) bool {
+		select {
+		case replies <- msg:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for {
+		var req SubscriptionRequest
+		if err := conn.ReadJSON(&req); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				h.logger.Errorf(ctx, log.CategoryDefault, "", "read json from websocket connection: %v", err)
+			}
+			return
+		}
+
+		var msg SubscriptionMessage
+		switch req.Type {
+		case MessageSubscribe:
+			msg = h.subscribe(ctx, sub, targets, claims, req, reply)
+		case MessageUnsubscribe:
+			msg = h.unsubscribe(sub, targets, req)
+		default:
+			msg = errorMessage(req, "unknown message type")
+		}
+
+		if msg.Type != "" && !reply(msg) {
+			return
+		}
+	}
+}
+
+// subscribe starts following a target, returning the message to reply with.
+// The acknowledgement is sent before following, so it comes before the first progress.
+func (h *SubscriptionHandler) subscribe(
+	ctx context.Context,
+	sub progressstream.Subscription,
+	targets *subscriptionTargets,
+	claims *token.AccessClaims,
+	req SubscriptionRequest,
+	reply func(SubscriptionMessage) bool,
+) SubscriptionMessage {
+	target := subscriptionTarget{Target: progressapp.ProgressTarget(req.Target), ID: req.ID}
+	if req.ID == "" {
+		return errorMessage(req, "id is required")
+	}
+	if targets.has(target) {
+		return SubscriptionMessage{Type: MessageSubscribed, Target: req.Target, ID: req.ID}
+	}
+	if targets.len() >= maxSubscriptions {
+		return errorMessage(req, "too many subscriptions")
+	}
+
+	res, err := h.resolveProgressUC.Execute(ctx, progressapp.ResolveProgressInput{
+		Target:      target.Target,
+		ID:          req.ID,
+		UserID:      claims.UserID,
+		Permissions: claims.Permissions,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return errorMessage(req, "not found")
+		case errors.Is(err, progressapp.ErrProgressForbidden):
+			return errorMessage(req, "forbidden")
+		case errors.Is(err, progressapp.ErrUnknownTarget):
+			return errorMessage(req, "unknown target")
+		default:
+			h.logger.Errorf(ctx, log.CategoryDefault, "", "execute resolve progress usecase: %v", err)
+			return errorMessage(req, "internal error")
+		}
+	}
+
+	subscribed := SubscriptionMessage{Type: MessageSubscribed, Target: req.Target, ID: req.ID, JobID: res.JobID}
+
+	// A job that no longer runs only has its final state to send
+	if res.Finished != nil {
+		if !reply(subscribed) {
+			return SubscriptionMessage{}
+		}
+		return SubscriptionMessage{
+			Type:     MessageProgress,
+			Target:   req.Target,
+			ID:       req.ID,
+			JobID:    res.JobID,
+			Progress: res.Finished,
+		}
+	}
+
+	targets.add(target, res.JobID)
+	if !reply(subscribed) {
+		return SubscriptionMessage{}
+	}
+
+	if err := sub.Follow(ctx, res.JobID); err != nil {
+		if ctx.Err() == nil {
+			h.logger.Errorf(ctx, log.CategoryDefault, "", "follow progress of job %s: %v", res.JobID, err)
+		}
+		h.forget(sub, targets, target)
+		return errorMessage(req, "internal error")
+	}
+
+	return SubscriptionMessage{}
+}
+
+// unsubscribe stops following a target, returning the message to reply with
+func (h *SubscriptionHandler) unsubscribe(
+	sub progressstream.Subscription,
+	targets *subscriptionTargets,
+	req SubscriptionRequest,
+) SubscriptionMessage {
+	h.forget(sub, targets, subscriptionTarget{Target: progressapp.ProgressTarget(req.Target), ID: req.ID})
+
+	return SubscriptionMessage{Type: MessageUnsubscribed, Target: req.Target, ID: req.ID}
+}
+
+// forget removes a target, unfollowing its job once no other target uses it
+func (h *SubscriptionHandler) forget(sub progressstream.Subscription, targets *subscriptionTargets, target subscriptionTarget) {
+	jobID, shared := targets.remove(target)
+	if jobID != "" && !shared {
+		sub.Unfollow(jobID)
+	}
+}
+
+func errorMessage(req SubscriptionRequest, reason string) SubscriptionMessage {
+	return SubscriptionMessage{Type: MessageError, Target: req.Target, ID: req.ID, Error: reason}
+}
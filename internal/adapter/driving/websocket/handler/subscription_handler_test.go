@@ -0,0 +1,209 @@
+package handler_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/memorydenylist"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/http/middleware"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/websocket/handler"
+	apikeyappmocks "github.com/st-ember/streaming-api/internal/application/apikeyapp/mocks"
+	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+	streamermocks "github.com/st-ember/streaming-api/internal/application/ports/progressstream/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	tokenmocks "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
+	"github.com/st-ember/streaming-api/internal/application/progressapp"
+	progressappmocks "github.com/st-ember/streaming-api/internal/application/progressapp/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// dialSubscription serves the handler behind the Auth middleware and connects to it
+func dialSubscription(t *testing.T, h *handler.SubscriptionHandler, logger *logmocks.MockLogger) *websocket.Conn {
+	t.Helper()
+
+	mockToken := tokenmocks.NewMockToken(t)
+	mockToken.EXPECT().ParseAccess("valid-token").Return(&tokenport.AccessClaims{UserID: "user-id"}, nil).Once()
+
+	server := httptest.NewServer(middleware.Auth(mockToken, memorydenylist.NewMemoryDenylist(), apikeyappmocks.NewMockAuthenticateAPIKeyUsecase(t), logger)(http.HandlerFunc(h.Subscribe)))
+	t.Cleanup(server.Close)
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "?token=valid-token"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(t, err)
+
+	return conn
+}
+
+// expectClose expects the subscription to be closed, returning a function
+// closing the client connection and waiting for it
+func expectClose(t *testing.T, sub *streamermocks.MockSubscription) func(conn *websocket.Conn) {
+	t.Helper()
+
+	closed := make(chan struct{})
+	sub.EXPECT().Close().Run(func() { close(closed) }).Return(nil).Once()
+
+	return func(conn *websocket.Conn) {
+		conn.Close()
+		select {
+		case <-closed:
+		case <-time.After(time.Second):
+			t.Fatal("subscription was not closed")
+		}
+	}
+}
+
+func TestSubscriptionHandler(t *testing.T) {
+	t.Run("should tag progress with the subscribed video", func(t *testing.T) {
+		t.Parallel()
+
+		// --- ARRANGE ---
+		mockOpenUC := progressappmocks.NewMockOpenProgressSubscriptionUsecase(t)
+		mockResolveUC := progressappmocks.NewMockResolveProgressUsecase(t)
+		mockSub := streamermocks.NewMockSubscription(t)
+		mockLogger := logmocks.NewMockLogger(t)
+		h := handler.NewSubscriptionHandler(mockOpenUC, mockResolveUC, mockLogger)
+
+		prg, _ := progress.NewProgress(100)
+		_ = prg.UpdateCurrentFrames(40)
+		prgCh := make(chan progressstream.JobProgress, 1)
+
+		mockOpenUC.EXPECT().Execute(mock.Anything).Return(mockSub, nil).Once()
+		mockSub.EXPECT().Progress().Return(prgCh)
+		mockResolveUC.EXPECT().Execute(mock.Anything, progressapp.ResolveProgressInput{
+			Target: progressapp.TargetVideo,
+			ID:     "video-id",
+			UserID: "user-id",
+		}).Return(&progressapp.ResolveProgressResult{JobID: "job-id", VideoID: "video-id"}, nil).Once()
+		mockSub.EXPECT().Follow(mock.Anything, "job-id").Run(func(_ context.Context, jobID string) {
+			prgCh <- progressstream.JobProgress{JobID: jobID, Progress: prg}
+		}).Return(nil).Once()
+		unfollowed := make(chan struct{})
+		mockSub.EXPECT().Unfollow("job-id").Run(func(string) { close(unfollowed) }).Once()
+		closeConn := expectClose(t, mockSub)
+		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		conn := dialSubscription(t, h, mockLogger)
+		defer closeConn(conn)
+
+		// --- ACT ---
+		require.NoError(t, conn.WriteJSON(handler.SubscriptionRequest{Type: handler.MessageSubscribe, Target: "video", ID: "video-id"}))
+
+		// --- ASSERT ---
+		var msg handler.SubscriptionMessage
+		require.NoError(t, conn.ReadJSON(&msg))
+		require.Equal(t, handler.SubscriptionMessage{Type: handler.MessageSubscribed, Target: "video", ID: "video-id", JobID: "job-id"}, msg)
+
+		msg = handler.SubscriptionMessage{}
+		require.NoError(t, conn.ReadJSON(&msg))
+		require.Equal(t, handler.MessageProgress, msg.Type)
+		require.Equal(t, "video-id", msg.ID)
+		require.Equal(t, 40, msg.Progress.Percentage)
+
+		require.NoError(t, conn.WriteJSON(handler.SubscriptionRequest{Type: handler.MessageUnsubscribe, Target: "video", ID: "video-id"}))
+		msg = handler.SubscriptionMessage{}
+		require.NoError(t, conn.ReadJSON(&msg))
+		require.Equal(t, handler.MessageUnsubscribed, msg.Type)
+		<-unfollowed
+	})
+
+	t.Run("should report subscriptions that are not allowed", func(t *testing.T) {
+		t.Parallel()
+
+		// --- ARRANGE ---
+		mockOpenUC := progressappmocks.NewMockOpenProgressSubscriptionUsecase(t)
+		mockResolveUC := progressappmocks.NewMockResolveProgressUsecase(t)
+		mockSub := streamermocks.NewMockSubscription(t)
+		mockLogger := logmocks.NewMockLogger(t)
+		h := handler.NewSubscriptionHandler(mockOpenUC, mockResolveUC, mockLogger)
+
+		mockOpenUC.EXPECT().Execute(mock.Anything).Return(mockSub, nil).Once()
+		mockSub.EXPECT().Progress().Return(make(chan progressstream.JobProgress))
+		mockResolveUC.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(in progressapp.ResolveProgressInput) bool {
+			return in.Target == progressapp.TargetJob
+		})).Return(nil, progressapp.ErrProgressForbidden).Once()
+		mockResolveUC.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(in progressapp.ResolveProgressInput) bool {
+			return in.Target == progressapp.TargetVideo
+		})).Return(nil, sql.ErrNoRows).Once()
+		closeConn := expectClose(t, mockSub)
+		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		conn := dialSubscription(t, h, mockLogger)
+		defer closeConn(conn)
+
+		// --- ACT & ASSERT ---
+		require.NoError(t, conn.WriteJSON(handler.SubscriptionRequest{Type: handler.MessageSubscribe, Target: "job", ID: "job-id"}))
+		var msg handler.SubscriptionMessage
+		require.NoError(t, conn.ReadJSON(&msg))
+		require.Equal(t, handler.SubscriptionMessage{Type: handler.MessageError, Target: "job", ID: "job-id", Error: "forbidden"}, msg)
+
+		require.NoError(t, conn.WriteJSON(handler.SubscriptionRequest{Type: handler.MessageSubscribe, Target: "video", ID: "missing"}))
+		msg = handler.SubscriptionMessage{}
+		require.NoError(t, conn.ReadJSON(&msg))
+		require.Equal(t, handler.SubscriptionMessage{Type: handler.MessageError, Target: "video", ID: "missing", Error: "not found"}, msg)
+	})
+
+	t.Run("should send the final state of a finished job without following it", func(t *testing.T) {
+		t.Parallel()
+
+		// --- ARRANGE ---
+		mockOpenUC := progressappmocks.NewMockOpenProgressSubscriptionUsecase(t)
+		mockResolveUC := progressappmocks.NewMockResolveProgressUsecase(t)
+		mockSub := streamermocks.NewMockSubscription(t)
+		mockLogger := logmocks.NewMockLogger(t)
+		h := handler.NewSubscriptionHandler(mockOpenUC, mockResolveUC, mockLogger)
+
+		mockOpenUC.EXPECT().Execute(mock.Anything).Return(mockSub, nil).Once()
+		mockSub.EXPECT().Progress().Return(make(chan progressstream.JobProgress))
+		mockResolveUC.EXPECT().Execute(mock.Anything, mock.Anything).Return(&progressapp.ResolveProgressResult{
+			JobID:    "job-id",
+			VideoID:  "video-id",
+			Finished: progress.NewFinishedProgress(progress.StatusEnd),
+		}, nil).Once()
+		closeConn := expectClose(t, mockSub)
+		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		conn := dialSubscription(t, h, mockLogger)
+		defer closeConn(conn)
+
+		// --- ACT ---
+		require.NoError(t, conn.WriteJSON(handler.SubscriptionRequest{Type: handler.MessageSubscribe, Target: "video", ID: "video-id"}))
+
+		// --- ASSERT ---
+		var msg handler.SubscriptionMessage
+		require.NoError(t, conn.ReadJSON(&msg))
+		require.Equal(t, handler.MessageSubscribed, msg.Type)
+
+		msg = handler.SubscriptionMessage{}
+		require.NoError(t, conn.ReadJSON(&msg))
+		require.Equal(t, handler.MessageProgress, msg.Type)
+		require.Equal(t, progress.StatusEnd, msg.Progress.Status)
+	})
+
+	t.Run("should return 401 without claims", func(t *testing.T) {
+		t.Parallel()
+
+		// --- ARRANGE ---
+		mockOpenUC := progressappmocks.NewMockOpenProgressSubscriptionUsecase(t)
+		mockResolveUC := progressappmocks.NewMockResolveProgressUsecase(t)
+		mockLogger := logmocks.NewMockLogger(t)
+		h := handler.NewSubscriptionHandler(mockOpenUC, mockResolveUC, mockLogger)
+
+		mockLogger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, "no claims in context").Once()
+
+		// --- ACT ---
+		rr := httptest.NewRecorder()
+		h.Subscribe(rr, httptest.NewRequest(http.MethodGet, "/progress/subscribe", nil))
+
+		// --- ASSERT ---
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
@@ -0,0 +1,30 @@
+package handler
+
+import "github.com/st-ember/streaming-api/internal/domain/progress"
+
+// Types of the messages exchanged on a progress subscription
+const (
+	MessageSubscribe    = "subscribe"
+	MessageUnsubscribe  = "unsubscribe"
+	MessageSubscribed   = "subscribed"
+	MessageUnsubscribed = "unsubscribed"
+	MessageProgress     = "progress"
+	MessageError        = "error"
+)
+
+// SubscriptionRequest is sent by clients to change the progress they follow
+type SubscriptionRequest struct {
+	Type   string `json:"type"`
+	Target string `json:"target"`
+	ID     string `json:"id"`
+}
+
+// SubscriptionMessage is sent to clients, tagged with the target it is about
+type SubscriptionMessage struct {
+	Type     string             `json:"type"`
+	Target   string             `json:"target"`
+	ID       string             `json:"id"`
+	JobID    string             `json:"job_id,omitempty"`
+	Progress *progress.Progress `json:"progress,omitempty"`
+	Error    string             `json:"error,omitempty"`
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"sync"
+
+	"github.com/st-ember/streaming-api/internal/application/progressapp"
+)
+
+// subscriptionTarget is a video or job a client subscribed to
+type subscriptionTarget struct {
+	Target progressapp.ProgressTarget
+	ID     string
+}
+
+// subscriptionTargets maps the targets of a connection to the jobs reporting their progress.
+// A video and one of its jobs may share a job, which is followed once.
+type subscriptionTargets struct {
+	mu   sync.Mutex
+	jobs map[subscriptionTarget]string
+}
+
+func newSubscriptionTargets() *subscriptionTargets {
+	return &subscriptionTargets{jobs: make(map[subscriptionTarget]string)}
+}
+
+func (t *subscriptionTargets) add(target subscriptionTarget, jobID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.jobs[target] = jobID
+}
+
+func (t *subscriptionTargets) has(target subscriptionTarget) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, ok := t.jobs[target]
+	return ok
+}
+
+func (t *subscriptionTargets) len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.jobs)
+}
+
+// remove forgets a target, reporting its job and whether another target still uses it
+func (t *subscriptionTargets) remove(target subscriptionTarget) (jobID string, shared bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	jobID, ok := t.jobs[target]
+	if !ok {
+		return "", false
+	}
+	delete(t.jobs, target)
+
+	for _, other := range t.jobs {
+		if other == jobID {
+			return jobID, true
+		}
+	}
+
+	return jobID, false
+}
+
+// targets lists the targets whose progress is reported by a job
+func (t *subscriptionTargets) targets(jobID string) []subscriptionTarget {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var targets []subscriptionTarget
+	for target, id := range t.jobs {
+		if id == jobID {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets
+}
+
+// removeJob forgets every target of a job
+func (t *subscriptionTargets) removeJob(jobID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for target, id := range t.jobs {
+		if id == jobID {
+			delete(t.jobs, target)
+		}
+	}
+}
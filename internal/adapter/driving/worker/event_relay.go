@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/eventapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// EventRelay polls the outbox and publishes pending events to the bus.
+// Several instances can run side by side, the events of a video
+// are still published one at a time and in order.
+type EventRelay struct {
+	relayUC      eventapp.RelayEventsUsecase
+	logger       log.Logger
+	pollInterval time.Duration
+	batchSize    int
+}
+
+func NewEventRelay(
+	relayUC eventapp.RelayEventsUsecase,
+	logger log.Logger,
+	pollInterval time.Duration,
+	batchSize int,
+) *EventRelay {
+	return &EventRelay{
+		relayUC,
+		logger,
+		pollInterval,
+		batchSize,
+	}
+}
+
+func (r *EventRelay) Run(ctx context.Context) {
+	r.logger.Infof(ctx, log.CategoryDefault, "", "event relay started")
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Infof(ctx, log.CategoryDefault, "", "event relay shutting down")
+			return
+		case <-ticker.C:
+			r.drain(ctx)
+		}
+	}
+}
+
+// drain publishes batches until the outbox has no more events ready
+func (r *EventRelay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		n, err := r.relayUC.Execute(ctx, r.batchSize)
+		if err != nil {
+			r.logger.Errorf(ctx, log.CategoryEvent, "", "relay events: %v", err)
+			return
+		}
+
+		if n < r.batchSize {
+			return
+		}
+	}
+}
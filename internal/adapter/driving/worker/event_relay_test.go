@@ -0,0 +1,91 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/worker"
+	mockevent "github.com/st-ember/streaming-api/internal/application/eventapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEventRelay_Run(t *testing.T) {
+	t.Run("should drain full batches then wait for the next tick", func(t *testing.T) {
+		relayUC := mockevent.NewMockRelayEventsUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		r := worker.NewEventRelay(relayUC, logger, 10*time.Millisecond, 2)
+
+		drained := make(chan struct{})
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "event relay started").Once()
+		relayUC.EXPECT().Execute(mock.Anything, 2).Return(2, nil).Twice()
+		relayUC.EXPECT().Execute(mock.Anything, 2).Run(func(context.Context, int) {
+			close(drained)
+		}).Return(1, nil).Once()
+		relayUC.EXPECT().Execute(mock.Anything, 2).Return(0, nil).Maybe()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "event relay shutting down").Maybe()
+
+		go r.Run(t.Context())
+
+		select {
+		case <-drained:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Outbox was not drained in time")
+		}
+	})
+
+	t.Run("should log error and continue when relaying fails", func(t *testing.T) {
+		relayUC := mockevent.NewMockRelayEventsUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		r := worker.NewEventRelay(relayUC, logger, 10*time.Millisecond, 2)
+
+		retried := make(chan struct{})
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "event relay started").Once()
+		relayUC.EXPECT().Execute(mock.Anything, 2).Return(0, errors.New("redis unavailable")).Once()
+		logger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, "relay events: %v", mock.Anything).Once()
+		relayUC.EXPECT().Execute(mock.Anything, 2).Run(func(context.Context, int) {
+			close(retried)
+		}).Return(0, nil).Once()
+		relayUC.EXPECT().Execute(mock.Anything, 2).Return(0, nil).Maybe()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "event relay shutting down").Maybe()
+
+		go r.Run(t.Context())
+
+		select {
+		case <-retried:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Relay did not retry on the next tick")
+		}
+	})
+
+	t.Run("should shut down gracefully on context cancellation", func(t *testing.T) {
+		relayUC := mockevent.NewMockRelayEventsUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		r := worker.NewEventRelay(relayUC, logger, 10*time.Millisecond, 2)
+
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "event relay started").Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "event relay shutting down").Once()
+		relayUC.EXPECT().Execute(mock.Anything, 2).Return(0, nil).Maybe()
+
+		done := make(chan struct{})
+		go func() {
+			r.Run(ctx)
+			close(done)
+		}()
+
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("EventRelay did not shut down in time")
+		}
+	})
+}
@@ -11,8 +11,14 @@ import (
 	"github.com/st-ember/streaming-api/internal/domain/job"
 )
 
+// JobScheduler claims runnable jobs for the workers of a node while the node has room for them.
+// A claimed job is running in Postgres, so no other node picks it up.
 type JobScheduler struct {
+	id           string
 	findNextUC   jobapp.FindNextPendingTranscodeJobUsecase
+	estimateUC   jobapp.EstimateJobCostUsecase
+	releaseUC    jobapp.ReleaseJobUsecase
+	budget       *ResourceBudget
 	logger       log.Logger
 	jobCh        chan *job.Job
 	pollInterval time.Duration
@@ -20,14 +26,22 @@ type JobScheduler struct {
 }
 
 func NewJobScheduler(
+	id string,
 	findNextUC jobapp.FindNextPendingTranscodeJobUsecase,
+	estimateUC jobapp.EstimateJobCostUsecase,
+	releaseUC jobapp.ReleaseJobUsecase,
+	budget *ResourceBudget,
 	logger log.Logger,
 	jobCh chan *job.Job,
 	pollInterval time.Duration,
 	workerLimit int,
 ) *JobScheduler {
 	return &JobScheduler{
+		id,
 		findNextUC,
+		estimateUC,
+		releaseUC,
+		budget,
 		logger,
 		jobCh,
 		pollInterval,
@@ -47,7 +61,7 @@ func (s *JobScheduler) Run(ctx context.Context) {
 			s.logger.Infof(ctx, log.CategoryDefault, "", "job scheduler shutting down")
 			return
 		case <-ticker.C:
-			job, err := s.findNextUC.Execute(ctx)
+			job, err := s.findNextUC.Execute(ctx, s.id)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
 					continue
@@ -59,13 +73,41 @@ func (s *JobScheduler) Run(ctx context.Context) {
 				continue
 			}
 
+			// Admit the job only while the node has room for it
+			cost := s.estimate(ctx, job)
+			if !s.budget.TryAcquire(job.ID, cost) {
+				s.release(ctx, job)
+				s.logger.Infof(ctx, log.CategoryJob, job.ID, "waiting for resources to run job %s, will try again in %v", job.ID, s.pollInterval)
+				continue
+			}
+
 			select {
-			// Send job
+			// Send job, the worker releases its resources once done
 			case s.jobCh <- job:
-				s.logger.Infof(ctx, log.CategoryJob, job.ID, "job %s is added to queue", job.ID)
+				s.logger.Infof(ctx, log.CategoryJob, job.ID, "job %s is added to queue (%.2f cpu, %d MB, %v of work)", job.ID, cost.CPU, cost.Memory>>20, cost.Work)
 			default: // Default case to make the scheduler more reactive for later adjustments
+				s.budget.Release(job.ID)
+				s.release(ctx, job)
 				s.logger.Infof(ctx, log.CategoryJob, job.ID, "job queue full now, will try again in %v seconds", s.pollInterval)
 			}
 		}
 	}
 }
+
+// estimate gets the cost of a job, assuming the worst when it cannot be estimated
+func (s *JobScheduler) estimate(ctx context.Context, j *job.Job) job.Cost {
+	cost, err := s.estimateUC.Execute(ctx, j)
+	if err != nil {
+		s.logger.Errorf(ctx, log.CategoryJob, j.ID, "estimate cost of job %s: %v", j.ID, err)
+		return s.budget.Whole()
+	}
+
+	return cost
+}
+
+// release puts a claimed job that this node cannot run now back in the queue
+func (s *JobScheduler) release(ctx context.Context, j *job.Job) {
+	if err := s.releaseUC.Execute(ctx, j); err != nil {
+		s.logger.Errorf(ctx, log.CategoryJob, j.ID, "release job %s: %v", j.ID, err)
+	}
+}
@@ -9,25 +9,30 @@ import (
 
 	"github.com/st-ember/streaming-api/internal/adapter/driving/worker"
 	mockjob "github.com/st-ember/streaming-api/internal/application/jobapp/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
 	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+const queuedFormat = "job %s is added to queue (%.2f cpu, %d MB, %v of work)"
+
 func TestJobScheduler_Run(t *testing.T) {
 	t.Run("should shut down gracefully on context cancellation", func(t *testing.T) {
 		findNextUC := mockjob.NewMockFindNextPendingTranscodeJobUsecase(t)
+		estimateUC := mockjob.NewMockEstimateJobCostUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
 		logger := mocklog.NewMockLogger(t)
 		jobCh := make(chan *job.Job, 1)
 
 		ctx, cancel := context.WithCancel(t.Context())
-		s := worker.NewJobScheduler(findNextUC, logger, jobCh, 10*time.Millisecond, 5)
+		s := worker.NewJobScheduler("node-1", findNextUC, estimateUC, releaseUC, worker.NewResourceBudget(4, 0), logger, jobCh, 10*time.Millisecond, 5)
 
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler started").Once()
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler shutting down").Once()
 
-		findNextUC.EXPECT().Execute(mock.Anything).Return(nil, nil).Maybe()
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(nil, nil).Maybe()
 
 		done := make(chan struct{})
 		go func() {
@@ -48,19 +53,22 @@ func TestJobScheduler_Run(t *testing.T) {
 
 	t.Run("should find and queue job", func(t *testing.T) {
 		findNextUC := mockjob.NewMockFindNextPendingTranscodeJobUsecase(t)
+		estimateUC := mockjob.NewMockEstimateJobCostUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
 		logger := mocklog.NewMockLogger(t)
 		jobCh := make(chan *job.Job, 1)
 
-		s := worker.NewJobScheduler(findNextUC, logger, jobCh, 10*time.Millisecond, 5)
+		s := worker.NewJobScheduler("node-1", findNextUC, estimateUC, releaseUC, worker.NewResourceBudget(4, 0), logger, jobCh, 10*time.Millisecond, 5)
 
 		testJob, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
 
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler started").Once()
-		findNextUC.EXPECT().Execute(mock.Anything).Return(testJob, nil).Once()
-		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job %s is added to queue", mock.Anything).Once()
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(testJob, nil).Once()
+		estimateUC.EXPECT().Execute(mock.Anything, testJob).Return(job.Cost{CPU: 2}, nil).Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, queuedFormat, mock.Anything).Once()
 
 		// Setup expectations for subsequent iterations to avoid noise or allow shutdown
-		findNextUC.EXPECT().Execute(mock.Anything).Return(nil, nil).Maybe()
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(nil, nil).Maybe()
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler shutting down").Maybe()
 
 		go s.Run(t.Context())
@@ -75,14 +83,16 @@ func TestJobScheduler_Run(t *testing.T) {
 
 	t.Run("should continue when no jobs are found", func(t *testing.T) {
 		findNextUC := mockjob.NewMockFindNextPendingTranscodeJobUsecase(t)
+		estimateUC := mockjob.NewMockEstimateJobCostUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
 		logger := mocklog.NewMockLogger(t)
 		jobCh := make(chan *job.Job, 1)
 
-		s := worker.NewJobScheduler(findNextUC, logger, jobCh, 10*time.Millisecond, 5)
+		s := worker.NewJobScheduler("node-1", findNextUC, estimateUC, releaseUC, worker.NewResourceBudget(4, 0), logger, jobCh, 10*time.Millisecond, 5)
 
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler started").Once()
-		findNextUC.EXPECT().Execute(mock.Anything).Return(nil, sql.ErrNoRows).Once()
-		findNextUC.EXPECT().Execute(mock.Anything).Return(nil, nil).Maybe()
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(nil, sql.ErrNoRows).Once()
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(nil, nil).Maybe()
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler shutting down").Maybe()
 
 		go s.Run(t.Context())
@@ -93,16 +103,18 @@ func TestJobScheduler_Run(t *testing.T) {
 
 	t.Run("should log error and continue when finding job fails", func(t *testing.T) {
 		findNextUC := mockjob.NewMockFindNextPendingTranscodeJobUsecase(t)
+		estimateUC := mockjob.NewMockEstimateJobCostUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
 		logger := mocklog.NewMockLogger(t)
 		jobCh := make(chan *job.Job, 1)
 
-		s := worker.NewJobScheduler(findNextUC, logger, jobCh, 10*time.Millisecond, 5)
+		s := worker.NewJobScheduler("node-1", findNextUC, estimateUC, releaseUC, worker.NewResourceBudget(4, 0), logger, jobCh, 10*time.Millisecond, 5)
 
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler started").Once()
-		findNextUC.EXPECT().Execute(mock.Anything).Return(nil, errors.New("db error")).Once()
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(nil, errors.New("db error")).Once()
 		logger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 
-		findNextUC.EXPECT().Execute(mock.Anything).Return(nil, nil).Maybe()
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(nil, nil).Maybe()
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler shutting down").Maybe()
 
 		go s.Run(t.Context())
@@ -110,12 +122,14 @@ func TestJobScheduler_Run(t *testing.T) {
 		time.Sleep(50 * time.Millisecond)
 	})
 
-	t.Run("should log when queue is full", func(t *testing.T) {
+	t.Run("should release the job when queue is full", func(t *testing.T) {
 		findNextUC := mockjob.NewMockFindNextPendingTranscodeJobUsecase(t)
+		estimateUC := mockjob.NewMockEstimateJobCostUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
 		logger := mocklog.NewMockLogger(t)
 		jobCh := make(chan *job.Job, 1)
 
-		s := worker.NewJobScheduler(findNextUC, logger, jobCh, 10*time.Millisecond, 5)
+		s := worker.NewJobScheduler("node-1", findNextUC, estimateUC, releaseUC, worker.NewResourceBudget(4, 0), logger, jobCh, 10*time.Millisecond, 5)
 
 		testJob1, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
 		testJob2, _ := job.NewJob("job-2", "video-1", job.TypeTranscode)
@@ -124,14 +138,102 @@ func TestJobScheduler_Run(t *testing.T) {
 		jobCh <- testJob1
 
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler started").Once()
-		findNextUC.EXPECT().Execute(mock.Anything).Return(testJob2, nil).Once()
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(testJob2, nil).Once()
+		estimateUC.EXPECT().Execute(mock.Anything, testJob2).Return(job.Cost{CPU: 2}, nil).Once()
+		releaseUC.EXPECT().Execute(mock.Anything, testJob2).Return(nil).Once()
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job queue full now, will try again in %v seconds", mock.Anything).Once()
 
-		findNextUC.EXPECT().Execute(mock.Anything).Return(nil, nil).Maybe()
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(nil, nil).Maybe()
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler shutting down").Maybe()
 
 		go s.Run(t.Context())
 
 		time.Sleep(50 * time.Millisecond)
 	})
+
+	t.Run("should wait for resources when the budget is used", func(t *testing.T) {
+		findNextUC := mockjob.NewMockFindNextPendingTranscodeJobUsecase(t)
+		estimateUC := mockjob.NewMockEstimateJobCostUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+		jobCh := make(chan *job.Job, 1)
+
+		// A running job already takes most of the budget
+		budget := worker.NewResourceBudget(4, 0)
+		require.True(t, budget.TryAcquire("running-job", job.Cost{CPU: 3}))
+
+		s := worker.NewJobScheduler("node-1", findNextUC, estimateUC, releaseUC, budget, logger, jobCh, 10*time.Millisecond, 5)
+
+		testJob, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
+
+		waited := make(chan struct{})
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler started").Once()
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(testJob, nil)
+		estimateUC.EXPECT().Execute(mock.Anything, testJob).Return(job.Cost{CPU: 2}, nil)
+		// The job goes back to the queue for any node with room for it
+		releaseUC.EXPECT().Execute(mock.Anything, testJob).Return(nil)
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "waiting for resources to run job %s, will try again in %v", mock.Anything).
+			Run(func(context.Context, log.LogCategory, string, string, ...any) {
+				select {
+				case <-waited:
+				default:
+					close(waited)
+				}
+			}).
+			Maybe()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, queuedFormat, mock.Anything).Maybe()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler shutting down").Maybe()
+
+		go s.Run(t.Context())
+
+		select {
+		case <-waited:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Job was not held back")
+		}
+		require.Equal(t, 0, len(jobCh))
+
+		// The job is admitted once the running one is done
+		budget.Release("running-job")
+
+		select {
+		case queuedJob := <-jobCh:
+			require.Equal(t, testJob.ID, queuedJob.ID)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Job was not queued in time")
+		}
+	})
+
+	t.Run("should assume the whole budget when estimating fails", func(t *testing.T) {
+		findNextUC := mockjob.NewMockFindNextPendingTranscodeJobUsecase(t)
+		estimateUC := mockjob.NewMockEstimateJobCostUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+		jobCh := make(chan *job.Job, 1)
+
+		budget := worker.NewResourceBudget(4, 0)
+		s := worker.NewJobScheduler("node-1", findNextUC, estimateUC, releaseUC, budget, logger, jobCh, 10*time.Millisecond, 5)
+
+		testJob, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
+
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler started").Once()
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(testJob, nil).Once()
+		estimateUC.EXPECT().Execute(mock.Anything, testJob).Return(job.Cost{}, errors.New("ffprobe failed")).Once()
+		logger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, "estimate cost of job %s: %v", mock.Anything).Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, queuedFormat, mock.Anything).Once()
+
+		findNextUC.EXPECT().Execute(mock.Anything, "node-1").Return(nil, nil).Maybe()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler shutting down").Maybe()
+
+		go s.Run(t.Context())
+
+		select {
+		case <-jobCh:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Job was not queued in time")
+		}
+
+		// Nothing else fits until the job is done
+		require.False(t, budget.TryAcquire("job-2", job.Cost{CPU: 0.5}))
+	})
 }
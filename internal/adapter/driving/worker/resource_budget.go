@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"sync"
+
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+// ResourceBudget admits jobs while their estimated cost fits
+// in the CPU and memory of the node.
+type ResourceBudget struct {
+	mu         sync.Mutex
+	cpu        float64 // Cores, 0 for no limit
+	memory     int64   // Bytes, 0 for no limit
+	usedCPU    float64
+	usedMemory int64
+	held       map[string]job.Cost
+}
+
+func NewResourceBudget(cpu float64, memory int64) *ResourceBudget {
+	return &ResourceBudget{
+		cpu:    cpu,
+		memory: memory,
+		held:   make(map[string]job.Cost),
+	}
+}
+
+// TryAcquire reserves the cost of a job if it fits in what is left of the budget.
+// A job larger than the whole budget is admitted once nothing else runs,
+// so that it is never starved. A job already holding a reservation is not admitted again.
+func (b *ResourceBudget) TryAcquire(jobID string, cost job.Cost) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.held[jobID]; ok {
+		return false
+	}
+
+	fitsCPU := b.cpu == 0 || b.usedCPU+cost.CPU <= b.cpu
+	fitsMemory := b.memory == 0 || b.usedMemory+cost.Memory <= b.memory
+	if !(fitsCPU && fitsMemory) && len(b.held) > 0 {
+		return false
+	}
+
+	b.usedCPU += cost.CPU
+	b.usedMemory += cost.Memory
+	b.held[jobID] = cost
+
+	return true
+}
+
+// Release frees the cost reserved for a job
+func (b *ResourceBudget) Release(jobID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cost, ok := b.held[jobID]
+	if !ok {
+		return
+	}
+
+	b.usedCPU -= cost.CPU
+	b.usedMemory -= cost.Memory
+	delete(b.held, jobID)
+}
+
+// Whole returns the cost of a job taking the entire budget
+func (b *ResourceBudget) Whole() job.Cost {
+	return job.Cost{CPU: b.cpu, Memory: b.memory}
+}
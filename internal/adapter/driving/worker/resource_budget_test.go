@@ -0,0 +1,46 @@
+package worker_test
+
+import (
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/worker"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceBudget(t *testing.T) {
+	t.Run("should admit jobs while they fit", func(t *testing.T) {
+		b := worker.NewResourceBudget(4, 1<<30)
+
+		require.True(t, b.TryAcquire("job-1", job.Cost{CPU: 2, Memory: 512 << 20}))
+		require.True(t, b.TryAcquire("job-2", job.Cost{CPU: 1, Memory: 256 << 20}))
+
+		// Out of memory, though CPU is left
+		require.False(t, b.TryAcquire("job-3", job.Cost{CPU: 1, Memory: 512 << 20}))
+
+		b.Release("job-1")
+		require.True(t, b.TryAcquire("job-3", job.Cost{CPU: 1, Memory: 512 << 20}))
+	})
+
+	t.Run("should admit a job larger than the budget once idle", func(t *testing.T) {
+		b := worker.NewResourceBudget(2, 0)
+
+		require.True(t, b.TryAcquire("job-1", job.Cost{CPU: 1}))
+		require.False(t, b.TryAcquire("uhd-job", job.Cost{CPU: 8}))
+
+		b.Release("job-1")
+		require.True(t, b.TryAcquire("uhd-job", job.Cost{CPU: 8}))
+		require.False(t, b.TryAcquire("job-2", job.Cost{CPU: 0.5}))
+	})
+
+	t.Run("should not count a job twice", func(t *testing.T) {
+		b := worker.NewResourceBudget(2, 0)
+
+		require.True(t, b.TryAcquire("job-1", job.Cost{CPU: 1.5}))
+		require.False(t, b.TryAcquire("job-1", job.Cost{CPU: 1.5}))
+
+		b.Release("job-1")
+		b.Release("job-1")
+		require.True(t, b.TryAcquire("job-2", job.Cost{CPU: 2}))
+	})
+}
@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+)
+
+// SigningKeySyncer keeps the signing keys of an api node up to date,
+// rotating them when due. The interval must stay well below authapp.SigningKeyPropagation,
+// so a node learns a new key before any other node signs with it.
+type SigningKeySyncer struct {
+	syncUC   authapp.SyncSigningKeysUsecase
+	logger   log.Logger
+	interval time.Duration
+}
+
+func NewSigningKeySyncer(
+	syncUC authapp.SyncSigningKeysUsecase,
+	logger log.Logger,
+	interval time.Duration,
+) *SigningKeySyncer {
+	return &SigningKeySyncer{
+		syncUC,
+		logger,
+		interval,
+	}
+}
+
+func (s *SigningKeySyncer) Run(ctx context.Context) {
+	s.logger.Infof(ctx, log.CategoryDefault, "", "signing key syncer started")
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Infof(ctx, log.CategoryDefault, "", "signing key syncer shutting down")
+			return
+		case <-ticker.C:
+			// Keys loaded before keep working, the next tick tries again
+			if err := s.syncUC.Execute(ctx); err != nil {
+				s.logger.Errorf(ctx, log.CategoryAuth, "", "sync signing keys: %v", err)
+			}
+		}
+	}
+}
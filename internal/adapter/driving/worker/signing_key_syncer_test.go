@@ -0,0 +1,40 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/worker"
+	mockauth "github.com/st-ember/streaming-api/internal/application/authapp/mocks"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSigningKeySyncer_Run(t *testing.T) {
+	t.Run("should log error and sync again on the next tick", func(t *testing.T) {
+		syncUC := mockauth.NewMockSyncSigningKeysUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		s := worker.NewSigningKeySyncer(syncUC, logger, 10*time.Millisecond)
+
+		retried := make(chan struct{})
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "signing key syncer started").Once()
+		syncUC.EXPECT().Execute(mock.Anything).Return(errors.New("db unavailable")).Once()
+		logger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, "sync signing keys: %v", mock.Anything).Once()
+		syncUC.EXPECT().Execute(mock.Anything).Run(func(context.Context) {
+			close(retried)
+		}).Return(nil).Once()
+		syncUC.EXPECT().Execute(mock.Anything).Return(nil).Maybe()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "signing key syncer shutting down").Maybe()
+
+		go s.Run(t.Context())
+
+		select {
+		case <-retried:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Keys were not synced again in time")
+		}
+	})
+}
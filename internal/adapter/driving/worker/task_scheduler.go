@@ -0,0 +1,199 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+// TaskHandler runs the scheduled tasks of one kind
+type TaskHandler interface {
+	Handle(ctx context.Context, task *schedule.Task) error
+}
+
+// TaskHandlerFunc adapts a function to a TaskHandler
+type TaskHandlerFunc func(ctx context.Context, task *schedule.Task) error
+
+func (f TaskHandlerFunc) Handle(ctx context.Context, task *schedule.Task) error {
+	return f(ctx, task)
+}
+
+// TaskScheduler polls Postgres for due tasks and runs them with the handler
+// registered for their kind. Several instances can run side by side,
+// each due task is claimed by only one of them.
+// Tasks are claimed one at a time right before they run, and the lease of a run
+// is renewed while it lasts, so only runs of an instance that stopped are claimed again.
+type TaskScheduler struct {
+	claimUC      scheduleapp.ClaimDueTasksUsecase
+	renewUC      scheduleapp.RenewTaskLeaseUsecase
+	completeUC   scheduleapp.CompleteTaskUsecase
+	failUC       scheduleapp.FailTaskUsecase
+	ensureUC     scheduleapp.EnsureRecurringTaskUsecase
+	logger       log.Logger
+	handlers     map[schedule.TaskKind]TaskHandler
+	recurring    map[schedule.TaskKind]string
+	pollInterval time.Duration
+	lease        time.Duration
+	batchSize    int
+}
+
+func NewTaskScheduler(
+	claimUC scheduleapp.ClaimDueTasksUsecase,
+	renewUC scheduleapp.RenewTaskLeaseUsecase,
+	completeUC scheduleapp.CompleteTaskUsecase,
+	failUC scheduleapp.FailTaskUsecase,
+	ensureUC scheduleapp.EnsureRecurringTaskUsecase,
+	logger log.Logger,
+	pollInterval time.Duration,
+	lease time.Duration,
+	batchSize int,
+) *TaskScheduler {
+	return &TaskScheduler{
+		claimUC,
+		renewUC,
+		completeUC,
+		failUC,
+		ensureUC,
+		logger,
+		map[schedule.TaskKind]TaskHandler{},
+		map[schedule.TaskKind]string{},
+		pollInterval,
+		lease,
+		batchSize,
+	}
+}
+
+// Handle registers the handler of a task kind, it must be called before Run
+func (s *TaskScheduler) Handle(kind schedule.TaskKind, handler TaskHandler) {
+	s.handlers[kind] = handler
+}
+
+// HandleRecurring registers the handler of a task kind run on the cron expression,
+// Run schedules the task if it does not exist yet. It must be called before Run.
+func (s *TaskScheduler) HandleRecurring(kind schedule.TaskKind, cron string, handler TaskHandler) {
+	s.handlers[kind] = handler
+	s.recurring[kind] = cron
+}
+
+func (s *TaskScheduler) Run(ctx context.Context) {
+	s.logger.Infof(ctx, log.CategoryDefault, "", "task scheduler started")
+
+	for kind, cron := range s.recurring {
+		if _, err := s.ensureUC.Execute(ctx, kind, cron); err != nil {
+			s.logger.Errorf(ctx, log.CategoryDefault, "", "schedule recurring %s task: %v", kind, err)
+		}
+	}
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Infof(ctx, log.CategoryDefault, "", "task scheduler shutting down")
+			return
+		case <-ticker.C:
+			s.runDue(ctx)
+		}
+	}
+}
+
+// runDue runs up to batchSize due tasks. Each task is claimed right before it runs,
+// so none waits behind the others while its lease runs out.
+func (s *TaskScheduler) runDue(ctx context.Context) {
+	for range s.batchSize {
+		if ctx.Err() != nil {
+			return
+		}
+
+		ts, err := s.claimUC.Execute(ctx, time.Now().UTC(), 1)
+		if err != nil {
+			s.logger.Errorf(ctx, log.CategoryDefault, "", "claim due tasks: %v", err)
+			return
+		}
+		if len(ts) == 0 {
+			return
+		}
+
+		s.run(ctx, ts[0])
+	}
+}
+
+// run executes a claimed task and records the outcome
+func (s *TaskScheduler) run(ctx context.Context, t *schedule.Task) {
+	var err error
+	if handler, ok := s.handlers[t.Kind]; ok {
+		err = s.handle(ctx, handler, t)
+	} else {
+		err = fmt.Errorf("no handler registered for task kind %s", t.Kind)
+	}
+
+	if err != nil {
+		s.logger.Errorf(ctx, log.CategoryTask, t.ID, "run task %s: %v", t.ID, err)
+		if err := s.failUC.Execute(ctx, t, err.Error()); err != nil {
+			s.logger.Errorf(ctx, log.CategoryTask, t.ID, "mark task %s as failed: %v", t.ID, err)
+		}
+		return
+	}
+
+	if err := s.completeUC.Execute(ctx, t); err != nil {
+		s.logger.Errorf(ctx, log.CategoryTask, t.ID, "complete task %s: %v", t.ID, err)
+		return
+	}
+
+	s.logger.Infof(ctx, log.CategoryTask, t.ID, "completed task %s", t.ID)
+}
+
+// handle runs the handler while renewing the lease of the run.
+// The handler is interrupted when another instance claimed the task meanwhile.
+func (s *TaskScheduler) handle(ctx context.Context, handler TaskHandler, t *schedule.Task) error {
+	handleCtx, interrupt := context.WithCancel(ctx)
+	defer interrupt()
+
+	leaseCtx, stopLease := context.WithCancel(ctx)
+	leaseDone := make(chan struct{})
+	go func() {
+		defer close(leaseDone)
+		s.keepLease(leaseCtx, t, interrupt)
+	}()
+
+	err := handler.Handle(handleCtx, t)
+
+	// The outcome is recorded once the lease is no longer renewed
+	stopLease()
+	<-leaseDone
+
+	return err
+}
+
+// keepLease renews the lease of the run every third of it until ctx ends
+func (s *TaskScheduler) keepLease(ctx context.Context, t *schedule.Task, interrupt context.CancelFunc) {
+	if s.lease <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.lease / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.renewUC.Execute(ctx, t, time.Now().UTC())
+			if errors.Is(err, scheduleapp.ErrTaskNotClaimed) {
+				s.logger.Warnf(ctx, log.CategoryTask, t.ID, "task %s was claimed again, interrupting its run", t.ID)
+				interrupt()
+				return
+			}
+			if err != nil && ctx.Err() == nil {
+				s.logger.Errorf(ctx, log.CategoryTask, t.ID, "renew task %s lease: %v", t.ID, err)
+			}
+		}
+	}
+}
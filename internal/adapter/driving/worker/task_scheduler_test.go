@@ -0,0 +1,279 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/worker"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	mockschedule "github.com/st-ember/streaming-api/internal/application/scheduleapp/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// dueTask returns a one-off task claimed by the scheduler
+func dueTask(t *testing.T, kind schedule.TaskKind) *schedule.Task {
+	now := time.Now()
+	task, err := schedule.NewTask("task-1", kind, "video-1", now)
+	require.NoError(t, err)
+	require.NoError(t, task.Fire(now, time.Minute))
+	return task
+}
+
+func TestTaskScheduler_Run(t *testing.T) {
+	t.Run("should run claimed task with its handler", func(t *testing.T) {
+		claimUC := mockschedule.NewMockClaimDueTasksUsecase(t)
+		completeUC := mockschedule.NewMockCompleteTaskUsecase(t)
+		failUC := mockschedule.NewMockFailTaskUsecase(t)
+		renewUC := mockschedule.NewMockRenewTaskLeaseUsecase(t)
+		ensureUC := mockschedule.NewMockEnsureRecurringTaskUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		task := dueTask(t, "video.publish")
+		handled := make(chan string, 1)
+
+		s := worker.NewTaskScheduler(claimUC, renewUC, completeUC, failUC, ensureUC, logger, 10*time.Millisecond, time.Minute, 5)
+		s.Handle("video.publish", worker.TaskHandlerFunc(func(ctx context.Context, task *schedule.Task) error {
+			handled <- task.Payload
+			return nil
+		}))
+
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler started").Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).Return([]*schedule.Task{task}, nil).Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).Return(nil, nil).Maybe()
+		completed := make(chan struct{})
+		completeUC.EXPECT().Execute(mock.Anything, task).
+			Run(func(ctx context.Context, task *schedule.Task) { close(completed) }).
+			Return(nil).Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "completed task %s", mock.Anything).Maybe()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler shutting down").Maybe()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		go s.Run(ctx)
+
+		select {
+		case payload := <-handled:
+			require.Equal(t, "video-1", payload)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("task was not handled in time")
+		}
+
+		select {
+		case <-completed:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("task was not completed in time")
+		}
+	})
+
+	t.Run("should fail task without a registered handler", func(t *testing.T) {
+		claimUC := mockschedule.NewMockClaimDueTasksUsecase(t)
+		completeUC := mockschedule.NewMockCompleteTaskUsecase(t)
+		failUC := mockschedule.NewMockFailTaskUsecase(t)
+		renewUC := mockschedule.NewMockRenewTaskLeaseUsecase(t)
+		ensureUC := mockschedule.NewMockEnsureRecurringTaskUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		task := dueTask(t, "gc")
+		failed := make(chan string, 1)
+
+		s := worker.NewTaskScheduler(claimUC, renewUC, completeUC, failUC, ensureUC, logger, 10*time.Millisecond, time.Minute, 5)
+
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler started").Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).Return([]*schedule.Task{task}, nil).Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).Return(nil, nil).Maybe()
+		logger.EXPECT().Errorf(mock.Anything, mock.Anything, task.ID, "run task %s: %v", mock.Anything).Once()
+		failUC.EXPECT().Execute(mock.Anything, task, "no handler registered for task kind gc").
+			Run(func(ctx context.Context, task *schedule.Task, errMsg string) { failed <- errMsg }).
+			Return(nil).Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler shutting down").Maybe()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		go s.Run(ctx)
+
+		select {
+		case <-failed:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("task was not failed in time")
+		}
+	})
+
+	t.Run("should keep polling when claiming fails", func(t *testing.T) {
+		claimUC := mockschedule.NewMockClaimDueTasksUsecase(t)
+		completeUC := mockschedule.NewMockCompleteTaskUsecase(t)
+		failUC := mockschedule.NewMockFailTaskUsecase(t)
+		renewUC := mockschedule.NewMockRenewTaskLeaseUsecase(t)
+		ensureUC := mockschedule.NewMockEnsureRecurringTaskUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		s := worker.NewTaskScheduler(claimUC, renewUC, completeUC, failUC, ensureUC, logger, 10*time.Millisecond, time.Minute, 5)
+
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler started").Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).Return(nil, errors.New("db down")).Once()
+		logger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, "claim due tasks: %v", mock.Anything).Once()
+
+		polled := make(chan struct{})
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).
+			Run(func(ctx context.Context, now time.Time, limit int) { close(polled) }).
+			Return(nil, nil).Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).Return(nil, nil).Maybe()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler shutting down").Maybe()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		go s.Run(ctx)
+
+		select {
+		case <-polled:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("scheduler stopped polling after an error")
+		}
+	})
+	t.Run("should claim the next task once the previous one ran", func(t *testing.T) {
+		claimUC := mockschedule.NewMockClaimDueTasksUsecase(t)
+		completeUC := mockschedule.NewMockCompleteTaskUsecase(t)
+		failUC := mockschedule.NewMockFailTaskUsecase(t)
+		renewUC := mockschedule.NewMockRenewTaskLeaseUsecase(t)
+		ensureUC := mockschedule.NewMockEnsureRecurringTaskUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		first := dueTask(t, "video.publish")
+		second := dueTask(t, "video.publish")
+		second.ID = "task-2"
+		handled := make(chan string, 2)
+		var ran atomic.Int32
+
+		s := worker.NewTaskScheduler(claimUC, renewUC, completeUC, failUC, ensureUC, logger, 10*time.Millisecond, time.Minute, 5)
+		s.Handle("video.publish", worker.TaskHandlerFunc(func(ctx context.Context, task *schedule.Task) error {
+			ran.Add(1)
+			handled <- task.ID
+			return nil
+		}))
+
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler started").Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).Return([]*schedule.Task{first}, nil).Once()
+		// The second task is only claimed once the first one ran
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).
+			Run(func(ctx context.Context, now time.Time, limit int) { assert.EqualValues(t, 1, ran.Load()) }).
+			Return([]*schedule.Task{second}, nil).Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).Return(nil, nil).Maybe()
+		completed := make(chan string, 2)
+		completeUC.EXPECT().Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, task *schedule.Task) { completed <- task.ID }).
+			Return(nil).Twice()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "completed task %s", mock.Anything).Maybe()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler shutting down").Maybe()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		go s.Run(ctx)
+
+		for _, id := range []string{"task-1", "task-2"} {
+			select {
+			case handledID := <-handled:
+				require.Equal(t, id, handledID)
+			case <-time.After(500 * time.Millisecond):
+				t.Fatalf("%s was not handled in time", id)
+			}
+			select {
+			case completedID := <-completed:
+				require.Equal(t, id, completedID)
+			case <-time.After(500 * time.Millisecond):
+				t.Fatalf("%s was not completed in time", id)
+			}
+		}
+	})
+
+	t.Run("should renew the lease of a long run and interrupt it once claimed again", func(t *testing.T) {
+		claimUC := mockschedule.NewMockClaimDueTasksUsecase(t)
+		completeUC := mockschedule.NewMockCompleteTaskUsecase(t)
+		failUC := mockschedule.NewMockFailTaskUsecase(t)
+		renewUC := mockschedule.NewMockRenewTaskLeaseUsecase(t)
+		ensureUC := mockschedule.NewMockEnsureRecurringTaskUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		task := dueTask(t, "video.publish")
+		interrupted := make(chan struct{})
+
+		// A lease of 30ms is renewed every 10ms
+		s := worker.NewTaskScheduler(claimUC, renewUC, completeUC, failUC, ensureUC, logger, 10*time.Millisecond, 30*time.Millisecond, 5)
+		s.Handle("video.publish", worker.TaskHandlerFunc(func(ctx context.Context, task *schedule.Task) error {
+			<-ctx.Done()
+			close(interrupted)
+			return ctx.Err()
+		}))
+
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler started").Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).Return([]*schedule.Task{task}, nil).Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).Return(nil, nil).Maybe()
+		renewUC.EXPECT().Execute(mock.Anything, task, mock.Anything).Return(nil).Once()
+		renewUC.EXPECT().Execute(mock.Anything, task, mock.Anything).Return(scheduleapp.ErrTaskNotClaimed).Once()
+		logger.EXPECT().Warnf(mock.Anything, mock.Anything, task.ID, "task %s was claimed again, interrupting its run", mock.Anything).Once()
+		logger.EXPECT().Errorf(mock.Anything, mock.Anything, task.ID, "run task %s: %v", mock.Anything).Once()
+		// The other instance owns the task now, the outcome of this run is dropped
+		failUC.EXPECT().Execute(mock.Anything, task, mock.Anything).Return(scheduleapp.ErrTaskNotClaimed).Once()
+		dropped := make(chan struct{})
+		logger.EXPECT().Errorf(mock.Anything, mock.Anything, task.ID, "mark task %s as failed: %v", mock.Anything).
+			Run(func(ctx context.Context, category log.LogCategory, sourceID string, format string, args ...any) {
+				close(dropped)
+			}).
+			Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler shutting down").Maybe()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		go s.Run(ctx)
+
+		select {
+		case <-interrupted:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("run was not interrupted in time")
+		}
+
+		select {
+		case <-dropped:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("outcome of the run was not dropped in time")
+		}
+	})
+
+	t.Run("should schedule recurring tasks on start", func(t *testing.T) {
+		claimUC := mockschedule.NewMockClaimDueTasksUsecase(t)
+		completeUC := mockschedule.NewMockCompleteTaskUsecase(t)
+		failUC := mockschedule.NewMockFailTaskUsecase(t)
+		renewUC := mockschedule.NewMockRenewTaskLeaseUsecase(t)
+		ensureUC := mockschedule.NewMockEnsureRecurringTaskUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		s := worker.NewTaskScheduler(claimUC, renewUC, completeUC, failUC, ensureUC, logger, 10*time.Millisecond, time.Minute, 5)
+		s.HandleRecurring(schedule.KindCollectGarbage, "0 3 * * *", worker.TaskHandlerFunc(func(ctx context.Context, task *schedule.Task) error {
+			return nil
+		}))
+
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler started").Once()
+		ensured := make(chan struct{})
+		ensureUC.EXPECT().Execute(mock.Anything, schedule.KindCollectGarbage, "0 3 * * *").
+			Run(func(ctx context.Context, kind schedule.TaskKind, cron string) { close(ensured) }).
+			Return(nil, nil).Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 1).Return(nil, nil).Maybe()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "task scheduler shutting down").Maybe()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		go s.Run(ctx)
+
+		select {
+		case <-ensured:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("recurring task was not scheduled")
+		}
+	})
+}
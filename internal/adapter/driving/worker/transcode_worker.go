@@ -2,64 +2,110 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/st-ember/streaming-api/internal/application/jobapp"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
 	"github.com/st-ember/streaming-api/internal/application/ports/storage"
 	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
 	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
 )
 
 type TranscodeWorker struct {
-	startUC    jobapp.StartTranscodeJobUsecase
-	completeUC jobapp.CompleteTranscodeJobUsecase
-	failUC     jobapp.FailTranscodeJobUsecase
-	storer     storage.AssetStorer
-	logger     log.Logger
-	transcoder transcode.Transcoder
-	jobCh      chan *job.Job
+	startUC       jobapp.StartTranscodeJobUsecase
+	completeUC    jobapp.CompleteTranscodeJobUsecase
+	splitUC       jobapp.CompleteSplitJobUsecase
+	failUC        jobapp.FailTranscodeJobUsecase
+	releaseUC     jobapp.ReleaseJobUsecase
+	getJobUC      jobapp.GetJobUsecase
+	storer        storage.AssetStorer
+	logger        log.Logger
+	transcoder    transcode.Transcoder
+	streamer      progressstream.ProgressStreamer
+	budget        *ResourceBudget
+	jobCh         chan *job.Job
+	checkInterval time.Duration
 }
 
 func NewTranscodeWorker(
 	startUC jobapp.StartTranscodeJobUsecase,
 	completeUC jobapp.CompleteTranscodeJobUsecase,
+	splitUC jobapp.CompleteSplitJobUsecase,
 	failUC jobapp.FailTranscodeJobUsecase,
+	releaseUC jobapp.ReleaseJobUsecase,
+	getJobUC jobapp.GetJobUsecase,
 	storer storage.AssetStorer,
 	logger log.Logger,
 	transcoder transcode.Transcoder,
+	streamer progressstream.ProgressStreamer,
+	budget *ResourceBudget,
 	jobCh chan *job.Job,
+	checkInterval time.Duration,
 ) *TranscodeWorker {
 	return &TranscodeWorker{
 		startUC,
 		completeUC,
+		splitUC,
 		failUC,
+		releaseUC,
+		getJobUC,
 		storer,
 		logger,
 		transcoder,
+		streamer,
+		budget,
 		jobCh,
+		checkInterval,
 	}
 }
 
 func (w *TranscodeWorker) Start(ctx context.Context) {
 	for job := range w.jobCh {
 		func() {
+			// Resources were reserved by the scheduler when admitting the job
+			defer w.budget.Release(job.ID)
+
+			// Jobs still queued at shutdown are left to other nodes
+			if ctx.Err() != nil {
+				w.release(ctx, job)
+				return
+			}
+
 			resp, err := w.startUC.Execute(ctx, job)
 			if err != nil {
+				if errors.Is(err, jobapp.ErrJobCancelled) || errors.Is(err, jobapp.ErrJobNotClaimed) {
+					w.logger.Infof(ctx, log.CategoryJob, job.ID, "skipped job %s: %v", job.ID, err)
+					return
+				}
 				w.logger.Errorf(ctx, log.CategoryJob, job.ID, "start job %s: %v", job.ID, err)
+				w.release(ctx, job)
 				return
 			}
 
-			out, err := w.transcoder.Transcode(ctx, resp.ResourceID, resp.SourceFilename, job.ID)
+			// Per-job context, cancelled when the job is cancelled to kill ffmpeg
+			jobCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			go w.watchCancellation(jobCtx, job.ID, cancel)
+
+			out, err := w.execute(jobCtx, job, resp)
+			if w.isCancelled(ctx, jobCtx) {
+				w.logger.Infof(ctx, log.CategoryJob, job.ID, "cancelled job %s", job.ID)
+				return
+			}
 			if err != nil {
 				// Execute fail transcode job usecase
-				w.failUC.Execute(ctx, job, err.Error())
+				w.failUC.Execute(ctx, job, failureInput(err))
 				w.logger.Errorf(ctx, log.CategoryJob, job.ID, "transcode job %s: %v", job.ID, err)
 				return
 			}
 
-			tempOutputDir := filepath.Dir(out.ManifestPath)
+			tempOutputDir := out.OutputDir
 			// Clean up temporary directory on error or when job finishes
 			defer func() {
 				if err := os.RemoveAll(tempOutputDir); err != nil {
@@ -67,13 +113,16 @@ func (w *TranscodeWorker) Start(ctx context.Context) {
 				}
 			}()
 
-			// Move temp files from manifest path into permanent storage
-			for _, relativeFilePath := range out.OutputFiles {
+			// Move temp files from manifest path into permanent storage,
+			// reporting the store stage of the progress left by the transcoder
+			prg := out.Progress
+			for i, relativeFilePath := range out.OutputFiles {
 				fullTempPath := filepath.Join(tempOutputDir, relativeFilePath)
 				tempFile, err := os.Open(fullTempPath)
 				if err != nil {
 					w.logger.Errorf(ctx, "job %s: open temporary file %s for saving: %v", job.ID, fullTempPath, err)
-					w.failUC.Execute(ctx, job, "failed to read transcoded output")
+					w.failUC.Execute(ctx, job, jobapp.FailTranscodeJobInput{ErrorMsg: "failed to read transcoded output"})
+					w.pushProgress(ctx, job.ID, prg, prg.MarkAsError)
 					return
 				}
 
@@ -82,17 +131,31 @@ func (w *TranscodeWorker) Start(ctx context.Context) {
 				tempFile.Close()
 				if err != nil {
 					w.logger.Errorf(ctx, "job %s: save transcoded file %s to storage: %v", job.ID, relativeFilePath, err)
-					w.failUC.Execute(ctx, job, "failed to save transcoded output")
+					w.failUC.Execute(ctx, job, jobapp.FailTranscodeJobInput{ErrorMsg: "failed to save transcoded output"})
+					w.pushProgress(ctx, job.ID, prg, prg.MarkAsError)
 					return
 				}
+				w.pushProgress(ctx, job.ID, prg, func() error {
+					return prg.UpdateStage((i + 1) * 100 / len(out.OutputFiles))
+				})
 
 				// Log successful move
 				w.logger.Infof(ctx, log.CategoryJob, resp.ResourceID, "deleted and moved temp files to permanent storage for video %s", resp.ResourceID)
 			}
 
-			if err := w.completeUC.Execute(ctx, job, filepath.Base(out.ManifestPath), out.Duration); err != nil {
+			// Cancelled while moving files, leave the job as cancelled
+			if w.isCancelled(ctx, jobCtx) {
+				w.pushProgress(ctx, job.ID, prg, prg.Cancel)
+				w.logger.Infof(ctx, log.CategoryJob, job.ID, "cancelled job %s", job.ID)
+				return
+			}
+
+			finish := prg.End
+			if err := w.complete(ctx, job, resp, out); err != nil {
+				finish = prg.MarkAsError
 				w.logger.Errorf(ctx, log.CategoryJob, job.ID, "complete job %s: %v", job.ID, err)
 			}
+			w.pushProgress(ctx, job.ID, prg, finish)
 
 			// Log successful job completion
 			w.logger.Infof(ctx, log.CategoryJob, job.ID, "completed job %s", job.ID)
@@ -101,3 +164,143 @@ func (w *TranscodeWorker) Start(ctx context.Context) {
 
 	w.logger.Infof(ctx, log.CategoryDefault, "", "worker finished draining queue and is shutting down")
 }
+
+// release puts a claimed job back in the queue, also while the node shuts down
+func (w *TranscodeWorker) release(ctx context.Context, j *job.Job) {
+	if err := w.releaseUC.Execute(context.WithoutCancel(ctx), j); err != nil {
+		w.logger.Errorf(ctx, log.CategoryJob, j.ID, "release job %s: %v", j.ID, err)
+	}
+}
+
+// execute runs the pipeline step of the job
+func (w *TranscodeWorker) execute(
+	ctx context.Context,
+	j *job.Job,
+	resp *jobapp.StartTranscodeJobResult,
+) (*transcode.TranscodeOutput, error) {
+	switch j.Type {
+	case job.TypeProbe:
+		info, err := w.transcoder.Probe(ctx, resp.ResourceID, resp.SourceFilename)
+		if err != nil {
+			return nil, err
+		}
+		// Nothing to store, the probe only reports the source
+		return &transcode.TranscodeOutput{Duration: info.Duration}, nil
+	case job.TypeThumbnail:
+		return w.transcoder.Thumbnail(ctx, resp.ResourceID, resp.SourceFilename, j.ID)
+	case job.TypeSplit:
+		return w.transcoder.Split(ctx, resp.ResourceID, resp.SourceFilename, j.ID)
+	case job.TypeChunkEncode:
+		spec, err := j.ChunkSpec()
+		if err != nil {
+			return nil, err
+		}
+		return w.transcoder.EncodeChunk(ctx, resp.ResourceID, *spec, j.ID)
+	case job.TypeAssemble:
+		spec, err := j.ChunkSpec()
+		if err != nil {
+			return nil, err
+		}
+		return w.transcoder.Assemble(ctx, resp.ResourceID, resp.SourceFilename, *spec, j.ID)
+	case job.TypeTranscode:
+		return w.transcoder.Transcode(ctx, resp.ResourceID, resp.SourceFilename, j.ID)
+	default:
+		return nil, fmt.Errorf("no step runs jobs of type %q", j.Type)
+	}
+}
+
+// complete records the outcome of the job once its output is stored
+func (w *TranscodeWorker) complete(
+	ctx context.Context,
+	j *job.Job,
+	resp *jobapp.StartTranscodeJobResult,
+	out *transcode.TranscodeOutput,
+) error {
+	switch j.Type {
+	case job.TypeProbe:
+		return w.completeUC.Execute(ctx, j, "", out.Duration)
+	case job.TypeThumbnail:
+		return w.completeUC.Execute(ctx, j, transcode.ThumbnailFilename, 0)
+	case job.TypeSplit:
+		// Queues a job for every chunk
+		return w.splitUC.Execute(ctx, j, out.OutputFiles)
+	case job.TypeChunkEncode:
+		return w.completeUC.Execute(ctx, j, "", 0)
+	case job.TypeAssemble:
+		if err := w.completeUC.Execute(ctx, j, filepath.Base(out.ManifestPath), out.Duration); err != nil {
+			return err
+		}
+
+		// The chunks are no longer needed once the stream is packaged
+		if err := w.storer.Delete(ctx, resp.ResourceID, transcode.ChunksDir); err != nil {
+			w.logger.Errorf(ctx, log.CategoryJob, j.ID, "delete chunks of video %s: %v", resp.ResourceID, err)
+		}
+		return nil
+	default:
+		return w.completeUC.Execute(ctx, j, filepath.Base(out.ManifestPath), out.Duration)
+	}
+}
+
+// pushProgress applies update to the progress of the job and reports it,
+// doing nothing for jobs without a progress of their own
+func (w *TranscodeWorker) pushProgress(ctx context.Context, jobID string, prg *progress.Progress, update func() error) {
+	if prg == nil {
+		return
+	}
+
+	if err := update(); err != nil {
+		w.logger.Errorf(ctx, log.CategoryJob, jobID, "update progress: %v", err)
+		return
+	}
+
+	// Deliver the final state even when the worker is shutting down
+	if err := w.streamer.Push(context.WithoutCancel(ctx), jobID, prg); err != nil {
+		w.logger.Errorf(ctx, log.CategoryJob, jobID, "push progress %v", err)
+	}
+}
+
+// watchCancellation polls the job status and cancels the job context
+// once the job has been cancelled, which kills the running ffmpeg process.
+func (w *TranscodeWorker) watchCancellation(ctx context.Context, jobID string, cancel context.CancelFunc) {
+	ticker := time.NewTicker(w.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j, err := w.getJobUC.Execute(ctx, jobID)
+			if err != nil {
+				if ctx.Err() == nil {
+					w.logger.Errorf(ctx, log.CategoryJob, jobID, "check job %s status: %v", jobID, err)
+				}
+				continue
+			}
+
+			if j.IsCancelled() {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// failureInput keeps the details of a failed transcoding process for the job history
+func failureInput(err error) jobapp.FailTranscodeJobInput {
+	input := jobapp.FailTranscodeJobInput{ErrorMsg: err.Error()}
+
+	var processErr *transcode.ProcessError
+	if errors.As(err, &processErr) {
+		input.ExitCode = &processErr.ExitCode
+		input.StderrTail = processErr.StderrTail
+	}
+
+	return input
+}
+
+// isCancelled reports whether the job context was cancelled by the job itself
+// rather than by the worker shutting down.
+func (w *TranscodeWorker) isCancelled(ctx, jobCtx context.Context) bool {
+	return jobCtx.Err() != nil && ctx.Err() == nil
+}
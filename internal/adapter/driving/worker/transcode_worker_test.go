@@ -1,7 +1,9 @@
 package worker_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -11,25 +13,39 @@ import (
 	"github.com/st-ember/streaming-api/internal/application/jobapp"
 	mockjob "github.com/st-ember/streaming-api/internal/application/jobapp/mocks"
 	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	mockprogressstream "github.com/st-ember/streaming-api/internal/application/ports/progressstream/mocks"
 	mockstorage "github.com/st-ember/streaming-api/internal/application/ports/storage/mocks"
 	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
 	mocktranscode "github.com/st-ember/streaming-api/internal/application/ports/transcode/mocks"
 	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+// storeStageProgress is the progress a transcoder leaves at the store stage for the worker to report
+func storeStageProgress(stages ...progress.Stage) *progress.Progress {
+	prg := progress.NewStagedProgress(stages...)
+	_ = prg.StartStage(progress.StageStore)
+
+	return prg
+}
+
 func TestTranscodeWorker_Start(t *testing.T) {
 	t.Run("successful transcode workflow", func(t *testing.T) {
 		startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
 		completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+		splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
 		failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		getJobUC := mockjob.NewMockGetJobUsecase(t)
 		storer := mockstorage.NewMockAssetStorer(t)
 		logger := mocklog.NewMockLogger(t)
 		transcoder := mocktranscode.NewMockTranscoder(t)
+		streamer := mockprogressstream.NewMockProgressStreamer(t)
 		jobCh := make(chan *job.Job, 1)
 
-		w := worker.NewTranscodeWorker(startUC, completeUC, failUC, storer, logger, transcoder, jobCh)
+		w := worker.NewTranscodeWorker(startUC, completeUC, splitUC, failUC, releaseUC, getJobUC, storer, logger, transcoder, streamer, worker.NewResourceBudget(0, 0), jobCh, time.Hour)
 
 		testJob, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
 		resourceID := "res-1"
@@ -52,12 +68,24 @@ func TestTranscodeWorker_Start(t *testing.T) {
 		transcoder.EXPECT().Transcode(mock.Anything, resourceID, sourceFile, testJob.ID).Return(&transcode.TranscodeOutput{
 			Duration:     10 * time.Second,
 			ManifestPath: filepath.Join(tempDir, manifestName),
+			OutputDir:    tempDir,
 			OutputFiles:  []string{manifestName, segmentName},
+			Progress:     storeStageProgress(progress.TranscodeStages("480p", "720p")...),
 		}, nil)
 
 		storer.EXPECT().Save(mock.Anything, resourceID, manifestName, mock.Anything).Return(nil)
 		storer.EXPECT().Save(mock.Anything, resourceID, segmentName, mock.Anything).Return(nil)
 
+		// The store stage is reported per saved file, then the end of the job
+		for _, pct := range []int{50, 100} {
+			streamer.EXPECT().Push(mock.Anything, testJob.ID, mock.MatchedBy(func(p *progress.Progress) bool {
+				return p.Stage == progress.StageStore && p.Stages[4].Percentage == pct && p.Status == progress.StatusContinue
+			})).Return(nil).Once()
+		}
+		streamer.EXPECT().Push(mock.Anything, testJob.ID, mock.MatchedBy(func(p *progress.Progress) bool {
+			return p.Status == progress.StatusEnd && p.Percentage == 100
+		})).Return(nil).Once()
+
 		completeUC.EXPECT().Execute(mock.Anything, testJob, manifestName, 10*time.Second).Return(nil)
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
 
@@ -70,20 +98,25 @@ func TestTranscodeWorker_Start(t *testing.T) {
 		require.True(t, os.IsNotExist(err))
 	})
 
-	t.Run("should continue if starting job fails", func(t *testing.T) {
+	t.Run("should release the job and continue if starting job fails", func(t *testing.T) {
 		startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
 		completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+		splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
 		failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		getJobUC := mockjob.NewMockGetJobUsecase(t)
 		storer := mockstorage.NewMockAssetStorer(t)
 		logger := mocklog.NewMockLogger(t)
 		transcoder := mocktranscode.NewMockTranscoder(t)
+		streamer := mockprogressstream.NewMockProgressStreamer(t)
 		jobCh := make(chan *job.Job, 1)
 
-		w := worker.NewTranscodeWorker(startUC, completeUC, failUC, storer, logger, transcoder, jobCh)
+		w := worker.NewTranscodeWorker(startUC, completeUC, splitUC, failUC, releaseUC, getJobUC, storer, logger, transcoder, streamer, worker.NewResourceBudget(0, 0), jobCh, time.Hour)
 
 		testJob, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
 
 		startUC.EXPECT().Execute(mock.Anything, testJob).Return(nil, errors.New("start failed"))
+		releaseUC.EXPECT().Execute(mock.Anything, testJob).Return(nil).Once()
 		logger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
 
@@ -94,16 +127,55 @@ func TestTranscodeWorker_Start(t *testing.T) {
 		time.Sleep(50 * time.Millisecond)
 	})
 
+	t.Run("should release jobs still queued at shutdown", func(t *testing.T) {
+		startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
+		completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+		splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
+		failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		getJobUC := mockjob.NewMockGetJobUsecase(t)
+		storer := mockstorage.NewMockAssetStorer(t)
+		logger := mocklog.NewMockLogger(t)
+		transcoder := mocktranscode.NewMockTranscoder(t)
+		streamer := mockprogressstream.NewMockProgressStreamer(t)
+		jobCh := make(chan *job.Job, 1)
+
+		w := worker.NewTranscodeWorker(startUC, completeUC, splitUC, failUC, releaseUC, getJobUC, storer, logger, transcoder, streamer, worker.NewResourceBudget(0, 0), jobCh, time.Hour)
+
+		testJob, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
+		released := make(chan struct{})
+		releaseUC.EXPECT().Execute(mock.Anything, testJob).Run(func(context.Context, *job.Job) {
+			close(released)
+		}).Return(nil).Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		jobCh <- testJob
+		close(jobCh)
+		go w.Start(ctx)
+
+		select {
+		case <-released:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Job was not released")
+		}
+	})
+
 	t.Run("should mark as failed if transcoding fails", func(t *testing.T) {
 		startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
 		completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+		splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
 		failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		getJobUC := mockjob.NewMockGetJobUsecase(t)
 		storer := mockstorage.NewMockAssetStorer(t)
 		logger := mocklog.NewMockLogger(t)
 		transcoder := mocktranscode.NewMockTranscoder(t)
+		streamer := mockprogressstream.NewMockProgressStreamer(t)
 		jobCh := make(chan *job.Job, 1)
 
-		w := worker.NewTranscodeWorker(startUC, completeUC, failUC, storer, logger, transcoder, jobCh)
+		w := worker.NewTranscodeWorker(startUC, completeUC, splitUC, failUC, releaseUC, getJobUC, storer, logger, transcoder, streamer, worker.NewResourceBudget(0, 0), jobCh, time.Hour)
 
 		testJob, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
 		resourceID := "res-1"
@@ -115,7 +187,7 @@ func TestTranscodeWorker_Start(t *testing.T) {
 		}, nil)
 
 		transcoder.EXPECT().Transcode(mock.Anything, resourceID, sourceFile, testJob.ID).Return(nil, errors.New("transcode failed"))
-		failUC.EXPECT().Execute(mock.Anything, testJob, "transcode failed").Return(nil)
+		failUC.EXPECT().Execute(mock.Anything, testJob, jobapp.FailTranscodeJobInput{ErrorMsg: "transcode failed"}).Return(nil)
 		logger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
 
@@ -126,16 +198,60 @@ func TestTranscodeWorker_Start(t *testing.T) {
 		time.Sleep(50 * time.Millisecond)
 	})
 
+	t.Run("should keep process details when ffmpeg fails", func(t *testing.T) {
+		startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
+		completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+		splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
+		failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		getJobUC := mockjob.NewMockGetJobUsecase(t)
+		storer := mockstorage.NewMockAssetStorer(t)
+		logger := mocklog.NewMockLogger(t)
+		transcoder := mocktranscode.NewMockTranscoder(t)
+		streamer := mockprogressstream.NewMockProgressStreamer(t)
+		jobCh := make(chan *job.Job, 1)
+
+		w := worker.NewTranscodeWorker(startUC, completeUC, splitUC, failUC, releaseUC, getJobUC, storer, logger, transcoder, streamer, worker.NewResourceBudget(0, 0), jobCh, time.Hour)
+
+		testJob, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
+		processErr := &transcode.ProcessError{ExitCode: 1, StderrTail: "Invalid data found", Err: errors.New("exit status 1")}
+
+		startUC.EXPECT().Execute(mock.Anything, testJob).Return(&jobapp.StartTranscodeJobResult{
+			ResourceID:     "res-1",
+			SourceFilename: "input.mp4",
+		}, nil)
+		transcoder.EXPECT().Transcode(mock.Anything, "res-1", "input.mp4", testJob.ID).Return(nil, processErr)
+
+		failed := make(chan jobapp.FailTranscodeJobInput, 1)
+		failUC.EXPECT().Execute(mock.Anything, testJob, mock.Anything).
+			Run(func(_ context.Context, _ *job.Job, input jobapp.FailTranscodeJobInput) { failed <- input }).
+			Return(nil)
+		logger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		go w.Start(t.Context())
+		jobCh <- testJob
+		close(jobCh)
+
+		input := <-failed
+		require.Equal(t, 1, *input.ExitCode)
+		require.Equal(t, "Invalid data found", input.StderrTail)
+	})
+
 	t.Run("should mark as failed if saving to storage fails", func(t *testing.T) {
 		startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
 		completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+		splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
 		failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		getJobUC := mockjob.NewMockGetJobUsecase(t)
 		storer := mockstorage.NewMockAssetStorer(t)
 		logger := mocklog.NewMockLogger(t)
 		transcoder := mocktranscode.NewMockTranscoder(t)
+		streamer := mockprogressstream.NewMockProgressStreamer(t)
 		jobCh := make(chan *job.Job, 1)
 
-		w := worker.NewTranscodeWorker(startUC, completeUC, failUC, storer, logger, transcoder, jobCh)
+		w := worker.NewTranscodeWorker(startUC, completeUC, splitUC, failUC, releaseUC, getJobUC, storer, logger, transcoder, streamer, worker.NewResourceBudget(0, 0), jobCh, time.Hour)
 
 		testJob, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
 		resourceID := "res-1"
@@ -153,11 +269,16 @@ func TestTranscodeWorker_Start(t *testing.T) {
 		transcoder.EXPECT().Transcode(mock.Anything, resourceID, sourceFile, testJob.ID).Return(&transcode.TranscodeOutput{
 			Duration:     10 * time.Second,
 			ManifestPath: filepath.Join(tempDir, manifestName),
+			OutputDir:    tempDir,
 			OutputFiles:  []string{manifestName},
+			Progress:     storeStageProgress(progress.TranscodeStages("480p", "720p")...),
 		}, nil)
 
 		storer.EXPECT().Save(mock.Anything, resourceID, manifestName, mock.Anything).Return(errors.New("save failed"))
-		failUC.EXPECT().Execute(mock.Anything, testJob, "failed to save transcoded output").Return(nil)
+		failUC.EXPECT().Execute(mock.Anything, testJob, jobapp.FailTranscodeJobInput{ErrorMsg: "failed to save transcoded output"}).Return(nil)
+		streamer.EXPECT().Push(mock.Anything, testJob.ID, mock.MatchedBy(func(p *progress.Progress) bool {
+			return p.Stage == progress.StageStore && p.Status == progress.StatusError
+		})).Return(nil).Once()
 		logger.EXPECT().Errorf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
 		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
 
@@ -169,4 +290,267 @@ func TestTranscodeWorker_Start(t *testing.T) {
 		_, err := os.Stat(tempDir)
 		require.True(t, os.IsNotExist(err))
 	})
+
+	t.Run("should stop transcoding and skip failing if job is cancelled", func(t *testing.T) {
+		startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
+		completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+		splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
+		failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		getJobUC := mockjob.NewMockGetJobUsecase(t)
+		storer := mockstorage.NewMockAssetStorer(t)
+		logger := mocklog.NewMockLogger(t)
+		transcoder := mocktranscode.NewMockTranscoder(t)
+		streamer := mockprogressstream.NewMockProgressStreamer(t)
+		jobCh := make(chan *job.Job, 1)
+
+		w := worker.NewTranscodeWorker(startUC, completeUC, splitUC, failUC, releaseUC, getJobUC, storer, logger, transcoder, streamer, worker.NewResourceBudget(0, 0), jobCh, 10*time.Millisecond)
+
+		testJob, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
+		resourceID := "res-1"
+		sourceFile := "input.mp4"
+
+		cancelledJob, _ := job.NewJob("job-1", "video-1", job.TypeTranscode)
+		_ = cancelledJob.Cancel()
+
+		startUC.EXPECT().Execute(mock.Anything, testJob).Return(&jobapp.StartTranscodeJobResult{
+			ResourceID:     resourceID,
+			SourceFilename: sourceFile,
+		}, nil)
+
+		// Transcoding only returns once its context is cancelled
+		transcodeDone := make(chan struct{})
+		transcoder.EXPECT().Transcode(mock.Anything, resourceID, sourceFile, testJob.ID).
+			RunAndReturn(func(ctx context.Context, _, _, _ string) (*transcode.TranscodeOutput, error) {
+				defer close(transcodeDone)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+
+		getJobUC.EXPECT().Execute(mock.Anything, testJob.ID).Return(cancelledJob, nil)
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		go w.Start(t.Context())
+		jobCh <- testJob
+		close(jobCh)
+
+		select {
+		case <-transcodeDone:
+		case <-time.After(time.Second):
+			t.Fatal("transcoding was not cancelled")
+		}
+		time.Sleep(50 * time.Millisecond)
+	})
+}
+
+func TestTranscodeWorker_ChunkedJobs(t *testing.T) {
+	t.Run("split job queues the chunk jobs", func(t *testing.T) {
+		startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
+		completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+		splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
+		failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		getJobUC := mockjob.NewMockGetJobUsecase(t)
+		storer := mockstorage.NewMockAssetStorer(t)
+		logger := mocklog.NewMockLogger(t)
+		transcoder := mocktranscode.NewMockTranscoder(t)
+		streamer := mockprogressstream.NewMockProgressStreamer(t)
+		jobCh := make(chan *job.Job, 1)
+
+		w := worker.NewTranscodeWorker(startUC, completeUC, splitUC, failUC, releaseUC, getJobUC, storer, logger, transcoder, streamer, worker.NewResourceBudget(0, 0), jobCh, time.Hour)
+
+		testJob, _ := job.NewJob("job-1", "video-1", job.TypeSplit)
+		resourceID := "res-1"
+		sourceFile := "input.mp4"
+		chunks := []string{"chunks/0000.mp4", "chunks/0001.mp4"}
+
+		tempDir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "chunks"), 0755))
+		for _, c := range chunks {
+			require.NoError(t, os.WriteFile(filepath.Join(tempDir, c), []byte("chunk"), 0644))
+		}
+
+		startUC.EXPECT().Execute(mock.Anything, testJob).Return(&jobapp.StartTranscodeJobResult{
+			ResourceID:     resourceID,
+			SourceFilename: sourceFile,
+		}, nil)
+		transcoder.EXPECT().Split(mock.Anything, resourceID, sourceFile, testJob.ID).Return(&transcode.TranscodeOutput{
+			OutputDir:   tempDir,
+			OutputFiles: chunks,
+		}, nil)
+		storer.EXPECT().Save(mock.Anything, resourceID, chunks[0], mock.Anything).Return(nil)
+		storer.EXPECT().Save(mock.Anything, resourceID, chunks[1], mock.Anything).Return(nil)
+
+		done := make(chan struct{})
+		splitUC.EXPECT().Execute(mock.Anything, testJob, chunks).Run(func(context.Context, *job.Job, []string) {
+			close(done)
+		}).Return(nil).Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		go w.Start(t.Context())
+		jobCh <- testJob
+		close(jobCh)
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("split job was not completed")
+		}
+	})
+
+	t.Run("assemble job completes the video and deletes the chunks", func(t *testing.T) {
+		startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
+		completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+		splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
+		failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		getJobUC := mockjob.NewMockGetJobUsecase(t)
+		storer := mockstorage.NewMockAssetStorer(t)
+		logger := mocklog.NewMockLogger(t)
+		transcoder := mocktranscode.NewMockTranscoder(t)
+		streamer := mockprogressstream.NewMockProgressStreamer(t)
+		jobCh := make(chan *job.Job, 1)
+
+		w := worker.NewTranscodeWorker(startUC, completeUC, splitUC, failUC, releaseUC, getJobUC, storer, logger, transcoder, streamer, worker.NewResourceBudget(0, 0), jobCh, time.Hour)
+
+		split, _ := job.NewJob("split-1", "video-1", job.TypeSplit)
+		testJob, _ := job.NewJob("assemble-1", "video-1", job.TypeAssemble)
+		testJob.DependsOn = []string{split.ID}
+		n := 0
+		_, err := job.NewChunkJobs(split, testJob, []string{"chunks/0000.mp4"}, func() string { n++; return fmt.Sprintf("job-%d", n) })
+		require.NoError(t, err)
+		spec, _ := testJob.ChunkSpec()
+		resourceID := "res-1"
+		sourceFile := "input.mp4"
+
+		tempDir := t.TempDir()
+		manifestName := "manifest.mpd"
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, manifestName), []byte("manifest"), 0644))
+
+		startUC.EXPECT().Execute(mock.Anything, testJob).Return(&jobapp.StartTranscodeJobResult{
+			ResourceID:     resourceID,
+			SourceFilename: sourceFile,
+		}, nil)
+		transcoder.EXPECT().Assemble(mock.Anything, resourceID, sourceFile, *spec, testJob.ID).Return(&transcode.TranscodeOutput{
+			Duration:     90 * time.Second,
+			ManifestPath: filepath.Join(tempDir, manifestName),
+			OutputDir:    tempDir,
+			OutputFiles:  []string{manifestName},
+			Progress:     storeStageProgress(progress.ChunkedTranscodeStages...),
+		}, nil)
+		storer.EXPECT().Save(mock.Anything, resourceID, manifestName, mock.Anything).Return(nil)
+
+		// The chunked transcode ends once its output is stored
+		streamer.EXPECT().Push(mock.Anything, testJob.ID, mock.MatchedBy(func(p *progress.Progress) bool {
+			return p.Stage == progress.StageStore && p.Status == progress.StatusContinue
+		})).Return(nil).Once()
+		streamer.EXPECT().Push(mock.Anything, testJob.ID, mock.MatchedBy(func(p *progress.Progress) bool {
+			return p.Status == progress.StatusEnd && p.Percentage == 100
+		})).Return(nil).Once()
+		completeUC.EXPECT().Execute(mock.Anything, testJob, manifestName, 90*time.Second).Return(nil).Once()
+
+		done := make(chan struct{})
+		storer.EXPECT().Delete(mock.Anything, resourceID, transcode.ChunksDir).Run(func(context.Context, string, string) {
+			close(done)
+		}).Return(nil).Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		go w.Start(t.Context())
+		jobCh <- testJob
+		close(jobCh)
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("chunks were not deleted")
+		}
+	})
+	t.Run("probe job completes with the duration of the source", func(t *testing.T) {
+		startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
+		completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+		splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
+		failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		getJobUC := mockjob.NewMockGetJobUsecase(t)
+		storer := mockstorage.NewMockAssetStorer(t)
+		logger := mocklog.NewMockLogger(t)
+		transcoder := mocktranscode.NewMockTranscoder(t)
+		streamer := mockprogressstream.NewMockProgressStreamer(t)
+		jobCh := make(chan *job.Job, 1)
+
+		w := worker.NewTranscodeWorker(startUC, completeUC, splitUC, failUC, releaseUC, getJobUC, storer, logger, transcoder, streamer, worker.NewResourceBudget(0, 0), jobCh, time.Hour)
+
+		testJob, _ := job.NewJob("job-1", "video-1", job.TypeProbe)
+		startUC.EXPECT().Execute(mock.Anything, testJob).Return(&jobapp.StartTranscodeJobResult{
+			ResourceID:     "res-1",
+			SourceFilename: "input.mp4",
+		}, nil)
+		transcoder.EXPECT().Probe(mock.Anything, "res-1", "input.mp4").Return(&transcode.SourceInfo{
+			Width:    1920,
+			Height:   1080,
+			Duration: 90 * time.Second,
+		}, nil)
+
+		done := make(chan struct{})
+		completeUC.EXPECT().Execute(mock.Anything, testJob, "", 90*time.Second).Run(func(context.Context, *job.Job, string, time.Duration) {
+			close(done)
+		}).Return(nil).Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		go w.Start(t.Context())
+		jobCh <- testJob
+		close(jobCh)
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("probe job was not completed")
+		}
+	})
+
+	t.Run("thumbnail job stores the image", func(t *testing.T) {
+		startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
+		completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+		splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
+		failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+		releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+		getJobUC := mockjob.NewMockGetJobUsecase(t)
+		storer := mockstorage.NewMockAssetStorer(t)
+		logger := mocklog.NewMockLogger(t)
+		transcoder := mocktranscode.NewMockTranscoder(t)
+		streamer := mockprogressstream.NewMockProgressStreamer(t)
+		jobCh := make(chan *job.Job, 1)
+
+		w := worker.NewTranscodeWorker(startUC, completeUC, splitUC, failUC, releaseUC, getJobUC, storer, logger, transcoder, streamer, worker.NewResourceBudget(0, 0), jobCh, time.Hour)
+
+		testJob, _ := job.NewJob("job-1", "video-1", job.TypeThumbnail)
+		tempDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, transcode.ThumbnailFilename), []byte("jpeg"), 0644))
+
+		startUC.EXPECT().Execute(mock.Anything, testJob).Return(&jobapp.StartTranscodeJobResult{
+			ResourceID:     "res-1",
+			SourceFilename: "input.mp4",
+		}, nil)
+		transcoder.EXPECT().Thumbnail(mock.Anything, "res-1", "input.mp4", testJob.ID).Return(&transcode.TranscodeOutput{
+			OutputDir:   tempDir,
+			OutputFiles: []string{transcode.ThumbnailFilename},
+		}, nil)
+		storer.EXPECT().Save(mock.Anything, "res-1", transcode.ThumbnailFilename, mock.Anything).Return(nil).Once()
+
+		done := make(chan struct{})
+		completeUC.EXPECT().Execute(mock.Anything, testJob, transcode.ThumbnailFilename, time.Duration(0)).Run(func(context.Context, *job.Job, string, time.Duration) {
+			close(done)
+		}).Return(nil).Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		go w.Start(t.Context())
+		jobCh <- testJob
+		close(jobCh)
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("thumbnail job was not completed")
+		}
+	})
 }
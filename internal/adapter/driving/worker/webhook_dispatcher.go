@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+)
+
+// WebhookDispatcher polls the due webhook deliveries and sends them.
+// The deliveries of a batch are sent concurrently, so a slow endpoint
+// only delays the batch by the sender timeout.
+type WebhookDispatcher struct {
+	claimUC      webhookapp.ClaimDueDeliveriesUsecase
+	sendUC       webhookapp.SendDeliveryUsecase
+	logger       log.Logger
+	pollInterval time.Duration
+	batchSize    int
+}
+
+func NewWebhookDispatcher(
+	claimUC webhookapp.ClaimDueDeliveriesUsecase,
+	sendUC webhookapp.SendDeliveryUsecase,
+	logger log.Logger,
+	pollInterval time.Duration,
+	batchSize int,
+) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		claimUC,
+		sendUC,
+		logger,
+		pollInterval,
+		batchSize,
+	}
+}
+
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	d.logger.Infof(ctx, log.CategoryDefault, "", "webhook dispatcher started")
+
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Infof(ctx, log.CategoryDefault, "", "webhook dispatcher shutting down")
+			return
+		case <-ticker.C:
+			d.drain(ctx)
+		}
+	}
+}
+
+// drain sends batches until no more deliveries are due
+func (d *WebhookDispatcher) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		claimed, err := d.claimUC.Execute(ctx, time.Now(), d.batchSize)
+		if err != nil {
+			d.logger.Errorf(ctx, log.CategoryWebhook, "", "claim due deliveries: %v", err)
+			return
+		}
+
+		var wg sync.WaitGroup
+		for _, cd := range claimed {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				d.send(ctx, cd)
+			}()
+		}
+		wg.Wait()
+
+		if len(claimed) < d.batchSize {
+			return
+		}
+	}
+}
+
+func (d *WebhookDispatcher) send(ctx context.Context, cd *webhookapp.ClaimedDelivery) {
+	delivery := cd.Delivery
+
+	if err := d.sendUC.Execute(ctx, cd); err != nil {
+		d.logger.Errorf(ctx, log.CategoryWebhook, delivery.ID, "send delivery %s: %v", delivery.ID, err)
+		return
+	}
+
+	if delivery.IsFailed() {
+		d.logger.Warnf(ctx, log.CategoryWebhook, delivery.ID, "delivery %s to subscription %s failed after %d attempts: %s",
+			delivery.ID, delivery.SubscriptionID, delivery.Attempts, delivery.LastError)
+	}
+}
@@ -0,0 +1,105 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driving/worker"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	mockwebhook "github.com/st-ember/streaming-api/internal/application/webhookapp/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWebhookDispatcher_Run(t *testing.T) {
+	t.Run("should send every claimed delivery", func(t *testing.T) {
+		claimUC := mockwebhook.NewMockClaimDueDeliveriesUsecase(t)
+		sendUC := mockwebhook.NewMockSendDeliveryUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		d := worker.NewWebhookDispatcher(claimUC, sendUC, logger, 10*time.Millisecond, 10)
+
+		first := &webhookapp.ClaimedDelivery{Delivery: &webhook.Delivery{ID: "delivery-1"}}
+		second := &webhookapp.ClaimedDelivery{Delivery: &webhook.Delivery{ID: "delivery-2"}}
+
+		sent := make(chan string, 2)
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "webhook dispatcher started").Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 10).Return([]*webhookapp.ClaimedDelivery{first, second}, nil).Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 10).Return([]*webhookapp.ClaimedDelivery{}, nil).Maybe()
+		sendUC.EXPECT().Execute(mock.Anything, mock.Anything).Run(func(_ context.Context, cd *webhookapp.ClaimedDelivery) {
+			sent <- cd.Delivery.ID
+		}).Return(nil).Twice()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "webhook dispatcher shutting down").Maybe()
+
+		go d.Run(t.Context())
+
+		for range 2 {
+			select {
+			case <-sent:
+			case <-time.After(500 * time.Millisecond):
+				t.Fatal("Deliveries were not sent in time")
+			}
+		}
+	})
+
+	t.Run("should log error and continue when sending fails", func(t *testing.T) {
+		claimUC := mockwebhook.NewMockClaimDueDeliveriesUsecase(t)
+		sendUC := mockwebhook.NewMockSendDeliveryUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		d := worker.NewWebhookDispatcher(claimUC, sendUC, logger, 10*time.Millisecond, 10)
+
+		cd := &webhookapp.ClaimedDelivery{Delivery: &webhook.Delivery{ID: "delivery-1"}}
+
+		logged := make(chan struct{})
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "webhook dispatcher started").Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 10).Return([]*webhookapp.ClaimedDelivery{cd}, nil).Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 10).Return([]*webhookapp.ClaimedDelivery{}, nil).Maybe()
+		sendUC.EXPECT().Execute(mock.Anything, cd).Return(errors.New("db error")).Once()
+		logger.EXPECT().Errorf(mock.Anything, mock.Anything, "delivery-1", "send delivery %s: %v", mock.Anything).Run(
+			func(context.Context, log.LogCategory, string, string, ...any) {
+				close(logged)
+			}).Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "webhook dispatcher shutting down").Maybe()
+
+		go d.Run(t.Context())
+
+		select {
+		case <-logged:
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("Error was not logged in time")
+		}
+	})
+
+	t.Run("should shut down gracefully on context cancellation", func(t *testing.T) {
+		claimUC := mockwebhook.NewMockClaimDueDeliveriesUsecase(t)
+		sendUC := mockwebhook.NewMockSendDeliveryUsecase(t)
+		logger := mocklog.NewMockLogger(t)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		d := worker.NewWebhookDispatcher(claimUC, sendUC, logger, 10*time.Millisecond, 10)
+
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "webhook dispatcher started").Once()
+		logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "webhook dispatcher shutting down").Once()
+		claimUC.EXPECT().Execute(mock.Anything, mock.Anything, 10).Return([]*webhookapp.ClaimedDelivery{}, nil).Maybe()
+
+		done := make(chan struct{})
+		go func() {
+			d.Run(ctx)
+			close(done)
+		}()
+
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("WebhookDispatcher did not shut down in time")
+		}
+	})
+}
@@ -2,53 +2,79 @@ package worker
 
 import (
 	"context"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/st-ember/streaming-api/internal/application/jobapp"
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
 	"github.com/st-ember/streaming-api/internal/application/ports/storage"
 	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 )
 
 type WorkerPool struct {
-	startUC     jobapp.StartTranscodeJobUsecase
-	completeUC  jobapp.CompleteTranscodeJobUsecase
-	failUC      jobapp.FailTranscodeJobUsecase
-	storer      storage.AssetStorer
-	logger      log.Logger
-	transcoder  transcode.Transcoder
-	jobCh       chan *job.Job
-	scheduler   *JobScheduler
-	workerLimit int
-	wg          sync.WaitGroup
+	startUC      jobapp.StartTranscodeJobUsecase
+	completeUC   jobapp.CompleteTranscodeJobUsecase
+	splitUC      jobapp.CompleteSplitJobUsecase
+	failUC       jobapp.FailTranscodeJobUsecase
+	releaseUC    jobapp.ReleaseJobUsecase
+	getJobUC     jobapp.GetJobUsecase
+	storer       storage.AssetStorer
+	logger       log.Logger
+	transcoder   transcode.Transcoder
+	streamer     progressstream.ProgressStreamer
+	budget       *ResourceBudget
+	jobCh        chan *job.Job
+	scheduler    *JobScheduler
+	pollInterval time.Duration
+	workerLimit  int
+	wg           sync.WaitGroup
 }
 
 func NewWorkerPool(
 	findNextUC jobapp.FindNextPendingTranscodeJobUsecase,
+	estimateUC jobapp.EstimateJobCostUsecase,
 	startUC jobapp.StartTranscodeJobUsecase,
 	completeUC jobapp.CompleteTranscodeJobUsecase,
+	splitUC jobapp.CompleteSplitJobUsecase,
 	failUC jobapp.FailTranscodeJobUsecase,
+	releaseUC jobapp.ReleaseJobUsecase,
+	getJobUC jobapp.GetJobUsecase,
 	storer storage.AssetStorer,
 	logger log.Logger,
 	transcoder transcode.Transcoder,
+	streamer progressstream.ProgressStreamer,
 	pollInterval time.Duration,
 	workerLimit int,
+	budget *ResourceBudget,
 ) *WorkerPool {
 	jobCh := make(chan *job.Job, workerLimit)
 
-	scheduler := NewJobScheduler(findNextUC, logger, jobCh, pollInterval, workerLimit)
+	// Identifies the jobs claimed by this instance in the job history
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "worker"
+	}
+
+	scheduler := NewJobScheduler(hostname, findNextUC, estimateUC, releaseUC, budget, logger, jobCh, pollInterval, workerLimit)
 
 	return &WorkerPool{
 		startUC,
 		completeUC,
+		splitUC,
 		failUC,
+		releaseUC,
+		getJobUC,
 		storer,
 		logger,
 		transcoder,
+		streamer,
+		budget,
 		jobCh,
 		scheduler,
+		pollInterval,
 		workerLimit,
 		sync.WaitGroup{},
 	}
@@ -67,8 +93,8 @@ func (p *WorkerPool) Start(ctx context.Context) {
 		go func() {
 			defer p.wg.Done()
 			worker := NewTranscodeWorker(
-				p.startUC, p.completeUC, p.failUC,
-				p.storer, p.logger, p.transcoder, p.jobCh,
+				p.startUC, p.completeUC, p.splitUC, p.failUC, p.releaseUC, p.getJobUC,
+				p.storer, p.logger, p.transcoder, p.streamer, p.budget, p.jobCh, p.pollInterval,
 			)
 			worker.Start(ctx)
 		}()
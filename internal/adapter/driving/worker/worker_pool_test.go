@@ -9,27 +9,34 @@ import (
 	"github.com/st-ember/streaming-api/internal/application/jobapp"
 	mockjob "github.com/st-ember/streaming-api/internal/application/jobapp/mocks"
 	mocklog "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	mockprogressstream "github.com/st-ember/streaming-api/internal/application/ports/progressstream/mocks"
 	mockstorage "github.com/st-ember/streaming-api/internal/application/ports/storage/mocks"
 	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
 	mocktranscode "github.com/st-ember/streaming-api/internal/application/ports/transcode/mocks"
 	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
 	"github.com/stretchr/testify/mock"
 )
 
 func TestWorkerPool_GracefulShutdown(t *testing.T) {
 	// Setup mocks
 	findNextUC := mockjob.NewMockFindNextPendingTranscodeJobUsecase(t)
+	estimateUC := mockjob.NewMockEstimateJobCostUsecase(t)
 	startUC := mockjob.NewMockStartTranscodeJobUsecase(t)
 	completeUC := mockjob.NewMockCompleteTranscodeJobUsecase(t)
+	splitUC := mockjob.NewMockCompleteSplitJobUsecase(t)
 	failUC := mockjob.NewMockFailTranscodeJobUsecase(t)
+	releaseUC := mockjob.NewMockReleaseJobUsecase(t)
+	getJobUC := mockjob.NewMockGetJobUsecase(t)
 	storer := mockstorage.NewMockAssetStorer(t)
 	logger := mocklog.NewMockLogger(t)
 	transcoder := mocktranscode.NewMockTranscoder(t)
+	streamer := mockprogressstream.NewMockProgressStreamer(t)
 
 	// Create pool with 1 worker
 	p := worker.NewWorkerPool(
-		findNextUC, startUC, completeUC, failUC,
-		storer, logger, transcoder, 2, 1,
+		findNextUC, estimateUC, startUC, completeUC, splitUC, failUC, releaseUC, getJobUC,
+		storer, logger, transcoder, streamer, 2, 1, worker.NewResourceBudget(4, 0),
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -41,7 +48,8 @@ func TestWorkerPool_GracefulShutdown(t *testing.T) {
 	logger.EXPECT().Infof(mock.Anything, mock.Anything, mock.Anything, "job scheduler started").Once()
 
 	// Scheduler: returns one job, then we'll cancel context during the next poll
-	findNextUC.EXPECT().Execute(mock.Anything).Return(testJob, nil).Once()
+	findNextUC.EXPECT().Execute(mock.Anything, mock.Anything).Return(testJob, nil).Once()
+	estimateUC.EXPECT().Execute(mock.Anything, testJob).Return(job.Cost{CPU: 2}, nil).Once()
 
 	// Signal when job processing starts
 	jobProcessingStarted := make(chan struct{})
@@ -56,13 +64,20 @@ func TestWorkerPool_GracefulShutdown(t *testing.T) {
 	transcoder.EXPECT().Transcode(mock.Anything, "res-1", "in.mp4", testJob.ID).Return(&transcode.TranscodeOutput{
 		Duration:     10 * time.Second,
 		ManifestPath: "/tmp/fake/manifest.m3u8",
+		OutputDir:    "/tmp/fake",
 		OutputFiles:  []string{},
+		Progress:     storeStageProgress(progress.TranscodeStages("480p", "720p")...),
 	}, nil).Once()
 
 	completeUC.EXPECT().Execute(mock.Anything, testJob, "manifest.m3u8", 10*time.Second).Return(nil).Once()
+	streamer.EXPECT().Push(mock.Anything, testJob.ID, mock.Anything).Return(nil).Once()
+
+	// Cancellation checks may run while the job is in flight
+	getJobUC.EXPECT().Execute(mock.Anything, testJob.ID).Return(testJob, nil).Maybe()
 
 	// Subsequent scheduler poll triggers the context cancellation
-	findNextUC.EXPECT().Execute(mock.Anything).Run(func(ctx context.Context) {
+	findNextUC.EXPECT().Execute(mock.Anything, mock.Anything).Run(func(ctx context.Context, workerID string) {
+		<-jobProcessingStarted // Jobs still queued at shutdown would be released instead
 		cancel()
 	}).Return(nil, nil).Maybe()
 
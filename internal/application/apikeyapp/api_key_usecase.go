@@ -0,0 +1,8 @@
+package apikeyapp
+
+type APIKeyUsecase struct {
+	Create       CreateAPIKeyUsecase
+	List         ListAPIKeysUsecase
+	Revoke       RevokeAPIKeyUsecase
+	Authenticate AuthenticateAPIKeyUsecase
+}
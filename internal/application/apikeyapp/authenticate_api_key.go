@@ -0,0 +1,94 @@
+package apikeyapp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/st-ember/streaming-api/internal/application/ports/hash"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// AuthenticateAPIKeyUsecase checks a presented key, returning the claims of the request it authenticates.
+// The key acts as its creator, with the permissions of the key the creator still holds,
+// so taking a role from a user also takes it from their keys.
+// A secret verified lately is not hashed again, the key itself is read on every request.
+type AuthenticateAPIKeyUsecase interface {
+	Execute(ctx context.Context, key string) (*token.AccessClaims, error)
+}
+
+type authenticateAPIKeyUsecase struct {
+	authRepo repo.AuthRepo
+	hasher   hash.Hasher
+	verified *verifiedKeys
+}
+
+func NewAuthenticateAPIKeyUsecase(authRepo repo.AuthRepo, hasher hash.Hasher) AuthenticateAPIKeyUsecase {
+	return &authenticateAPIKeyUsecase{authRepo, hasher, newVerifiedKeys()}
+}
+
+func (u *authenticateAPIKeyUsecase) Execute(ctx context.Context, key string) (*token.AccessClaims, error) {
+	prefix, secret, ok := splitKey(key)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+
+	k, err := u.authRepo.FindAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidAPIKey
+		}
+		return nil, fmt.Errorf("find api key %s: %w", prefix, err)
+	}
+
+	now := time.Now().UTC()
+	if !k.IsUsable(now) || !u.verify(k, secret, now) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	held, err := u.authRepo.FindPermissionsByUserID(ctx, k.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find permissions by user id %s: %w", k.UserID, err)
+	}
+
+	permissions := []string{}
+	for _, p := range k.Permissions {
+		if slices.Contains(held, p) {
+			permissions = append(permissions, p)
+		}
+	}
+
+	if k.MarkUsed(now) {
+		if err := u.authRepo.TouchAPIKey(ctx, k.ID, now); err != nil {
+			return nil, fmt.Errorf("touch api key %s: %w", k.ID, err)
+		}
+	}
+
+	return &token.AccessClaims{
+		UserID:           k.UserID,
+		APIKeyID:         k.ID,
+		Permissions:      permissions,
+		RegisteredClaims: jwt.RegisteredClaims{ID: k.ID},
+	}, nil
+}
+
+// verify checks the secret against the hash of the key, unless it was verified lately
+func (u *authenticateAPIKeyUsecase) verify(k *auth.APIKey, secret string, now time.Time) bool {
+	if u.verified.matches(k, secret, now) {
+		return true
+	}
+
+	if !u.hasher.Verify(secret, k.Hash) {
+		return false
+	}
+
+	u.verified.add(k, secret, now)
+
+	return true
+}
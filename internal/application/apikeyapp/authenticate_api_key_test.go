@@ -0,0 +1,144 @@
+package apikeyapp_test
+
+import (
+	"database/sql"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/hash"
+	"github.com/st-ember/streaming-api/internal/application/apikeyapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// countingHasher counts the secrets verified with Argon2
+type countingHasher struct {
+	*hash.Argon2Hasher
+	verified atomic.Int32
+}
+
+func (h *countingHasher) Verify(password, encoded string) bool {
+	h.verified.Add(1)
+	return h.Argon2Hasher.Verify(password, encoded)
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	hasher := hash.NewArgon2Hasher()
+	hashed, err := hasher.Hash("secret")
+	require.NoError(t, err)
+
+	t.Run("should act as the creator with the permissions they still hold", func(t *testing.T) {
+		t.Parallel()
+		mockAuthRepo := repomocks.NewMockAuthRepo(t)
+
+		k, _ := auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", hashed, []string{auth.PermissionVideoUpload, auth.PermissionVideoUpdate}, nil)
+
+		mockAuthRepo.EXPECT().FindAPIKeyByPrefix(mock.Anything, "sak_abc").Return(k, nil).Once()
+		mockAuthRepo.EXPECT().FindPermissionsByUserID(mock.Anything, "user-1").Return([]string{auth.PermissionVideoUpload}, nil).Once()
+		mockAuthRepo.EXPECT().TouchAPIKey(mock.Anything, "key-1", mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+		usecase := apikeyapp.NewAuthenticateAPIKeyUsecase(mockAuthRepo, hasher)
+		claims, err := usecase.Execute(t.Context(), "sak_abc_secret")
+
+		require.NoError(t, err)
+		require.Equal(t, "user-1", claims.UserID)
+		require.Equal(t, "key-1", claims.APIKeyID)
+		require.Empty(t, claims.SessionID)
+		require.Equal(t, []string{auth.PermissionVideoUpload}, claims.Permissions)
+	})
+
+	t.Run("should not record a recent use again", func(t *testing.T) {
+		t.Parallel()
+		mockAuthRepo := repomocks.NewMockAuthRepo(t)
+
+		k, _ := auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", hashed, nil, nil)
+		k.MarkUsed(time.Now().UTC())
+
+		mockAuthRepo.EXPECT().FindAPIKeyByPrefix(mock.Anything, "sak_abc").Return(k, nil).Once()
+		mockAuthRepo.EXPECT().FindPermissionsByUserID(mock.Anything, "user-1").Return(nil, nil).Once()
+
+		usecase := apikeyapp.NewAuthenticateAPIKeyUsecase(mockAuthRepo, hasher)
+		_, err := usecase.Execute(t.Context(), "sak_abc_secret")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject malformed and unknown keys", func(t *testing.T) {
+		t.Parallel()
+		mockAuthRepo := repomocks.NewMockAuthRepo(t)
+
+		mockAuthRepo.EXPECT().FindAPIKeyByPrefix(mock.Anything, "sak_ghost").Return(nil, sql.ErrNoRows).Once()
+
+		usecase := apikeyapp.NewAuthenticateAPIKeyUsecase(mockAuthRepo, hasher)
+
+		for _, key := range []string{"", "secret", "sak_", "sak_abc", "other_abc_secret"} {
+			_, err := usecase.Execute(t.Context(), key)
+			require.ErrorIs(t, err, apikeyapp.ErrInvalidAPIKey, key)
+		}
+
+		_, err := usecase.Execute(t.Context(), "sak_ghost_secret")
+		require.ErrorIs(t, err, apikeyapp.ErrInvalidAPIKey)
+	})
+
+	t.Run("should reject a wrong secret", func(t *testing.T) {
+		t.Parallel()
+		mockAuthRepo := repomocks.NewMockAuthRepo(t)
+
+		k, _ := auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", hashed, nil, nil)
+
+		mockAuthRepo.EXPECT().FindAPIKeyByPrefix(mock.Anything, "sak_abc").Return(k, nil).Once()
+
+		usecase := apikeyapp.NewAuthenticateAPIKeyUsecase(mockAuthRepo, hasher)
+		_, err := usecase.Execute(t.Context(), "sak_abc_wrong")
+
+		require.ErrorIs(t, err, apikeyapp.ErrInvalidAPIKey)
+	})
+
+	t.Run("should reject a revoked key", func(t *testing.T) {
+		t.Parallel()
+		mockAuthRepo := repomocks.NewMockAuthRepo(t)
+
+		k, _ := auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", hashed, nil, nil)
+		k.Revoke()
+
+		mockAuthRepo.EXPECT().FindAPIKeyByPrefix(mock.Anything, "sak_abc").Return(k, nil).Once()
+
+		usecase := apikeyapp.NewAuthenticateAPIKeyUsecase(mockAuthRepo, hasher)
+		_, err := usecase.Execute(t.Context(), "sak_abc_secret")
+
+		require.ErrorIs(t, err, apikeyapp.ErrInvalidAPIKey)
+	})
+
+	t.Run("should not hash a secret verified lately again", func(t *testing.T) {
+		t.Parallel()
+		mockAuthRepo := repomocks.NewMockAuthRepo(t)
+		counting := &countingHasher{Argon2Hasher: hasher}
+
+		k, _ := auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", hashed, nil, nil)
+
+		mockAuthRepo.EXPECT().FindAPIKeyByPrefix(mock.Anything, "sak_abc").Return(k, nil).Times(4)
+		mockAuthRepo.EXPECT().FindPermissionsByUserID(mock.Anything, "user-1").Return(nil, nil).Twice()
+		mockAuthRepo.EXPECT().TouchAPIKey(mock.Anything, "key-1", mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+		usecase := apikeyapp.NewAuthenticateAPIKeyUsecase(mockAuthRepo, counting)
+
+		for range 2 {
+			_, err := usecase.Execute(t.Context(), "sak_abc_secret")
+			require.NoError(t, err)
+		}
+		require.EqualValues(t, 1, counting.verified.Load())
+
+		// Another secret is still hashed and rejected
+		_, err := usecase.Execute(t.Context(), "sak_abc_wrong")
+		require.ErrorIs(t, err, apikeyapp.ErrInvalidAPIKey)
+		require.EqualValues(t, 2, counting.verified.Load())
+
+		// The key is still read, revoking it takes effect at once
+		k.Revoke()
+		_, err = usecase.Execute(t.Context(), "sak_abc_secret")
+		require.ErrorIs(t, err, apikeyapp.ErrInvalidAPIKey)
+	})
+}
@@ -0,0 +1,68 @@
+package apikeyapp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/hash"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// CreateAPIKeyUsecase issues a key scoped to a subset of the permissions of its creator.
+// The key is only returned here, as only the hash of its secret is stored.
+type CreateAPIKeyUsecase interface {
+	Execute(ctx context.Context, input CreateAPIKeyInput) (k *auth.APIKey, key string, err error)
+}
+
+type createAPIKeyUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	hasher     hash.Hasher
+}
+
+func NewCreateAPIKeyUsecase(uowFactory repo.UnitOfWorkFactory, hasher hash.Hasher) CreateAPIKeyUsecase {
+	return &createAPIKeyUsecase{uowFactory, hasher}
+}
+
+func (u *createAPIKeyUsecase) Execute(ctx context.Context, input CreateAPIKeyInput) (*auth.APIKey, string, error) {
+	for _, p := range input.Permissions {
+		if !slices.Contains(input.HeldPermissions, p) {
+			return nil, "", fmt.Errorf("grant %s: %w", p, ErrPermissionNotHeld)
+		}
+	}
+
+	key, prefix, secret, err := generateKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	hashed, err := u.hasher.Hash(secret)
+	if err != nil {
+		return nil, "", fmt.Errorf("hash api key secret: %w", err)
+	}
+
+	k, err := auth.NewAPIKey(uuid.NewString(), input.UserID, input.Name, prefix, hashed, input.Permissions, input.ExpiresAt)
+	if err != nil {
+		return nil, "", fmt.Errorf("create api key entity: %w", err)
+	}
+
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Persist entities
+	if err := uow.AuthRepo().SaveAPIKey(ctx, k); err != nil {
+		return nil, "", fmt.Errorf("save api key %s in db: %w", k.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, "", fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return k, key, nil
+}
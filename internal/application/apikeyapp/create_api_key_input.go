@@ -0,0 +1,11 @@
+package apikeyapp
+
+import "time"
+
+type CreateAPIKeyInput struct {
+	UserID          string
+	Name            string
+	Permissions     []string
+	ExpiresAt       *time.Time
+	HeldPermissions []string // Permissions of the creator, bounding those of the key
+}
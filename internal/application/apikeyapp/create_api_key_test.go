@@ -0,0 +1,56 @@
+package apikeyapp_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/hash"
+	"github.com/st-ember/streaming-api/internal/application/apikeyapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAPIKey_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().SaveAPIKey(mock.Anything, mock.AnythingOfType("*auth.APIKey")).Return(nil).Once()
+
+	hasher := hash.NewArgon2Hasher()
+	usecase := apikeyapp.NewCreateAPIKeyUsecase(mockUowFactory, hasher)
+	k, key, err := usecase.Execute(t.Context(), apikeyapp.CreateAPIKeyInput{
+		UserID:          "user-1",
+		Name:            "ingest",
+		Permissions:     []string{auth.PermissionVideoUpload},
+		HeldPermissions: []string{auth.PermissionVideoUpload, auth.PermissionVideoUpdate},
+	})
+
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(key, k.Prefix+"_"))
+	require.True(t, strings.HasPrefix(k.Prefix, auth.APIKeyPrefix))
+	require.NotContains(t, k.Hash, key)
+	require.True(t, hasher.Verify(strings.TrimPrefix(key, k.Prefix+"_"), k.Hash))
+}
+
+func TestCreateAPIKey_PermissionNotHeld(t *testing.T) {
+	t.Parallel()
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	usecase := apikeyapp.NewCreateAPIKeyUsecase(mockUowFactory, hash.NewArgon2Hasher())
+	_, _, err := usecase.Execute(t.Context(), apikeyapp.CreateAPIKeyInput{
+		UserID:          "user-1",
+		Name:            "ingest",
+		Permissions:     []string{auth.PermissionJobAdmin},
+		HeldPermissions: []string{auth.PermissionVideoUpload},
+	})
+
+	require.ErrorIs(t, err, apikeyapp.ErrPermissionNotHeld)
+}
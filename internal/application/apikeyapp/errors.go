@@ -0,0 +1,11 @@
+package apikeyapp
+
+import "errors"
+
+var (
+	// ErrInvalidAPIKey is returned for keys that cannot authenticate,
+	// whether malformed, unknown, revoked or expired
+	ErrInvalidAPIKey = errors.New("invalid api key")
+	// ErrPermissionNotHeld is returned when a key would get a permission its creator lacks
+	ErrPermissionNotHeld = errors.New("cannot grant a permission you do not hold")
+)
@@ -0,0 +1,45 @@
+package apikeyapp
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+const (
+	// lookupBytes is the size of the id looking keys up, before hex encoding
+	lookupBytes = 6
+	// secretBytes is the size of the secret of keys, before hex encoding
+	secretBytes = 32
+)
+
+// generateKey returns a new key, made of its prefix and secret joined by an underscore
+func generateKey() (key, prefix, secret string, err error) {
+	b := make([]byte, lookupBytes+secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", fmt.Errorf("generate api key: %w", err)
+	}
+
+	prefix = auth.APIKeyPrefix + hex.EncodeToString(b[:lookupBytes])
+	secret = hex.EncodeToString(b[lookupBytes:])
+
+	return prefix + "_" + secret, prefix, secret, nil
+}
+
+// splitKey parses a presented key into its prefix and secret
+func splitKey(key string) (prefix, secret string, ok bool) {
+	rest, found := strings.CutPrefix(key, auth.APIKeyPrefix)
+	if !found {
+		return "", "", false
+	}
+
+	lookup, secret, found := strings.Cut(rest, "_")
+	if !found || lookup == "" || secret == "" {
+		return "", "", false
+	}
+
+	return auth.APIKeyPrefix + lookup, secret, true
+}
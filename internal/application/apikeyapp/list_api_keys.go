@@ -0,0 +1,31 @@
+package apikeyapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// ListAPIKeysUsecase lists the keys of a user, revoked and expired ones included
+type ListAPIKeysUsecase interface {
+	Execute(ctx context.Context, userID string) ([]*auth.APIKey, error)
+}
+
+type listAPIKeysUsecase struct {
+	authRepo repo.AuthRepo
+}
+
+func NewListAPIKeysUsecase(authRepo repo.AuthRepo) ListAPIKeysUsecase {
+	return &listAPIKeysUsecase{authRepo}
+}
+
+func (u *listAPIKeysUsecase) Execute(ctx context.Context, userID string) ([]*auth.APIKey, error) {
+	ks, err := u.authRepo.FindAPIKeysByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find api keys of user %s: %w", userID, err)
+	}
+
+	return ks, nil
+}
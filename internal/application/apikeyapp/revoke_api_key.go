@@ -0,0 +1,59 @@
+package apikeyapp
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+)
+
+// RevokeAPIKeyUsecase stops a key of a user from authenticating, from its next request
+type RevokeAPIKeyUsecase interface {
+	Execute(ctx context.Context, userID, id string) error
+}
+
+type revokeAPIKeyUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewRevokeAPIKeyUsecase(uowFactory repo.UnitOfWorkFactory) RevokeAPIKeyUsecase {
+	return &revokeAPIKeyUsecase{uowFactory}
+}
+
+func (u *revokeAPIKeyUsecase) Execute(ctx context.Context, userID, id string) error {
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	k, err := authRepo.FindAPIKeyByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("find api key %s: %w", id, err)
+	}
+
+	// Keys of other users are reported missing
+	if k.UserID != userID {
+		return sql.ErrNoRows
+	}
+
+	if k.IsRevoked() {
+		return nil
+	}
+
+	k.Revoke()
+
+	if err := authRepo.SaveAPIKey(ctx, k); err != nil {
+		return fmt.Errorf("save api key %s: %w", k.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package apikeyapp_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/apikeyapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeAPIKey_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	k, _ := auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", "hash", nil, nil)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindAPIKeyByID(mock.Anything, "key-1").Return(k, nil).Once()
+	mockAuthRepo.EXPECT().SaveAPIKey(mock.Anything, k).Return(nil).Once()
+
+	usecase := apikeyapp.NewRevokeAPIKeyUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), "user-1", "key-1")
+
+	require.NoError(t, err)
+	require.True(t, k.IsRevoked())
+}
+
+func TestRevokeAPIKey_KeyOfAnotherUser(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	k, _ := auth.NewAPIKey("key-1", "user-2", "ingest", "sak_abc", "hash", nil, nil)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindAPIKeyByID(mock.Anything, "key-1").Return(k, nil).Once()
+
+	usecase := apikeyapp.NewRevokeAPIKeyUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), "user-1", "key-1")
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.False(t, k.IsRevoked())
+}
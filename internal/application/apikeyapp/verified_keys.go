@@ -0,0 +1,63 @@
+package apikeyapp
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"sync"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// verifiedKeyTTL is how long a verified secret is not hashed again
+const verifiedKeyTTL = 5 * time.Minute
+
+// verifiedKeys remembers the secrets verified lately, so a busy key does not pay
+// for an Argon2 verification on every request. Only a digest of each secret is kept,
+// along with the hash it was verified against, so a key whose hash changed is verified again.
+type verifiedKeys struct {
+	mu      sync.Mutex
+	entries map[string]verifiedKey // By key id
+}
+
+type verifiedKey struct {
+	hash      string
+	digest    [sha256.Size]byte
+	expiresAt time.Time
+}
+
+func newVerifiedKeys() *verifiedKeys {
+	return &verifiedKeys{entries: make(map[string]verifiedKey)}
+}
+
+// matches reports whether the secret was verified lately against the hash of the key
+func (c *verifiedKeys) matches(k *auth.APIKey, secret string, now time.Time) bool {
+	c.mu.Lock()
+	entry, ok := c.entries[k.ID]
+	c.mu.Unlock()
+
+	if !ok || entry.hash != k.Hash || !now.Before(entry.expiresAt) {
+		return false
+	}
+
+	digest := sha256.Sum256([]byte(secret))
+	return subtle.ConstantTimeCompare(digest[:], entry.digest[:]) == 1
+}
+
+// add remembers a verified secret, forgetting the ones that expired
+func (c *verifiedKeys) add(k *auth.APIKey, secret string, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, id)
+		}
+	}
+
+	c.entries[k.ID] = verifiedKey{
+		hash:      k.Hash,
+		digest:    sha256.Sum256([]byte(secret)),
+		expiresAt: now.Add(verifiedKeyTTL),
+	}
+}
@@ -0,0 +1,17 @@
+package authapp
+
+type AuthUsecase struct {
+	Login         LoginUsecase
+	Signup        SignupUsecase
+	Refresh       RefreshUsecase
+	ListSessions  ListSessionsUsecase
+	RevokeSession RevokeSessionUsecase
+	PublicKeys    ListPublicKeysUsecase
+	OIDCLogin     OIDCLoginUsecase    // nil when no identity provider is configured
+	OIDCCallback  OIDCCallbackUsecase // nil when no identity provider is configured
+
+	RequestEmailVerification RequestEmailVerificationUsecase
+	VerifyEmail              VerifyEmailUsecase
+	RequestPasswordReset     RequestPasswordResetUsecase
+	ResetPassword            ResetPasswordUsecase
+}
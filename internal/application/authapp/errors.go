@@ -0,0 +1,47 @@
+package authapp
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidRefreshToken is returned for refresh tokens that cannot be exchanged,
+// whether malformed, expired, unknown, revoked or replayed
+var ErrInvalidRefreshToken = errors.New("invalid refresh token")
+
+// ErrSignupRoleNotAllowed is returned when signing up with a role only admins may grant
+var ErrSignupRoleNotAllowed = errors.New("role cannot be chosen at signup")
+
+// ErrInvalidOIDCLogin is returned for callbacks of sign ins that are unknown, expired or already completed
+var ErrInvalidOIDCLogin = errors.New("invalid oidc login")
+
+// ErrOIDCEmailMissing is returned when the identity provider does not share the email of a new user
+var ErrOIDCEmailMissing = errors.New("identity provider did not share an email")
+
+// ErrOIDCAccountConflict is returned when a local account has the email of the identity,
+// but the identity provider has not verified the email, so the accounts cannot be linked
+var ErrOIDCAccountConflict = errors.New("an account with this email already exists")
+
+// ErrInvalidCredentials is returned for logins with an unknown username or email, or a wrong password,
+// without telling which
+var ErrInvalidCredentials = errors.New("invalid username, email or password")
+
+// LoginThrottledError is returned for logins attempted while the account or the client address
+// is delayed or locked out after failed logins
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("too many failed logins, retry after %s", e.RetryAfter)
+}
+
+// ErrInvalidUserToken is returned for verification and reset tokens that are unknown, expired or already used
+var ErrInvalidUserToken = errors.New("invalid or expired token")
+
+// ErrEmailAlreadyVerified is returned when requesting the verification of a verified email
+var ErrEmailAlreadyVerified = errors.New("email is already verified")
+
+// ErrPasswordEmpty is returned when resetting a password to an empty one
+var ErrPasswordEmpty = errors.New("password cannot be empty")
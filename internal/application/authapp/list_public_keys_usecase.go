@@ -0,0 +1,25 @@
+package authapp
+
+import (
+	"context"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+)
+
+// ListPublicKeysUsecase lists the public keys verifying access tokens,
+// for other services to verify them without calling the api
+type ListPublicKeysUsecase interface {
+	Execute(ctx context.Context) []token.JWK
+}
+
+type listPublicKeysUsecase struct {
+	token token.Token
+}
+
+func NewListPublicKeysUsecase(token token.Token) ListPublicKeysUsecase {
+	return &listPublicKeysUsecase{token}
+}
+
+func (lu *listPublicKeysUsecase) Execute(ctx context.Context) []token.JWK {
+	return lu.token.PublicKeys()
+}
@@ -0,0 +1,36 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// ListSessionsUsecase lists the sessions of a user that can still be refreshed
+type ListSessionsUsecase interface {
+	Execute(ctx context.Context, userID string) ([]*auth.RefreshFamily, error)
+}
+
+type listSessionsUsecase struct {
+	authRepo repo.AuthRepo
+}
+
+func NewListSessionsUsecase(authRepo repo.AuthRepo) ListSessionsUsecase {
+	return &listSessionsUsecase{authRepo}
+}
+
+func (lu *listSessionsUsecase) Execute(ctx context.Context, userID string) ([]*auth.RefreshFamily, error) {
+	// Sessions unused for longer than a refresh token lives have expired
+	since := time.Now().Add(-token.RefreshLifetime)
+
+	fs, err := lu.authRepo.FindActiveRefreshFamilies(ctx, userID, since)
+	if err != nil {
+		return nil, fmt.Errorf("find sessions of user %s: %w", userID, err)
+	}
+
+	return fs, nil
+}
@@ -0,0 +1,36 @@
+package authapp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSessionsUsecase_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	usecase := authapp.NewListSessionsUsecase(mockAuthRepo)
+
+	f, _ := auth.NewRefreshFamily("session-1", "user-1", "token-1")
+
+	// Only sessions used within the lifetime of a refresh token are listed
+	sinceExpired := mock.MatchedBy(func(since time.Time) bool {
+		return time.Since(since.Add(tokenport.RefreshLifetime)) < time.Minute
+	})
+	mockAuthRepo.EXPECT().FindActiveRefreshFamilies(mock.Anything, "user-1", sinceExpired).Return([]*auth.RefreshFamily{f}, nil).Once()
+
+	// --- ACT ---
+	fs, err := usecase.Execute(t.Context(), "user-1")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, []*auth.RefreshFamily{f}, fs)
+}
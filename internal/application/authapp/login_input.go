@@ -0,0 +1,7 @@
+package authapp
+
+type LoginInput struct {
+	Login    string // Username or email
+	Password string
+	IP       string // Address of the client, empty when unknown
+}
@@ -2,45 +2,87 @@ package authapp
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/st-ember/streaming-api/internal/application/ports/hash"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/ports/loginattempts"
 	"github.com/st-ember/streaming-api/internal/application/ports/repo"
 	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 )
 
+// LoginUsecase logs a user in with a password. Failed logins are counted by account and by client address,
+// delaying then locking out further attempts, see auth.AccountLoginThrottle and auth.AddressLoginThrottle.
+// Failures are counted whether the account exists or not, so lockouts do not reveal it.
+// Each attempt is counted as a failure before the password is checked, so concurrent attempts
+// cannot all pass the throttle, and taken back once it succeeds.
 type LoginUsecase interface {
-	Execute(
-		ctx context.Context,
-		login, password string,
-	) (accessToken, refreshToken string, err error)
+	Execute(ctx context.Context, input LoginInput) (accessToken, refreshToken string, err error)
 }
 
 type loginUsecase struct {
 	authRepo repo.AuthRepo
 	hasher   hash.Hasher
 	token    token.Token
+	attempts loginattempts.Store
+	logger   log.Logger
+
+	missingOnce sync.Once
+	missingHash string
 }
 
 func NewLoginUsecase(
 	authRepo repo.AuthRepo,
 	hasher hash.Hasher,
 	token token.Token,
+	attempts loginattempts.Store,
+	logger log.Logger,
 ) LoginUsecase {
-	return &loginUsecase{authRepo, hasher, token}
+	return &loginUsecase{authRepo: authRepo, hasher: hasher, token: token, attempts: attempts, logger: logger}
 }
 
-func (lu *loginUsecase) Execute(
-	ctx context.Context,
-	key, password string,
-) (accessToken, refreshToken string, err error) {
-	u, err := lu.authRepo.FindUserByKey(ctx, key)
+func (lu *loginUsecase) Execute(ctx context.Context, input LoginInput) (accessToken, refreshToken string, err error) {
+	if err := lu.checkThrottle(ctx, input); err != nil {
+		return "", "", err
+	}
+
+	failures, err := lu.reserve(ctx, input)
 	if err != nil {
-		return "", "", fmt.Errorf("find user by login %s: %w", key, err)
+		return "", "", err
+	}
+
+	u, err := lu.authRepo.FindUserByKey(ctx, input.Login)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		lu.release(ctx, input)
+		return "", "", fmt.Errorf("find user by login %s: %w", input.Login, err)
+	}
+
+	// Unknown accounts are checked against a hash too, so they take as long to reject
+	userID, passwordHash := "", lu.missingUserHash()
+	if u != nil {
+		userID, passwordHash = u.ID, u.PasswordHash
 	}
 
-	if !lu.hasher.Verify(password, u.PasswordHash) {
-		return "", "", fmt.Errorf("invalid password: %s", password)
+	if !lu.hasher.Verify(input.Password, passwordHash) || u == nil {
+		lu.auditLockouts(ctx, input, userID, failures)
+		return "", "", ErrInvalidCredentials
+	}
+
+	// The failures of the address are kept, or logging into one account would clear guesses at others
+	if err := lu.attempts.Reset(ctx, accountKey(input.Login)); err != nil {
+		return "", "", fmt.Errorf("reset login failures: %w", err)
+	}
+	if input.IP != "" {
+		if err := lu.attempts.Release(ctx, addressKey(input.IP)); err != nil {
+			return "", "", fmt.Errorf("release login failure: %w", err)
+		}
 	}
 
 	permissions, err := lu.authRepo.FindPermissionsByUserID(ctx, u.ID)
@@ -51,15 +93,133 @@ func (lu *loginUsecase) Execute(
 	u.Permissions = permissions
 
 	// Generate tokens
-	at, err := lu.token.GenerateAccess(u.ID, u.Username, u.Permissions)
+	f, rt, err := startRefreshFamily(ctx, lu.authRepo, lu.token, u.ID)
 	if err != nil {
-		return "", "", fmt.Errorf("generate access token: %w", err)
+		return "", "", err
 	}
 
-	rt, err := lu.token.GenerateRefresh(u.ID)
+	at, err := lu.token.GenerateAccess(u.ID, u.Username, f.ID, u.Permissions)
 	if err != nil {
-		return "", "", fmt.Errorf("generate refresh token: %w", err)
+		return "", "", fmt.Errorf("generate access token: %w", err)
 	}
 
 	return at, rt, nil
 }
+
+// checkThrottle rejects the login while the account or the address has to wait, before the password is checked
+func (lu *loginUsecase) checkThrottle(ctx context.Context, input LoginInput) error {
+	now := time.Now()
+
+	f, err := lu.attempts.Get(ctx, accountKey(input.Login))
+	if err != nil {
+		return fmt.Errorf("get login failures: %w", err)
+	}
+	wait := auth.AccountLoginThrottle.Wait(f, now)
+
+	if input.IP != "" {
+		f, err := lu.attempts.Get(ctx, addressKey(input.IP))
+		if err != nil {
+			return fmt.Errorf("get login failures: %w", err)
+		}
+		wait = max(wait, auth.AddressLoginThrottle.Wait(f, now))
+	}
+
+	if wait > 0 {
+		return &LoginThrottledError{RetryAfter: wait}
+	}
+
+	return nil
+}
+
+// loginFailures are the failures counted for an attempt, by account and by address
+type loginFailures struct {
+	account auth.LoginFailures
+	address auth.LoginFailures
+}
+
+// reserve counts the attempt as failed before the password is checked.
+// Attempts that passed checkThrottle together get distinct counts,
+// those counted past the lockout are rejected without checking the password.
+func (lu *loginUsecase) reserve(ctx context.Context, input LoginInput) (loginFailures, error) {
+	var failures loginFailures
+
+	throttle := auth.AccountLoginThrottle
+	f, err := lu.attempts.Fail(ctx, accountKey(input.Login), throttle.LockoutDuration)
+	if err != nil {
+		return failures, fmt.Errorf("record login failure: %w", err)
+	}
+	failures.account = f
+	if f.Count > throttle.LockoutAfter {
+		return failures, &LoginThrottledError{RetryAfter: throttle.LockoutDuration}
+	}
+
+	if input.IP == "" {
+		return failures, nil
+	}
+
+	throttle = auth.AddressLoginThrottle
+	f, err = lu.attempts.Fail(ctx, addressKey(input.IP), throttle.LockoutDuration)
+	if err != nil {
+		return failures, fmt.Errorf("record login failure: %w", err)
+	}
+	failures.address = f
+	if f.Count > throttle.LockoutAfter {
+		return failures, &LoginThrottledError{RetryAfter: throttle.LockoutDuration}
+	}
+
+	return failures, nil
+}
+
+// release takes back the failures reserved for an attempt that could not be checked
+func (lu *loginUsecase) release(ctx context.Context, input LoginInput) {
+	keys := []string{accountKey(input.Login)}
+	if input.IP != "" {
+		keys = append(keys, addressKey(input.IP))
+	}
+
+	for _, key := range keys {
+		if err := lu.attempts.Release(ctx, key); err != nil {
+			lu.logger.Errorf(ctx, log.CategoryAuth, "", "release login failure: %v", err)
+		}
+	}
+}
+
+// auditLockouts records the lockouts the failed attempt started.
+// userID is empty for unknown accounts.
+func (lu *loginUsecase) auditLockouts(ctx context.Context, input LoginInput, userID string, failures loginFailures) {
+	throttle := auth.AccountLoginThrottle
+	if throttle.LocksOut(failures.account) {
+		lu.logger.Warnf(ctx, log.CategoryAudit, userID,
+			"account %q locked out for %s after %d failed logins, the last from %s",
+			input.Login, throttle.LockoutDuration, failures.account.Count, input.IP)
+	}
+
+	throttle = auth.AddressLoginThrottle
+	if input.IP != "" && throttle.LocksOut(failures.address) {
+		lu.logger.Warnf(ctx, log.CategoryAudit, "",
+			"address %s locked out for %s after %d failed logins, the last for account %q",
+			input.IP, throttle.LockoutDuration, failures.address.Count, input.Login)
+	}
+}
+
+// missingUserHash is the hash passwords of unknown accounts are checked against
+func (lu *loginUsecase) missingUserHash() string {
+	lu.missingOnce.Do(func() {
+		hashed, err := lu.hasher.Hash(uuid.NewString())
+		if err != nil {
+			return // Verify rejects an empty hash
+		}
+		lu.missingHash = hashed
+	})
+
+	return lu.missingHash
+}
+
+// accountKey counts the failures of a username or email, whatever its case
+func accountKey(login string) string {
+	return "account:" + strings.ToLower(login)
+}
+
+func addressKey(ip string) string {
+	return "address:" + ip
+}
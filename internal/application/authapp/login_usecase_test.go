@@ -0,0 +1,217 @@
+package authapp_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/hash"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	loginattemptsmocks "github.com/st-ember/streaming-api/internal/application/ports/loginattempts/mocks"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	tokenmocks "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/user"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type loginMocks struct {
+	authRepo *repomocks.MockAuthRepo
+	token    *tokenmocks.MockToken
+	attempts *loginattemptsmocks.MockStore
+	logger   *logmocks.MockLogger
+}
+
+func newLoginUsecase(t *testing.T) (authapp.LoginUsecase, loginMocks) {
+	m := loginMocks{
+		authRepo: repomocks.NewMockAuthRepo(t),
+		token:    tokenmocks.NewMockToken(t),
+		attempts: loginattemptsmocks.NewMockStore(t),
+		logger:   logmocks.NewMockLogger(t),
+	}
+
+	return authapp.NewLoginUsecase(m.authRepo, hash.NewArgon2Hasher(), m.token, m.attempts, m.logger), m
+}
+
+// loginUser is jane, whose password is "secret"
+func loginUser(t *testing.T) *user.User {
+	hashed, err := hash.NewArgon2Hasher().Hash("secret")
+	require.NoError(t, err)
+	u, _ := user.NewUser("user-1", "jane@example.com", "jane", hashed)
+	return u
+}
+
+var janeLogin = authapp.LoginInput{Login: "Jane", Password: "secret", IP: "203.0.113.7"}
+
+// expectNoFailures finds no failures for the account and address of janeLogin
+func (m loginMocks) expectNoFailures() {
+	m.attempts.EXPECT().Get(mock.Anything, "account:jane").Return(auth.LoginFailures{}, nil).Once()
+	m.attempts.EXPECT().Get(mock.Anything, "address:203.0.113.7").Return(auth.LoginFailures{}, nil).Once()
+}
+
+// expectReserved counts the attempt of janeLogin as failed before its password is checked
+func (m loginMocks) expectReserved(account, address int) {
+	m.attempts.EXPECT().Fail(mock.Anything, "account:jane", auth.AccountLoginThrottle.LockoutDuration).
+		Return(auth.LoginFailures{Count: account, LastAt: time.Now()}, nil).Once()
+	m.attempts.EXPECT().Fail(mock.Anything, "address:203.0.113.7", auth.AddressLoginThrottle.LockoutDuration).
+		Return(auth.LoginFailures{Count: address, LastAt: time.Now()}, nil).Once()
+}
+
+func TestLoginUsecase_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newLoginUsecase(t)
+
+	m.expectNoFailures()
+	m.expectReserved(1, 1)
+	m.authRepo.EXPECT().FindUserByKey(mock.Anything, "Jane").Return(loginUser(t), nil).Once()
+	// Only the failures of the account are forgotten, the address only gets its attempt back
+	m.attempts.EXPECT().Reset(mock.Anything, "account:jane").Return(nil).Once()
+	m.attempts.EXPECT().Release(mock.Anything, "address:203.0.113.7").Return(nil).Once()
+	m.authRepo.EXPECT().FindPermissionsByUserID(mock.Anything, "user-1").Return([]string{auth.PermissionVideoUpload}, nil).Once()
+	m.authRepo.EXPECT().SaveRefreshFamily(mock.Anything, mock.Anything).Return(nil).Once()
+	m.token.EXPECT().GenerateRefresh("user-1", mock.Anything, mock.Anything).Return("refresh-1", nil).Once()
+	m.token.EXPECT().GenerateAccess("user-1", "jane", mock.Anything, []string{auth.PermissionVideoUpload}).Return("access-1", nil).Once()
+
+	// --- ACT ---
+	at, rt, err := usecase.Execute(t.Context(), janeLogin)
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, "access-1", at)
+	require.Equal(t, "refresh-1", rt)
+}
+
+func TestLoginUsecase_WrongPassword(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newLoginUsecase(t)
+
+	m.expectNoFailures()
+	m.expectReserved(1, 1)
+	m.authRepo.EXPECT().FindUserByKey(mock.Anything, "Jane").Return(loginUser(t), nil).Once()
+
+	input := janeLogin
+	input.Password = "guess"
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), input)
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrInvalidCredentials)
+	require.NotContains(t, err.Error(), "guess")
+}
+
+func TestLoginUsecase_UnknownAccountCounted(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newLoginUsecase(t)
+
+	m.expectNoFailures()
+	m.expectReserved(1, 1)
+	m.authRepo.EXPECT().FindUserByKey(mock.Anything, "Jane").Return(nil, sql.ErrNoRows).Once()
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), janeLogin)
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrInvalidCredentials)
+}
+
+func TestLoginUsecase_ReleasedOnError(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newLoginUsecase(t)
+
+	m.expectNoFailures()
+	m.expectReserved(1, 1)
+	m.authRepo.EXPECT().FindUserByKey(mock.Anything, "Jane").Return(nil, sql.ErrConnDone).Once()
+	// The attempt could not be checked, it is not held against the user
+	m.attempts.EXPECT().Release(mock.Anything, "account:jane").Return(nil).Once()
+	m.attempts.EXPECT().Release(mock.Anything, "address:203.0.113.7").Return(nil).Once()
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), janeLogin)
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, sql.ErrConnDone)
+}
+
+func TestLoginUsecase_LockoutAudited(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newLoginUsecase(t)
+
+	m.expectNoFailures()
+	m.expectReserved(auth.AccountLoginThrottle.LockoutAfter, 1)
+	m.authRepo.EXPECT().FindUserByKey(mock.Anything, "Jane").Return(loginUser(t), nil).Once()
+	m.logger.EXPECT().
+		Warnf(mock.Anything, log.CategoryAudit, "user-1", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Once()
+
+	input := janeLogin
+	input.Password = "guess"
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), input)
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrInvalidCredentials)
+}
+
+func TestLoginUsecase_Throttled(t *testing.T) {
+	t.Parallel()
+
+	t.Run("account locked out", func(t *testing.T) {
+		usecase, m := newLoginUsecase(t)
+
+		locked := auth.LoginFailures{Count: auth.AccountLoginThrottle.LockoutAfter, LastAt: time.Now()}
+		m.attempts.EXPECT().Get(mock.Anything, "account:jane").Return(locked, nil).Once()
+		m.attempts.EXPECT().Get(mock.Anything, "address:203.0.113.7").Return(auth.LoginFailures{}, nil).Once()
+
+		// The password is not checked, even a right one
+		_, _, err := usecase.Execute(t.Context(), janeLogin)
+
+		var throttled *authapp.LoginThrottledError
+		require.ErrorAs(t, err, &throttled)
+		require.InDelta(t, auth.AccountLoginThrottle.LockoutDuration.Seconds(), throttled.RetryAfter.Seconds(), 1)
+	})
+
+	t.Run("concurrent attempt counted past the lockout", func(t *testing.T) {
+		usecase, m := newLoginUsecase(t)
+
+		// Another attempt passed the throttle at the same time and took the last count
+		m.expectNoFailures()
+		m.attempts.EXPECT().Fail(mock.Anything, "account:jane", mock.Anything).
+			Return(auth.LoginFailures{Count: auth.AccountLoginThrottle.LockoutAfter + 1, LastAt: time.Now()}, nil).Once()
+
+		// The password is not checked, even a right one
+		_, _, err := usecase.Execute(t.Context(), janeLogin)
+
+		var throttled *authapp.LoginThrottledError
+		require.ErrorAs(t, err, &throttled)
+		require.Equal(t, auth.AccountLoginThrottle.LockoutDuration, throttled.RetryAfter)
+	})
+
+	t.Run("address delayed", func(t *testing.T) {
+		usecase, m := newLoginUsecase(t)
+
+		delayed := auth.LoginFailures{Count: auth.AddressLoginThrottle.FreeAttempts + 1, LastAt: time.Now()}
+		m.attempts.EXPECT().Get(mock.Anything, "account:jane").Return(auth.LoginFailures{}, nil).Once()
+		m.attempts.EXPECT().Get(mock.Anything, "address:203.0.113.7").Return(delayed, nil).Once()
+
+		_, _, err := usecase.Execute(t.Context(), janeLogin)
+
+		var throttled *authapp.LoginThrottledError
+		require.ErrorAs(t, err, &throttled)
+		require.LessOrEqual(t, throttled.RetryAfter, auth.AddressLoginThrottle.BaseDelay)
+	})
+}
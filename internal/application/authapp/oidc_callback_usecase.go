@@ -0,0 +1,213 @@
+package authapp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/hash"
+	"github.com/st-ember/streaming-api/internal/application/ports/oidc"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/user"
+)
+
+// OIDCCallbackUsecase completes a sign in at the identity provider, issuing the api's own tokens.
+// Users are found by their identity at the provider. Users signing in for the first time are linked
+// to the local account of their email when the provider verified it, and created otherwise.
+// Roles mapped from groups are synced at every sign in.
+type OIDCCallbackUsecase interface {
+	Execute(ctx context.Context, state, code string) (accessToken, refreshToken string, err error)
+}
+
+type oidcCallbackUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	authRepo   repo.AuthRepo
+	provider   oidc.Provider
+	hasher     hash.Hasher
+	token      token.Token
+	mapping    auth.GroupRoleMapping
+}
+
+func NewOIDCCallbackUsecase(
+	uowFactory repo.UnitOfWorkFactory,
+	authRepo repo.AuthRepo,
+	provider oidc.Provider,
+	hasher hash.Hasher,
+	token token.Token,
+	mapping auth.GroupRoleMapping,
+) OIDCCallbackUsecase {
+	return &oidcCallbackUsecase{uowFactory, authRepo, provider, hasher, token, mapping}
+}
+
+func (ou *oidcCallbackUsecase) Execute(ctx context.Context, state, code string) (accessToken, refreshToken string, err error) {
+	// Taking the login completes it, a replayed callback finds nothing
+	l, err := ou.authRepo.TakeOIDCLogin(ctx, state)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", "", ErrInvalidOIDCLogin
+		}
+		return "", "", fmt.Errorf("take oidc login: %w", err)
+	}
+
+	if l.IsExpired(time.Now()) {
+		return "", "", ErrInvalidOIDCLogin
+	}
+
+	identity, err := ou.provider.Exchange(ctx, code, l.CodeVerifier)
+	if err != nil {
+		return "", "", fmt.Errorf("exchange code: %w", err)
+	}
+
+	if identity.Nonce != l.Nonce {
+		return "", "", fmt.Errorf("%w: nonce does not match the login", oidc.ErrRejected)
+	}
+
+	// Initialize unit of work
+	uow, err := ou.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	u, err := ou.findOrLinkUser(ctx, authRepo, identity)
+	if err != nil {
+		return "", "", err
+	}
+
+	granted, revoked := ou.mapping.Resolve(identity.Groups)
+	for _, r := range granted {
+		if err := authRepo.SaveUserRole(ctx, u.ID, r); err != nil {
+			return "", "", fmt.Errorf("grant role %s: %w", r, err)
+		}
+	}
+	for _, r := range revoked {
+		if err := authRepo.DeleteUserRole(ctx, u.ID, r); err != nil {
+			return "", "", fmt.Errorf("revoke role %s: %w", r, err)
+		}
+	}
+
+	permissions, err := authRepo.FindPermissionsByUserID(ctx, u.ID)
+	if err != nil {
+		return "", "", fmt.Errorf("find permissions by user id %s: %w", u.ID, err)
+	}
+
+	u.Permissions = permissions
+
+	f, rt, err := startRefreshFamily(ctx, authRepo, ou.token, u.ID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return "", "", fmt.Errorf("finalize transaction %w", err)
+	}
+
+	at, err := ou.token.GenerateAccess(u.ID, u.Username, f.ID, u.Permissions)
+	if err != nil {
+		return "", "", fmt.Errorf("generate access token: %w", err)
+	}
+
+	return at, rt, nil
+}
+
+// findOrLinkUser returns the user of the identity, linking it to a local user on first sign in
+func (ou *oidcCallbackUsecase) findOrLinkUser(ctx context.Context, authRepo repo.AuthRepo, identity *oidc.Identity) (*user.User, error) {
+	u, err := authRepo.FindUserByIdentity(ctx, identity.Issuer, identity.Subject)
+	if err == nil {
+		return u, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("find user by identity %s: %w", identity.Subject, err)
+	}
+
+	if identity.Email == "" {
+		return nil, ErrOIDCEmailMissing
+	}
+
+	u, err = authRepo.FindUserByKey(ctx, identity.Email)
+	switch {
+	case err == nil:
+		// Anyone may claim an unverified email at some providers, linking would hand them the account
+		if !identity.EmailVerified || !strings.EqualFold(u.Email, identity.Email) {
+			return nil, ErrOIDCAccountConflict
+		}
+
+		if !u.IsEmailVerified() {
+			u.VerifyEmail(time.Now())
+			if err := authRepo.SaveUser(ctx, u); err != nil {
+				return nil, fmt.Errorf("save user %s: %w", u.ID, err)
+			}
+		}
+	case errors.Is(err, sql.ErrNoRows):
+		if u, err = ou.createUser(ctx, authRepo, identity); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("find user by email %s: %w", identity.Email, err)
+	}
+
+	if err := authRepo.SaveUserIdentity(ctx, identity.Issuer, identity.Subject, u.ID); err != nil {
+		return nil, fmt.Errorf("save user identity %s: %w", identity.Subject, err)
+	}
+
+	return u, nil
+}
+
+// createUser registers the user of the identity. Its password is random and never shown,
+// the user signs in through the provider.
+func (ou *oidcCallbackUsecase) createUser(ctx context.Context, authRepo repo.AuthRepo, identity *oidc.Identity) (*user.User, error) {
+	username, err := ou.availableUsername(ctx, authRepo, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := randomOIDCSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	hashedPwd, err := ou.hasher.Hash(password)
+	if err != nil {
+		return nil, fmt.Errorf("hash password: %w", err)
+	}
+
+	u, err := user.NewUser(uuid.NewString(), identity.Email, username, hashedPwd)
+	if err != nil {
+		return nil, fmt.Errorf("create user: %w", err)
+	}
+
+	if identity.EmailVerified {
+		u.VerifyEmail(time.Now())
+	}
+
+	if err := authRepo.SaveUser(ctx, u); err != nil {
+		return nil, fmt.Errorf("save user: %w", err)
+	}
+
+	return u, nil
+}
+
+// availableUsername prefers the username at the provider, falling back to the email when it is missing or taken
+func (ou *oidcCallbackUsecase) availableUsername(ctx context.Context, authRepo repo.AuthRepo, identity *oidc.Identity) (string, error) {
+	if identity.Username == "" {
+		return identity.Email, nil
+	}
+
+	_, err := authRepo.FindUserByKey(ctx, identity.Username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return identity.Username, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("find user by username %s: %w", identity.Username, err)
+	}
+
+	return identity.Email, nil
+}
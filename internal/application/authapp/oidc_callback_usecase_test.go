@@ -0,0 +1,240 @@
+package authapp_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/hash"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/oidc"
+	oidcmocks "github.com/st-ember/streaming-api/internal/application/ports/oidc/mocks"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	tokenmocks "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/user"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const issuer = "https://idp.test"
+
+var groupRoles = auth.GroupRoleMapping{
+	DefaultRole: auth.RoleViewer,
+	GroupRoles:  map[string]string{"uploaders": auth.RoleUploader, "admins": auth.RoleAdmin},
+}
+
+type callbackMocks struct {
+	authRepo   *repomocks.MockAuthRepo
+	txAuthRepo *repomocks.MockAuthRepo
+	uow        *repomocks.MockUnitOfWork
+	uowFactory *repomocks.MockUnitOfWorkFactory
+	provider   *oidcmocks.MockProvider
+	token      *tokenmocks.MockToken
+}
+
+func newCallbackUsecase(t *testing.T) (authapp.OIDCCallbackUsecase, callbackMocks) {
+	m := callbackMocks{
+		authRepo:   repomocks.NewMockAuthRepo(t),
+		txAuthRepo: repomocks.NewMockAuthRepo(t),
+		uow:        repomocks.NewMockUnitOfWork(t),
+		uowFactory: repomocks.NewMockUnitOfWorkFactory(t),
+		provider:   oidcmocks.NewMockProvider(t),
+		token:      tokenmocks.NewMockToken(t),
+	}
+
+	return authapp.NewOIDCCallbackUsecase(m.uowFactory, m.authRepo, m.provider, hash.NewArgon2Hasher(), m.token, groupRoles), m
+}
+
+// expectExchange takes the login of state-1 and redeems code-1 for the identity
+func (m callbackMocks) expectExchange(identity *oidc.Identity) {
+	l, _ := auth.NewOIDCLogin("state-1", "nonce-1", "verifier-1")
+	m.authRepo.EXPECT().TakeOIDCLogin(mock.Anything, "state-1").Return(l, nil).Once()
+	m.provider.EXPECT().Exchange(mock.Anything, "code-1", "verifier-1").Return(identity, nil).Once()
+}
+
+func (m callbackMocks) expectTransaction() {
+	m.uowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(m.uow, nil).Once()
+	m.uow.EXPECT().AuthRepo().Return(m.txAuthRepo).Once()
+	m.uow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+}
+
+// expectSignIn expects the session of the user to start with the permissions of its roles
+func (m callbackMocks) expectSignIn(userID, username string) {
+	perms := []string{auth.PermissionVideoUpload}
+	m.txAuthRepo.EXPECT().FindPermissionsByUserID(mock.Anything, userID).Return(perms, nil).Once()
+	m.txAuthRepo.EXPECT().SaveRefreshFamily(mock.Anything, mock.Anything).Return(nil).Once()
+	m.token.EXPECT().GenerateRefresh(userID, mock.Anything, mock.Anything).Return("refresh-1", nil).Once()
+	m.uow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	m.token.EXPECT().GenerateAccess(userID, username, mock.Anything, perms).Return("access-1", nil).Once()
+}
+
+func identity(groups ...string) *oidc.Identity {
+	return &oidc.Identity{
+		Issuer:        issuer,
+		Subject:       "sub-1",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		Username:      "jane",
+		Groups:        groups,
+		Nonce:         "nonce-1",
+	}
+}
+
+func TestOIDCCallbackUsecase_LinkedUser(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newCallbackUsecase(t)
+	u, _ := user.NewUser("user-1", "jane@example.com", "jane", "hash")
+
+	m.expectExchange(identity("uploaders", "unmapped"))
+	m.expectTransaction()
+	m.txAuthRepo.EXPECT().FindUserByIdentity(mock.Anything, issuer, "sub-1").Return(u, nil).Once()
+
+	// Roles of the groups are granted, the other mapped ones revoked
+	m.txAuthRepo.EXPECT().SaveUserRole(mock.Anything, "user-1", auth.RoleUploader).Return(nil).Once()
+	m.txAuthRepo.EXPECT().SaveUserRole(mock.Anything, "user-1", auth.RoleViewer).Return(nil).Once()
+	m.txAuthRepo.EXPECT().DeleteUserRole(mock.Anything, "user-1", auth.RoleAdmin).Return(nil).Once()
+	m.expectSignIn("user-1", "jane")
+
+	// --- ACT ---
+	at, rt, err := usecase.Execute(t.Context(), "state-1", "code-1")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, "access-1", at)
+	require.Equal(t, "refresh-1", rt)
+}
+
+func TestOIDCCallbackUsecase_NewUser(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newCallbackUsecase(t)
+
+	m.expectExchange(identity())
+	m.expectTransaction()
+	m.txAuthRepo.EXPECT().FindUserByIdentity(mock.Anything, issuer, "sub-1").Return(nil, sql.ErrNoRows).Once()
+	m.txAuthRepo.EXPECT().FindUserByKey(mock.Anything, "jane@example.com").Return(nil, sql.ErrNoRows).Once()
+
+	// The username is taken locally, the email is used instead
+	other, _ := user.NewUser("user-2", "other@example.com", "jane", "hash")
+	m.txAuthRepo.EXPECT().FindUserByKey(mock.Anything, "jane").Return(other, nil).Once()
+
+	var created *user.User
+	m.txAuthRepo.EXPECT().SaveUser(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, u *user.User) { created = u }).Return(nil).Once()
+	m.txAuthRepo.EXPECT().SaveUserIdentity(mock.Anything, issuer, "sub-1", mock.Anything).Return(nil).Once()
+	m.txAuthRepo.EXPECT().SaveUserRole(mock.Anything, mock.Anything, auth.RoleViewer).Return(nil).Once()
+	m.txAuthRepo.EXPECT().DeleteUserRole(mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+	m.txAuthRepo.EXPECT().FindPermissionsByUserID(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	m.txAuthRepo.EXPECT().SaveRefreshFamily(mock.Anything, mock.Anything).Return(nil).Once()
+	m.token.EXPECT().GenerateRefresh(mock.Anything, mock.Anything, mock.Anything).Return("refresh-1", nil).Once()
+	m.uow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	m.token.EXPECT().GenerateAccess(mock.Anything, "jane@example.com", mock.Anything, mock.Anything).Return("access-1", nil).Once()
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), "state-1", "code-1")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, "jane@example.com", created.Email)
+	require.Equal(t, "jane@example.com", created.Username)
+	require.NotEmpty(t, created.PasswordHash)
+	require.True(t, created.IsEmailVerified())
+}
+
+func TestOIDCCallbackUsecase_LinksVerifiedEmail(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newCallbackUsecase(t)
+	u, _ := user.NewUser("user-1", "Jane@example.com", "jane", "hash")
+
+	m.expectExchange(identity())
+	m.expectTransaction()
+	m.txAuthRepo.EXPECT().FindUserByIdentity(mock.Anything, issuer, "sub-1").Return(nil, sql.ErrNoRows).Once()
+	m.txAuthRepo.EXPECT().FindUserByKey(mock.Anything, "jane@example.com").Return(u, nil).Once()
+	// The provider verified the email, so is the local user
+	m.txAuthRepo.EXPECT().SaveUser(mock.Anything, u).Return(nil).Once()
+	m.txAuthRepo.EXPECT().SaveUserIdentity(mock.Anything, issuer, "sub-1", "user-1").Return(nil).Once()
+	m.txAuthRepo.EXPECT().SaveUserRole(mock.Anything, "user-1", auth.RoleViewer).Return(nil).Once()
+	m.txAuthRepo.EXPECT().DeleteUserRole(mock.Anything, "user-1", mock.Anything).Return(nil).Twice()
+	m.expectSignIn("user-1", "jane")
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), "state-1", "code-1")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.True(t, u.IsEmailVerified())
+}
+
+func TestOIDCCallbackUsecase_UnverifiedEmailConflict(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newCallbackUsecase(t)
+	u, _ := user.NewUser("user-1", "jane@example.com", "jane", "hash")
+
+	id := identity()
+	id.EmailVerified = false
+	m.expectExchange(id)
+	m.expectTransaction()
+	m.txAuthRepo.EXPECT().FindUserByIdentity(mock.Anything, issuer, "sub-1").Return(nil, sql.ErrNoRows).Once()
+	m.txAuthRepo.EXPECT().FindUserByKey(mock.Anything, "jane@example.com").Return(u, nil).Once()
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), "state-1", "code-1")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrOIDCAccountConflict)
+}
+
+func TestOIDCCallbackUsecase_UnknownState(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newCallbackUsecase(t)
+	m.authRepo.EXPECT().TakeOIDCLogin(mock.Anything, "state-1").Return(nil, sql.ErrNoRows).Once()
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), "state-1", "code-1")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrInvalidOIDCLogin)
+}
+
+func TestOIDCCallbackUsecase_ExpiredLogin(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newCallbackUsecase(t)
+	l, _ := auth.NewOIDCLogin("state-1", "nonce-1", "verifier-1")
+	l.CreatedAt = time.Now().Add(-auth.OIDCLoginLifetime)
+	m.authRepo.EXPECT().TakeOIDCLogin(mock.Anything, "state-1").Return(l, nil).Once()
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), "state-1", "code-1")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrInvalidOIDCLogin)
+}
+
+func TestOIDCCallbackUsecase_NonceMismatch(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newCallbackUsecase(t)
+	id := identity()
+	id.Nonce = "nonce-of-another-login"
+	m.expectExchange(id)
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), "state-1", "code-1")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, oidc.ErrRejected)
+}
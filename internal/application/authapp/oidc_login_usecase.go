@@ -0,0 +1,56 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/oidc"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// OIDCLoginUsecase starts a sign in at the identity provider, returning the url to send the browser to.
+// The state must come back with the callback, the caller binds it to the browser.
+type OIDCLoginUsecase interface {
+	Execute(ctx context.Context) (authURL, state string, err error)
+}
+
+type oidcLoginUsecase struct {
+	authRepo repo.AuthRepo
+	provider oidc.Provider
+}
+
+func NewOIDCLoginUsecase(authRepo repo.AuthRepo, provider oidc.Provider) OIDCLoginUsecase {
+	return &oidcLoginUsecase{authRepo, provider}
+}
+
+func (ou *oidcLoginUsecase) Execute(ctx context.Context) (authURL, state string, err error) {
+	secrets := make([]string, 3)
+	for i := range secrets {
+		if secrets[i], err = randomOIDCSecret(); err != nil {
+			return "", "", err
+		}
+	}
+
+	l, err := auth.NewOIDCLogin(secrets[0], secrets[1], secrets[2])
+	if err != nil {
+		return "", "", fmt.Errorf("create oidc login: %w", err)
+	}
+
+	authURL, err = ou.provider.AuthCodeURL(ctx, l.State, l.Nonce, codeChallenge(l.CodeVerifier))
+	if err != nil {
+		return "", "", fmt.Errorf("build authorization url: %w", err)
+	}
+
+	// Sign ins abandoned at the provider are cleaned up as new ones start
+	if err := ou.authRepo.DeleteExpiredOIDCLogins(ctx, time.Now().Add(-auth.OIDCLoginLifetime)); err != nil {
+		return "", "", fmt.Errorf("delete expired oidc logins: %w", err)
+	}
+
+	if err := ou.authRepo.SaveOIDCLogin(ctx, l); err != nil {
+		return "", "", fmt.Errorf("save oidc login: %w", err)
+	}
+
+	return authURL, l.State, nil
+}
@@ -0,0 +1,47 @@
+package authapp_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	oidcmocks "github.com/st-ember/streaming-api/internal/application/ports/oidc/mocks"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDCLoginUsecase_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockProvider := oidcmocks.NewMockProvider(t)
+	usecase := authapp.NewOIDCLoginUsecase(mockAuthRepo, mockProvider)
+
+	var saved *auth.OIDCLogin
+	mockAuthRepo.EXPECT().DeleteExpiredOIDCLogins(mock.Anything, mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().SaveOIDCLogin(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, l *auth.OIDCLogin) { saved = l }).Return(nil).Once()
+
+	var challenge string
+	mockProvider.EXPECT().AuthCodeURL(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _, _, c string) { challenge = c }).Return("https://idp.test/authorize", nil).Once()
+
+	// --- ACT ---
+	authURL, state, err := usecase.Execute(t.Context())
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, "https://idp.test/authorize", authURL)
+	require.Equal(t, saved.State, state)
+	require.NotEqual(t, saved.State, saved.Nonce)
+
+	// The provider gets the S256 challenge of the verifier kept for the callback
+	sum := sha256.Sum256([]byte(saved.CodeVerifier))
+	require.Equal(t, base64.RawURLEncoding.EncodeToString(sum[:]), challenge)
+	require.Len(t, saved.CodeVerifier, 43)
+}
@@ -0,0 +1,27 @@
+package authapp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+)
+
+// oidcSecretBytes is the size of the state, nonce and code verifier of sign ins, before encoding
+const oidcSecretBytes = 32
+
+// randomOIDCSecret returns an unguessable url safe value, 43 characters as PKCE verifiers require at least
+func randomOIDCSecret() (string, error) {
+	b := make([]byte, oidcSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate oidc secret: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// codeChallenge derives the S256 PKCE challenge sent to the provider from the verifier kept by the api
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
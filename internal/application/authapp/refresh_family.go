@@ -0,0 +1,31 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// startRefreshFamily records a new refresh token family for a login, returning it with its first token.
+// The family id is the session id carried by the access tokens of the login.
+func startRefreshFamily(ctx context.Context, authRepo repo.AuthRepo, tk token.Token, userID string) (*auth.RefreshFamily, string, error) {
+	f, err := auth.NewRefreshFamily(uuid.NewString(), userID, uuid.NewString())
+	if err != nil {
+		return nil, "", fmt.Errorf("create refresh family: %w", err)
+	}
+
+	if err := authRepo.SaveRefreshFamily(ctx, f); err != nil {
+		return nil, "", fmt.Errorf("save refresh family: %w", err)
+	}
+
+	rt, err := tk.GenerateRefresh(userID, f.ID, f.CurrentTokenID)
+	if err != nil {
+		return nil, "", fmt.Errorf("generate refresh token: %w", err)
+	}
+
+	return f, rt, nil
+}
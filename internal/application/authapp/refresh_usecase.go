@@ -0,0 +1,117 @@
+package authapp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/denylist"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// RefreshUsecase exchanges a refresh token for a new pair of tokens.
+// The refresh token is rotated on every use, replaying one that was
+// already exchanged revokes every token issued from the same login,
+// denying its access tokens too.
+type RefreshUsecase interface {
+	Execute(
+		ctx context.Context,
+		refreshToken string,
+	) (accessToken, nextRefreshToken string, err error)
+}
+
+type refreshUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	token      token.Token
+	denylist   denylist.Denylist
+}
+
+func NewRefreshUsecase(uowFactory repo.UnitOfWorkFactory, token token.Token, denylist denylist.Denylist) RefreshUsecase {
+	return &refreshUsecase{uowFactory, token, denylist}
+}
+
+func (ru *refreshUsecase) Execute(
+	ctx context.Context,
+	refreshToken string,
+) (accessToken, nextRefreshToken string, err error) {
+	claims, err := ru.token.ParseRefresh(refreshToken)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %w", ErrInvalidRefreshToken, err)
+	}
+
+	// Initialize unit of work
+	uow, err := ru.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	// Lock the family, so a token exchanged twice at once is detected as replayed
+	f, err := authRepo.LockRefreshFamily(ctx, claims.FamilyID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", "", fmt.Errorf("%w: unknown family %s", ErrInvalidRefreshToken, claims.FamilyID)
+		}
+		return "", "", fmt.Errorf("find refresh family %s: %w", claims.FamilyID, err)
+	}
+
+	if f.UserID != claims.UserID {
+		return "", "", fmt.Errorf("%w: family %s belongs to another user", ErrInvalidRefreshToken, f.ID)
+	}
+
+	nextTokenID := uuid.NewString()
+	if err := f.Rotate(claims.ID, nextTokenID); err != nil {
+		if errors.Is(err, auth.ErrRefreshTokenReused) {
+			// Keep the family revoked, the holder of the latest token must log in again
+			if err := authRepo.SaveRefreshFamily(ctx, f); err != nil {
+				return "", "", fmt.Errorf("revoke refresh family %s: %w", f.ID, err)
+			}
+			if err := uow.Commit(ctx); err != nil {
+				return "", "", fmt.Errorf("finalize transaction %w", err)
+			}
+			// Access tokens issued from the family may be in the hands of whoever replayed the token
+			if err := ru.denylist.Deny(ctx, f.ID, token.AccessLifetime); err != nil {
+				return "", "", fmt.Errorf("deny session %s: %w", f.ID, err)
+			}
+		}
+		return "", "", fmt.Errorf("%w: rotate family %s: %w", ErrInvalidRefreshToken, f.ID, err)
+	}
+
+	// Permissions are read again, so changes to the roles of the user apply from the next refresh
+	u, err := authRepo.FindUserByID(ctx, f.UserID)
+	if err != nil {
+		return "", "", fmt.Errorf("find user by id %s: %w", f.UserID, err)
+	}
+
+	permissions, err := authRepo.FindPermissionsByUserID(ctx, u.ID)
+	if err != nil {
+		return "", "", fmt.Errorf("find permissions by user id %s: %w", u.ID, err)
+	}
+
+	// Generate tokens
+	at, err := ru.token.GenerateAccess(u.ID, u.Username, f.ID, permissions)
+	if err != nil {
+		return "", "", fmt.Errorf("generate access token: %w", err)
+	}
+
+	rt, err := ru.token.GenerateRefresh(u.ID, f.ID, nextTokenID)
+	if err != nil {
+		return "", "", fmt.Errorf("generate refresh token: %w", err)
+	}
+
+	if err := authRepo.SaveRefreshFamily(ctx, f); err != nil {
+		return "", "", fmt.Errorf("save refresh family %s: %w", f.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return "", "", fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return at, rt, nil
+}
@@ -0,0 +1,151 @@
+package authapp_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	denylistmocks "github.com/st-ember/streaming-api/internal/application/ports/denylist/mocks"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	tokenmocks "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/user"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func refreshClaims(tokenID string) *tokenport.RefreshClaims {
+	return &tokenport.RefreshClaims{
+		UserID:           "user-1",
+		FamilyID:         "family-1",
+		RegisteredClaims: jwt.RegisteredClaims{ID: tokenID},
+	}
+}
+
+func TestRefreshUsecase_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockToken := tokenmocks.NewMockToken(t)
+	mockDenylist := denylistmocks.NewMockDenylist(t)
+	usecase := authapp.NewRefreshUsecase(mockUowFactory, mockToken, mockDenylist)
+
+	f, _ := auth.NewRefreshFamily("family-1", "user-1", "token-1")
+	u, _ := user.NewUser("user-1", "user@test.com", "tester", "hash")
+
+	mockToken.EXPECT().ParseRefresh("refresh-1").Return(refreshClaims("token-1"), nil).Once()
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().LockRefreshFamily(mock.Anything, "family-1").Return(f, nil).Once()
+	mockAuthRepo.EXPECT().FindUserByID(mock.Anything, "user-1").Return(u, nil).Once()
+	mockAuthRepo.EXPECT().FindPermissionsByUserID(mock.Anything, "user-1").Return([]string{auth.PermissionVideoUpload}, nil).Once()
+	mockToken.EXPECT().GenerateAccess("user-1", "tester", "family-1", []string{auth.PermissionVideoUpload}).Return("access-2", nil).Once()
+	mockToken.EXPECT().GenerateRefresh("user-1", "family-1", mock.Anything).Return("refresh-2", nil).Once()
+	mockAuthRepo.EXPECT().SaveRefreshFamily(mock.Anything, f).Return(nil).Once()
+
+	// --- ACT ---
+	at, rt, err := usecase.Execute(t.Context(), "refresh-1")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, "access-2", at)
+	require.Equal(t, "refresh-2", rt)
+	require.NotEqual(t, "token-1", f.CurrentTokenID)
+	require.Equal(t, 1, f.Rotations)
+}
+
+func TestRefreshUsecase_ReplayedToken(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockToken := tokenmocks.NewMockToken(t)
+	mockDenylist := denylistmocks.NewMockDenylist(t)
+	usecase := authapp.NewRefreshUsecase(mockUowFactory, mockToken, mockDenylist)
+
+	// The family already rotated past token-1
+	f, _ := auth.NewRefreshFamily("family-1", "user-1", "token-1")
+	_ = f.Rotate("token-1", "token-2")
+
+	mockToken.EXPECT().ParseRefresh("refresh-1").Return(refreshClaims("token-1"), nil).Once()
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().LockRefreshFamily(mock.Anything, "family-1").Return(f, nil).Once()
+
+	// The revocation is committed
+	mockAuthRepo.EXPECT().SaveRefreshFamily(mock.Anything, mock.MatchedBy(func(saved *auth.RefreshFamily) bool {
+		return saved.IsRevoked()
+	})).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	// Access tokens of the family are denied once the revocation is committed
+	mockDenylist.EXPECT().Deny(mock.Anything, "family-1", tokenport.AccessLifetime).Return(nil).Once()
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), "refresh-1")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrInvalidRefreshToken)
+	require.ErrorIs(t, err, auth.ErrRefreshTokenReused)
+}
+
+func TestRefreshUsecase_RevokedFamily(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockToken := tokenmocks.NewMockToken(t)
+	mockDenylist := denylistmocks.NewMockDenylist(t)
+	usecase := authapp.NewRefreshUsecase(mockUowFactory, mockToken, mockDenylist)
+
+	f, _ := auth.NewRefreshFamily("family-1", "user-1", "token-1")
+	f.Revoke()
+
+	mockToken.EXPECT().ParseRefresh("refresh-1").Return(refreshClaims("token-1"), nil).Once()
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().LockRefreshFamily(mock.Anything, "family-1").Return(f, nil).Once()
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), "refresh-1")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrInvalidRefreshToken)
+	require.ErrorIs(t, err, auth.ErrRefreshFamilyRevoked)
+}
+
+func TestRefreshUsecase_UnknownFamily(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockToken := tokenmocks.NewMockToken(t)
+	mockDenylist := denylistmocks.NewMockDenylist(t)
+	usecase := authapp.NewRefreshUsecase(mockUowFactory, mockToken, mockDenylist)
+
+	mockToken.EXPECT().ParseRefresh("refresh-1").Return(refreshClaims("token-1"), nil).Once()
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().LockRefreshFamily(mock.Anything, "family-1").Return(nil, sql.ErrNoRows).Once()
+
+	// --- ACT ---
+	_, _, err := usecase.Execute(t.Context(), "refresh-1")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrInvalidRefreshToken)
+}
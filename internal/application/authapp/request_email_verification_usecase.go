@@ -0,0 +1,54 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// RequestEmailVerificationUsecase mails a user a new link verifying their email, the previous one stops working
+type RequestEmailVerificationUsecase interface {
+	Execute(ctx context.Context, userID string) error
+}
+
+type requestEmailVerificationUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	mailer     *UserMailer
+}
+
+func NewRequestEmailVerificationUsecase(uowFactory repo.UnitOfWorkFactory, mailer *UserMailer) RequestEmailVerificationUsecase {
+	return &requestEmailVerificationUsecase{uowFactory, mailer}
+}
+
+func (ru *requestEmailVerificationUsecase) Execute(ctx context.Context, userID string) error {
+	// Initialize unit of work
+	uow, err := ru.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	u, err := authRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("find user %s: %w", userID, err)
+	}
+
+	if u.IsEmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+
+	token, err := issueUserToken(ctx, authRepo, u.ID, auth.PurposeVerifyEmail)
+	if err != nil {
+		return err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return ru.mailer.sendVerification(ctx, u, token)
+}
@@ -0,0 +1,69 @@
+package authapp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// RequestPasswordResetUsecase mails the user of an email a link to choose a new password.
+// Unknown emails succeed without mailing, so callers cannot tell which emails have an account.
+// The mail is sent in the background, so the time taken does not tell either.
+type RequestPasswordResetUsecase interface {
+	Execute(ctx context.Context, email string) error
+}
+
+type requestPasswordResetUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	mailer     *UserMailer
+	logger     log.Logger
+}
+
+func NewRequestPasswordResetUsecase(uowFactory repo.UnitOfWorkFactory, mailer *UserMailer, logger log.Logger) RequestPasswordResetUsecase {
+	return &requestPasswordResetUsecase{uowFactory, mailer, logger}
+}
+
+func (ru *requestPasswordResetUsecase) Execute(ctx context.Context, email string) error {
+	// Initialize unit of work
+	uow, err := ru.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	// The key also matches usernames, only the email of the user is accepted
+	u, err := authRepo.FindUserByKey(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !strings.EqualFold(u.Email, email)) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("find user by email %s: %w", email, err)
+	}
+
+	token, err := issueUserToken(ctx, authRepo, u.ID, auth.PurposeResetPassword)
+	if err != nil {
+		return err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	// The request may be over before the mail is sent
+	sendCtx := context.WithoutCancel(ctx)
+	go func() {
+		if err := ru.mailer.sendPasswordReset(sendCtx, u, token); err != nil {
+			ru.logger.Errorf(sendCtx, log.CategoryAuth, u.ID, "request password reset: %v", err)
+		}
+	}()
+
+	return nil
+}
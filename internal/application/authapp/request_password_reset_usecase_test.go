@@ -0,0 +1,168 @@
+package authapp_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
+	logmocks "github.com/st-ember/streaming-api/internal/application/ports/log/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/mailsender"
+	mailsendermocks "github.com/st-ember/streaming-api/internal/application/ports/mailsender/mocks"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/user"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var mailLinks = authapp.MailLinks{
+	VerifyEmailURL:   "https://app.test/verify-email",
+	ResetPasswordURL: "https://app.test/reset-password",
+}
+
+var linkPattern = regexp.MustCompile(`https://app\.test/\S+`)
+
+// mailedToken is the token of the link in the body of the mail
+func mailedToken(t *testing.T, msg mailsender.Message) string {
+	t.Helper()
+
+	link, err := url.Parse(linkPattern.FindString(msg.Body))
+	require.NoError(t, err)
+
+	return link.Query().Get("token")
+}
+
+func TestRequestPasswordResetUsecase_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockSender := mailsendermocks.NewMockSender(t)
+	mockLogger := logmocks.NewMockLogger(t)
+	usecase := authapp.NewRequestPasswordResetUsecase(mockUowFactory, authapp.NewUserMailer(mockSender, mailLinks), mockLogger)
+
+	u, _ := user.NewUser("user-1", "jane@example.com", "jane", "hash")
+
+	var saved *auth.UserToken
+	var mailed mailsender.Message
+	sent := make(chan struct{})
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindUserByKey(mock.Anything, "Jane@example.com").Return(u, nil).Once()
+	mockAuthRepo.EXPECT().DeleteUserTokens(mock.Anything, "user-1", auth.PurposeResetPassword).Return(nil).Once()
+	mockAuthRepo.EXPECT().SaveUserToken(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, t *auth.UserToken) { saved = t }).Return(nil).Once()
+	mockSender.EXPECT().Send(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, msg mailsender.Message) { mailed = msg; close(sent) }).Return(nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "Jane@example.com")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	// The mail is sent in the background
+	<-sent
+	require.Equal(t, "jane@example.com", mailed.To)
+	require.Equal(t, auth.PurposeResetPassword, saved.Purpose)
+
+	// Only the hash of the mailed token is stored
+	token := mailedToken(t, mailed)
+	sum := sha256.Sum256([]byte(token))
+	require.NotEmpty(t, token)
+	require.Equal(t, hex.EncodeToString(sum[:]), saved.Hash)
+}
+
+func TestRequestPasswordResetUsecase_MailFails(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockSender := mailsendermocks.NewMockSender(t)
+	mockLogger := logmocks.NewMockLogger(t)
+	usecase := authapp.NewRequestPasswordResetUsecase(mockUowFactory, authapp.NewUserMailer(mockSender, mailLinks), mockLogger)
+
+	u, _ := user.NewUser("user-1", "jane@example.com", "jane", "hash")
+	logged := make(chan struct{})
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindUserByKey(mock.Anything, "jane@example.com").Return(u, nil).Once()
+	mockAuthRepo.EXPECT().DeleteUserTokens(mock.Anything, "user-1", auth.PurposeResetPassword).Return(nil).Once()
+	mockAuthRepo.EXPECT().SaveUserToken(mock.Anything, mock.Anything).Return(nil).Once()
+	mockSender.EXPECT().Send(mock.Anything, mock.Anything).Return(errors.New("smtp down")).Once()
+	mockLogger.EXPECT().Errorf(mock.Anything, log.CategoryAuth, "user-1", mock.Anything, mock.Anything).
+		Run(func(context.Context, log.LogCategory, string, string, ...any) { close(logged) }).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "jane@example.com")
+
+	// --- ASSERT ---
+	// The caller is answered the same, the failure is logged
+	require.NoError(t, err)
+	<-logged
+}
+
+func TestRequestPasswordResetUsecase_UnknownEmail(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockSender := mailsendermocks.NewMockSender(t)
+	mockLogger := logmocks.NewMockLogger(t)
+	usecase := authapp.NewRequestPasswordResetUsecase(mockUowFactory, authapp.NewUserMailer(mockSender, mailLinks), mockLogger)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindUserByKey(mock.Anything, "nobody@example.com").Return(nil, sql.ErrNoRows).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "nobody@example.com")
+
+	// --- ASSERT ---
+	// Nothing is mailed, but the caller cannot tell
+	require.NoError(t, err)
+}
+
+func TestRequestPasswordResetUsecase_UsernameNotAccepted(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockSender := mailsendermocks.NewMockSender(t)
+	mockLogger := logmocks.NewMockLogger(t)
+	usecase := authapp.NewRequestPasswordResetUsecase(mockUowFactory, authapp.NewUserMailer(mockSender, mailLinks), mockLogger)
+
+	u, _ := user.NewUser("user-1", "jane@example.com", "jane", "hash")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindUserByKey(mock.Anything, "jane").Return(u, nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "jane")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+}
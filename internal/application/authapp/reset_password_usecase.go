@@ -0,0 +1,117 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/denylist"
+	"github.com/st-ember/streaming-api/internal/application/ports/hash"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// ResetPasswordUsecase sets a new password with the token mailed to the user.
+// Every session and api key of the user is revoked, whoever knew the old password is logged out
+// and cannot keep access through a key created with it.
+// Using the token proves owning the email, which is verified along.
+type ResetPasswordUsecase interface {
+	Execute(ctx context.Context, token, password string) error
+}
+
+type resetPasswordUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	hasher     hash.Hasher
+	denylist   denylist.Denylist
+}
+
+func NewResetPasswordUsecase(uowFactory repo.UnitOfWorkFactory, hasher hash.Hasher, denylist denylist.Denylist) ResetPasswordUsecase {
+	return &resetPasswordUsecase{uowFactory, hasher, denylist}
+}
+
+func (ru *resetPasswordUsecase) Execute(ctx context.Context, resetToken, password string) error {
+	if password == "" {
+		return ErrPasswordEmpty
+	}
+
+	// Hashed before the transaction, hashing is slow
+	hashedPwd, err := ru.hasher.Hash(password)
+	if err != nil {
+		return fmt.Errorf("hash password: %w", err)
+	}
+
+	// Initialize unit of work
+	uow, err := ru.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	t, err := takeUserToken(ctx, authRepo, resetToken, auth.PurposeResetPassword)
+	if err != nil {
+		return err
+	}
+
+	u, err := authRepo.FindUserByID(ctx, t.UserID)
+	if err != nil {
+		return fmt.Errorf("find user %s: %w", t.UserID, err)
+	}
+
+	if err := u.UpdatePasswordHash(hashedPwd); err != nil {
+		return fmt.Errorf("update password: %w", err)
+	}
+
+	now := time.Now()
+	u.VerifyEmail(now)
+
+	if err := authRepo.SaveUser(ctx, u); err != nil {
+		return fmt.Errorf("save user %s: %w", u.ID, err)
+	}
+
+	// Other links mailed before stop working
+	if err := authRepo.DeleteUserTokens(ctx, u.ID, auth.PurposeResetPassword); err != nil {
+		return fmt.Errorf("delete reset tokens: %w", err)
+	}
+
+	fs, err := authRepo.FindActiveRefreshFamilies(ctx, u.ID, now.Add(-token.RefreshLifetime))
+	if err != nil {
+		return fmt.Errorf("find sessions of user %s: %w", u.ID, err)
+	}
+
+	for _, f := range fs {
+		f.Revoke()
+		if err := authRepo.SaveRefreshFamily(ctx, f); err != nil {
+			return fmt.Errorf("save session %s: %w", f.ID, err)
+		}
+	}
+
+	ks, err := authRepo.FindAPIKeysByUserID(ctx, u.ID)
+	if err != nil {
+		return fmt.Errorf("find api keys of user %s: %w", u.ID, err)
+	}
+
+	for _, k := range ks {
+		if k.IsRevoked() {
+			continue
+		}
+		k.Revoke()
+		if err := authRepo.SaveAPIKey(ctx, k); err != nil {
+			return fmt.Errorf("save api key %s: %w", k.ID, err)
+		}
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	for _, f := range fs {
+		if err := ru.denylist.Deny(ctx, f.ID, token.AccessLifetime); err != nil {
+			return fmt.Errorf("deny session %s: %w", f.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package authapp_test
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/hash"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	denylistmocks "github.com/st-ember/streaming-api/internal/application/ports/denylist/mocks"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/user"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenHash is the stored form of the token
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestResetPasswordUsecase_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockDenylist := denylistmocks.NewMockDenylist(t)
+	hasher := hash.NewArgon2Hasher()
+	usecase := authapp.NewResetPasswordUsecase(mockUowFactory, hasher, mockDenylist)
+
+	u, _ := user.NewUser("user-1", "jane@example.com", "jane", "old-hash")
+	rt, _ := auth.NewUserToken(tokenHash("reset-1"), "user-1", auth.PurposeResetPassword)
+	f, _ := auth.NewRefreshFamily("session-1", "user-1", "token-1")
+	k, _ := auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", "hash", nil, nil)
+	revoked, _ := auth.NewAPIKey("key-2", "user-1", "old", "sak_def", "hash", nil, nil)
+	revoked.Revoke()
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().TakeUserToken(mock.Anything, tokenHash("reset-1"), auth.PurposeResetPassword).Return(rt, nil).Once()
+	mockAuthRepo.EXPECT().FindUserByID(mock.Anything, "user-1").Return(u, nil).Once()
+	mockAuthRepo.EXPECT().SaveUser(mock.Anything, u).Return(nil).Once()
+	mockAuthRepo.EXPECT().DeleteUserTokens(mock.Anything, "user-1", auth.PurposeResetPassword).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindActiveRefreshFamilies(mock.Anything, "user-1", mock.Anything).Return([]*auth.RefreshFamily{f}, nil).Once()
+	mockAuthRepo.EXPECT().SaveRefreshFamily(mock.Anything, f).Return(nil).Once()
+	// Keys created with the old password stop working, revoked ones are left as they are
+	mockAuthRepo.EXPECT().FindAPIKeysByUserID(mock.Anything, "user-1").Return([]*auth.APIKey{k, revoked}, nil).Once()
+	mockAuthRepo.EXPECT().SaveAPIKey(mock.Anything, k).Return(nil).Once()
+
+	// Sessions logged in with the old password are logged out
+	mockDenylist.EXPECT().Deny(mock.Anything, "session-1", tokenport.AccessLifetime).Return(nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "reset-1", "new-password")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.True(t, f.IsRevoked())
+	require.True(t, k.IsRevoked())
+	require.True(t, u.IsEmailVerified())
+	require.True(t, hasher.Verify("new-password", u.PasswordHash))
+}
+
+func TestResetPasswordUsecase_InvalidToken(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	usecase := authapp.NewResetPasswordUsecase(mockUowFactory, hash.NewArgon2Hasher(), denylistmocks.NewMockDenylist(t))
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().TakeUserToken(mock.Anything, tokenHash("used"), auth.PurposeResetPassword).Return(nil, sql.ErrNoRows).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "used", "new-password")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrInvalidUserToken)
+}
+
+func TestResetPasswordUsecase_ExpiredToken(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	usecase := authapp.NewResetPasswordUsecase(mockUowFactory, hash.NewArgon2Hasher(), denylistmocks.NewMockDenylist(t))
+
+	rt, _ := auth.NewUserToken(tokenHash("reset-1"), "user-1", auth.PurposeResetPassword)
+	rt.ExpiresAt = time.Now().Add(-time.Minute)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().TakeUserToken(mock.Anything, tokenHash("reset-1"), auth.PurposeResetPassword).Return(rt, nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "reset-1", "new-password")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrInvalidUserToken)
+}
+
+func TestResetPasswordUsecase_EmptyPassword(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase := authapp.NewResetPasswordUsecase(repomocks.NewMockUnitOfWorkFactory(t), hash.NewArgon2Hasher(), denylistmocks.NewMockDenylist(t))
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "reset-1", "")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrPasswordEmpty)
+}
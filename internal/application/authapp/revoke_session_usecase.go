@@ -0,0 +1,66 @@
+package authapp
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/denylist"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+)
+
+// RevokeSessionUsecase ends a session of a user, logging out is revoking the current one.
+// The session can no longer be refreshed, and its access tokens are denied until they expire.
+type RevokeSessionUsecase interface {
+	Execute(ctx context.Context, userID, sessionID string) error
+}
+
+type revokeSessionUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	denylist   denylist.Denylist
+}
+
+func NewRevokeSessionUsecase(uowFactory repo.UnitOfWorkFactory, denylist denylist.Denylist) RevokeSessionUsecase {
+	return &revokeSessionUsecase{uowFactory, denylist}
+}
+
+func (ru *revokeSessionUsecase) Execute(ctx context.Context, userID, sessionID string) error {
+	// Initialize unit of work
+	uow, err := ru.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	f, err := authRepo.LockRefreshFamily(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("find session %s: %w", sessionID, err)
+	}
+
+	// Sessions of other users are reported missing
+	if f.UserID != userID {
+		return sql.ErrNoRows
+	}
+
+	// Revoking again only denies the session once more, so a failed denial can be retried
+	if !f.IsRevoked() {
+		f.Revoke()
+
+		if err := authRepo.SaveRefreshFamily(ctx, f); err != nil {
+			return fmt.Errorf("save session %s: %w", f.ID, err)
+		}
+
+		if err := uow.Commit(ctx); err != nil {
+			return fmt.Errorf("finalize transaction %w", err)
+		}
+	}
+
+	if err := ru.denylist.Deny(ctx, f.ID, token.AccessLifetime); err != nil {
+		return fmt.Errorf("deny session %s: %w", f.ID, err)
+	}
+
+	return nil
+}
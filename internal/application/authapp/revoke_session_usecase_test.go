@@ -0,0 +1,126 @@
+package authapp_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	denylistmocks "github.com/st-ember/streaming-api/internal/application/ports/denylist/mocks"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeSessionUsecase_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockDenylist := denylistmocks.NewMockDenylist(t)
+	usecase := authapp.NewRevokeSessionUsecase(mockUowFactory, mockDenylist)
+
+	f, _ := auth.NewRefreshFamily("session-1", "user-1", "token-1")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().LockRefreshFamily(mock.Anything, "session-1").Return(f, nil).Once()
+	mockAuthRepo.EXPECT().SaveRefreshFamily(mock.Anything, f).Return(nil).Once()
+
+	// Access tokens are denied as long as they may live
+	mockDenylist.EXPECT().Deny(mock.Anything, "session-1", tokenport.AccessLifetime).Return(nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "user-1", "session-1")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.True(t, f.IsRevoked())
+}
+
+func TestRevokeSessionUsecase_AlreadyRevoked(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockDenylist := denylistmocks.NewMockDenylist(t)
+	usecase := authapp.NewRevokeSessionUsecase(mockUowFactory, mockDenylist)
+
+	f, _ := auth.NewRefreshFamily("session-1", "user-1", "token-1")
+	f.Revoke()
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().LockRefreshFamily(mock.Anything, "session-1").Return(f, nil).Once()
+
+	// The session is denied again without being saved
+	mockDenylist.EXPECT().Deny(mock.Anything, "session-1", tokenport.AccessLifetime).Return(nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "user-1", "session-1")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+}
+
+func TestRevokeSessionUsecase_SessionOfAnotherUser(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockDenylist := denylistmocks.NewMockDenylist(t)
+	usecase := authapp.NewRevokeSessionUsecase(mockUowFactory, mockDenylist)
+
+	f, _ := auth.NewRefreshFamily("session-1", "user-2", "token-1")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().LockRefreshFamily(mock.Anything, "session-1").Return(f, nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "user-1", "session-1")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.False(t, f.IsRevoked())
+}
+
+func TestRevokeSessionUsecase_DenylistFailure(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockDenylist := denylistmocks.NewMockDenylist(t)
+	usecase := authapp.NewRevokeSessionUsecase(mockUowFactory, mockDenylist)
+
+	f, _ := auth.NewRefreshFamily("session-1", "user-1", "token-1")
+	denyErr := errors.New("connection refused")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().LockRefreshFamily(mock.Anything, "session-1").Return(f, nil).Once()
+	mockAuthRepo.EXPECT().SaveRefreshFamily(mock.Anything, f).Return(nil).Once()
+	mockDenylist.EXPECT().Deny(mock.Anything, "session-1", tokenport.AccessLifetime).Return(denyErr).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "user-1", "session-1")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, denyErr)
+}
@@ -6,11 +6,16 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/st-ember/streaming-api/internal/application/ports/hash"
+	"github.com/st-ember/streaming-api/internal/application/ports/log"
 	"github.com/st-ember/streaming-api/internal/application/ports/repo"
 	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 	"github.com/st-ember/streaming-api/internal/domain/user"
 )
 
+// SignupUsecase registers a user with one of the signup roles, viewer when none is given.
+// Other roles are only granted by admins through the role management api.
+// The user is mailed a link verifying their email, a failed mail is logged and can be requested again.
 type SignupUsecase interface {
 	Execute(
 		ctx context.Context,
@@ -23,6 +28,8 @@ type signupUsecase struct {
 	authRepo   repo.AuthRepo
 	hasher     hash.Hasher
 	token      token.Token
+	mailer     *UserMailer
+	logger     log.Logger
 }
 
 func NewSignupUsecase(
@@ -30,14 +37,23 @@ func NewSignupUsecase(
 	authRepo repo.AuthRepo,
 	hasher hash.Hasher,
 	token token.Token,
+	mailer *UserMailer,
+	logger log.Logger,
 ) SignupUsecase {
-	return &signupUsecase{uowFactory, authRepo, hasher, token}
+	return &signupUsecase{uowFactory, authRepo, hasher, token, mailer, logger}
 }
 
 func (su *signupUsecase) Execute(
 	ctx context.Context,
 	username, email, password, roleName string,
 ) (accessToken, refreshToken string, err error) {
+	if roleName == "" {
+		roleName = auth.RoleViewer
+	}
+	if !auth.IsSignupRole(roleName) {
+		return "", "", ErrSignupRoleNotAllowed
+	}
+
 	hashedPwd, err := su.hasher.Hash(password)
 	if err != nil {
 		return "", "", fmt.Errorf("hash password")
@@ -76,19 +92,30 @@ func (su *signupUsecase) Execute(
 		return "", "", fmt.Errorf("save user role: %w", err)
 	}
 
+	verifyToken, err := issueUserToken(ctx, txAuthRepo, u.ID, auth.PurposeVerifyEmail)
+	if err != nil {
+		uow.Rollback(ctx)
+		return "", "", err
+	}
+
+	f, rt, err := startRefreshFamily(ctx, txAuthRepo, su.token, u.ID)
+	if err != nil {
+		uow.Rollback(ctx)
+		return "", "", err
+	}
+
 	if err := uow.Commit(ctx); err != nil {
 		return "", "", fmt.Errorf("commit user info: %w", err)
 	}
 
-	// Generate tokens
-	at, err := su.token.GenerateAccess(u.ID, u.Username, u.Permissions)
-	if err != nil {
-		return "", "", fmt.Errorf("generate access token: %w", err)
+	if err := su.mailer.sendVerification(ctx, u, verifyToken); err != nil {
+		su.logger.Warnf(ctx, log.CategoryAuth, u.ID, "signup: %v", err)
 	}
 
-	rt, err := su.token.GenerateRefresh(u.ID)
+	// Generate tokens
+	at, err := su.token.GenerateAccess(u.ID, u.Username, f.ID, u.Permissions)
 	if err != nil {
-		return "", "", fmt.Errorf("generate refresh token: %w", err)
+		return "", "", fmt.Errorf("generate access token: %w", err)
 	}
 
 	return at, rt, nil
@@ -0,0 +1,152 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// SigningKeyPropagation is how long a new key is published before it signs,
+// every node must sync its keys and every verifier refresh its JWK set within it
+const SigningKeyPropagation = 10 * time.Minute
+
+// SyncSigningKeysUsecase rotates the keys signing access tokens when due, then loads them into the token.
+// Every api node runs it periodically, the first one finding a rotation due performs it.
+type SyncSigningKeysUsecase interface {
+	Execute(ctx context.Context) error
+}
+
+type syncSigningKeysUsecase struct {
+	uowFactory       repo.UnitOfWorkFactory
+	token            token.Token
+	generator        token.KeyGenerator
+	algorithm        string
+	rotationInterval time.Duration
+}
+
+func NewSyncSigningKeysUsecase(
+	uowFactory repo.UnitOfWorkFactory,
+	token token.Token,
+	generator token.KeyGenerator,
+	algorithm string,
+	rotationInterval time.Duration,
+) SyncSigningKeysUsecase {
+	return &syncSigningKeysUsecase{uowFactory, token, generator, algorithm, rotationInterval}
+}
+
+func (su *syncSigningKeysUsecase) Execute(ctx context.Context) error {
+	// Initialize unit of work
+	uow, err := su.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	keys, err := authRepo.LockSigningKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("lock signing keys: %w", err)
+	}
+
+	now := time.Now()
+	if su.rotationDue(keys, now) {
+		k, err := su.rotate(ctx, authRepo, keys, now)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, k)
+	}
+
+	if err := authRepo.DeleteExpiredSigningKeys(ctx, now); err != nil {
+		return fmt.Errorf("delete expired signing keys: %w", err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	valid := make([]*auth.SigningKey, 0, len(keys))
+	for _, k := range keys {
+		if k.Verifies(now) {
+			valid = append(valid, k)
+		}
+	}
+
+	if err := su.token.SetSigningKeys(valid); err != nil {
+		return fmt.Errorf("set signing keys: %w", err)
+	}
+
+	return nil
+}
+
+// rotationDue reports whether the newest key is older than the rotation interval,
+// or was made for another algorithm than the configured one
+func (su *syncSigningKeysUsecase) rotationDue(keys []*auth.SigningKey, now time.Time) bool {
+	var newest *auth.SigningKey
+	for _, k := range keys {
+		if newest == nil || k.CreatedAt.After(newest.CreatedAt) {
+			newest = k
+		}
+	}
+
+	if newest == nil {
+		return true
+	}
+
+	age := now.Sub(newest.CreatedAt)
+
+	// Waiting for the propagation keeps nodes of a rolling deploy from rotating back and forth
+	if newest.Algorithm != su.algorithm && age >= SigningKeyPropagation {
+		return true
+	}
+
+	return age >= su.rotationInterval
+}
+
+// rotate adds a key signing once it propagated, and retires the previous keys
+// once the last tokens they signed have expired
+func (su *syncSigningKeysUsecase) rotate(
+	ctx context.Context,
+	authRepo repo.AuthRepo,
+	keys []*auth.SigningKey,
+	now time.Time,
+) (*auth.SigningKey, error) {
+	// Without a signing key, nobody can have learnt of the previous ones, the new key signs at once
+	activeFrom := now
+	if auth.CurrentSigningKey(keys, now) != nil {
+		activeFrom = now.Add(SigningKeyPropagation)
+	}
+
+	der, err := su.generator.Generate(su.algorithm)
+	if err != nil {
+		return nil, fmt.Errorf("generate signing key: %w", err)
+	}
+
+	k, err := auth.NewSigningKey(uuid.NewString(), su.algorithm, der, activeFrom)
+	if err != nil {
+		return nil, fmt.Errorf("create signing key: %w", err)
+	}
+
+	if err := authRepo.SaveSigningKey(ctx, k); err != nil {
+		return nil, fmt.Errorf("save signing key %s: %w", k.ID, err)
+	}
+
+	for _, old := range keys {
+		if old.IsRetired() {
+			continue
+		}
+
+		old.Retire(activeFrom.Add(token.AccessLifetime))
+		if err := authRepo.SaveSigningKey(ctx, old); err != nil {
+			return nil, fmt.Errorf("retire signing key %s: %w", old.ID, err)
+		}
+	}
+
+	return k, nil
+}
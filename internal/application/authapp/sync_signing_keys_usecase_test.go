@@ -0,0 +1,215 @@
+package authapp_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	tokenmocks "github.com/st-ember/streaming-api/internal/application/ports/token/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const rotationInterval = 30 * 24 * time.Hour
+
+type syncSigningKeysMocks struct {
+	authRepo   *repomocks.MockAuthRepo
+	uow        *repomocks.MockUnitOfWork
+	uowFactory *repomocks.MockUnitOfWorkFactory
+	token      *tokenmocks.MockToken
+	generator  *tokenmocks.MockKeyGenerator
+}
+
+// newSyncSigningKeys builds the usecase, expecting the stored keys to be locked
+// and the transaction to be committed
+func newSyncSigningKeys(t *testing.T, algorithm string, stored []*auth.SigningKey) (authapp.SyncSigningKeysUsecase, *syncSigningKeysMocks) {
+	t.Helper()
+
+	m := &syncSigningKeysMocks{
+		authRepo:   repomocks.NewMockAuthRepo(t),
+		uow:        repomocks.NewMockUnitOfWork(t),
+		uowFactory: repomocks.NewMockUnitOfWorkFactory(t),
+		token:      tokenmocks.NewMockToken(t),
+		generator:  tokenmocks.NewMockKeyGenerator(t),
+	}
+
+	m.uowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(m.uow, nil).Once()
+	m.uow.EXPECT().AuthRepo().Return(m.authRepo).Once()
+	m.uow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	m.uow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	m.authRepo.EXPECT().LockSigningKeys(mock.Anything).Return(stored, nil).Once()
+	m.authRepo.EXPECT().DeleteExpiredSigningKeys(mock.Anything, mock.Anything).Return(nil).Once()
+
+	usecase := authapp.NewSyncSigningKeysUsecase(m.uowFactory, m.token, m.generator, algorithm, rotationInterval)
+
+	return usecase, m
+}
+
+// storedKey is a key created age ago, signing since then
+func storedKey(id, algorithm string, age time.Duration) *auth.SigningKey {
+	k, _ := auth.NewSigningKey(id, algorithm, []byte("der-"+id), time.Now().Add(-age))
+	k.CreatedAt = k.ActiveFrom
+	return k
+}
+
+func TestSyncSigningKeysUsecase_FirstKey(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	usecase, m := newSyncSigningKeys(t, auth.SigningAlgorithmEdDSA, []*auth.SigningKey{})
+
+	m.generator.EXPECT().Generate(auth.SigningAlgorithmEdDSA).Return([]byte("der"), nil).Once()
+	m.authRepo.EXPECT().SaveSigningKey(mock.Anything, mock.Anything).Return(nil).Once()
+
+	// The first key signs at once
+	var loaded []*auth.SigningKey
+	m.token.EXPECT().SetSigningKeys(mock.Anything).Run(func(keys []*auth.SigningKey) {
+		loaded = keys
+	}).Return(nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context())
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Len(t, loaded, 1)
+	require.Equal(t, auth.SigningAlgorithmEdDSA, loaded[0].Algorithm)
+	require.Equal(t, loaded[0], auth.CurrentSigningKey(loaded, time.Now()))
+}
+
+func TestSyncSigningKeysUsecase_NotDue(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	current := storedKey("key-1", auth.SigningAlgorithmRS256, time.Hour)
+	usecase, m := newSyncSigningKeys(t, auth.SigningAlgorithmRS256, []*auth.SigningKey{current})
+
+	// Only loads the stored key
+	m.token.EXPECT().SetSigningKeys([]*auth.SigningKey{current}).Return(nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context())
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.False(t, current.IsRetired())
+}
+
+func TestSyncSigningKeysUsecase_Rotation(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	current := storedKey("key-1", auth.SigningAlgorithmRS256, rotationInterval+time.Hour)
+	usecase, m := newSyncSigningKeys(t, auth.SigningAlgorithmRS256, []*auth.SigningKey{current})
+
+	m.generator.EXPECT().Generate(auth.SigningAlgorithmRS256).Return([]byte("der"), nil).Once()
+	m.authRepo.EXPECT().SaveSigningKey(mock.Anything, mock.Anything).Return(nil).Twice()
+
+	var loaded []*auth.SigningKey
+	m.token.EXPECT().SetSigningKeys(mock.Anything).Run(func(keys []*auth.SigningKey) {
+		loaded = keys
+	}).Return(nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context())
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Len(t, loaded, 2)
+	next := loaded[1]
+
+	// The new key is published before it signs
+	now := time.Now()
+	require.Equal(t, current, auth.CurrentSigningKey(loaded, now))
+	require.Equal(t, next, auth.CurrentSigningKey(loaded, now.Add(authapp.SigningKeyPropagation)))
+
+	// The old key verifies the tokens it signed until they expired
+	require.True(t, current.IsRetired())
+	require.Equal(t, next.ActiveFrom.Add(tokenport.AccessLifetime), *current.ExpiresAt)
+}
+
+func TestSyncSigningKeysUsecase_AlgorithmChange(t *testing.T) {
+	t.Run("should rotate to the configured algorithm", func(t *testing.T) {
+		t.Parallel()
+
+		// --- ARRANGE ---
+		current := storedKey("key-1", auth.SigningAlgorithmRS256, time.Hour)
+		usecase, m := newSyncSigningKeys(t, auth.SigningAlgorithmEdDSA, []*auth.SigningKey{current})
+
+		m.generator.EXPECT().Generate(auth.SigningAlgorithmEdDSA).Return([]byte("der"), nil).Once()
+		m.authRepo.EXPECT().SaveSigningKey(mock.Anything, mock.Anything).Return(nil).Twice()
+		m.token.EXPECT().SetSigningKeys(mock.Anything).Return(nil).Once()
+
+		// --- ACT ---
+		err := usecase.Execute(t.Context())
+
+		// --- ASSERT ---
+		require.NoError(t, err)
+		require.True(t, current.IsRetired())
+	})
+
+	t.Run("should wait for the newest key to propagate", func(t *testing.T) {
+		t.Parallel()
+
+		// --- ARRANGE ---
+		// Another node just rotated to its own algorithm
+		current := storedKey("key-1", auth.SigningAlgorithmRS256, time.Minute)
+		usecase, m := newSyncSigningKeys(t, auth.SigningAlgorithmEdDSA, []*auth.SigningKey{current})
+
+		m.token.EXPECT().SetSigningKeys([]*auth.SigningKey{current}).Return(nil).Once()
+
+		// --- ACT ---
+		err := usecase.Execute(t.Context())
+
+		// --- ASSERT ---
+		require.NoError(t, err)
+	})
+}
+
+func TestSyncSigningKeysUsecase_ExpiredKeysAreNotLoaded(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	expired := storedKey("key-1", auth.SigningAlgorithmRS256, 2*time.Hour)
+	expired.Retire(time.Now().Add(-time.Minute))
+	current := storedKey("key-2", auth.SigningAlgorithmRS256, time.Hour)
+	usecase, m := newSyncSigningKeys(t, auth.SigningAlgorithmRS256, []*auth.SigningKey{expired, current})
+
+	m.token.EXPECT().SetSigningKeys([]*auth.SigningKey{current}).Return(nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context())
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+}
+
+func TestSyncSigningKeysUsecase_GenerateFailure(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockToken := tokenmocks.NewMockToken(t)
+	mockGenerator := tokenmocks.NewMockKeyGenerator(t)
+	usecase := authapp.NewSyncSigningKeysUsecase(mockUowFactory, mockToken, mockGenerator, auth.SigningAlgorithmRS256, rotationInterval)
+
+	genErr := errors.New("entropy exhausted")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().LockSigningKeys(mock.Anything).Return(nil, nil).Once()
+	mockGenerator.EXPECT().Generate(auth.SigningAlgorithmRS256).Return(nil, genErr).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context())
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, genErr)
+}
@@ -0,0 +1,112 @@
+package authapp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/mailsender"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/user"
+)
+
+// userTokenBytes is the size of the tokens mailed to users, before encoding
+const userTokenBytes = 32
+
+// MailLinks are the pages of the app users open from their mails, the token is added to their query
+type MailLinks struct {
+	VerifyEmailURL   string
+	ResetPasswordURL string
+}
+
+// UserMailer mails users the links carrying their verification and reset tokens
+type UserMailer struct {
+	sender mailsender.Sender
+	links  MailLinks
+}
+
+func NewUserMailer(sender mailsender.Sender, links MailLinks) *UserMailer {
+	return &UserMailer{sender, links}
+}
+
+func (m *UserMailer) sendVerification(ctx context.Context, u *user.User, token string) error {
+	link, err := withToken(m.links.VerifyEmailURL, token)
+	if err != nil {
+		return err
+	}
+
+	return m.send(ctx, u, "Verify your email", fmt.Sprintf(
+		"Hello %s,\n\nOpen the link below to verify your email:\n%s\n\nThe link expires in %s.\n",
+		u.Username, link, auth.PurposeVerifyEmail.Lifetime(),
+	))
+}
+
+func (m *UserMailer) sendPasswordReset(ctx context.Context, u *user.User, token string) error {
+	link, err := withToken(m.links.ResetPasswordURL, token)
+	if err != nil {
+		return err
+	}
+
+	return m.send(ctx, u, "Reset your password", fmt.Sprintf(
+		"Hello %s,\n\nOpen the link below to choose a new password:\n%s\n\n"+
+			"The link expires in %s. If you did not ask to reset your password, ignore this mail.\n",
+		u.Username, link, auth.PurposeResetPassword.Lifetime(),
+	))
+}
+
+func (m *UserMailer) send(ctx context.Context, u *user.User, subject, body string) error {
+	if err := m.sender.Send(ctx, mailsender.Message{To: u.Email, Subject: subject, Body: body}); err != nil {
+		return fmt.Errorf("mail %s to user %s: %w", subject, u.ID, err)
+	}
+
+	return nil
+}
+
+func withToken(page, token string) (string, error) {
+	u, err := url.Parse(page)
+	if err != nil {
+		return "", fmt.Errorf("parse link %s: %w", page, err)
+	}
+
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// issueUserToken records a new token for the purpose, replacing those issued before, and returns it
+func issueUserToken(ctx context.Context, authRepo repo.AuthRepo, userID string, purpose auth.UserTokenPurpose) (string, error) {
+	b := make([]byte, userTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate %s token: %w", purpose, err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	t, err := auth.NewUserToken(hashUserToken(token), userID, purpose)
+	if err != nil {
+		return "", fmt.Errorf("create %s token: %w", purpose, err)
+	}
+
+	// Only the latest link mailed works
+	if err := authRepo.DeleteUserTokens(ctx, userID, purpose); err != nil {
+		return "", fmt.Errorf("delete previous %s tokens: %w", purpose, err)
+	}
+
+	if err := authRepo.SaveUserToken(ctx, t); err != nil {
+		return "", fmt.Errorf("save %s token: %w", purpose, err)
+	}
+
+	return token, nil
+}
+
+// hashUserToken is the stored form of a token. Tokens are random, so a fast hash is enough.
+func hashUserToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,75 @@
+package authapp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// VerifyEmailUsecase marks the email of a user verified with the token mailed to it
+type VerifyEmailUsecase interface {
+	Execute(ctx context.Context, token string) error
+}
+
+type verifyEmailUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewVerifyEmailUsecase(uowFactory repo.UnitOfWorkFactory) VerifyEmailUsecase {
+	return &verifyEmailUsecase{uowFactory}
+}
+
+func (vu *verifyEmailUsecase) Execute(ctx context.Context, token string) error {
+	// Initialize unit of work
+	uow, err := vu.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	t, err := takeUserToken(ctx, authRepo, token, auth.PurposeVerifyEmail)
+	if err != nil {
+		return err
+	}
+
+	u, err := authRepo.FindUserByID(ctx, t.UserID)
+	if err != nil {
+		return fmt.Errorf("find user %s: %w", t.UserID, err)
+	}
+
+	u.VerifyEmail(time.Now())
+
+	if err := authRepo.SaveUser(ctx, u); err != nil {
+		return fmt.Errorf("save user %s: %w", u.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
+
+// takeUserToken uses the token, ErrInvalidUserToken when it cannot be used
+func takeUserToken(ctx context.Context, authRepo repo.AuthRepo, token string, purpose auth.UserTokenPurpose) (*auth.UserToken, error) {
+	t, err := authRepo.TakeUserToken(ctx, hashUserToken(token), purpose)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidUserToken
+		}
+		return nil, fmt.Errorf("take %s token: %w", purpose, err)
+	}
+
+	if t.IsExpired(time.Now()) {
+		return nil, ErrInvalidUserToken
+	}
+
+	return t, nil
+}
@@ -0,0 +1,62 @@
+package authapp_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/user"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyEmailUsecase_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	usecase := authapp.NewVerifyEmailUsecase(mockUowFactory)
+
+	u, _ := user.NewUser("user-1", "jane@example.com", "jane", "hash")
+	vt, _ := auth.NewUserToken(tokenHash("verify-1"), "user-1", auth.PurposeVerifyEmail)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().TakeUserToken(mock.Anything, tokenHash("verify-1"), auth.PurposeVerifyEmail).Return(vt, nil).Once()
+	mockAuthRepo.EXPECT().FindUserByID(mock.Anything, "user-1").Return(u, nil).Once()
+	mockAuthRepo.EXPECT().SaveUser(mock.Anything, u).Return(nil).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "verify-1")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.True(t, u.IsEmailVerified())
+}
+
+func TestVerifyEmailUsecase_InvalidToken(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	usecase := authapp.NewVerifyEmailUsecase(mockUowFactory)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().TakeUserToken(mock.Anything, tokenHash("unknown"), auth.PurposeVerifyEmail).Return(nil, sql.ErrNoRows).Once()
+
+	// --- ACT ---
+	err := usecase.Execute(t.Context(), "unknown")
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, authapp.ErrInvalidUserToken)
+}
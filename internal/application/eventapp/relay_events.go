@@ -0,0 +1,108 @@
+package eventapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/eventbus"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+// RelayEventsUsecase publishes pending outbox events to the bus
+// and queues a webhook delivery for each subscription matching them.
+// An event is marked as published in the transaction that claimed it,
+// so a crash between publishing and committing publishes it again:
+// delivery is at least once.
+type RelayEventsUsecase interface {
+	// Execute returns the number of events published
+	Execute(ctx context.Context, limit int) (int, error)
+}
+
+type relayEventsUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	publisher  eventbus.Publisher
+}
+
+func NewRelayEventsUsecase(uowFactory repo.UnitOfWorkFactory, publisher eventbus.Publisher) RelayEventsUsecase {
+	return &relayEventsUsecase{uowFactory, publisher}
+}
+
+func (u *relayEventsUsecase) Execute(ctx context.Context, limit int) (int, error) {
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Initialize repos
+	outboxRepo := uow.OutboxRepo()
+	webhookRepo := uow.WebhookRepo()
+
+	// Lock the next event of each video
+	es, err := outboxRepo.ClaimPending(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("claim pending events: %w", err)
+	}
+	if len(es) == 0 {
+		return 0, nil
+	}
+
+	subscriptions, err := webhookRepo.ListActiveSubscriptions(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list active webhook subscriptions: %w", err)
+	}
+
+	// An event failing to publish stays pending and holds back the next events of its video,
+	// the events of other videos are still published
+	var publishErrs []error
+	published := 0
+	for _, e := range es {
+		if err := u.publisher.Publish(ctx, e); err != nil {
+			publishErrs = append(publishErrs, fmt.Errorf("publish event %s: %w", e.ID, err))
+			continue
+		}
+
+		// Update and persist entities
+		if err := e.MarkAsPublished(time.Now()); err != nil {
+			return 0, fmt.Errorf("mark event %s as published: %w", e.ID, err)
+		}
+		if err := outboxRepo.Save(ctx, e); err != nil {
+			return 0, fmt.Errorf("save event %s in db: %w", e.ID, err)
+		}
+		if err := queueDeliveries(ctx, webhookRepo, subscriptions, e); err != nil {
+			return 0, err
+		}
+		published++
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return published, errors.Join(publishErrs...)
+}
+
+// queueDeliveries creates the webhook deliveries of the event, in the transaction marking it as published
+func queueDeliveries(ctx context.Context, webhookRepo repo.WebhookRepo, subscriptions []*webhook.Subscription, e *event.Event) error {
+	for _, s := range subscriptions {
+		if !s.Matches(e.Type) {
+			continue
+		}
+
+		d, err := webhook.NewDelivery(uuid.NewString(), s, e)
+		if err != nil {
+			return fmt.Errorf("create delivery of event %s to subscription %s: %w", e.ID, s.ID, err)
+		}
+		if err := webhookRepo.SaveDelivery(ctx, d); err != nil {
+			return fmt.Errorf("save delivery %s in db: %w", d.ID, err)
+		}
+	}
+
+	return nil
+}
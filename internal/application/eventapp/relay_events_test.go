@@ -0,0 +1,146 @@
+package eventapp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/eventapp"
+	eventbusmocks "github.com/st-ember/streaming-api/internal/application/ports/eventbus/mocks"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayEvents_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockPublisher := eventbusmocks.NewMockPublisher(t)
+
+	uploaded, _ := event.NewEvent("event-1", event.TypeVideoUploaded, "video-1", nil)
+	other, _ := event.NewEvent("event-2", event.TypeVideoUploaded, "video-2", nil)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockOutboxRepo.EXPECT().ClaimPending(mock.Anything, 10).Return([]*event.Event{uploaded, other}, nil).Once()
+	mockWebhookRepo.EXPECT().ListActiveSubscriptions(mock.Anything).Return([]*webhook.Subscription{}, nil).Once()
+	mockPublisher.EXPECT().Publish(mock.Anything, uploaded).Return(nil).Once()
+	mockPublisher.EXPECT().Publish(mock.Anything, other).Return(nil).Once()
+	mockOutboxRepo.EXPECT().Save(mock.Anything, uploaded).Return(nil).Once()
+	mockOutboxRepo.EXPECT().Save(mock.Anything, other).Return(nil).Once()
+
+	usecase := eventapp.NewRelayEventsUsecase(mockUowFactory, mockPublisher)
+	n, err := usecase.Execute(t.Context(), 10)
+
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.True(t, uploaded.IsPublished())
+	require.True(t, other.IsPublished())
+}
+
+func TestRelayEvents_QueuesWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockPublisher := eventbusmocks.NewMockPublisher(t)
+
+	published, _ := event.NewEvent("event-1", event.TypeVideoPublished, "video-1", nil)
+	all, _ := webhook.NewSubscription("sub-1", "https://example.com/all", "secret", nil)
+	failures, _ := webhook.NewSubscription("sub-2", "https://example.com/failures", "secret",
+		[]event.EventType{event.TypeVideoFailed})
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockOutboxRepo.EXPECT().ClaimPending(mock.Anything, 10).Return([]*event.Event{published}, nil).Once()
+	mockWebhookRepo.EXPECT().ListActiveSubscriptions(mock.Anything).Return([]*webhook.Subscription{all, failures}, nil).Once()
+	mockPublisher.EXPECT().Publish(mock.Anything, published).Return(nil).Once()
+	mockOutboxRepo.EXPECT().Save(mock.Anything, published).Return(nil).Once()
+	// Only the subscription matching the event gets a delivery
+	mockWebhookRepo.EXPECT().SaveDelivery(mock.Anything, mock.MatchedBy(func(d *webhook.Delivery) bool {
+		return d.SubscriptionID == "sub-1" && d.EventID == "event-1"
+	})).Return(nil).Once()
+
+	usecase := eventapp.NewRelayEventsUsecase(mockUowFactory, mockPublisher)
+	n, err := usecase.Execute(t.Context(), 10)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
+
+func TestRelayEvents_KeepsEventPendingOnPublishFailure(t *testing.T) {
+	t.Parallel()
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockPublisher := eventbusmocks.NewMockPublisher(t)
+
+	failing, _ := event.NewEvent("event-1", event.TypeVideoUploaded, "video-1", nil)
+	other, _ := event.NewEvent("event-2", event.TypeVideoUploaded, "video-2", nil)
+	expectedErr := errors.New("redis unavailable")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockOutboxRepo.EXPECT().ClaimPending(mock.Anything, 10).Return([]*event.Event{failing, other}, nil).Once()
+	mockWebhookRepo.EXPECT().ListActiveSubscriptions(mock.Anything).Return([]*webhook.Subscription{}, nil).Once()
+	mockPublisher.EXPECT().Publish(mock.Anything, failing).Return(expectedErr).Once()
+	mockPublisher.EXPECT().Publish(mock.Anything, other).Return(nil).Once()
+	mockOutboxRepo.EXPECT().Save(mock.Anything, other).Return(nil).Once()
+
+	usecase := eventapp.NewRelayEventsUsecase(mockUowFactory, mockPublisher)
+	n, err := usecase.Execute(t.Context(), 10)
+
+	// The other video is not held back by the failure
+	require.ErrorIs(t, err, expectedErr)
+	require.Equal(t, 1, n)
+	require.False(t, failing.IsPublished())
+	require.True(t, other.IsPublished())
+}
+
+func TestRelayEvents_FailsOnCommit(t *testing.T) {
+	t.Parallel()
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockPublisher := eventbusmocks.NewMockPublisher(t)
+
+	e, _ := event.NewEvent("event-1", event.TypeVideoArchived, "video-1", nil)
+	expectedErr := errors.New("commit failed")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(expectedErr).Once()
+
+	mockOutboxRepo.EXPECT().ClaimPending(mock.Anything, 10).Return([]*event.Event{e}, nil).Once()
+	mockWebhookRepo.EXPECT().ListActiveSubscriptions(mock.Anything).Return([]*webhook.Subscription{}, nil).Once()
+	mockPublisher.EXPECT().Publish(mock.Anything, e).Return(nil).Once()
+	mockOutboxRepo.EXPECT().Save(mock.Anything, e).Return(nil).Once()
+
+	usecase := eventapp.NewRelayEventsUsecase(mockUowFactory, mockPublisher)
+	n, err := usecase.Execute(t.Context(), 10)
+
+	// The event stays pending and will be published again
+	require.ErrorIs(t, err, expectedErr)
+	require.Equal(t, 0, n)
+}
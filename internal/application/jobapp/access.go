@@ -0,0 +1,16 @@
+package jobapp
+
+import (
+	"slices"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+)
+
+// canManage reports whether the user may change the jobs of the video,
+// only its owner and video or job admins can
+func canManage(v *video.Video, userID string, permissions []string) bool {
+	return v.IsOwnedBy(userID) ||
+		slices.Contains(permissions, auth.PermissionVideoAdmin) ||
+		slices.Contains(permissions, auth.PermissionJobAdmin)
+}
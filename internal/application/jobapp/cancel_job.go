@@ -0,0 +1,111 @@
+package jobapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+)
+
+// CancelJobUsecase marks a queued or running job as cancelled.
+// Workers running the job notice the change and stop the transcode.
+// Only the owner of the video and admins may cancel its jobs.
+type CancelJobUsecase interface {
+	Execute(ctx context.Context, input CancelJobInput) error
+}
+
+type cancelJobUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewCancelJobUsecase(uowFactory repo.UnitOfWorkFactory) CancelJobUsecase {
+	return &cancelJobUsecase{uowFactory}
+}
+
+func (u *cancelJobUsecase) Execute(ctx context.Context, input CancelJobInput) error {
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Initialize repos
+	videoRepo := uow.VideoRepo()
+	jobRepo := uow.JobRepo()
+	outboxRepo := uow.OutboxRepo()
+
+	// Find job
+	j, err := jobRepo.FindByID(ctx, input.ID)
+	if err != nil {
+		return fmt.Errorf("find job %s: %w", input.ID, err)
+	}
+
+	// Lock the related video, the jobs of its pipeline change one at a time
+	video, err := videoRepo.LockByID(ctx, j.VideoID)
+	if err != nil {
+		return fmt.Errorf("get video related to job %s: %w", j.ID, err)
+	}
+
+	// Read the job again, it may have finished before the video was locked
+	j, err = jobRepo.FindByID(ctx, input.ID)
+	if err != nil {
+		return fmt.Errorf("find job %s: %w", input.ID, err)
+	}
+
+	// Check access
+	if !canManage(video, input.UserID, input.Permissions) {
+		return fmt.Errorf("cancel job %s: %w", j.ID, ErrJobForbidden)
+	}
+
+	// Update job entity
+	wasRunning := j.IsRunning()
+	if err := j.Cancel(); err != nil {
+		return fmt.Errorf("cancel job %s: %w", j.ID, err)
+	}
+
+	// Jobs left that cannot be of use anymore are cancelled with it
+	if err := abandonPipeline(ctx, jobRepo, outboxRepo, j, true); err != nil {
+		return err
+	}
+
+	// Release the video so it can be processed again
+	released := video.IsProcessing()
+	if released {
+		if err := video.MarkAsFailed(); err != nil {
+			return fmt.Errorf("mark video %s as failed: %w", video.ID, err)
+		}
+	}
+
+	// Persist entities
+	if err := jobRepo.Save(ctx, j); err != nil {
+		return fmt.Errorf("save job %s in db: %w", j.ID, err)
+	}
+
+	// Close the attempt of the interrupted run
+	if wasRunning {
+		if err := cancelAttempt(ctx, jobRepo, j); err != nil {
+			return err
+		}
+	}
+	if err := videoRepo.Save(ctx, video); err != nil {
+		return fmt.Errorf("save video %s in db: %w", video.ID, err)
+	}
+
+	// Record events
+	if err := recordJobEvent(ctx, outboxRepo, event.TypeJobCancelled, j); err != nil {
+		return err
+	}
+	if released {
+		if err := recordVideoEvent(ctx, outboxRepo, event.TypeVideoFailed, video); err != nil {
+			return err
+		}
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package jobapp
+
+// CancelJobInput names the job to cancel and the user asking
+type CancelJobInput struct {
+	ID          string
+	UserID      string
+	Permissions []string
+}
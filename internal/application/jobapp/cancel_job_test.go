@@ -0,0 +1,276 @@
+package jobapp_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelJob_SuccessCaseRunningJob(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	runningJob, err := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	require.NoError(t, err)
+	runningJob.Status = job.StatusRunning
+
+	relatedVideo, err := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	require.NoError(t, err)
+	relatedVideo.Status = video.StatusProcessing
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCancelled, event.TypeJobCancelled, event.TypeVideoFailed)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(runningJob, nil).Twice()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	// A job waiting on its turn in the same pipeline is cancelled with it
+	pendingSibling, _ := job.NewJob("sibling-id", "video-id", job.TypeThumbnail)
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{runningJob, pendingSibling}, nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, pendingSibling).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, runningJob).Return(nil).Once()
+	attempt := runningAttempt()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 0).Return(attempt, nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, attempt).Return(nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, relatedVideo).Return(nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewCancelJobUsecase(mockUowFactory)
+	err = usecase.Execute(t.Context(), jobapp.CancelJobInput{ID: "job-id", UserID: "owner-id"})
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, job.StatusCancelled, runningJob.Status)
+	require.Equal(t, job.StatusCancelled, pendingSibling.Status)
+	// The video is released so it can be processed again
+	require.Equal(t, video.StatusFailed, relatedVideo.Status)
+	require.Equal(t, job.StatusCancelled, attempt.Status)
+}
+
+func TestCancelJob_SuccessCaseClaimedJobNotStarted(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	// Claimed by a worker, still waiting for its turn to start
+	claimedJob, err := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	require.NoError(t, err)
+	require.NoError(t, claimedJob.Start("worker-1"))
+
+	relatedVideo, err := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	require.NoError(t, err)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCancelled)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(claimedJob, nil).Twice()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{claimedJob}, nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, claimedJob).Return(nil).Once()
+	// The attempt is only opened once the worker starts the job
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 1).Return(nil, sql.ErrNoRows).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, relatedVideo).Return(nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewCancelJobUsecase(mockUowFactory)
+	err = usecase.Execute(t.Context(), jobapp.CancelJobInput{ID: "job-id", UserID: "owner-id"})
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, job.StatusCancelled, claimedJob.Status)
+}
+
+func TestCancelJob_SuccessCasePendingJob(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	pendingJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCancelled)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(pendingJob, nil).Twice()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{pendingJob}, nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, pendingJob).Return(nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, relatedVideo).Return(nil).Once()
+
+	usecase := jobapp.NewCancelJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), jobapp.CancelJobInput{ID: "job-id", UserID: "owner-id"})
+
+	require.NoError(t, err)
+	require.Equal(t, job.StatusCancelled, pendingJob.Status)
+	require.Equal(t, video.StatusPending, relatedVideo.Status)
+}
+
+func TestCancelJob_FailsOnJobNotFound(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(nil, sql.ErrNoRows).Once()
+
+	usecase := jobapp.NewCancelJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), jobapp.CancelJobInput{ID: "job-id", UserID: "owner-id"})
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestCancelJob_FailsIfNotOwner(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	pendingJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(pendingJob, nil).Twice()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+
+	usecase := jobapp.NewCancelJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), jobapp.CancelJobInput{ID: "job-id", UserID: "someone-else"})
+
+	require.ErrorIs(t, err, jobapp.ErrJobForbidden)
+	require.Equal(t, job.StatusPending, pendingJob.Status)
+}
+
+func TestCancelJob_SuccessCaseJobAdmin(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	pendingJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCancelled)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(pendingJob, nil).Twice()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{pendingJob}, nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, pendingJob).Return(nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, relatedVideo).Return(nil).Once()
+
+	usecase := jobapp.NewCancelJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), jobapp.CancelJobInput{
+		ID:          "job-id",
+		UserID:      "admin-id",
+		Permissions: []string{auth.PermissionJobAdmin},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, job.StatusCancelled, pendingJob.Status)
+}
+
+func TestCancelJob_FailsIfJobFinished(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	completedJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	completedJob.Status = job.StatusCompleted
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(completedJob, nil).Twice()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+
+	usecase := jobapp.NewCancelJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), jobapp.CancelJobInput{ID: "job-id", UserID: "owner-id"})
+
+	require.ErrorIs(t, err, job.ErrCannotBeCancelled)
+}
+
+func TestCancelJob_FailsOnCommit(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	pendingJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	expectedErr := errors.New("commit failed")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCancelled)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(expectedErr).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(pendingJob, nil).Twice()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{pendingJob}, nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, pendingJob).Return(nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, relatedVideo).Return(nil).Once()
+
+	usecase := jobapp.NewCancelJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), jobapp.CancelJobInput{ID: "job-id", UserID: "owner-id"})
+
+	require.ErrorIs(t, err, expectedErr)
+}
@@ -0,0 +1,91 @@
+package jobapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+type CompleteSplitJobUsecase interface {
+	Execute(ctx context.Context, job *job.Job, chunks []string) error
+}
+
+type completeSplitJobUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewCompleteSplitJobUsecase(uowFactory repo.UnitOfWorkFactory) *completeSplitJobUsecase {
+	return &completeSplitJobUsecase{uowFactory}
+}
+
+// Execute completes a split job and queues the jobs encoding its chunks,
+// which any worker can pick up, then makes the assemble job of the video wait on them.
+func (u *completeSplitJobUsecase) Execute(ctx context.Context, j *job.Job, chunks []string) error {
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Initialize repos
+	jobRepo := uow.JobRepo()
+	outboxRepo := uow.OutboxRepo()
+
+	// Create the jobs of the chunks, the assemble job waits on them
+	js, err := jobRepo.ListByVideoID(ctx, j.VideoID)
+	if err != nil {
+		return fmt.Errorf("list jobs of video %s: %w", j.VideoID, err)
+	}
+	assemble, err := job.FindAssembleJob(js, j)
+	if err != nil {
+		return fmt.Errorf("find assemble job of job %s: %w", j.ID, err)
+	}
+	chunkJobs, err := job.NewChunkJobs(j, assemble, chunks, uuid.NewString)
+	if err != nil {
+		return fmt.Errorf("create chunk jobs of job %s: %w", j.ID, err)
+	}
+
+	// Update job entity
+	if err := j.Complete(fmt.Sprintf("%d chunks", len(chunks))); err != nil {
+		return fmt.Errorf("complete job %s: %w", j.ID, err)
+	}
+
+	// Close the attempt
+	attempt, err := jobRepo.FindAttempt(ctx, j.ID, j.Attempts)
+	if err != nil {
+		return fmt.Errorf("find job %s attempt %d: %w", j.ID, j.Attempts, err)
+	}
+	if err := attempt.Complete(); err != nil {
+		return fmt.Errorf("complete job %s attempt %d: %w", j.ID, attempt.Number, err)
+	}
+
+	// Persist entities
+	if err := jobRepo.Save(ctx, j); err != nil {
+		return fmt.Errorf("save job %s in db: %w", j.ID, err)
+	}
+	if err := jobRepo.SaveAttempt(ctx, attempt); err != nil {
+		return fmt.Errorf("save job %s attempt %d in db: %w", j.ID, attempt.Number, err)
+	}
+	// Chunks first, the assemble job depends on them
+	for _, cj := range append(chunkJobs, assemble) {
+		if err := jobRepo.Save(ctx, cj); err != nil {
+			return fmt.Errorf("save job %s in db: %w", cj.ID, err)
+		}
+	}
+
+	// Record events
+	if err := recordJobEvent(ctx, outboxRepo, event.TypeJobCompleted, j); err != nil {
+		return err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package jobapp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompleteSplitJob_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	splitJob, err := job.NewJob("job-id", "video-id", job.TypeSplit)
+	require.NoError(t, err)
+	splitJob.Status = job.StatusRunning
+
+	assembleJob, err := job.NewJob("assemble-id", "video-id", job.TypeAssemble)
+	require.NoError(t, err)
+	assembleJob.DependsOn = []string{splitJob.ID}
+
+	chunks := []string{"chunks/0000.mp4", "chunks/0001.mp4", "chunks/0002.mp4"}
+
+	var saved []*job.Job
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCompleted)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{splitJob, assembleJob}, nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).
+		Run(func(_ context.Context, j *job.Job) { saved = append(saved, j) }).
+		Return(nil).Times(5)
+
+	// --- ACT ---
+	usecase := jobapp.NewCompleteSplitJobUsecase(mockUowFactory)
+	err = usecase.Execute(t.Context(), splitJob, chunks)
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, job.StatusCompleted, splitJob.Status)
+	require.Len(t, saved, 5)
+	require.Equal(t, splitJob, saved[0])
+
+	// Every chunk gets its own job, the assemble job of the video waits for all of them
+	for i, j := range saved[1:4] {
+		require.Equal(t, job.TypeChunkEncode, j.Type)
+		require.Equal(t, []string{splitJob.ID}, j.DependsOn)
+		spec, err := j.ChunkSpec()
+		require.NoError(t, err)
+		require.Equal(t, chunks[i], spec.Path)
+		require.Equal(t, assembleJob.ID, spec.GroupID)
+	}
+	require.Same(t, assembleJob, saved[4])
+	require.Len(t, assembleJob.DependsOn, 4)
+}
+
+func TestCompleteSplitJob_FailsOnNoChunks(t *testing.T) {
+	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	splitJob, _ := job.NewJob("job-id", "video-id", job.TypeSplit)
+	splitJob.Status = job.StatusRunning
+	assembleJob, _ := job.NewJob("assemble-id", "video-id", job.TypeAssemble)
+	assembleJob.DependsOn = []string{splitJob.ID}
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{splitJob, assembleJob}, nil).Once()
+
+	usecase := jobapp.NewCompleteSplitJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), splitJob, nil)
+
+	// Nothing is saved, the split job is left running
+	require.ErrorIs(t, err, job.ErrChunksEmpty)
+	require.Equal(t, job.StatusRunning, splitJob.Status)
+}
+
+func TestCompleteSplitJob_FailsOnSaveChunkJob(t *testing.T) {
+	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	splitJob, _ := job.NewJob("job-id", "video-id", job.TypeSplit)
+	splitJob.Status = job.StatusRunning
+	assembleJob, _ := job.NewJob("assemble-id", "video-id", job.TypeAssemble)
+	assembleJob.DependsOn = []string{splitJob.ID}
+
+	expectedErr := errors.New("db error")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{splitJob, assembleJob}, nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, splitJob).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(expectedErr).Once()
+
+	usecase := jobapp.NewCompleteSplitJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), splitJob, []string{"chunks/0000.mp4"})
+
+	require.ErrorIs(t, err, expectedErr)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 )
 
@@ -28,13 +29,13 @@ func NewCompleteTranscodeJobUsecase(uowFactory repo.UnitOfWorkFactory) *complete
 
 func (u *completeTranscodeJobUsecase) Execute(
 	ctx context.Context,
-	job *job.Job,
+	j *job.Job,
 	result string,
 	duration time.Duration,
 ) error {
 	// Update job entity
-	if err := job.Complete(result); err != nil {
-		return fmt.Errorf("complete job %s: %w", job.ID, err)
+	if err := j.Complete(result); err != nil {
+		return fmt.Errorf("complete job %s: %w", j.ID, err)
 	}
 
 	// Initialize unit of work
@@ -47,29 +48,64 @@ func (u *completeTranscodeJobUsecase) Execute(
 	// Initialize repos
 	videoRepo := uow.VideoRepo()
 	jobRepo := uow.JobRepo()
+	outboxRepo := uow.OutboxRepo()
 
-	// Find related video
-	video, err := videoRepo.FindByID(ctx, job.VideoID)
+	// Lock the related video, the jobs of its pipeline finish one at a time
+	video, err := videoRepo.LockByID(ctx, j.VideoID)
 	if err != nil {
-		return fmt.Errorf("get video related to job %s: %w", job.ID, err)
+		return fmt.Errorf("get video related to job %s: %w", j.ID, err)
 	}
 
-	// Update video entity
-	if err := video.UpdateDuration(duration); err != nil {
-		return fmt.Errorf("update video %s duration: %w", video.ID, err)
+	// Update video entity, the first step reading the duration sets it:
+	// the probe, or the step producing the stream when it did not run
+	if duration > 0 && video.Duration == 0 {
+		if err := video.UpdateDuration(duration); err != nil {
+			return fmt.Errorf("update video %s duration: %w", video.ID, err)
+		}
 	}
-	if err := video.Publish(); err != nil {
-		return fmt.Errorf("publish video %s: %w", video.ID, err)
+
+	// Publish once every required step of the pipeline has completed
+	done, err := pipelineSucceeded(ctx, jobRepo, j)
+	if err != nil {
+		return err
+	}
+	if done {
+		if err := video.Publish(time.Now()); err != nil {
+			return fmt.Errorf("publish video %s: %w", video.ID, err)
+		}
+	}
+
+	// Close the attempt
+	attempt, err := jobRepo.FindAttempt(ctx, j.ID, j.Attempts)
+	if err != nil {
+		return fmt.Errorf("find job %s attempt %d: %w", j.ID, j.Attempts, err)
+	}
+	if err := attempt.Complete(); err != nil {
+		return fmt.Errorf("complete job %s attempt %d: %w", j.ID, attempt.Number, err)
 	}
 
 	// Persist entities
-	if err := jobRepo.Save(ctx, job); err != nil {
-		return fmt.Errorf("save job %s in db: %w", job.ID, err)
+	if err := jobRepo.Save(ctx, j); err != nil {
+		return fmt.Errorf("save job %s in db: %w", j.ID, err)
+	}
+	if err := jobRepo.SaveAttempt(ctx, attempt); err != nil {
+		return fmt.Errorf("save job %s attempt %d in db: %w", j.ID, attempt.Number, err)
 	}
 	if err := videoRepo.Save(ctx, video); err != nil {
 		return fmt.Errorf("save video %s in db: %w", video.ID, err)
 	}
 
+	// Record events
+	if err := recordJobEvent(ctx, outboxRepo, event.TypeJobCompleted, j); err != nil {
+		return err
+	}
+	// A video held until its publish time is published by its task
+	if done && video.IsPublished() {
+		if err := recordVideoEvent(ctx, outboxRepo, event.TypeVideoPublished, video); err != nil {
+			return err
+		}
+	}
+
 	if err := uow.Commit(ctx); err != nil {
 		return fmt.Errorf("finalize transaction %w", err)
 	}
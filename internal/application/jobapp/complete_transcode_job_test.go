@@ -7,6 +7,7 @@ import (
 
 	"github.com/st-ember/streaming-api/internal/application/jobapp"
 	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/event"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 	"github.com/st-ember/streaming-api/internal/domain/video"
 	"github.com/stretchr/testify/mock"
@@ -20,6 +21,7 @@ func TestCompleteTranscodeJob_SuccessCase(t *testing.T) {
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
 
 	// Create valid domain objects for the test.
@@ -29,7 +31,7 @@ func TestCompleteTranscodeJob_SuccessCase(t *testing.T) {
 	startJob.Status = job.StatusRunning
 
 	// Video must be 'Processing' to be published.
-	relatedVideo, err := video.NewVideo("video-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo, err := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
 	require.NoError(t, err)
 	relatedVideo.Status = video.StatusProcessing
 
@@ -37,12 +39,17 @@ func TestCompleteTranscodeJob_SuccessCase(t *testing.T) {
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCompleted, event.TypeVideoPublished)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
 	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
 
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{startJob}, nil).Once()
 	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
 	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
 
 	// --- ACT ---
 	usecase := jobapp.NewCompleteTranscodeJobUsecase(mockUowFactory)
@@ -56,6 +63,135 @@ func TestCompleteTranscodeJob_SuccessCase(t *testing.T) {
 	require.Equal(t, 120*time.Second, relatedVideo.Duration)
 }
 
+func TestCompleteTranscodeJob_ChunkLeavesDurationAndWaitsForPipeline(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	chunkJob, _ := job.NewJob("job-id", "video-id", job.TypeChunkEncode)
+	chunkJob.Status = job.StatusRunning
+	assembleJob, _ := job.NewJob("assemble-id", "video-id", job.TypeAssemble)
+	assembleJob.DependsOn = []string{chunkJob.ID}
+
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo.Status = video.StatusProcessing
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCompleted)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{chunkJob, assembleJob}, nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, relatedVideo).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, chunkJob).Return(nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewCompleteTranscodeJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), chunkJob, "", 0)
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, job.StatusCompleted, chunkJob.Status)
+	require.Equal(t, video.StatusProcessing, relatedVideo.Status)
+	require.Zero(t, relatedVideo.Duration)
+}
+
+func TestCompleteTranscodeJob_KeepsProbedDuration(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	transcodeJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	transcodeJob.Status = job.StatusRunning
+
+	// The probe step already set the duration
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo.Status = video.StatusProcessing
+	relatedVideo.Duration = 90 * time.Second
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCompleted, event.TypeVideoPublished)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{transcodeJob}, nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, relatedVideo).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, transcodeJob).Return(nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewCompleteTranscodeJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), transcodeJob, "manifest.mpd", 91*time.Second)
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, video.StatusPublished, relatedVideo.Status)
+	require.Equal(t, 90*time.Second, relatedVideo.Duration)
+}
+
+func TestCompleteTranscodeJob_HoldsVideoUntilPublishTime(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	transcodeJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	transcodeJob.Status = job.StatusRunning
+
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	require.NoError(t, relatedVideo.SchedulePublish(time.Now().Add(time.Hour)))
+	relatedVideo.Status = video.StatusProcessing
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	// The video is published by its publish task, along with the event
+	expectEvents(mockOutboxRepo, event.TypeJobCompleted)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{transcodeJob}, nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, relatedVideo).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, transcodeJob).Return(nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewCompleteTranscodeJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), transcodeJob, "manifest.mpd", 0)
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, video.StatusReady, relatedVideo.Status)
+}
+
 func TestCompleteTranscodeJob_FailsIfJobCannotBeCompleted(t *testing.T) {
 	t.Parallel()
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
@@ -77,6 +213,7 @@ func TestCompleteTranscodeJob_FailsOnFindVideoByID(t *testing.T) {
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
 
 	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
@@ -87,8 +224,9 @@ func TestCompleteTranscodeJob_FailsOnFindVideoByID(t *testing.T) {
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(nil, expectedErr).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(nil, expectedErr).Once()
 
 	usecase := jobapp.NewCompleteTranscodeJobUsecase(mockUowFactory)
 	err := usecase.Execute(t.Context(), startJob, "success", 120*time.Second)
@@ -102,19 +240,22 @@ func TestCompleteTranscodeJob_FailsOnVideoPublish(t *testing.T) {
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
 	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
 	startJob.Status = job.StatusRunning
 
 	// Create a video that is NOT in 'Processing' state, so Publish() will fail.
-	relatedVideo, _ := video.NewVideo("video-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
 	relatedVideo.Status = video.StatusPending
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{startJob}, nil).Once()
 
 	usecase := jobapp.NewCompleteTranscodeJobUsecase(mockUowFactory)
 	err := usecase.Execute(t.Context(), startJob, "success", 120*time.Second)
@@ -128,22 +269,28 @@ func TestCompleteTranscodeJob_FailsOnCommit(t *testing.T) {
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
 	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
 	startJob.Status = job.StatusRunning
-	relatedVideo, _ := video.NewVideo("video-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
 	relatedVideo.Status = video.StatusProcessing
 	expectedErr := errors.New("commit failed")
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCompleted, event.TypeVideoPublished)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
 	mockUow.EXPECT().Commit(mock.Anything).Return(expectedErr).Once()
 
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{startJob}, nil).Once()
 	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
 	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
 
 	usecase := jobapp.NewCompleteTranscodeJobUsecase(mockUowFactory)
 	err := usecase.Execute(t.Context(), startJob, "success", 120*time.Second)
@@ -151,3 +298,83 @@ func TestCompleteTranscodeJob_FailsOnCommit(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorIs(t, err, expectedErr)
 }
+
+func TestCompleteTranscodeJob_FailsOnSaveEvent(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	startJob.Status = job.StatusRunning
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo.Status = video.StatusProcessing
+	expectedErr := errors.New("outbox unavailable")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{startJob}, nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
+	mockOutboxRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*event.Event")).Return(expectedErr).Once()
+
+	usecase := jobapp.NewCompleteTranscodeJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), startJob, "success", 120*time.Second)
+
+	// The state change is not committed without its event
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func TestCompleteTranscodeJob_KeepsVideoProcessingUntilPipelineSucceeds(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	// The next step of the pipeline still has to run
+	pipeline, err := job.NewPipeline("video-id", []job.PipelineStep{
+		{Name: "transcode", Type: job.TypeTranscode},
+		{Name: "captions", Type: job.TypeTranscode, DependsOn: []string{"transcode"}},
+	}, newSequentialIDs())
+	require.NoError(t, err)
+	startJob := pipeline[0]
+	startJob.Status = job.StatusRunning
+
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo.Status = video.StatusProcessing
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCompleted)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	// The pipeline is read once the video is locked, so two jobs finishing together see each other
+	mock.InOrder(
+		mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once(),
+		mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return(pipeline, nil).Once(),
+	)
+	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, startJob.ID, 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
+
+	usecase := jobapp.NewCompleteTranscodeJobUsecase(mockUowFactory)
+	err = usecase.Execute(t.Context(), startJob, "success", 120*time.Second)
+
+	require.NoError(t, err)
+	require.Equal(t, job.StatusCompleted, startJob.Status)
+	require.Equal(t, video.StatusProcessing, relatedVideo.Status)
+}
@@ -0,0 +1,10 @@
+package jobapp
+
+import "errors"
+
+var (
+	ErrJobCancelled     = errors.New("job has been cancelled")
+	ErrJobNotClaimed    = errors.New("job is no longer claimed by this worker")
+	ErrInvalidJobFilter = errors.New("invalid job filter")
+	ErrJobForbidden     = errors.New("not allowed to manage this job")
+)
@@ -0,0 +1,86 @@
+package jobapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+type EstimateJobCostUsecase interface {
+	Execute(ctx context.Context, job *job.Job) (job.Cost, error)
+}
+
+type estimateJobCostUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	prober     transcode.Prober
+	renditions int
+}
+
+func NewEstimateJobCostUsecase(
+	uowFactory repo.UnitOfWorkFactory,
+	prober transcode.Prober,
+	renditions int,
+) *estimateJobCostUsecase {
+	return &estimateJobCostUsecase{uowFactory, prober, renditions}
+}
+
+// Execute estimates the resources the job needs by probing the video it processes,
+// the chunk of the source for chunk encode jobs.
+// The estimate is stored on the job, a job waiting for resources is probed only once.
+func (u *estimateJobCostUsecase) Execute(ctx context.Context, j *job.Job) (job.Cost, error) {
+	// Steps that do not encode cost the same whatever the video
+	if !j.Type.Encodes() {
+		return job.EstimateCost(j.Type, 0, 0, 0, u.renditions), nil
+	}
+
+	if j.Cost != nil {
+		return *j.Cost, nil
+	}
+
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return job.Cost{}, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	videoRepo := uow.VideoRepo()
+	jobRepo := uow.JobRepo()
+
+	// Find related video
+	video, err := videoRepo.FindByID(ctx, j.VideoID)
+	if err != nil {
+		return job.Cost{}, fmt.Errorf("get video related to job %s: %w", j.ID, err)
+	}
+
+	assetPath := video.Filename
+	if j.Type == job.TypeChunkEncode {
+		spec, err := j.ChunkSpec()
+		if err != nil {
+			return job.Cost{}, fmt.Errorf("read job %s chunk: %w", j.ID, err)
+		}
+		assetPath = spec.Path
+	}
+
+	info, err := u.prober.Probe(ctx, video.ResourceID, assetPath)
+	if err != nil {
+		return job.Cost{}, fmt.Errorf("probe %s of video %s: %w", assetPath, video.ID, err)
+	}
+
+	cost := job.EstimateCost(j.Type, info.Width, info.Height, info.Duration, u.renditions)
+
+	// Persist estimate
+	if err := jobRepo.SaveCost(ctx, j.ID, cost); err != nil {
+		return job.Cost{}, fmt.Errorf("save cost of job %s in db: %w", j.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return job.Cost{}, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	j.Cost = &cost
+
+	return cost, nil
+}
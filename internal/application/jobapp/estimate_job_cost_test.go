@@ -0,0 +1,131 @@
+package jobapp_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
+	transcodemocks "github.com/st-ember/streaming-api/internal/application/ports/transcode/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateJobCost_ProbesSource(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockProber := transcodemocks.NewMockProber(t)
+
+	j, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockProber.EXPECT().Probe(mock.Anything, "resource-id", "file.mp4").
+		Return(&transcode.SourceInfo{Width: 3840, Height: 2160, Duration: time.Minute}, nil).Once()
+	mockJobRepo.EXPECT().SaveCost(mock.Anything, "job-id", job.EstimateCost(job.TypeTranscode, 3840, 2160, time.Minute, 2)).Return(nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewEstimateJobCostUsecase(mockUowFactory, mockProber, 2)
+	cost, err := usecase.Execute(t.Context(), j)
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, job.EstimateCost(job.TypeTranscode, 3840, 2160, time.Minute, 2), cost)
+	require.Equal(t, &cost, j.Cost)
+}
+
+func TestEstimateJobCost_ProbesChunk(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockProber := transcodemocks.NewMockProber(t)
+
+	split, _ := job.NewJob("split-id", "video-id", job.TypeSplit)
+	assemble, _ := job.NewJob("assemble-id", "video-id", job.TypeAssemble)
+	assemble.DependsOn = []string{split.ID}
+	jobs, _ := job.NewChunkJobs(split, assemble, []string{"chunks/0000.mp4"}, newSequentialIDs())
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockProber.EXPECT().Probe(mock.Anything, "resource-id", "chunks/0000.mp4").
+		Return(&transcode.SourceInfo{Width: 1920, Height: 1080, Duration: time.Minute}, nil).Once()
+	mockJobRepo.EXPECT().SaveCost(mock.Anything, jobs[0].ID, job.EstimateCost(job.TypeChunkEncode, 1920, 1080, time.Minute, 2)).Return(nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewEstimateJobCostUsecase(mockUowFactory, mockProber, 2)
+	cost, err := usecase.Execute(t.Context(), jobs[0])
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, job.EstimateCost(job.TypeChunkEncode, 1920, 1080, time.Minute, 2), cost)
+}
+
+func TestEstimateJobCost_FailsOnProbe(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockProber := transcodemocks.NewMockProber(t)
+	expectedErr := errors.New("ffprobe failed")
+
+	j, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(repomocks.NewMockJobRepo(t)).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockProber.EXPECT().Probe(mock.Anything, "resource-id", "file.mp4").Return(nil, expectedErr).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewEstimateJobCostUsecase(mockUowFactory, mockProber, 2)
+	_, err := usecase.Execute(t.Context(), j)
+
+	// --- ASSERT ---
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func TestEstimateJobCost_UsesStoredCost(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockProber := transcodemocks.NewMockProber(t)
+
+	j, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	j.Cost = &job.Cost{CPU: 2, Memory: 512 << 20, Work: time.Minute}
+
+	// --- ACT ---
+	usecase := jobapp.NewEstimateJobCostUsecase(mockUowFactory, mockProber, 2)
+	cost, err := usecase.Execute(t.Context(), j)
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, *j.Cost, cost)
+}
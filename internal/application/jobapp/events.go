@@ -0,0 +1,42 @@
+package jobapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+)
+
+// recordJobEvent adds an event of the job to the outbox,
+// it is published only if the transaction commits
+func recordJobEvent(ctx context.Context, outboxRepo repo.OutboxRepo, eventType event.EventType, j *job.Job) error {
+	e, err := event.NewJobEvent(uuid.NewString(), eventType, j)
+	if err != nil {
+		return fmt.Errorf("create %s event of job %s: %w", eventType, j.ID, err)
+	}
+
+	if err := outboxRepo.Save(ctx, e); err != nil {
+		return fmt.Errorf("save %s event of job %s in outbox: %w", eventType, j.ID, err)
+	}
+
+	return nil
+}
+
+// recordVideoEvent adds an event of the video to the outbox,
+// it is published only if the transaction commits
+func recordVideoEvent(ctx context.Context, outboxRepo repo.OutboxRepo, eventType event.EventType, v *video.Video) error {
+	e, err := event.NewVideoEvent(uuid.NewString(), eventType, v)
+	if err != nil {
+		return fmt.Errorf("create %s event of video %s: %w", eventType, v.ID, err)
+	}
+
+	if err := outboxRepo.Save(ctx, e); err != nil {
+		return fmt.Errorf("save %s event of video %s in outbox: %w", eventType, v.ID, err)
+	}
+
+	return nil
+}
@@ -3,8 +3,10 @@ package jobapp
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 )
 
@@ -12,7 +14,7 @@ type FailTranscodeJobUsecase interface {
 	Execute(
 		ctx context.Context,
 		job *job.Job,
-		errMsg string,
+		input FailTranscodeJobInput,
 	) error
 }
 
@@ -26,12 +28,12 @@ func NewFailTranscodeJobUsecase(uowFactory repo.UnitOfWorkFactory) *failTranscod
 
 func (u *failTranscodeJobUsecase) Execute(
 	ctx context.Context,
-	job *job.Job,
-	errMsg string,
+	j *job.Job,
+	input FailTranscodeJobInput,
 ) error {
 	// Update job entity
-	if err := job.MarkAsFailed(errMsg); err != nil {
-		return fmt.Errorf("mark job %s as failed: %w", job.ID, err)
+	if err := j.MarkAsFailed(input.ErrorMsg); err != nil {
+		return fmt.Errorf("mark job %s as failed: %w", j.ID, err)
 	}
 
 	// Initialize unit of work
@@ -44,26 +46,75 @@ func (u *failTranscodeJobUsecase) Execute(
 	// Initialize repos
 	videoRepo := uow.VideoRepo()
 	jobRepo := uow.JobRepo()
+	outboxRepo := uow.OutboxRepo()
 
-	// Find related video
-	video, err := videoRepo.FindByID(ctx, job.VideoID)
+	// Lock the related video, the jobs of its pipeline finish one at a time
+	video, err := videoRepo.LockByID(ctx, j.VideoID)
 	if err != nil {
-		return fmt.Errorf("get video related to job %s: %w", job.ID, err)
+		return fmt.Errorf("get video related to job %s: %w", j.ID, err)
 	}
 
-	// Update video entity
-	if err := video.MarkAsFailed(); err != nil {
-		return fmt.Errorf("mark video %s as failed: %w", video.ID, err)
+	// Jobs left that cannot be of use anymore are cancelled
+	if err := abandonPipeline(ctx, jobRepo, outboxRepo, j, !j.Optional); err != nil {
+		return err
+	}
+
+	// A failed required step fails the whole pipeline, once: the video may have
+	// failed with another job already. An optional step may have been the last step to finish.
+	var videoEvent event.EventType
+	if !j.Optional {
+		if video.IsProcessing() {
+			if err := video.MarkAsFailed(); err != nil {
+				return fmt.Errorf("mark video %s as failed: %w", video.ID, err)
+			}
+			videoEvent = event.TypeVideoFailed
+		}
+	} else {
+		done, err := pipelineSucceeded(ctx, jobRepo, j)
+		if err != nil {
+			return err
+		}
+		if done {
+			if err := video.Publish(time.Now()); err != nil {
+				return fmt.Errorf("publish video %s: %w", video.ID, err)
+			}
+			// A video held until its publish time is published by its task
+			if video.IsPublished() {
+				videoEvent = event.TypeVideoPublished
+			}
+		}
+	}
+
+	// Record how the attempt failed
+	attempt, err := jobRepo.FindAttempt(ctx, j.ID, j.Attempts)
+	if err != nil {
+		return fmt.Errorf("find job %s attempt %d: %w", j.ID, j.Attempts, err)
+	}
+	if err := attempt.Fail(input.ErrorMsg, input.ExitCode, input.StderrTail); err != nil {
+		return fmt.Errorf("mark job %s attempt %d as failed: %w", j.ID, attempt.Number, err)
 	}
 
 	// Persist entities
-	if err := jobRepo.Save(ctx, job); err != nil {
-		return fmt.Errorf("save job %s in db: %w", job.ID, err)
+	if err := jobRepo.Save(ctx, j); err != nil {
+		return fmt.Errorf("save job %s in db: %w", j.ID, err)
+	}
+	if err := jobRepo.SaveAttempt(ctx, attempt); err != nil {
+		return fmt.Errorf("save job %s attempt %d in db: %w", j.ID, attempt.Number, err)
 	}
 	if err := videoRepo.Save(ctx, video); err != nil {
 		return fmt.Errorf("save video %s in db: %w", video.ID, err)
 	}
 
+	// Record events
+	if err := recordJobEvent(ctx, outboxRepo, event.TypeJobFailed, j); err != nil {
+		return err
+	}
+	if videoEvent != "" {
+		if err := recordVideoEvent(ctx, outboxRepo, videoEvent, video); err != nil {
+			return err
+		}
+	}
+
 	if err := uow.Commit(ctx); err != nil {
 		return fmt.Errorf("finalize transaction %w", err)
 	}
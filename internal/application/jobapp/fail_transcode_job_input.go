@@ -0,0 +1,7 @@
+package jobapp
+
+type FailTranscodeJobInput struct {
+	ErrorMsg   string
+	ExitCode   *int   // Set when the transcoding process exited with an error
+	StderrTail string // The last lines of the transcoding process output
+}
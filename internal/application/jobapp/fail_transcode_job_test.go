@@ -1,11 +1,13 @@
 package jobapp_test
 
 import (
+	"database/sql"
 	"errors"
 	"testing"
 
 	"github.com/st-ember/streaming-api/internal/application/jobapp"
 	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/event"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 	"github.com/st-ember/streaming-api/internal/domain/video"
 	"github.com/stretchr/testify/mock"
@@ -19,6 +21,7 @@ func TestFailTranscodeJob_SuccessCase(t *testing.T) {
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
 
 	// Create valid domain objects for the test.
@@ -28,26 +31,37 @@ func TestFailTranscodeJob_SuccessCase(t *testing.T) {
 	startJob.Status = job.StatusRunning
 
 	// Video must be 'Processing' to be failed.
-	relatedVideo, err := video.NewVideo("video-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo, err := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
 	require.NoError(t, err)
 	relatedVideo.Status = video.StatusProcessing
 
 	errMsg := "transcode failed: invalid codec"
+	exitCode := 1
+	attempt := runningAttempt()
 
 	// Define mock expectations for the success path.
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobFailed, event.TypeVideoFailed)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
 	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
 
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{startJob}, nil).Once()
 	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
 	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 0).Return(attempt, nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, attempt).Return(nil).Once()
 
 	// --- ACT ---
 	usecase := jobapp.NewFailTranscodeJobUsecase(mockUowFactory)
-	err = usecase.Execute(t.Context(), startJob, errMsg)
+	err = usecase.Execute(t.Context(), startJob, jobapp.FailTranscodeJobInput{
+		ErrorMsg:   errMsg,
+		ExitCode:   &exitCode,
+		StderrTail: "Invalid data found when processing input",
+	})
 
 	// --- ASSERT ---
 	require.NoError(t, err)
@@ -55,6 +69,10 @@ func TestFailTranscodeJob_SuccessCase(t *testing.T) {
 	require.Equal(t, job.StatusFailed, startJob.Status)
 	require.Equal(t, errMsg, startJob.ErrorMsg)
 	require.Equal(t, video.StatusFailed, relatedVideo.Status)
+	// The attempt keeps the failure details
+	require.Equal(t, job.StatusFailed, attempt.Status)
+	require.Equal(t, 1, *attempt.ExitCode)
+	require.Equal(t, "Invalid data found when processing input", attempt.StderrTail)
 }
 
 func TestFailTranscodeJob_FailsIfJobCannotBeFailed(t *testing.T) {
@@ -66,7 +84,7 @@ func TestFailTranscodeJob_FailsIfJobCannotBeFailed(t *testing.T) {
 	startJob.Status = job.StatusCompleted
 
 	usecase := jobapp.NewFailTranscodeJobUsecase(mockUowFactory)
-	err := usecase.Execute(t.Context(), startJob, "some error")
+	err := usecase.Execute(t.Context(), startJob, jobapp.FailTranscodeJobInput{ErrorMsg: "some error"})
 
 	// We expect a domain error here, before any mocks are called.
 	require.Error(t, err)
@@ -78,6 +96,7 @@ func TestFailTranscodeJob_FailsOnFindVideoByID(t *testing.T) {
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
 
 	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
@@ -88,40 +107,113 @@ func TestFailTranscodeJob_FailsOnFindVideoByID(t *testing.T) {
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(nil, expectedErr).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(nil, expectedErr).Once()
 
 	usecase := jobapp.NewFailTranscodeJobUsecase(mockUowFactory)
-	err := usecase.Execute(t.Context(), startJob, "some error")
+	err := usecase.Execute(t.Context(), startJob, jobapp.FailTranscodeJobInput{ErrorMsg: "some error"})
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, expectedErr)
 }
 
-func TestFailTranscodeJob_FailsOnVideoMarkAsFailed(t *testing.T) {
+func TestFailTranscodeJob_CancelsSiblingsOfFailedVideo(t *testing.T) {
 	t.Parallel()
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
-	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
-	startJob.Status = job.StatusRunning
 
-	// Create a video that is NOT in 'Processing' state, so MarkAsFailed() will fail.
-	relatedVideo, _ := video.NewVideo("video-id", "title", "desc", "file.mp4", "resource-id")
-	relatedVideo.Status = video.StatusPublished
+	// Two chunks run side by side, the merge waits on both
+	pipeline, err := job.NewPipeline("video-id", []job.PipelineStep{
+		{Name: "chunk-0", Type: job.TypeChunkEncode},
+		{Name: "chunk-1", Type: job.TypeChunkEncode},
+		{Name: "merge", Type: job.TypeAssemble, DependsOn: []string{"chunk-0", "chunk-1"}},
+	}, newSequentialIDs())
+	require.NoError(t, err)
+	failedChunk, sibling, merge := pipeline[0], pipeline[1], pipeline[2]
+	failedChunk.Status = job.StatusRunning
+	require.NoError(t, sibling.Start("worker-2"))
+	siblingAttempt := &job.Attempt{JobID: sibling.ID, Number: sibling.Attempts, WorkerID: "worker-2", Status: job.StatusRunning}
+
+	// Another required job failed the video already
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo.Status = video.StatusFailed
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCancelled, event.TypeJobCancelled, event.TypeJobFailed)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return(pipeline, nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Times(3)
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, sibling.ID, sibling.Attempts).Return(siblingAttempt, nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, failedChunk.ID, 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Twice()
+	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
 
 	usecase := jobapp.NewFailTranscodeJobUsecase(mockUowFactory)
-	err := usecase.Execute(t.Context(), startJob, "some error")
+	err = usecase.Execute(t.Context(), failedChunk, jobapp.FailTranscodeJobInput{ErrorMsg: "some error"})
 
-	require.Error(t, err)
-	require.ErrorIs(t, err, video.ErrCannotBeMarkedAsFailed)
+	require.NoError(t, err)
+	require.Equal(t, job.StatusFailed, failedChunk.Status)
+	require.Equal(t, job.StatusCancelled, sibling.Status)
+	require.Equal(t, job.StatusCancelled, siblingAttempt.Status)
+	require.Equal(t, job.StatusCancelled, merge.Status)
+	// The video is not failed twice
+	require.Equal(t, video.StatusFailed, relatedVideo.Status)
+}
+
+func TestFailTranscodeJob_CancelsClaimedSiblingNotStarted(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	pipeline, err := job.NewPipeline("video-id", []job.PipelineStep{
+		{Name: "chunk-0", Type: job.TypeChunkEncode},
+		{Name: "chunk-1", Type: job.TypeChunkEncode},
+	}, newSequentialIDs())
+	require.NoError(t, err)
+	failedChunk, sibling := pipeline[0], pipeline[1]
+	failedChunk.Status = job.StatusRunning
+	// Claimed by another worker, which has not started it yet
+	require.NoError(t, sibling.Start("worker-2"))
+
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo.Status = video.StatusProcessing
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobCancelled, event.TypeJobFailed, event.TypeVideoFailed)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return(pipeline, nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Twice()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, sibling.ID, sibling.Attempts).Return(nil, sql.ErrNoRows).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, failedChunk.ID, 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
+
+	usecase := jobapp.NewFailTranscodeJobUsecase(mockUowFactory)
+	err = usecase.Execute(t.Context(), failedChunk, jobapp.FailTranscodeJobInput{ErrorMsg: "some error"})
+
+	require.NoError(t, err)
+	require.Equal(t, job.StatusFailed, failedChunk.Status)
+	require.Equal(t, job.StatusCancelled, sibling.Status)
+	require.Equal(t, video.StatusFailed, relatedVideo.Status)
 }
 
 func TestFailTranscodeJob_FailsOnCommit(t *testing.T) {
@@ -129,26 +221,76 @@ func TestFailTranscodeJob_FailsOnCommit(t *testing.T) {
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
 	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
 	startJob.Status = job.StatusRunning
-	relatedVideo, _ := video.NewVideo("video-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
 	relatedVideo.Status = video.StatusProcessing
 	expectedErr := errors.New("commit failed")
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobFailed, event.TypeVideoFailed)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
 	mockUow.EXPECT().Commit(mock.Anything).Return(expectedErr).Once()
 
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{startJob}, nil).Once()
 	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
 	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, "job-id", 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
 
 	usecase := jobapp.NewFailTranscodeJobUsecase(mockUowFactory)
-	err := usecase.Execute(t.Context(), startJob, "some error")
+	err := usecase.Execute(t.Context(), startJob, jobapp.FailTranscodeJobInput{ErrorMsg: "some error"})
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, expectedErr)
 }
+
+func TestFailTranscodeJob_PublishesVideoIfOptionalStepWasLast(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	// The required step already completed
+	pipeline, err := job.NewPipeline("video-id", []job.PipelineStep{
+		{Name: "transcode", Type: job.TypeTranscode},
+		{Name: "thumbnails", Type: job.TypeTranscode, DependsOn: []string{"transcode"}, Optional: true},
+	}, newSequentialIDs())
+	require.NoError(t, err)
+	pipeline[0].Status = job.StatusCompleted
+	optionalJob := pipeline[1]
+	optionalJob.Status = job.StatusRunning
+
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo.Status = video.StatusProcessing
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	expectEvents(mockOutboxRepo, event.TypeJobFailed, event.TypeVideoPublished)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return(pipeline, nil).Twice()
+	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().FindAttempt(mock.Anything, optionalJob.ID, 0).Return(runningAttempt(), nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
+
+	usecase := jobapp.NewFailTranscodeJobUsecase(mockUowFactory)
+	err = usecase.Execute(t.Context(), optionalJob, jobapp.FailTranscodeJobInput{ErrorMsg: "no thumbnails"})
+
+	require.NoError(t, err)
+	require.Equal(t, job.StatusFailed, optionalJob.Status)
+	require.Equal(t, video.StatusPublished, relatedVideo.Status)
+}
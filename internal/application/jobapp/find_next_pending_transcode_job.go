@@ -8,8 +8,10 @@ import (
 	"github.com/st-ember/streaming-api/internal/domain/job"
 )
 
+// FindNextPendingTranscodeJobUsecase claims the next runnable job for a worker,
+// the job is running once it is returned
 type FindNextPendingTranscodeJobUsecase interface {
-	Execute(ctx context.Context) (*job.Job, error)
+	Execute(ctx context.Context, workerID string) (*job.Job, error)
 }
 
 type findNextPendingTranscodeJobUsecase struct {
@@ -20,13 +22,23 @@ func NewFindNextPendingTranscodeJobUsecase(uowFactory repo.UnitOfWorkFactory) *f
 	return &findNextPendingTranscodeJobUsecase{uowFactory}
 }
 
-func (u *findNextPendingTranscodeJobUsecase) Execute(ctx context.Context) (*job.Job, error) {
+func (u *findNextPendingTranscodeJobUsecase) Execute(ctx context.Context, workerID string) (*job.Job, error) {
 	uow, err := u.uowFactory.NewUnitOfWork(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("initialize unit of work: %w", err)
 	}
-	defer uow.Close(ctx)
+	defer uow.Rollback(ctx)
 
 	jobRepo := uow.JobRepo()
-	return jobRepo.FindNextPendingTranscodeJob(ctx)
+	j, err := jobRepo.FindNextPendingTranscodeJob(ctx, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit the claim, so that no other worker picks the job
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return j, nil
 }
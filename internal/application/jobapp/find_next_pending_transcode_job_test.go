@@ -1,6 +1,7 @@
 package jobapp_test
 
 import (
+	"database/sql"
 	"errors"
 	"testing"
 
@@ -30,13 +31,14 @@ func TestFindNextPendingTranscodeJob_SuccessCase(t *testing.T) {
 	// Define expectations
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
-	mockJobRepo.EXPECT().FindNextPendingTranscodeJob(mock.Anything).Return(expectedJob, nil).Once()
-	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindNextPendingTranscodeJob(mock.Anything, "worker-1").Return(expectedJob, nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
 	// Create usecase
 	usecase := jobapp.NewFindNextPendingTranscodeJobUsecase(mockUowFactory)
 
 	// Execute usecase
-	foundJob, err := usecase.Execute(t.Context())
+	foundJob, err := usecase.Execute(t.Context(), "worker-1")
 
 	// ---Assertions---
 	require.NoError(t, err)
@@ -58,14 +60,14 @@ func TestFindNextPendingTranscodeJob_JobRepoReturnsError(t *testing.T) {
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
 	expectedErr := errors.New("connection failed")
-	mockJobRepo.EXPECT().FindNextPendingTranscodeJob(mock.Anything).Return(nil, expectedErr).Once()
-	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindNextPendingTranscodeJob(mock.Anything, "worker-1").Return(nil, expectedErr).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
 
 	// Create usecase
 	usecase := jobapp.NewFindNextPendingTranscodeJobUsecase(mockUowFactory)
 
 	// Execute usecase
-	foundJob, err := usecase.Execute(t.Context())
+	foundJob, err := usecase.Execute(t.Context(), "worker-1")
 
 	// ---Assertions---
 	require.Error(t, err)
@@ -84,16 +86,16 @@ func TestFindNextPendingTranscodeJob_NoJobFound(t *testing.T) {
 	// Job repo expectations
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
-	mockJobRepo.EXPECT().FindNextPendingTranscodeJob(mock.Anything).Return(nil, nil).Once()
-	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindNextPendingTranscodeJob(mock.Anything, "worker-1").Return(nil, sql.ErrNoRows).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
 
 	// Create usecase
 	usecase := jobapp.NewFindNextPendingTranscodeJobUsecase(mockUowFactory)
 
 	// Execute usecase
-	foundJob, err := usecase.Execute(t.Context())
+	foundJob, err := usecase.Execute(t.Context(), "worker-1")
 
 	// ---Assertions---
-	require.NoError(t, err)
+	require.ErrorIs(t, err, sql.ErrNoRows)
 	require.Nil(t, foundJob)
 }
@@ -0,0 +1,38 @@
+package jobapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+type GetJobUsecase interface {
+	Execute(ctx context.Context, id string) (*job.Job, error)
+}
+
+type getJobUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewGetJobUsecase(uowFactory repo.UnitOfWorkFactory) GetJobUsecase {
+	return &getJobUsecase{uowFactory}
+}
+
+func (u *getJobUsecase) Execute(ctx context.Context, id string) (*job.Job, error) {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Close(ctx)
+
+	jobRepo := uow.JobRepo()
+
+	j, err := jobRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find job %s: %w", id, err)
+	}
+
+	return j, nil
+}
@@ -0,0 +1,62 @@
+package jobapp_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetJob_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	storedJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(storedJob, nil).Once()
+
+	usecase := jobapp.NewGetJobUsecase(mockUowFactory)
+	j, err := usecase.Execute(t.Context(), "job-id")
+
+	require.NoError(t, err)
+	require.Equal(t, storedJob, j)
+}
+
+func TestGetJob_FailsOnUOWCreation(t *testing.T) {
+	t.Parallel()
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	expectedErr := errors.New("db down")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(nil, expectedErr).Once()
+
+	usecase := jobapp.NewGetJobUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), "job-id")
+
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func TestGetJob_FailsOnNotFound(t *testing.T) {
+	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(nil, sql.ErrNoRows).Once()
+
+	usecase := jobapp.NewGetJobUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), "job-id")
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,10 @@
+package jobapp
+
+type JobUsecase struct {
+	Get            GetJobUsecase
+	List           ListJobsUsecase
+	ListAttempts   ListJobAttemptsUsecase
+	Cancel         CancelJobUsecase
+	Retry          RetryJobUsecase
+	UpdatePriority UpdateJobPriorityUsecase
+}
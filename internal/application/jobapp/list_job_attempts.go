@@ -0,0 +1,44 @@
+package jobapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+// ListJobAttemptsUsecase returns the run history of a job.
+type ListJobAttemptsUsecase interface {
+	Execute(ctx context.Context, jobID string) ([]*job.Attempt, error)
+}
+
+type listJobAttemptsUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewListJobAttemptsUsecase(uowFactory repo.UnitOfWorkFactory) ListJobAttemptsUsecase {
+	return &listJobAttemptsUsecase{uowFactory}
+}
+
+func (u *listJobAttemptsUsecase) Execute(ctx context.Context, jobID string) ([]*job.Attempt, error) {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Close(ctx)
+
+	jobRepo := uow.JobRepo()
+
+	// Distinguish an unknown job from one that never ran
+	if _, err := jobRepo.FindByID(ctx, jobID); err != nil {
+		return nil, fmt.Errorf("find job %s: %w", jobID, err)
+	}
+
+	as, err := jobRepo.ListAttempts(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("list job %s attempts: %w", jobID, err)
+	}
+
+	return as, nil
+}
@@ -0,0 +1,58 @@
+package jobapp_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListJobAttempts_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	expectedAttempts := []*job.Attempt{
+		{JobID: "job-id", Number: 1, Status: job.StatusFailed},
+		{JobID: "job-id", Number: 2, Status: job.StatusCompleted},
+	}
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(storedJob(), nil).Once()
+	mockJobRepo.EXPECT().ListAttempts(mock.Anything, "job-id").Return(expectedAttempts, nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewListJobAttemptsUsecase(mockUowFactory)
+	result, err := usecase.Execute(t.Context(), "job-id")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, expectedAttempts, result)
+}
+
+func TestListJobAttempts_FailsIfJobNotFound(t *testing.T) {
+	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "missing-job").Return(nil, sql.ErrNoRows).Once()
+
+	usecase := jobapp.NewListJobAttemptsUsecase(mockUowFactory)
+	result, err := usecase.Execute(t.Context(), "missing-job")
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.Nil(t, result)
+}
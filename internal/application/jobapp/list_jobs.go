@@ -0,0 +1,58 @@
+package jobapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+type ListJobsUsecase interface {
+	Execute(ctx context.Context, input ListJobsInput) ([]*job.Job, error)
+}
+
+type listJobsUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewListJobsUsecase(uowFactory repo.UnitOfWorkFactory) ListJobsUsecase {
+	return &listJobsUsecase{uowFactory}
+}
+
+func (u *listJobsUsecase) Execute(ctx context.Context, input ListJobsInput) ([]*job.Job, error) {
+	// Validate filter
+	filter := repo.JobFilter{
+		Status:        job.JobStatus(input.Status),
+		Type:          job.JobType(input.Type),
+		VideoID:       input.VideoID,
+		CreatedAfter:  input.CreatedAfter,
+		CreatedBefore: input.CreatedBefore,
+		Page:          input.Page,
+	}
+
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, fmt.Errorf("status %s: %w", input.Status, ErrInvalidJobFilter)
+	}
+	if filter.Type != "" && !filter.Type.IsValid() {
+		return nil, fmt.Errorf("type %s: %w", input.Type, ErrInvalidJobFilter)
+	}
+	if filter.Page < 1 {
+		return nil, fmt.Errorf("page %d: %w", input.Page, ErrInvalidJobFilter)
+	}
+
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Close(ctx)
+
+	jobRepo := uow.JobRepo()
+
+	js, err := jobRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs: %w", err)
+	}
+
+	return js, nil
+}
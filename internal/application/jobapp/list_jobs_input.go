@@ -0,0 +1,13 @@
+package jobapp
+
+import "time"
+
+// ListJobsInput filters listed jobs, empty fields are ignored
+type ListJobsInput struct {
+	Status        string
+	Type          string
+	VideoID       string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Page          int
+}
@@ -0,0 +1,91 @@
+package jobapp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListJobs_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	expectedJobs := []*job.Job{
+		{ID: "job-1", Status: job.StatusFailed},
+		{ID: "job-2", Status: job.StatusFailed},
+	}
+	expectedFilter := repo.JobFilter{
+		Status:  job.StatusFailed,
+		Type:    job.TypeTranscode,
+		VideoID: "video-id",
+		Page:    2,
+	}
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().List(mock.Anything, expectedFilter).Return(expectedJobs, nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewListJobsUsecase(mockUowFactory)
+	result, err := usecase.Execute(t.Context(), jobapp.ListJobsInput{
+		Status:  "failed",
+		Type:    "transcode",
+		VideoID: "video-id",
+		Page:    2,
+	})
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, expectedJobs, result)
+}
+
+func TestListJobs_FailsOnInvalidFilter(t *testing.T) {
+	t.Parallel()
+
+	inputs := []jobapp.ListJobsInput{
+		{Status: "unknown", Page: 1},
+		{Type: "unknown", Page: 1},
+		{Page: 0},
+	}
+
+	for _, input := range inputs {
+		// No unit of work is opened for an invalid filter
+		mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+		usecase := jobapp.NewListJobsUsecase(mockUowFactory)
+		result, err := usecase.Execute(t.Context(), input)
+
+		require.ErrorIs(t, err, jobapp.ErrInvalidJobFilter)
+		require.Nil(t, result)
+	}
+}
+
+func TestListJobs_FailsOnRepoError(t *testing.T) {
+	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	expectedErr := errors.New("db connection lost")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().List(mock.Anything, mock.Anything).Return(nil, expectedErr).Once()
+
+	usecase := jobapp.NewListJobsUsecase(mockUowFactory)
+	result, err := usecase.Execute(t.Context(), jobapp.ListJobsInput{Page: 1})
+
+	require.ErrorIs(t, err, expectedErr)
+	require.Nil(t, result)
+}
@@ -7,6 +7,7 @@ package jobapp
 import (
 	"context"
 
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,16 +40,16 @@ func (_m *MockFailTranscodeJobUsecase) EXPECT() *MockFailTranscodeJobUsecase_Exp
 }
 
 // Execute provides a mock function for the type MockFailTranscodeJobUsecase
-func (_mock *MockFailTranscodeJobUsecase) Execute(ctx context.Context, job1 *job.Job, errMsg string) error {
-	ret := _mock.Called(ctx, job1, errMsg)
+func (_mock *MockFailTranscodeJobUsecase) Execute(ctx context.Context, job1 *job.Job, input jobapp.FailTranscodeJobInput) error {
+	ret := _mock.Called(ctx, job1, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *job.Job, string) error); ok {
-		r0 = returnFunc(ctx, job1, errMsg)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *job.Job, jobapp.FailTranscodeJobInput) error); ok {
+		r0 = returnFunc(ctx, job1, input)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -63,12 +64,12 @@ type MockFailTranscodeJobUsecase_Execute_Call struct {
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
 //   - job1 *job.Job
-//   - errMsg string
-func (_e *MockFailTranscodeJobUsecase_Expecter) Execute(ctx interface{}, job1 interface{}, errMsg interface{}) *MockFailTranscodeJobUsecase_Execute_Call {
-	return &MockFailTranscodeJobUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, job1, errMsg)}
+//   - input jobapp.FailTranscodeJobInput
+func (_e *MockFailTranscodeJobUsecase_Expecter) Execute(ctx interface{}, job1 interface{}, input interface{}) *MockFailTranscodeJobUsecase_Execute_Call {
+	return &MockFailTranscodeJobUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, job1, input)}
 }
 
-func (_c *MockFailTranscodeJobUsecase_Execute_Call) Run(run func(ctx context.Context, job1 *job.Job, errMsg string)) *MockFailTranscodeJobUsecase_Execute_Call {
+func (_c *MockFailTranscodeJobUsecase_Execute_Call) Run(run func(ctx context.Context, job1 *job.Job, input jobapp.FailTranscodeJobInput)) *MockFailTranscodeJobUsecase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -78,9 +79,9 @@ func (_c *MockFailTranscodeJobUsecase_Execute_Call) Run(run func(ctx context.Con
 		if args[1] != nil {
 			arg1 = args[1].(*job.Job)
 		}
-		var arg2 string
+		var arg2 jobapp.FailTranscodeJobInput
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(jobapp.FailTranscodeJobInput)
 		}
 		run(
 			arg0,
@@ -96,7 +97,7 @@ func (_c *MockFailTranscodeJobUsecase_Execute_Call) Return(err error) *MockFailT
 	return _c
 }
 
-func (_c *MockFailTranscodeJobUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, job1 *job.Job, errMsg string) error) *MockFailTranscodeJobUsecase_Execute_Call {
+func (_c *MockFailTranscodeJobUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, job1 *job.Job, input jobapp.FailTranscodeJobInput) error) *MockFailTranscodeJobUsecase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
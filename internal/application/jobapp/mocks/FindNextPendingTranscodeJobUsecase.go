@@ -39,8 +39,8 @@ func (_m *MockFindNextPendingTranscodeJobUsecase) EXPECT() *MockFindNextPendingT
 }
 
 // Execute provides a mock function for the type MockFindNextPendingTranscodeJobUsecase
-func (_mock *MockFindNextPendingTranscodeJobUsecase) Execute(ctx context.Context) (*job.Job, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockFindNextPendingTranscodeJobUsecase) Execute(ctx context.Context, workerID string) (*job.Job, error) {
+	ret := _mock.Called(ctx, workerID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
@@ -48,18 +48,18 @@ func (_mock *MockFindNextPendingTranscodeJobUsecase) Execute(ctx context.Context
 
 	var r0 *job.Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (*job.Job, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*job.Job, error)); ok {
+		return returnFunc(ctx, workerID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) *job.Job); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *job.Job); ok {
+		r0 = returnFunc(ctx, workerID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*job.Job)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, workerID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,18 +73,24 @@ type MockFindNextPendingTranscodeJobUsecase_Execute_Call struct {
 
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockFindNextPendingTranscodeJobUsecase_Expecter) Execute(ctx interface{}) *MockFindNextPendingTranscodeJobUsecase_Execute_Call {
-	return &MockFindNextPendingTranscodeJobUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+//   - workerID string
+func (_e *MockFindNextPendingTranscodeJobUsecase_Expecter) Execute(ctx interface{}, workerID interface{}) *MockFindNextPendingTranscodeJobUsecase_Execute_Call {
+	return &MockFindNextPendingTranscodeJobUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, workerID)}
 }
 
-func (_c *MockFindNextPendingTranscodeJobUsecase_Execute_Call) Run(run func(ctx context.Context)) *MockFindNextPendingTranscodeJobUsecase_Execute_Call {
+func (_c *MockFindNextPendingTranscodeJobUsecase_Execute_Call) Run(run func(ctx context.Context, workerID string)) *MockFindNextPendingTranscodeJobUsecase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -95,7 +101,7 @@ func (_c *MockFindNextPendingTranscodeJobUsecase_Execute_Call) Return(job1 *job.
 	return _c
 }
 
-func (_c *MockFindNextPendingTranscodeJobUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context) (*job.Job, error)) *MockFindNextPendingTranscodeJobUsecase_Execute_Call {
+func (_c *MockFindNextPendingTranscodeJobUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, workerID string) (*job.Job, error)) *MockFindNextPendingTranscodeJobUsecase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
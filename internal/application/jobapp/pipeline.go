@@ -0,0 +1,92 @@
+package jobapp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+// pipelineSucceeded reports whether the video pipeline of the job is done,
+// taking the not yet persisted state of the job into account
+func pipelineSucceeded(ctx context.Context, jobRepo repo.JobRepo, j *job.Job) (bool, error) {
+	js, err := jobRepo.ListByVideoID(ctx, j.VideoID)
+	if err != nil {
+		return false, fmt.Errorf("list jobs of video %s: %w", j.VideoID, err)
+	}
+
+	for i, other := range js {
+		if other.ID == j.ID {
+			js[i] = j
+		}
+	}
+
+	return job.PipelineSucceeded(js), nil
+}
+
+// abandonPipeline cancels the jobs that cannot be of use once the job failed or was cancelled:
+// every unfinished job of the video when whole is set, the jobs depending on it otherwise.
+// Workers running a cancelled job notice and stop it.
+func abandonPipeline(ctx context.Context, jobRepo repo.JobRepo, outboxRepo repo.OutboxRepo, j *job.Job, whole bool) error {
+	js, err := jobRepo.ListByVideoID(ctx, j.VideoID)
+	if err != nil {
+		return fmt.Errorf("list jobs of video %s: %w", j.VideoID, err)
+	}
+
+	abandoned := job.Dependents(js, j.ID)
+	if whole {
+		abandoned = js
+	}
+
+	for _, other := range abandoned {
+		if other.ID == j.ID || !other.CanBeCancelled() {
+			continue
+		}
+
+		wasRunning := other.IsRunning()
+		if err := other.Cancel(); err != nil {
+			return fmt.Errorf("cancel job %s: %w", other.ID, err)
+		}
+		if err := jobRepo.Save(ctx, other); err != nil {
+			return fmt.Errorf("save job %s in db: %w", other.ID, err)
+		}
+
+		// Close the attempt of the interrupted run
+		if wasRunning {
+			if err := cancelAttempt(ctx, jobRepo, other); err != nil {
+				return err
+			}
+		}
+
+		if err := recordJobEvent(ctx, outboxRepo, event.TypeJobCancelled, other); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cancelAttempt closes the attempt of a running job that was cancelled.
+// A job claimed by a worker but not started yet has no attempt, it is refused once it starts.
+func cancelAttempt(ctx context.Context, jobRepo repo.JobRepo, j *job.Job) error {
+	attempt, err := jobRepo.FindAttempt(ctx, j.ID, j.Attempts)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("find job %s attempt %d: %w", j.ID, j.Attempts, err)
+	}
+
+	if err := attempt.Cancel(); err != nil {
+		return fmt.Errorf("cancel job %s attempt %d: %w", j.ID, attempt.Number, err)
+	}
+	if err := jobRepo.SaveAttempt(ctx, attempt); err != nil {
+		return fmt.Errorf("save job %s attempt %d in db: %w", j.ID, attempt.Number, err)
+	}
+
+	return nil
+}
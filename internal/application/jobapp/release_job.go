@@ -0,0 +1,60 @@
+package jobapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+// ReleaseJobUsecase puts a job claimed by FindNextPendingTranscodeJobUsecase back in the queue,
+// when the worker cannot run it after all.
+type ReleaseJobUsecase interface {
+	Execute(ctx context.Context, job *job.Job) error
+}
+
+type releaseJobUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewReleaseJobUsecase(uowFactory repo.UnitOfWorkFactory) ReleaseJobUsecase {
+	return &releaseJobUsecase{uowFactory}
+}
+
+func (u *releaseJobUsecase) Execute(ctx context.Context, j *job.Job) error {
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Initialize repos
+	jobRepo := uow.JobRepo()
+
+	// A job cancelled in the meantime stays cancelled
+	stored, err := jobRepo.FindByID(ctx, j.ID)
+	if err != nil {
+		return fmt.Errorf("find job %s: %w", j.ID, err)
+	}
+	if !stored.IsRunning() || stored.WorkerID != j.WorkerID || stored.Attempts != j.Attempts {
+		return nil
+	}
+
+	// Update job entity
+	if err := stored.Release(); err != nil {
+		return fmt.Errorf("release job %s: %w", j.ID, err)
+	}
+
+	// Persist entity
+	if err := jobRepo.Save(ctx, stored); err != nil {
+		return fmt.Errorf("save job %s in db: %w", j.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
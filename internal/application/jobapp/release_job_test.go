@@ -0,0 +1,63 @@
+package jobapp_test
+
+import (
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseJob_ReturnsClaimedJobToQueue(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	claimedJob := storedJob()
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(storedJob(), nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(j *job.Job) bool {
+		return j.IsPending() && j.Attempts == 0 && j.WorkerID == ""
+	})).Return(nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewReleaseJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), claimedJob)
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+}
+
+func TestReleaseJob_KeepsJobCancelledMeanwhile(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	claimedJob := storedJob()
+	cancelledJob := storedJob()
+	require.NoError(t, cancelledJob.Cancel())
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(cancelledJob, nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewReleaseJobUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), claimedJob)
+
+	// --- ASSERT ---
+	require.NoError(t, err) // Nothing is saved
+}
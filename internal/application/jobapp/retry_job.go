@@ -0,0 +1,120 @@
+package jobapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+)
+
+// RetryJobUsecase puts a failed or cancelled job back in the queue, along with the rest
+// of its pipeline: a failed video resumes processing and its abandoned jobs are queued again.
+// The progress kept for the retried jobs is forgotten, they report it anew.
+type RetryJobUsecase interface {
+	Execute(ctx context.Context, id string) error
+}
+
+type retryJobUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	streamer   progressstream.ProgressStreamer
+}
+
+func NewRetryJobUsecase(uowFactory repo.UnitOfWorkFactory, streamer progressstream.ProgressStreamer) RetryJobUsecase {
+	return &retryJobUsecase{uowFactory, streamer}
+}
+
+func (u *retryJobUsecase) Execute(ctx context.Context, id string) error {
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Initialize repos
+	videoRepo := uow.VideoRepo()
+	jobRepo := uow.JobRepo()
+	outboxRepo := uow.OutboxRepo()
+
+	// Find job
+	j, err := jobRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("find job %s: %w", id, err)
+	}
+
+	// Lock the related video, the jobs of its pipeline change one at a time
+	v, err := videoRepo.LockByID(ctx, j.VideoID)
+	if err != nil {
+		return fmt.Errorf("get video related to job %s: %w", j.ID, err)
+	}
+
+	// Read the job again, it may have been retried before the video was locked
+	j, err = jobRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("find job %s: %w", id, err)
+	}
+
+	// Update job entity
+	if err := j.Retry(); err != nil {
+		return fmt.Errorf("retry job %s: %w", j.ID, err)
+	}
+
+	// The worker would refuse to start a job for an archived or published video
+	if !v.CanBeProcessed() {
+		return fmt.Errorf("retry job %s: %w", j.ID, video.ErrCannotBeMarkedAsProcessing)
+	}
+
+	// Resume the pipeline the failure abandoned
+	resumed := v.IsFailed()
+	if resumed {
+		if err := v.MarkAsProcessing(); err != nil {
+			return fmt.Errorf("mark video %s as processing: %w", v.ID, err)
+		}
+	}
+	js, err := jobRepo.ListByVideoID(ctx, j.VideoID)
+	if err != nil {
+		return fmt.Errorf("list jobs of video %s: %w", j.VideoID, err)
+	}
+	retried := []string{j.ID}
+	for _, other := range js {
+		if other.ID == j.ID || !other.CanBeRetried() {
+			continue
+		}
+		if err := other.Retry(); err != nil {
+			return fmt.Errorf("retry job %s: %w", other.ID, err)
+		}
+		if err := jobRepo.Save(ctx, other); err != nil {
+			return fmt.Errorf("save job %s in db: %w", other.ID, err)
+		}
+		retried = append(retried, other.ID)
+	}
+
+	// Persist entities
+	if err := jobRepo.Save(ctx, j); err != nil {
+		return fmt.Errorf("save job %s in db: %w", j.ID, err)
+	}
+	if resumed {
+		if err := videoRepo.Save(ctx, v); err != nil {
+			return fmt.Errorf("save video %s in db: %w", v.ID, err)
+		}
+		if err := recordVideoEvent(ctx, outboxRepo, event.TypeVideoProcessing, v); err != nil {
+			return err
+		}
+	}
+
+	// Readers would take the final progress of the previous attempts for the new ones
+	for _, id := range retried {
+		if err := u.streamer.Reset(ctx, id); err != nil {
+			return fmt.Errorf("reset progress of job %s: %w", id, err)
+		}
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package jobapp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	streamermocks "github.com/st-ember/streaming-api/internal/application/ports/progressstream/mocks"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryJob_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+
+	failedJob, err := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	require.NoError(t, err)
+	failedJob.Status = job.StatusFailed
+	failedJob.ErrorMsg = "transcode failed"
+
+	relatedVideo, err := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	require.NoError(t, err)
+	relatedVideo.Status = video.StatusFailed
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(failedJob, nil).Twice()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	// The sibling the failure cancelled is queued again, the completed one is kept
+	cancelledSibling, _ := job.NewJob("sibling-id", "video-id", job.TypeThumbnail)
+	cancelledSibling.Status = job.StatusCancelled
+	completedSibling, _ := job.NewJob("probe-id", "video-id", job.TypeProbe)
+	completedSibling.Status = job.StatusCompleted
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{completedSibling, failedJob, cancelledSibling}, nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, cancelledSibling).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, failedJob).Return(nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, relatedVideo).Return(nil).Once()
+	expectEvents(mockOutboxRepo, event.TypeVideoProcessing)
+	// The final progress of the previous attempts is forgotten
+	mockStreamer.EXPECT().Reset(mock.Anything, "job-id").Return(nil).Once()
+	mockStreamer.EXPECT().Reset(mock.Anything, "sibling-id").Return(nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewRetryJobUsecase(mockUowFactory, mockStreamer)
+	err = usecase.Execute(t.Context(), "job-id")
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, job.StatusPending, failedJob.Status)
+	require.Empty(t, failedJob.ErrorMsg)
+	require.Equal(t, job.StatusPending, cancelledSibling.Status)
+	require.Equal(t, job.StatusCompleted, completedSibling.Status)
+	// The failed video resumes processing
+	require.Equal(t, video.StatusProcessing, relatedVideo.Status)
+}
+
+func TestRetryJob_FailsIfJobNotFinished(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	runningJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	runningJob.Status = job.StatusRunning
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(runningJob, nil).Twice()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+
+	usecase := jobapp.NewRetryJobUsecase(mockUowFactory, mockStreamer)
+	err := usecase.Execute(t.Context(), "job-id")
+
+	require.ErrorIs(t, err, job.ErrCannotBeRetried)
+	require.Equal(t, job.StatusRunning, runningJob.Status)
+}
+
+func TestRetryJob_FailsIfVideoCannotBeProcessed(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	cancelledJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	cancelledJob.Status = job.StatusCancelled
+	archivedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	archivedVideo.Status = video.StatusArchived
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(cancelledJob, nil).Twice()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(archivedVideo, nil).Once()
+
+	usecase := jobapp.NewRetryJobUsecase(mockUowFactory, mockStreamer)
+	err := usecase.Execute(t.Context(), "job-id")
+
+	require.ErrorIs(t, err, video.ErrCannotBeMarkedAsProcessing)
+}
+
+func TestRetryJob_FailsIfProgressNotReset(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockStreamer := streamermocks.NewMockProgressStreamer(t)
+	failedJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	failedJob.Status = job.StatusFailed
+	pendingVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	streamErr := errors.New("redis down")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(failedJob, nil).Twice()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(pendingVideo, nil).Once()
+	mockJobRepo.EXPECT().ListByVideoID(mock.Anything, "video-id").Return([]*job.Job{failedJob}, nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, failedJob).Return(nil).Once()
+	// The retry is rolled back, readers would take the final progress of the failed attempt for the new one
+	mockStreamer.EXPECT().Reset(mock.Anything, "job-id").Return(streamErr).Once()
+
+	usecase := jobapp.NewRetryJobUsecase(mockUowFactory, mockStreamer)
+	err := usecase.Execute(t.Context(), "job-id")
+
+	require.ErrorIs(t, err, streamErr)
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 
 	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 )
 
+// StartTranscodeJobUsecase opens a run of a job claimed by FindNextPendingTranscodeJobUsecase
 type StartTranscodeJobUsecase interface {
 	Execute(ctx context.Context, job *job.Job) (*StartTranscodeJobResult, error)
 }
@@ -24,12 +26,7 @@ func NewStartTranscodeJobUsecase(
 	}
 }
 
-func (u *startTranscodeJobUsecase) Execute(ctx context.Context, job *job.Job) (*StartTranscodeJobResult, error) {
-	// Update job entity
-	if err := job.Start(); err != nil {
-		return nil, fmt.Errorf("start job %s: %w", job.ID, err)
-	}
-
+func (u *startTranscodeJobUsecase) Execute(ctx context.Context, j *job.Job) (*StartTranscodeJobResult, error) {
 	// Initialize unit of work
 	uow, err := u.uowFactory.NewUnitOfWork(ctx)
 	if err != nil {
@@ -40,26 +37,73 @@ func (u *startTranscodeJobUsecase) Execute(ctx context.Context, job *job.Job) (*
 	// Initialize repos
 	videoRepo := uow.VideoRepo()
 	jobRepo := uow.JobRepo()
+	outboxRepo := uow.OutboxRepo()
 
-	// Find related video
-	video, err := videoRepo.FindByID(ctx, job.VideoID)
+	// Lock the related video, the jobs of its pipeline change one at a time
+	video, err := videoRepo.LockByID(ctx, j.VideoID)
 	if err != nil {
-		return nil, fmt.Errorf("get video related to job %s: %w", job.ID, err)
+		return nil, fmt.Errorf("get video related to job %s: %w", j.ID, err)
+	}
+
+	// The job may have been cancelled while it was waiting in the queue
+	stored, err := jobRepo.FindByID(ctx, j.ID)
+	if err != nil {
+		return nil, fmt.Errorf("find job %s: %w", j.ID, err)
+	}
+	if stored.IsCancelled() {
+		return nil, fmt.Errorf("start job %s: %w", j.ID, ErrJobCancelled)
+	}
+	// Or released and claimed again by another worker
+	if !stored.IsRunning() || stored.WorkerID != j.WorkerID || stored.Attempts != j.Attempts {
+		return nil, fmt.Errorf("start job %s: %w", j.ID, ErrJobNotClaimed)
 	}
 
-	// Update video entity
-	if err := video.MarkAsProcessing(); err != nil {
-		return nil, fmt.Errorf("mark video %s as processing: %w", video.ID, err)
+	// A video that failed or was archived meanwhile runs no more jobs, it is never brought back here
+	if !video.IsPending() && !video.IsProcessing() {
+		if err := stored.Cancel(); err != nil {
+			return nil, fmt.Errorf("cancel job %s: %w", j.ID, err)
+		}
+		if err := jobRepo.Save(ctx, stored); err != nil {
+			return nil, fmt.Errorf("save job %s in db: %w", j.ID, err)
+		}
+		if err := recordJobEvent(ctx, outboxRepo, event.TypeJobCancelled, stored); err != nil {
+			return nil, err
+		}
+		if err := uow.Commit(ctx); err != nil {
+			return nil, fmt.Errorf("finalize transaction %w", err)
+		}
+		return nil, fmt.Errorf("start job %s: %w", j.ID, ErrJobCancelled)
 	}
 
-	// Persist entities
-	if err := jobRepo.Save(ctx, job); err != nil {
-		return nil, fmt.Errorf("save job %s in db: %w", job.ID, err)
+	// Update video entity, later steps of the pipeline find it processing already
+	started := video.IsPending()
+	if started {
+		if err := video.MarkAsProcessing(); err != nil {
+			return nil, fmt.Errorf("mark video %s as processing: %w", video.ID, err)
+		}
+	}
+
+	// Open a new attempt for this run
+	attempt, err := job.NewAttempt(j)
+	if err != nil {
+		return nil, fmt.Errorf("record job %s attempt: %w", j.ID, err)
+	}
+
+	// Persist entities, the job itself was saved as running by its claim
+	if err := jobRepo.SaveAttempt(ctx, attempt); err != nil {
+		return nil, fmt.Errorf("save job %s attempt %d in db: %w", j.ID, attempt.Number, err)
 	}
 	if err := videoRepo.Save(ctx, video); err != nil {
 		return nil, fmt.Errorf("save video %s in db: %w", video.ID, err)
 	}
 
+	// Record events
+	if started {
+		if err := recordVideoEvent(ctx, outboxRepo, event.TypeVideoProcessing, video); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := uow.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("finalize transaction %w", err)
 	}
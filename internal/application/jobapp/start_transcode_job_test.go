@@ -2,16 +2,48 @@ package jobapp_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/st-ember/streaming-api/internal/application/jobapp"
 	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/event"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 	"github.com/st-ember/streaming-api/internal/domain/video"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+// storedJob returns the persisted state of the job under test, claimed by a worker
+func storedJob() *job.Job {
+	j, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	_ = j.Start("worker-1")
+	return j
+}
+
+// newSequentialIDs returns an id generator for pipeline jobs
+func newSequentialIDs() func() string {
+	n := 0
+	return func() string {
+		n++
+		return fmt.Sprintf("job-%d", n)
+	}
+}
+
+// runningAttempt returns the open attempt of a job set to running
+func runningAttempt() *job.Attempt {
+	return &job.Attempt{JobID: "job-id", Number: 0, WorkerID: "worker-1", Status: job.StatusRunning}
+}
+
+// expectEvents expects the events of the specified types to be recorded in the outbox
+func expectEvents(m *repomocks.MockOutboxRepo, types ...event.EventType) {
+	for _, eventType := range types {
+		m.EXPECT().Save(mock.Anything, mock.MatchedBy(func(e *event.Event) bool {
+			return e.Type == eventType
+		})).Return(nil).Once()
+	}
+}
+
 func TestStartTranscodeJob_SuccessCase(t *testing.T) {
 	t.Parallel()
 
@@ -19,24 +51,27 @@ func TestStartTranscodeJob_SuccessCase(t *testing.T) {
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
 
 	// Create valid domain objects for the test
-	startJob, err := job.NewJob("job-id", "video-id", job.TypeTranscode)
-	require.NoError(t, err)
-	relatedVideo, err := video.NewVideo("video-id", "title", "desc", "file.mp4", "resource-id")
+	startJob := storedJob()
+	relatedVideo, err := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
 	require.NoError(t, err)
 
 	// Define mock expectations for the success path
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	expectEvents(mockOutboxRepo, event.TypeVideoProcessing)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
 	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
 
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(storedJob(), nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
 	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
-	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
 
 	// --- ACT ---
 	usecase := jobapp.NewStartTranscodeJobUsecase(mockUowFactory)
@@ -49,13 +84,45 @@ func TestStartTranscodeJob_SuccessCase(t *testing.T) {
 	require.Equal(t, relatedVideo.Filename, resp.SourceFilename)
 	// Assert that the domain objects were updated
 	require.Equal(t, video.StatusProcessing, relatedVideo.Status)
-	require.Equal(t, job.StatusRunning, startJob.Status)
+}
+
+func TestStartTranscodeJob_StartsLaterPipelineStep(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	// An earlier step already marked the video as processing
+	startJob := storedJob()
+	relatedVideo, err := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	require.NoError(t, err)
+	relatedVideo.Status = video.StatusProcessing
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(storedJob(), nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
+
+	usecase := jobapp.NewStartTranscodeJobUsecase(mockUowFactory)
+	_, err = usecase.Execute(t.Context(), startJob)
+
+	require.NoError(t, err)
+	require.Equal(t, video.StatusProcessing, relatedVideo.Status)
 }
 
 func TestStartTranscodeJob_FailsOnUOWCreation(t *testing.T) {
 	t.Parallel()
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
-	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	startJob := storedJob()
 	expectedErr := errors.New("db down")
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(nil, expectedErr).Once()
@@ -72,15 +139,17 @@ func TestStartTranscodeJob_FailsOnFindVideoByID(t *testing.T) {
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
-	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	startJob := storedJob()
 	expectedErr := errors.New("video not found")
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(nil, expectedErr).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(nil, expectedErr).Once()
 
 	usecase := jobapp.NewStartTranscodeJobUsecase(mockUowFactory)
 	_, err := usecase.Execute(t.Context(), startJob)
@@ -89,22 +158,25 @@ func TestStartTranscodeJob_FailsOnFindVideoByID(t *testing.T) {
 	require.ErrorIs(t, err, expectedErr)
 }
 
-func TestStartTranscodeJob_FailsOnJobSave(t *testing.T) {
+func TestStartTranscodeJob_FailsOnAttemptSave(t *testing.T) {
 	t.Parallel()
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
-	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
-	relatedVideo, _ := video.NewVideo("video-id", "title", "desc", "file.mp4", "resource-id")
-	expectedErr := errors.New("job save failed")
+	startJob := storedJob()
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	expectedErr := errors.New("attempt save failed")
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
-	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(expectedErr).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(storedJob(), nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(expectedErr).Once()
 
 	usecase := jobapp.NewStartTranscodeJobUsecase(mockUowFactory)
 	_, err := usecase.Execute(t.Context(), startJob)
@@ -118,17 +190,20 @@ func TestStartTranscodeJob_FailsOnVideoSave(t *testing.T) {
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
-	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
-	relatedVideo, _ := video.NewVideo("video-id", "title", "desc", "file.mp4", "resource-id")
+	startJob := storedJob()
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
 	expectedErr := errors.New("video save failed")
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
-	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(storedJob(), nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
 	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(expectedErr).Once()
 
 	usecase := jobapp.NewStartTranscodeJobUsecase(mockUowFactory)
@@ -143,17 +218,21 @@ func TestStartTranscodeJob_FailsOnCommit(t *testing.T) {
 	mockVideoRepo := repomocks.NewMockVideoRepo(t)
 	mockJobRepo := repomocks.NewMockJobRepo(t)
 	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
-	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
-	relatedVideo, _ := video.NewVideo("video-id", "title", "desc", "file.mp4", "resource-id")
+	startJob := storedJob()
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
 	expectedErr := errors.New("commit failed")
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	expectEvents(mockOutboxRepo, event.TypeVideoProcessing)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
-	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
-	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(storedJob(), nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().SaveAttempt(mock.Anything, mock.AnythingOfType("*job.Attempt")).Return(nil).Once()
 	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
 	mockUow.EXPECT().Commit(mock.Anything).Return(expectedErr).Once()
 
@@ -164,18 +243,93 @@ func TestStartTranscodeJob_FailsOnCommit(t *testing.T) {
 	require.ErrorIs(t, err, expectedErr)
 }
 
-func TestStartTranscodeJob_FailsIfJobCannotBeStarted(t *testing.T) {
+func TestStartTranscodeJob_FailsIfJobIsNoLongerClaimed(t *testing.T) {
 	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
 	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
 
-	// Create a job that is already in a running state
-	startJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
-	startJob.Status = job.StatusRunning
+	// The job was released and claimed again by another worker
+	startJob := storedJob()
+	reclaimedJob := storedJob()
+	require.NoError(t, reclaimedJob.Release())
+	require.NoError(t, reclaimedJob.Start("worker-2"))
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(reclaimedJob, nil).Once()
 
 	usecase := jobapp.NewStartTranscodeJobUsecase(mockUowFactory)
 	_, err := usecase.Execute(t.Context(), startJob)
 
-	// We expect a domain error here, before any mocks are called.
-	require.Error(t, err)
-	require.ErrorIs(t, err, job.ErrCannotBeStarted)
+	require.ErrorIs(t, err, jobapp.ErrJobNotClaimed)
+}
+
+func TestStartTranscodeJob_FailsIfJobWasCancelled(t *testing.T) {
+	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	// The queued copy is still claimed but the stored job was cancelled
+	startJob := storedJob()
+	cancelledJob := storedJob()
+	cancelledJob.Status = job.StatusCancelled
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(cancelledJob, nil).Once()
+
+	usecase := jobapp.NewStartTranscodeJobUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), startJob)
+
+	require.ErrorIs(t, err, jobapp.ErrJobCancelled)
+}
+
+func TestStartTranscodeJob_CancelsJobOfFailedVideo(t *testing.T) {
+	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	// A sibling failed the video while the job was claimed
+	startJob := storedJob()
+	stored := storedJob()
+	relatedVideo, _ := video.NewVideo("video-id", "owner-id", "title", "desc", "file.mp4", "resource-id")
+	relatedVideo.Status = video.StatusFailed
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	expectEvents(mockOutboxRepo, event.TypeJobCancelled)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(stored, nil).Once()
+	mockVideoRepo.EXPECT().LockByID(mock.Anything, "video-id").Return(relatedVideo, nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, stored).Return(nil).Once()
+
+	usecase := jobapp.NewStartTranscodeJobUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), startJob)
+
+	require.ErrorIs(t, err, jobapp.ErrJobCancelled)
+	require.Equal(t, job.StatusCancelled, stored.Status)
+	// The failed video is never brought back to processing
+	require.Equal(t, video.StatusFailed, relatedVideo.Status)
 }
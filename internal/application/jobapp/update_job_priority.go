@@ -0,0 +1,56 @@
+package jobapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+// UpdateJobPriorityUsecase moves a queued job up or down the queue.
+type UpdateJobPriorityUsecase interface {
+	Execute(ctx context.Context, id string, priority int) (*job.Job, error)
+}
+
+type updateJobPriorityUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewUpdateJobPriorityUsecase(uowFactory repo.UnitOfWorkFactory) UpdateJobPriorityUsecase {
+	return &updateJobPriorityUsecase{uowFactory}
+}
+
+func (u *updateJobPriorityUsecase) Execute(ctx context.Context, id string, priority int) (*job.Job, error) {
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Initialize repo
+	jobRepo := uow.JobRepo()
+
+	// Find job
+	j, err := jobRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find job %s: %w", id, err)
+	}
+
+	// Update job entity
+	if err := j.SetPriority(priority); err != nil {
+		return nil, fmt.Errorf("set job %s priority: %w", j.ID, err)
+	}
+
+	// Persist entity
+	if err := jobRepo.Save(ctx, j); err != nil {
+		return nil, fmt.Errorf("save job %s in db: %w", j.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return j, nil
+}
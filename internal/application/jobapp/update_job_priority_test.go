@@ -0,0 +1,77 @@
+package jobapp_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateJobPriority_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// --- ARRANGE ---
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	pendingJob, err := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	require.NoError(t, err)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(pendingJob, nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, pendingJob).Return(nil).Once()
+
+	// --- ACT ---
+	usecase := jobapp.NewUpdateJobPriorityUsecase(mockUowFactory)
+	result, err := usecase.Execute(t.Context(), "job-id", 5)
+
+	// --- ASSERT ---
+	require.NoError(t, err)
+	require.Equal(t, 5, result.Priority)
+}
+
+func TestUpdateJobPriority_FailsIfJobNotPending(t *testing.T) {
+	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	runningJob, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	runningJob.Status = job.StatusRunning
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(runningJob, nil).Once()
+
+	usecase := jobapp.NewUpdateJobPriorityUsecase(mockUowFactory)
+	result, err := usecase.Execute(t.Context(), "job-id", 5)
+
+	require.ErrorIs(t, err, job.ErrPriorityCannotBeChanged)
+	require.Nil(t, result)
+}
+
+func TestUpdateJobPriority_FailsIfJobNotFound(t *testing.T) {
+	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(nil, sql.ErrNoRows).Once()
+
+	usecase := jobapp.NewUpdateJobPriorityUsecase(mockUowFactory)
+	result, err := usecase.Execute(t.Context(), "job-id", 5)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.Nil(t, result)
+}
@@ -0,0 +1,13 @@
+package denylist
+
+import (
+	"context"
+	"time"
+)
+
+// Denylist holds revoked sessions, whose access tokens are rejected before they expire
+type Denylist interface {
+	// Deny rejects the session for ttl, which must outlive its last access token
+	Deny(ctx context.Context, sessionID string, ttl time.Duration) error
+	IsDenied(ctx context.Context, sessionID string) (bool, error)
+}
@@ -0,0 +1,13 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/st-ember/streaming-api/internal/domain/event"
+)
+
+// Publisher hands events to the bus other systems consume.
+// An event may be published more than once, consumers deduplicate on its id.
+type Publisher interface {
+	Publish(ctx context.Context, e *event.Event) error
+}
@@ -7,6 +7,10 @@ const (
 	CategoryVideo   LogCategory = "video"
 	CategoryJob     LogCategory = "job"
 	CategoryAuth    LogCategory = "auth"
+	CategoryTask    LogCategory = "task"
+	CategoryEvent   LogCategory = "event"
+	CategoryWebhook LogCategory = "webhook"
+	CategoryAudit   LogCategory = "audit" // Security events kept for review, such as account lockouts
 )
 
 func (lc LogCategory) String() string {
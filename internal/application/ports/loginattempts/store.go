@@ -0,0 +1,25 @@
+package loginattempts
+
+import (
+	"context"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// Store counts failed logins by key, such as an account or a client address
+type Store interface {
+	// Get returns the failures of the key, none when nothing is recorded
+	Get(ctx context.Context, key string) (auth.LoginFailures, error)
+
+	// Fail records a failure of the key, returning its failures.
+	// They are forgotten after ttl without another failure.
+	Fail(ctx context.Context, key string, ttl time.Duration) (auth.LoginFailures, error)
+
+	// Release takes back one failure recorded with Fail,
+	// for an attempt counted before it was checked that turned out to succeed
+	Release(ctx context.Context, key string) error
+
+	// Reset forgets the failures of the key
+	Reset(ctx context.Context, key string) error
+}
@@ -0,0 +1,15 @@
+package mailsender
+
+import "context"
+
+// Message is a plain text mail to one recipient
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Sender interface {
+	// Send hands the message to the mail server, delivery happens later
+	Send(ctx context.Context, msg Message) error
+}
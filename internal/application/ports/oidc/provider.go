@@ -0,0 +1,31 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrRejected is returned when the identity provider refuses the code, or returns an invalid id token
+var ErrRejected = errors.New("identity provider rejected the sign in")
+
+// Identity is the user signed in at the identity provider, from the claims of its id token
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Username      string // preferred_username, may be empty
+	Groups        []string
+	Nonce         string
+}
+
+// Provider signs users in at an OpenID Connect identity provider
+// with the authorization code flow and PKCE
+type Provider interface {
+	// AuthCodeURL builds the url of the provider's sign in page.
+	// The S256 code challenge is derived from the verifier later given to Exchange.
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+
+	// Exchange redeems the code the provider redirected back with, returning the verified identity
+	Exchange(ctx context.Context, code, codeVerifier string) (*Identity, error)
+}
@@ -7,6 +7,7 @@ package progressstream
 import (
 	"context"
 
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
 	"github.com/st-ember/streaming-api/internal/domain/progress"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -168,3 +169,122 @@ func (_c *MockProgressStreamer_Read_Call) RunAndReturn(run func(ctx context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// Reset provides a mock function for the type MockProgressStreamer
+func (_mock *MockProgressStreamer) Reset(ctx context.Context, jobID string) error {
+	ret := _mock.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProgressStreamer_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type MockProgressStreamer_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID string
+func (_e *MockProgressStreamer_Expecter) Reset(ctx interface{}, jobID interface{}) *MockProgressStreamer_Reset_Call {
+	return &MockProgressStreamer_Reset_Call{Call: _e.mock.On("Reset", ctx, jobID)}
+}
+
+func (_c *MockProgressStreamer_Reset_Call) Run(run func(ctx context.Context, jobID string)) *MockProgressStreamer_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProgressStreamer_Reset_Call) Return(err error) *MockProgressStreamer_Reset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProgressStreamer_Reset_Call) RunAndReturn(run func(ctx context.Context, jobID string) error) *MockProgressStreamer_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function for the type MockProgressStreamer
+func (_mock *MockProgressStreamer) Subscribe(ctx context.Context) (progressstream.Subscription, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 progressstream.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (progressstream.Subscription, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) progressstream.Subscription); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(progressstream.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProgressStreamer_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockProgressStreamer_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProgressStreamer_Expecter) Subscribe(ctx interface{}) *MockProgressStreamer_Subscribe_Call {
+	return &MockProgressStreamer_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx)}
+}
+
+func (_c *MockProgressStreamer_Subscribe_Call) Run(run func(ctx context.Context)) *MockProgressStreamer_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProgressStreamer_Subscribe_Call) Return(subscription progressstream.Subscription, err error) *MockProgressStreamer_Subscribe_Call {
+	_c.Call.Return(subscription, err)
+	return _c
+}
+
+func (_c *MockProgressStreamer_Subscribe_Call) RunAndReturn(run func(ctx context.Context) (progressstream.Subscription, error)) *MockProgressStreamer_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -9,4 +9,41 @@ import (
 type ProgressStreamer interface {
 	Push(ctx context.Context, jobID string, prg *progress.Progress) error
 	Read(ctx context.Context, jobID string) (<-chan *progress.Progress, error)
+
+	// Reset forgets the latest progress of a job before it runs again,
+	// so readers do not take the final progress of its previous attempt for the new one.
+	// Updates keep their sequence growing, clients still tell the ones they received.
+	Reset(ctx context.Context, jobID string) error
+
+	// Subscribe opens a subscription following the progress of many jobs at once
+	Subscribe(ctx context.Context) (Subscription, error)
+}
+
+// ChunkProgressStore keeps the progress of each chunk of a chunked transcode,
+// shared by the workers encoding them, so that the progress of the whole transcode can be pushed
+type ChunkProgressStore interface {
+	// Record stores the progress of one of the count chunks of groupID
+	// and returns the progress aggregated across all chunks reported so far
+	Record(ctx context.Context, groupID string, index, count int, prg *progress.Progress) (*progress.Progress, error)
+}
+
+// JobProgress is a progress update tagged with the job reporting it
+type JobProgress struct {
+	JobID    string
+	Progress *progress.Progress
+}
+
+// Subscription follows the progress of a changing set of jobs over a single stream.
+// Updates of different jobs are interleaved, each job keeps the order of its own.
+type Subscription interface {
+	// Follow starts delivering the updates of a job, starting with its latest progress.
+	// A job is no longer followed once it delivered a finished progress.
+	// It may wait for Progress to be received, so both must not run on the same goroutine.
+	Follow(ctx context.Context, jobID string) error
+	// Unfollow stops delivering the updates of a job
+	Unfollow(jobID string)
+	// Progress delivers the updates of every followed job,
+	// it is closed with the subscription or its context
+	Progress() <-chan JobProgress
+	Close() error
 }
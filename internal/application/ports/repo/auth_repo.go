@@ -2,7 +2,9 @@ package repo
 
 import (
 	"context"
+	"time"
 
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 	"github.com/st-ember/streaming-api/internal/domain/user"
 )
 
@@ -14,9 +16,12 @@ type AuthRepo interface {
 	// FindPermissionsByRole finds permissions related to role
 	FindPermissionsByRole(ctx context.Context, roleName string) ([]string, error)
 
-	// FindUserByKey finds user by unique username or email
+	// FindUserByKey finds user by unique username or email, sql.ErrNoRows when missing
 	FindUserByKey(ctx context.Context, login string) (*user.User, error)
 
+	// FindUserByID finds user by id
+	FindUserByID(ctx context.Context, id string) (*user.User, error)
+
 	// FindPermissionsByUserID finds permissions related to user
 	FindPermissionsByUserID(ctx context.Context, userID string) ([]string, error)
 
@@ -25,4 +30,81 @@ type AuthRepo interface {
 
 	// SaveUserRole upserts a userRole
 	SaveUserRole(ctx context.Context, userID, roleName string) error
+
+	// DeleteUserRole removes a role from a user, removing a role the user lacks has no effect
+	DeleteUserRole(ctx context.Context, userID, roleName string) error
+
+	// SeedRole creates a role with its permissions, or grants the role of the same name the ones it was never seeded with.
+	// Permissions granted or removed since an earlier seed are kept as they are.
+	SeedRole(ctx context.Context, r *auth.Role) error
+
+	// SaveRole upserts a role and replaces its permissions
+	SaveRole(ctx context.Context, r *auth.Role) error
+
+	// FindRoleByName finds a role with its permissions
+	FindRoleByName(ctx context.Context, name string) (*auth.Role, error)
+
+	// ListRoles finds every role with its permissions, by name
+	ListRoles(ctx context.Context) ([]*auth.Role, error)
+
+	// SaveRefreshFamily upserts a refresh token family
+	SaveRefreshFamily(ctx context.Context, f *auth.RefreshFamily) error
+
+	// LockRefreshFamily finds a refresh token family and locks it until the end of the transaction,
+	// so concurrent refreshes with the same token rotate it only once
+	LockRefreshFamily(ctx context.Context, id string) (*auth.RefreshFamily, error)
+
+	// FindActiveRefreshFamilies finds the unrevoked refresh token families of a user
+	// used since the given time, most recently used first
+	FindActiveRefreshFamilies(ctx context.Context, userID string, since time.Time) ([]*auth.RefreshFamily, error)
+
+	// SaveAPIKey upserts an api key, leaving its last use to TouchAPIKey
+	SaveAPIKey(ctx context.Context, k *auth.APIKey) error
+
+	// FindAPIKeyByID finds an api key
+	FindAPIKeyByID(ctx context.Context, id string) (*auth.APIKey, error)
+
+	// FindAPIKeyByPrefix finds the api key identified by the prefix of a presented key
+	FindAPIKeyByPrefix(ctx context.Context, prefix string) (*auth.APIKey, error)
+
+	// FindAPIKeysByUserID finds the api keys of a user, newest first
+	FindAPIKeysByUserID(ctx context.Context, userID string) ([]*auth.APIKey, error)
+
+	// TouchAPIKey records the last use of an api key
+	TouchAPIKey(ctx context.Context, id string, usedAt time.Time) error
+
+	// SaveOIDCLogin records a sign in started at the identity provider
+	SaveOIDCLogin(ctx context.Context, l *auth.OIDCLogin) error
+
+	// TakeOIDCLogin finds and deletes the sign in of the state, so a callback completes it once
+	TakeOIDCLogin(ctx context.Context, state string) (*auth.OIDCLogin, error)
+
+	// DeleteExpiredOIDCLogins deletes the sign ins started before the given time
+	DeleteExpiredOIDCLogins(ctx context.Context, before time.Time) error
+
+	// FindUserByIdentity finds the user linked to the subject of an identity provider
+	FindUserByIdentity(ctx context.Context, issuer, subject string) (*user.User, error)
+
+	// SaveUserIdentity links the subject of an identity provider to a user
+	SaveUserIdentity(ctx context.Context, issuer, subject, userID string) error
+
+	// SaveUserToken records a token mailed to a user
+	SaveUserToken(ctx context.Context, t *auth.UserToken) error
+
+	// TakeUserToken finds and deletes the token of the hash and purpose, so it is used once,
+	// sql.ErrNoRows when missing
+	TakeUserToken(ctx context.Context, hash string, purpose auth.UserTokenPurpose) (*auth.UserToken, error)
+
+	// DeleteUserTokens deletes the tokens of a user for the purpose, along with the expired tokens of every user
+	DeleteUserTokens(ctx context.Context, userID string, purpose auth.UserTokenPurpose) error
+
+	// LockSigningKeys finds every signing key, holding a lock until the end of the transaction
+	// so a single node rotates them at a time, even when there are no keys yet
+	LockSigningKeys(ctx context.Context) ([]*auth.SigningKey, error)
+
+	// SaveSigningKey upserts a signing key
+	SaveSigningKey(ctx context.Context, k *auth.SigningKey) error
+
+	// DeleteExpiredSigningKeys deletes the keys no longer verifying tokens at the given time
+	DeleteExpiredSigningKeys(ctx context.Context, now time.Time) error
 }
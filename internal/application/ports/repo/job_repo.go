@@ -2,12 +2,37 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/st-ember/streaming-api/internal/domain/job"
 )
 
+// JobFilter narrows down listed jobs, zero values are ignored
+type JobFilter struct {
+	Status        job.JobStatus
+	Type          job.JobType
+	VideoID       string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Page          int
+}
+
 type JobRepo interface {
 	Save(ctx context.Context, job *job.Job) error
-	FindByVideoID(ctx context.Context, id string) (*job.Job, error)
-	FindNextPendingTranscodeJob(ctx context.Context) (*job.Job, error)
+	FindByID(ctx context.Context, id string) (*job.Job, error)
+	// ListByVideoID finds every job of the video's pipeline
+	ListByVideoID(ctx context.Context, videoID string) ([]*job.Job, error)
+	// FindNextPendingTranscodeJob claims the next runnable job for the worker, the same way job.Start does.
+	// Concurrent callers never claim the same job.
+	FindNextPendingTranscodeJob(ctx context.Context, workerID string) (*job.Job, error)
+	List(ctx context.Context, filter JobFilter) ([]*job.Job, error)
+	// SaveCost stores the estimated cost of a job, Save leaves it untouched
+	SaveCost(ctx context.Context, jobID string, cost job.Cost) error
+
+	// SaveAttempt upserts a job attempt
+	SaveAttempt(ctx context.Context, attempt *job.Attempt) error
+	// FindAttempt finds the attempt of a job by its number
+	FindAttempt(ctx context.Context, jobID string, number int) (*job.Attempt, error)
+	// ListAttempts finds all attempts of a job, oldest first
+	ListAttempts(ctx context.Context, jobID string) ([]*job.Attempt, error)
 }
@@ -6,7 +6,9 @@ package repo
 
 import (
 	"context"
+	"time"
 
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 	"github.com/st-ember/streaming-api/internal/domain/user"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -38,47 +40,1572 @@ func (_m *MockAuthRepo) EXPECT() *MockAuthRepo_Expecter {
 	return &MockAuthRepo_Expecter{mock: &_m.Mock}
 }
 
+// DeleteExpiredOIDCLogins provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) DeleteExpiredOIDCLogins(ctx context.Context, before time.Time) error {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredOIDCLogins")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepo_DeleteExpiredOIDCLogins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredOIDCLogins'
+type MockAuthRepo_DeleteExpiredOIDCLogins_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredOIDCLogins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockAuthRepo_Expecter) DeleteExpiredOIDCLogins(ctx interface{}, before interface{}) *MockAuthRepo_DeleteExpiredOIDCLogins_Call {
+	return &MockAuthRepo_DeleteExpiredOIDCLogins_Call{Call: _e.mock.On("DeleteExpiredOIDCLogins", ctx, before)}
+}
+
+func (_c *MockAuthRepo_DeleteExpiredOIDCLogins_Call) Run(run func(ctx context.Context, before time.Time)) *MockAuthRepo_DeleteExpiredOIDCLogins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_DeleteExpiredOIDCLogins_Call) Return(err error) *MockAuthRepo_DeleteExpiredOIDCLogins_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepo_DeleteExpiredOIDCLogins_Call) RunAndReturn(run func(ctx context.Context, before time.Time) error) *MockAuthRepo_DeleteExpiredOIDCLogins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredSigningKeys provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) DeleteExpiredSigningKeys(ctx context.Context, now time.Time) error {
+	ret := _mock.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredSigningKeys")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepo_DeleteExpiredSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredSigningKeys'
+type MockAuthRepo_DeleteExpiredSigningKeys_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockAuthRepo_Expecter) DeleteExpiredSigningKeys(ctx interface{}, now interface{}) *MockAuthRepo_DeleteExpiredSigningKeys_Call {
+	return &MockAuthRepo_DeleteExpiredSigningKeys_Call{Call: _e.mock.On("DeleteExpiredSigningKeys", ctx, now)}
+}
+
+func (_c *MockAuthRepo_DeleteExpiredSigningKeys_Call) Run(run func(ctx context.Context, now time.Time)) *MockAuthRepo_DeleteExpiredSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_DeleteExpiredSigningKeys_Call) Return(err error) *MockAuthRepo_DeleteExpiredSigningKeys_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepo_DeleteExpiredSigningKeys_Call) RunAndReturn(run func(ctx context.Context, now time.Time) error) *MockAuthRepo_DeleteExpiredSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserRole provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) DeleteUserRole(ctx context.Context, userID string, roleName string) error {
+	ret := _mock.Called(ctx, userID, roleName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, roleName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepo_DeleteUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserRole'
+type MockAuthRepo_DeleteUserRole_Call struct {
+	*mock.Call
+}
+
+// DeleteUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - roleName string
+func (_e *MockAuthRepo_Expecter) DeleteUserRole(ctx interface{}, userID interface{}, roleName interface{}) *MockAuthRepo_DeleteUserRole_Call {
+	return &MockAuthRepo_DeleteUserRole_Call{Call: _e.mock.On("DeleteUserRole", ctx, userID, roleName)}
+}
+
+func (_c *MockAuthRepo_DeleteUserRole_Call) Run(run func(ctx context.Context, userID string, roleName string)) *MockAuthRepo_DeleteUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_DeleteUserRole_Call) Return(err error) *MockAuthRepo_DeleteUserRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepo_DeleteUserRole_Call) RunAndReturn(run func(ctx context.Context, userID string, roleName string) error) *MockAuthRepo_DeleteUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserTokens provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) DeleteUserTokens(ctx context.Context, userID string, purpose auth.UserTokenPurpose) error {
+	ret := _mock.Called(ctx, userID, purpose)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.UserTokenPurpose) error); ok {
+		r0 = returnFunc(ctx, userID, purpose)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepo_DeleteUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserTokens'
+type MockAuthRepo_DeleteUserTokens_Call struct {
+	*mock.Call
+}
+
+// DeleteUserTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - purpose auth.UserTokenPurpose
+func (_e *MockAuthRepo_Expecter) DeleteUserTokens(ctx interface{}, userID interface{}, purpose interface{}) *MockAuthRepo_DeleteUserTokens_Call {
+	return &MockAuthRepo_DeleteUserTokens_Call{Call: _e.mock.On("DeleteUserTokens", ctx, userID, purpose)}
+}
+
+func (_c *MockAuthRepo_DeleteUserTokens_Call) Run(run func(ctx context.Context, userID string, purpose auth.UserTokenPurpose)) *MockAuthRepo_DeleteUserTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 auth.UserTokenPurpose
+		if args[2] != nil {
+			arg2 = args[2].(auth.UserTokenPurpose)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_DeleteUserTokens_Call) Return(err error) *MockAuthRepo_DeleteUserTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepo_DeleteUserTokens_Call) RunAndReturn(run func(ctx context.Context, userID string, purpose auth.UserTokenPurpose) error) *MockAuthRepo_DeleteUserTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAPIKeyByID provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) FindAPIKeyByID(ctx context.Context, id string) (*auth.APIKey, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIKeyByID")
+	}
+
+	var r0 *auth.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*auth.APIKey, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *auth.APIKey); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_FindAPIKeyByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIKeyByID'
+type MockAuthRepo_FindAPIKeyByID_Call struct {
+	*mock.Call
+}
+
+// FindAPIKeyByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockAuthRepo_Expecter) FindAPIKeyByID(ctx interface{}, id interface{}) *MockAuthRepo_FindAPIKeyByID_Call {
+	return &MockAuthRepo_FindAPIKeyByID_Call{Call: _e.mock.On("FindAPIKeyByID", ctx, id)}
+}
+
+func (_c *MockAuthRepo_FindAPIKeyByID_Call) Run(run func(ctx context.Context, id string)) *MockAuthRepo_FindAPIKeyByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_FindAPIKeyByID_Call) Return(apiKey *auth.APIKey, err error) *MockAuthRepo_FindAPIKeyByID_Call {
+	_c.Call.Return(apiKey, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_FindAPIKeyByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*auth.APIKey, error)) *MockAuthRepo_FindAPIKeyByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAPIKeyByPrefix provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) FindAPIKeyByPrefix(ctx context.Context, prefix string) (*auth.APIKey, error) {
+	ret := _mock.Called(ctx, prefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIKeyByPrefix")
+	}
+
+	var r0 *auth.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*auth.APIKey, error)); ok {
+		return returnFunc(ctx, prefix)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *auth.APIKey); ok {
+		r0 = returnFunc(ctx, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_FindAPIKeyByPrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIKeyByPrefix'
+type MockAuthRepo_FindAPIKeyByPrefix_Call struct {
+	*mock.Call
+}
+
+// FindAPIKeyByPrefix is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+func (_e *MockAuthRepo_Expecter) FindAPIKeyByPrefix(ctx interface{}, prefix interface{}) *MockAuthRepo_FindAPIKeyByPrefix_Call {
+	return &MockAuthRepo_FindAPIKeyByPrefix_Call{Call: _e.mock.On("FindAPIKeyByPrefix", ctx, prefix)}
+}
+
+func (_c *MockAuthRepo_FindAPIKeyByPrefix_Call) Run(run func(ctx context.Context, prefix string)) *MockAuthRepo_FindAPIKeyByPrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_FindAPIKeyByPrefix_Call) Return(apiKey *auth.APIKey, err error) *MockAuthRepo_FindAPIKeyByPrefix_Call {
+	_c.Call.Return(apiKey, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_FindAPIKeyByPrefix_Call) RunAndReturn(run func(ctx context.Context, prefix string) (*auth.APIKey, error)) *MockAuthRepo_FindAPIKeyByPrefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAPIKeysByUserID provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) FindAPIKeysByUserID(ctx context.Context, userID string) ([]*auth.APIKey, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIKeysByUserID")
+	}
+
+	var r0 []*auth.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*auth.APIKey, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*auth.APIKey); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*auth.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_FindAPIKeysByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIKeysByUserID'
+type MockAuthRepo_FindAPIKeysByUserID_Call struct {
+	*mock.Call
+}
+
+// FindAPIKeysByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAuthRepo_Expecter) FindAPIKeysByUserID(ctx interface{}, userID interface{}) *MockAuthRepo_FindAPIKeysByUserID_Call {
+	return &MockAuthRepo_FindAPIKeysByUserID_Call{Call: _e.mock.On("FindAPIKeysByUserID", ctx, userID)}
+}
+
+func (_c *MockAuthRepo_FindAPIKeysByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockAuthRepo_FindAPIKeysByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_FindAPIKeysByUserID_Call) Return(apiKeys []*auth.APIKey, err error) *MockAuthRepo_FindAPIKeysByUserID_Call {
+	_c.Call.Return(apiKeys, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_FindAPIKeysByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*auth.APIKey, error)) *MockAuthRepo_FindAPIKeysByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindActiveRefreshFamilies provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) FindActiveRefreshFamilies(ctx context.Context, userID string, since time.Time) ([]*auth.RefreshFamily, error) {
+	ret := _mock.Called(ctx, userID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindActiveRefreshFamilies")
+	}
+
+	var r0 []*auth.RefreshFamily
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]*auth.RefreshFamily, error)); ok {
+		return returnFunc(ctx, userID, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) []*auth.RefreshFamily); ok {
+		r0 = returnFunc(ctx, userID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*auth.RefreshFamily)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_FindActiveRefreshFamilies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindActiveRefreshFamilies'
+type MockAuthRepo_FindActiveRefreshFamilies_Call struct {
+	*mock.Call
+}
+
+// FindActiveRefreshFamilies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - since time.Time
+func (_e *MockAuthRepo_Expecter) FindActiveRefreshFamilies(ctx interface{}, userID interface{}, since interface{}) *MockAuthRepo_FindActiveRefreshFamilies_Call {
+	return &MockAuthRepo_FindActiveRefreshFamilies_Call{Call: _e.mock.On("FindActiveRefreshFamilies", ctx, userID, since)}
+}
+
+func (_c *MockAuthRepo_FindActiveRefreshFamilies_Call) Run(run func(ctx context.Context, userID string, since time.Time)) *MockAuthRepo_FindActiveRefreshFamilies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_FindActiveRefreshFamilies_Call) Return(refreshFamilys []*auth.RefreshFamily, err error) *MockAuthRepo_FindActiveRefreshFamilies_Call {
+	_c.Call.Return(refreshFamilys, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_FindActiveRefreshFamilies_Call) RunAndReturn(run func(ctx context.Context, userID string, since time.Time) ([]*auth.RefreshFamily, error)) *MockAuthRepo_FindActiveRefreshFamilies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindPermissionsByRole provides a mock function for the type MockAuthRepo
 func (_mock *MockAuthRepo) FindPermissionsByRole(ctx context.Context, roleName string) ([]string, error) {
 	ret := _mock.Called(ctx, roleName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindPermissionsByRole")
+		panic("no return value specified for FindPermissionsByRole")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, roleName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, roleName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, roleName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_FindPermissionsByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPermissionsByRole'
+type MockAuthRepo_FindPermissionsByRole_Call struct {
+	*mock.Call
+}
+
+// FindPermissionsByRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleName string
+func (_e *MockAuthRepo_Expecter) FindPermissionsByRole(ctx interface{}, roleName interface{}) *MockAuthRepo_FindPermissionsByRole_Call {
+	return &MockAuthRepo_FindPermissionsByRole_Call{Call: _e.mock.On("FindPermissionsByRole", ctx, roleName)}
+}
+
+func (_c *MockAuthRepo_FindPermissionsByRole_Call) Run(run func(ctx context.Context, roleName string)) *MockAuthRepo_FindPermissionsByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_FindPermissionsByRole_Call) Return(strings []string, err error) *MockAuthRepo_FindPermissionsByRole_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_FindPermissionsByRole_Call) RunAndReturn(run func(ctx context.Context, roleName string) ([]string, error)) *MockAuthRepo_FindPermissionsByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPermissionsByUserID provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) FindPermissionsByUserID(ctx context.Context, userID string) ([]string, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPermissionsByUserID")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_FindPermissionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPermissionsByUserID'
+type MockAuthRepo_FindPermissionsByUserID_Call struct {
+	*mock.Call
+}
+
+// FindPermissionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAuthRepo_Expecter) FindPermissionsByUserID(ctx interface{}, userID interface{}) *MockAuthRepo_FindPermissionsByUserID_Call {
+	return &MockAuthRepo_FindPermissionsByUserID_Call{Call: _e.mock.On("FindPermissionsByUserID", ctx, userID)}
+}
+
+func (_c *MockAuthRepo_FindPermissionsByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockAuthRepo_FindPermissionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_FindPermissionsByUserID_Call) Return(strings []string, err error) *MockAuthRepo_FindPermissionsByUserID_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_FindPermissionsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]string, error)) *MockAuthRepo_FindPermissionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRoleByName provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) FindRoleByName(ctx context.Context, name string) (*auth.Role, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRoleByName")
+	}
+
+	var r0 *auth.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*auth.Role, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *auth.Role); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_FindRoleByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRoleByName'
+type MockAuthRepo_FindRoleByName_Call struct {
+	*mock.Call
+}
+
+// FindRoleByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockAuthRepo_Expecter) FindRoleByName(ctx interface{}, name interface{}) *MockAuthRepo_FindRoleByName_Call {
+	return &MockAuthRepo_FindRoleByName_Call{Call: _e.mock.On("FindRoleByName", ctx, name)}
+}
+
+func (_c *MockAuthRepo_FindRoleByName_Call) Run(run func(ctx context.Context, name string)) *MockAuthRepo_FindRoleByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_FindRoleByName_Call) Return(role *auth.Role, err error) *MockAuthRepo_FindRoleByName_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_FindRoleByName_Call) RunAndReturn(run func(ctx context.Context, name string) (*auth.Role, error)) *MockAuthRepo_FindRoleByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserByID provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) FindUserByID(ctx context.Context, id string) (*user.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserByID")
+	}
+
+	var r0 *user.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*user.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *user.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_FindUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByID'
+type MockAuthRepo_FindUserByID_Call struct {
+	*mock.Call
+}
+
+// FindUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockAuthRepo_Expecter) FindUserByID(ctx interface{}, id interface{}) *MockAuthRepo_FindUserByID_Call {
+	return &MockAuthRepo_FindUserByID_Call{Call: _e.mock.On("FindUserByID", ctx, id)}
+}
+
+func (_c *MockAuthRepo_FindUserByID_Call) Run(run func(ctx context.Context, id string)) *MockAuthRepo_FindUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_FindUserByID_Call) Return(user1 *user.User, err error) *MockAuthRepo_FindUserByID_Call {
+	_c.Call.Return(user1, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_FindUserByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*user.User, error)) *MockAuthRepo_FindUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserByIdentity provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) FindUserByIdentity(ctx context.Context, issuer string, subject string) (*user.User, error) {
+	ret := _mock.Called(ctx, issuer, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserByIdentity")
+	}
+
+	var r0 *user.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*user.User, error)); ok {
+		return returnFunc(ctx, issuer, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *user.User); ok {
+		r0 = returnFunc(ctx, issuer, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, issuer, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_FindUserByIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByIdentity'
+type MockAuthRepo_FindUserByIdentity_Call struct {
+	*mock.Call
+}
+
+// FindUserByIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issuer string
+//   - subject string
+func (_e *MockAuthRepo_Expecter) FindUserByIdentity(ctx interface{}, issuer interface{}, subject interface{}) *MockAuthRepo_FindUserByIdentity_Call {
+	return &MockAuthRepo_FindUserByIdentity_Call{Call: _e.mock.On("FindUserByIdentity", ctx, issuer, subject)}
+}
+
+func (_c *MockAuthRepo_FindUserByIdentity_Call) Run(run func(ctx context.Context, issuer string, subject string)) *MockAuthRepo_FindUserByIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_FindUserByIdentity_Call) Return(user1 *user.User, err error) *MockAuthRepo_FindUserByIdentity_Call {
+	_c.Call.Return(user1, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_FindUserByIdentity_Call) RunAndReturn(run func(ctx context.Context, issuer string, subject string) (*user.User, error)) *MockAuthRepo_FindUserByIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserByKey provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) FindUserByKey(ctx context.Context, login string) (*user.User, error) {
+	ret := _mock.Called(ctx, login)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserByKey")
+	}
+
+	var r0 *user.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*user.User, error)); ok {
+		return returnFunc(ctx, login)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *user.User); ok {
+		r0 = returnFunc(ctx, login)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, login)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_FindUserByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByKey'
+type MockAuthRepo_FindUserByKey_Call struct {
+	*mock.Call
+}
+
+// FindUserByKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - login string
+func (_e *MockAuthRepo_Expecter) FindUserByKey(ctx interface{}, login interface{}) *MockAuthRepo_FindUserByKey_Call {
+	return &MockAuthRepo_FindUserByKey_Call{Call: _e.mock.On("FindUserByKey", ctx, login)}
+}
+
+func (_c *MockAuthRepo_FindUserByKey_Call) Run(run func(ctx context.Context, login string)) *MockAuthRepo_FindUserByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_FindUserByKey_Call) Return(user1 *user.User, err error) *MockAuthRepo_FindUserByKey_Call {
+	_c.Call.Return(user1, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_FindUserByKey_Call) RunAndReturn(run func(ctx context.Context, login string) (*user.User, error)) *MockAuthRepo_FindUserByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoles provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) ListRoles(ctx context.Context) ([]*auth.Role, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 []*auth.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*auth.Role, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*auth.Role); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*auth.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_ListRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoles'
+type MockAuthRepo_ListRoles_Call struct {
+	*mock.Call
+}
+
+// ListRoles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthRepo_Expecter) ListRoles(ctx interface{}) *MockAuthRepo_ListRoles_Call {
+	return &MockAuthRepo_ListRoles_Call{Call: _e.mock.On("ListRoles", ctx)}
+}
+
+func (_c *MockAuthRepo_ListRoles_Call) Run(run func(ctx context.Context)) *MockAuthRepo_ListRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_ListRoles_Call) Return(roles []*auth.Role, err error) *MockAuthRepo_ListRoles_Call {
+	_c.Call.Return(roles, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_ListRoles_Call) RunAndReturn(run func(ctx context.Context) ([]*auth.Role, error)) *MockAuthRepo_ListRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockRefreshFamily provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) LockRefreshFamily(ctx context.Context, id string) (*auth.RefreshFamily, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockRefreshFamily")
+	}
+
+	var r0 *auth.RefreshFamily
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*auth.RefreshFamily, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *auth.RefreshFamily); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.RefreshFamily)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_LockRefreshFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockRefreshFamily'
+type MockAuthRepo_LockRefreshFamily_Call struct {
+	*mock.Call
+}
+
+// LockRefreshFamily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockAuthRepo_Expecter) LockRefreshFamily(ctx interface{}, id interface{}) *MockAuthRepo_LockRefreshFamily_Call {
+	return &MockAuthRepo_LockRefreshFamily_Call{Call: _e.mock.On("LockRefreshFamily", ctx, id)}
+}
+
+func (_c *MockAuthRepo_LockRefreshFamily_Call) Run(run func(ctx context.Context, id string)) *MockAuthRepo_LockRefreshFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_LockRefreshFamily_Call) Return(refreshFamily *auth.RefreshFamily, err error) *MockAuthRepo_LockRefreshFamily_Call {
+	_c.Call.Return(refreshFamily, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_LockRefreshFamily_Call) RunAndReturn(run func(ctx context.Context, id string) (*auth.RefreshFamily, error)) *MockAuthRepo_LockRefreshFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockSigningKeys provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) LockSigningKeys(ctx context.Context) ([]*auth.SigningKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockSigningKeys")
+	}
+
+	var r0 []*auth.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*auth.SigningKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*auth.SigningKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*auth.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_LockSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockSigningKeys'
+type MockAuthRepo_LockSigningKeys_Call struct {
+	*mock.Call
+}
+
+// LockSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthRepo_Expecter) LockSigningKeys(ctx interface{}) *MockAuthRepo_LockSigningKeys_Call {
+	return &MockAuthRepo_LockSigningKeys_Call{Call: _e.mock.On("LockSigningKeys", ctx)}
+}
+
+func (_c *MockAuthRepo_LockSigningKeys_Call) Run(run func(ctx context.Context)) *MockAuthRepo_LockSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_LockSigningKeys_Call) Return(signingKeys []*auth.SigningKey, err error) *MockAuthRepo_LockSigningKeys_Call {
+	_c.Call.Return(signingKeys, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_LockSigningKeys_Call) RunAndReturn(run func(ctx context.Context) ([]*auth.SigningKey, error)) *MockAuthRepo_LockSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveAPIKey provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) SaveAPIKey(ctx context.Context, k *auth.APIKey) error {
+	ret := _mock.Called(ctx, k)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAPIKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.APIKey) error); ok {
+		r0 = returnFunc(ctx, k)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepo_SaveAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAPIKey'
+type MockAuthRepo_SaveAPIKey_Call struct {
+	*mock.Call
+}
+
+// SaveAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - k *auth.APIKey
+func (_e *MockAuthRepo_Expecter) SaveAPIKey(ctx interface{}, k interface{}) *MockAuthRepo_SaveAPIKey_Call {
+	return &MockAuthRepo_SaveAPIKey_Call{Call: _e.mock.On("SaveAPIKey", ctx, k)}
+}
+
+func (_c *MockAuthRepo_SaveAPIKey_Call) Run(run func(ctx context.Context, k *auth.APIKey)) *MockAuthRepo_SaveAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.APIKey
+		if args[1] != nil {
+			arg1 = args[1].(*auth.APIKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveAPIKey_Call) Return(err error) *MockAuthRepo_SaveAPIKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveAPIKey_Call) RunAndReturn(run func(ctx context.Context, k *auth.APIKey) error) *MockAuthRepo_SaveAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveOIDCLogin provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) SaveOIDCLogin(ctx context.Context, l *auth.OIDCLogin) error {
+	ret := _mock.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveOIDCLogin")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.OIDCLogin) error); ok {
+		r0 = returnFunc(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepo_SaveOIDCLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveOIDCLogin'
+type MockAuthRepo_SaveOIDCLogin_Call struct {
+	*mock.Call
+}
+
+// SaveOIDCLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - l *auth.OIDCLogin
+func (_e *MockAuthRepo_Expecter) SaveOIDCLogin(ctx interface{}, l interface{}) *MockAuthRepo_SaveOIDCLogin_Call {
+	return &MockAuthRepo_SaveOIDCLogin_Call{Call: _e.mock.On("SaveOIDCLogin", ctx, l)}
+}
+
+func (_c *MockAuthRepo_SaveOIDCLogin_Call) Run(run func(ctx context.Context, l *auth.OIDCLogin)) *MockAuthRepo_SaveOIDCLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.OIDCLogin
+		if args[1] != nil {
+			arg1 = args[1].(*auth.OIDCLogin)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveOIDCLogin_Call) Return(err error) *MockAuthRepo_SaveOIDCLogin_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveOIDCLogin_Call) RunAndReturn(run func(ctx context.Context, l *auth.OIDCLogin) error) *MockAuthRepo_SaveOIDCLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveRefreshFamily provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) SaveRefreshFamily(ctx context.Context, f *auth.RefreshFamily) error {
+	ret := _mock.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRefreshFamily")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RefreshFamily) error); ok {
+		r0 = returnFunc(ctx, f)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepo_SaveRefreshFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRefreshFamily'
+type MockAuthRepo_SaveRefreshFamily_Call struct {
+	*mock.Call
+}
+
+// SaveRefreshFamily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f *auth.RefreshFamily
+func (_e *MockAuthRepo_Expecter) SaveRefreshFamily(ctx interface{}, f interface{}) *MockAuthRepo_SaveRefreshFamily_Call {
+	return &MockAuthRepo_SaveRefreshFamily_Call{Call: _e.mock.On("SaveRefreshFamily", ctx, f)}
+}
+
+func (_c *MockAuthRepo_SaveRefreshFamily_Call) Run(run func(ctx context.Context, f *auth.RefreshFamily)) *MockAuthRepo_SaveRefreshFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.RefreshFamily
+		if args[1] != nil {
+			arg1 = args[1].(*auth.RefreshFamily)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveRefreshFamily_Call) Return(err error) *MockAuthRepo_SaveRefreshFamily_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveRefreshFamily_Call) RunAndReturn(run func(ctx context.Context, f *auth.RefreshFamily) error) *MockAuthRepo_SaveRefreshFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveRole provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) SaveRole(ctx context.Context, r *auth.Role) error {
+	ret := _mock.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.Role) error); ok {
+		r0 = returnFunc(ctx, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepo_SaveRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRole'
+type MockAuthRepo_SaveRole_Call struct {
+	*mock.Call
+}
+
+// SaveRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r *auth.Role
+func (_e *MockAuthRepo_Expecter) SaveRole(ctx interface{}, r interface{}) *MockAuthRepo_SaveRole_Call {
+	return &MockAuthRepo_SaveRole_Call{Call: _e.mock.On("SaveRole", ctx, r)}
+}
+
+func (_c *MockAuthRepo_SaveRole_Call) Run(run func(ctx context.Context, r *auth.Role)) *MockAuthRepo_SaveRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.Role
+		if args[1] != nil {
+			arg1 = args[1].(*auth.Role)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveRole_Call) Return(err error) *MockAuthRepo_SaveRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveRole_Call) RunAndReturn(run func(ctx context.Context, r *auth.Role) error) *MockAuthRepo_SaveRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSigningKey provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) SaveSigningKey(ctx context.Context, k *auth.SigningKey) error {
+	ret := _mock.Called(ctx, k)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSigningKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SigningKey) error); ok {
+		r0 = returnFunc(ctx, k)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepo_SaveSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSigningKey'
+type MockAuthRepo_SaveSigningKey_Call struct {
+	*mock.Call
+}
+
+// SaveSigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - k *auth.SigningKey
+func (_e *MockAuthRepo_Expecter) SaveSigningKey(ctx interface{}, k interface{}) *MockAuthRepo_SaveSigningKey_Call {
+	return &MockAuthRepo_SaveSigningKey_Call{Call: _e.mock.On("SaveSigningKey", ctx, k)}
+}
+
+func (_c *MockAuthRepo_SaveSigningKey_Call) Run(run func(ctx context.Context, k *auth.SigningKey)) *MockAuthRepo_SaveSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.SigningKey
+		if args[1] != nil {
+			arg1 = args[1].(*auth.SigningKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveSigningKey_Call) Return(err error) *MockAuthRepo_SaveSigningKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveSigningKey_Call) RunAndReturn(run func(ctx context.Context, k *auth.SigningKey) error) *MockAuthRepo_SaveSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveUser provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) SaveUser(ctx context.Context, u *user.User) error {
+	ret := _mock.Called(ctx, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *user.User) error); ok {
+		r0 = returnFunc(ctx, u)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepo_SaveUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUser'
+type MockAuthRepo_SaveUser_Call struct {
+	*mock.Call
+}
+
+// SaveUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - u *user.User
+func (_e *MockAuthRepo_Expecter) SaveUser(ctx interface{}, u interface{}) *MockAuthRepo_SaveUser_Call {
+	return &MockAuthRepo_SaveUser_Call{Call: _e.mock.On("SaveUser", ctx, u)}
+}
+
+func (_c *MockAuthRepo_SaveUser_Call) Run(run func(ctx context.Context, u *user.User)) *MockAuthRepo_SaveUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *user.User
+		if args[1] != nil {
+			arg1 = args[1].(*user.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveUser_Call) Return(err error) *MockAuthRepo_SaveUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveUser_Call) RunAndReturn(run func(ctx context.Context, u *user.User) error) *MockAuthRepo_SaveUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveUserIdentity provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) SaveUserIdentity(ctx context.Context, issuer string, subject string, userID string) error {
+	ret := _mock.Called(ctx, issuer, subject, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveUserIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, issuer, subject, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepo_SaveUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUserIdentity'
+type MockAuthRepo_SaveUserIdentity_Call struct {
+	*mock.Call
+}
+
+// SaveUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issuer string
+//   - subject string
+//   - userID string
+func (_e *MockAuthRepo_Expecter) SaveUserIdentity(ctx interface{}, issuer interface{}, subject interface{}, userID interface{}) *MockAuthRepo_SaveUserIdentity_Call {
+	return &MockAuthRepo_SaveUserIdentity_Call{Call: _e.mock.On("SaveUserIdentity", ctx, issuer, subject, userID)}
+}
+
+func (_c *MockAuthRepo_SaveUserIdentity_Call) Run(run func(ctx context.Context, issuer string, subject string, userID string)) *MockAuthRepo_SaveUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveUserIdentity_Call) Return(err error) *MockAuthRepo_SaveUserIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepo_SaveUserIdentity_Call) RunAndReturn(run func(ctx context.Context, issuer string, subject string, userID string) error) *MockAuthRepo_SaveUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveUserRole provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) SaveUserRole(ctx context.Context, userID string, roleName string) error {
+	ret := _mock.Called(ctx, userID, roleName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveUserRole")
 	}
 
-	var r0 []string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
-		return returnFunc(ctx, roleName)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
-		r0 = returnFunc(ctx, roleName)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, roleName)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, roleName)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockAuthRepo_FindPermissionsByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPermissionsByRole'
-type MockAuthRepo_FindPermissionsByRole_Call struct {
+// MockAuthRepo_SaveUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUserRole'
+type MockAuthRepo_SaveUserRole_Call struct {
 	*mock.Call
 }
 
-// FindPermissionsByRole is a helper method to define mock.On call
+// SaveUserRole is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID string
 //   - roleName string
-func (_e *MockAuthRepo_Expecter) FindPermissionsByRole(ctx interface{}, roleName interface{}) *MockAuthRepo_FindPermissionsByRole_Call {
-	return &MockAuthRepo_FindPermissionsByRole_Call{Call: _e.mock.On("FindPermissionsByRole", ctx, roleName)}
+func (_e *MockAuthRepo_Expecter) SaveUserRole(ctx interface{}, userID interface{}, roleName interface{}) *MockAuthRepo_SaveUserRole_Call {
+	return &MockAuthRepo_SaveUserRole_Call{Call: _e.mock.On("SaveUserRole", ctx, userID, roleName)}
 }
 
-func (_c *MockAuthRepo_FindPermissionsByRole_Call) Run(run func(ctx context.Context, roleName string)) *MockAuthRepo_FindPermissionsByRole_Call {
+func (_c *MockAuthRepo_SaveUserRole_Call) Run(run func(ctx context.Context, userID string, roleName string)) *MockAuthRepo_SaveUserRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -88,73 +1615,67 @@ func (_c *MockAuthRepo_FindPermissionsByRole_Call) Run(run func(ctx context.Cont
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAuthRepo_FindPermissionsByRole_Call) Return(strings []string, err error) *MockAuthRepo_FindPermissionsByRole_Call {
-	_c.Call.Return(strings, err)
+func (_c *MockAuthRepo_SaveUserRole_Call) Return(err error) *MockAuthRepo_SaveUserRole_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthRepo_FindPermissionsByRole_Call) RunAndReturn(run func(ctx context.Context, roleName string) ([]string, error)) *MockAuthRepo_FindPermissionsByRole_Call {
+func (_c *MockAuthRepo_SaveUserRole_Call) RunAndReturn(run func(ctx context.Context, userID string, roleName string) error) *MockAuthRepo_SaveUserRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindPermissionsByUserID provides a mock function for the type MockAuthRepo
-func (_mock *MockAuthRepo) FindPermissionsByUserID(ctx context.Context, userID string) ([]string, error) {
-	ret := _mock.Called(ctx, userID)
+// SaveUserToken provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) SaveUserToken(ctx context.Context, t *auth.UserToken) error {
+	ret := _mock.Called(ctx, t)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindPermissionsByUserID")
+		panic("no return value specified for SaveUserToken")
 	}
 
-	var r0 []string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
-		return returnFunc(ctx, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
-		r0 = returnFunc(ctx, userID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.UserToken) error); ok {
+		r0 = returnFunc(ctx, t)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockAuthRepo_FindPermissionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPermissionsByUserID'
-type MockAuthRepo_FindPermissionsByUserID_Call struct {
+// MockAuthRepo_SaveUserToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUserToken'
+type MockAuthRepo_SaveUserToken_Call struct {
 	*mock.Call
 }
 
-// FindPermissionsByUserID is a helper method to define mock.On call
+// SaveUserToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *MockAuthRepo_Expecter) FindPermissionsByUserID(ctx interface{}, userID interface{}) *MockAuthRepo_FindPermissionsByUserID_Call {
-	return &MockAuthRepo_FindPermissionsByUserID_Call{Call: _e.mock.On("FindPermissionsByUserID", ctx, userID)}
+//   - t *auth.UserToken
+func (_e *MockAuthRepo_Expecter) SaveUserToken(ctx interface{}, t interface{}) *MockAuthRepo_SaveUserToken_Call {
+	return &MockAuthRepo_SaveUserToken_Call{Call: _e.mock.On("SaveUserToken", ctx, t)}
 }
 
-func (_c *MockAuthRepo_FindPermissionsByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockAuthRepo_FindPermissionsByUserID_Call {
+func (_c *MockAuthRepo_SaveUserToken_Call) Run(run func(ctx context.Context, t *auth.UserToken)) *MockAuthRepo_SaveUserToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *auth.UserToken
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*auth.UserToken)
 		}
 		run(
 			arg0,
@@ -164,65 +1685,54 @@ func (_c *MockAuthRepo_FindPermissionsByUserID_Call) Run(run func(ctx context.Co
 	return _c
 }
 
-func (_c *MockAuthRepo_FindPermissionsByUserID_Call) Return(strings []string, err error) *MockAuthRepo_FindPermissionsByUserID_Call {
-	_c.Call.Return(strings, err)
+func (_c *MockAuthRepo_SaveUserToken_Call) Return(err error) *MockAuthRepo_SaveUserToken_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthRepo_FindPermissionsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]string, error)) *MockAuthRepo_FindPermissionsByUserID_Call {
+func (_c *MockAuthRepo_SaveUserToken_Call) RunAndReturn(run func(ctx context.Context, t *auth.UserToken) error) *MockAuthRepo_SaveUserToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindUserByKey provides a mock function for the type MockAuthRepo
-func (_mock *MockAuthRepo) FindUserByKey(ctx context.Context, login string) (*user.User, error) {
-	ret := _mock.Called(ctx, login)
+// SeedRole provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) SeedRole(ctx context.Context, r *auth.Role) error {
+	ret := _mock.Called(ctx, r)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindUserByKey")
+		panic("no return value specified for SeedRole")
 	}
 
-	var r0 *user.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*user.User, error)); ok {
-		return returnFunc(ctx, login)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *user.User); ok {
-		r0 = returnFunc(ctx, login)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*user.User)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, login)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.Role) error); ok {
+		r0 = returnFunc(ctx, r)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockAuthRepo_FindUserByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByKey'
-type MockAuthRepo_FindUserByKey_Call struct {
+// MockAuthRepo_SeedRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SeedRole'
+type MockAuthRepo_SeedRole_Call struct {
 	*mock.Call
 }
 
-// FindUserByKey is a helper method to define mock.On call
+// SeedRole is a helper method to define mock.On call
 //   - ctx context.Context
-//   - login string
-func (_e *MockAuthRepo_Expecter) FindUserByKey(ctx interface{}, login interface{}) *MockAuthRepo_FindUserByKey_Call {
-	return &MockAuthRepo_FindUserByKey_Call{Call: _e.mock.On("FindUserByKey", ctx, login)}
+//   - r *auth.Role
+func (_e *MockAuthRepo_Expecter) SeedRole(ctx interface{}, r interface{}) *MockAuthRepo_SeedRole_Call {
+	return &MockAuthRepo_SeedRole_Call{Call: _e.mock.On("SeedRole", ctx, r)}
 }
 
-func (_c *MockAuthRepo_FindUserByKey_Call) Run(run func(ctx context.Context, login string)) *MockAuthRepo_FindUserByKey_Call {
+func (_c *MockAuthRepo_SeedRole_Call) Run(run func(ctx context.Context, r *auth.Role)) *MockAuthRepo_SeedRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *auth.Role
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*auth.Role)
 		}
 		run(
 			arg0,
@@ -232,54 +1742,54 @@ func (_c *MockAuthRepo_FindUserByKey_Call) Run(run func(ctx context.Context, log
 	return _c
 }
 
-func (_c *MockAuthRepo_FindUserByKey_Call) Return(user1 *user.User, err error) *MockAuthRepo_FindUserByKey_Call {
-	_c.Call.Return(user1, err)
+func (_c *MockAuthRepo_SeedRole_Call) Return(err error) *MockAuthRepo_SeedRole_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthRepo_FindUserByKey_Call) RunAndReturn(run func(ctx context.Context, login string) (*user.User, error)) *MockAuthRepo_FindUserByKey_Call {
+func (_c *MockAuthRepo_SeedRole_Call) RunAndReturn(run func(ctx context.Context, r *auth.Role) error) *MockAuthRepo_SeedRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveUser provides a mock function for the type MockAuthRepo
-func (_mock *MockAuthRepo) SaveUser(ctx context.Context, u *user.User) error {
-	ret := _mock.Called(ctx, u)
+// SyncPermissions provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) SyncPermissions(ctx context.Context, permissions []string) error {
+	ret := _mock.Called(ctx, permissions)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveUser")
+		panic("no return value specified for SyncPermissions")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *user.User) error); ok {
-		r0 = returnFunc(ctx, u)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = returnFunc(ctx, permissions)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockAuthRepo_SaveUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUser'
-type MockAuthRepo_SaveUser_Call struct {
+// MockAuthRepo_SyncPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncPermissions'
+type MockAuthRepo_SyncPermissions_Call struct {
 	*mock.Call
 }
 
-// SaveUser is a helper method to define mock.On call
+// SyncPermissions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - u *user.User
-func (_e *MockAuthRepo_Expecter) SaveUser(ctx interface{}, u interface{}) *MockAuthRepo_SaveUser_Call {
-	return &MockAuthRepo_SaveUser_Call{Call: _e.mock.On("SaveUser", ctx, u)}
+//   - permissions []string
+func (_e *MockAuthRepo_Expecter) SyncPermissions(ctx interface{}, permissions interface{}) *MockAuthRepo_SyncPermissions_Call {
+	return &MockAuthRepo_SyncPermissions_Call{Call: _e.mock.On("SyncPermissions", ctx, permissions)}
 }
 
-func (_c *MockAuthRepo_SaveUser_Call) Run(run func(ctx context.Context, u *user.User)) *MockAuthRepo_SaveUser_Call {
+func (_c *MockAuthRepo_SyncPermissions_Call) Run(run func(ctx context.Context, permissions []string)) *MockAuthRepo_SyncPermissions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *user.User
+		var arg1 []string
 		if args[1] != nil {
-			arg1 = args[1].(*user.User)
+			arg1 = args[1].([]string)
 		}
 		run(
 			arg0,
@@ -289,47 +1799,57 @@ func (_c *MockAuthRepo_SaveUser_Call) Run(run func(ctx context.Context, u *user.
 	return _c
 }
 
-func (_c *MockAuthRepo_SaveUser_Call) Return(err error) *MockAuthRepo_SaveUser_Call {
+func (_c *MockAuthRepo_SyncPermissions_Call) Return(err error) *MockAuthRepo_SyncPermissions_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthRepo_SaveUser_Call) RunAndReturn(run func(ctx context.Context, u *user.User) error) *MockAuthRepo_SaveUser_Call {
+func (_c *MockAuthRepo_SyncPermissions_Call) RunAndReturn(run func(ctx context.Context, permissions []string) error) *MockAuthRepo_SyncPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveUserRole provides a mock function for the type MockAuthRepo
-func (_mock *MockAuthRepo) SaveUserRole(ctx context.Context, userID string, roleName string) error {
-	ret := _mock.Called(ctx, userID, roleName)
+// TakeOIDCLogin provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) TakeOIDCLogin(ctx context.Context, state string) (*auth.OIDCLogin, error) {
+	ret := _mock.Called(ctx, state)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveUserRole")
+		panic("no return value specified for TakeOIDCLogin")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, userID, roleName)
+	var r0 *auth.OIDCLogin
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*auth.OIDCLogin, error)); ok {
+		return returnFunc(ctx, state)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *auth.OIDCLogin); ok {
+		r0 = returnFunc(ctx, state)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.OIDCLogin)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockAuthRepo_SaveUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUserRole'
-type MockAuthRepo_SaveUserRole_Call struct {
+// MockAuthRepo_TakeOIDCLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeOIDCLogin'
+type MockAuthRepo_TakeOIDCLogin_Call struct {
 	*mock.Call
 }
 
-// SaveUserRole is a helper method to define mock.On call
+// TakeOIDCLogin is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - roleName string
-func (_e *MockAuthRepo_Expecter) SaveUserRole(ctx interface{}, userID interface{}, roleName interface{}) *MockAuthRepo_SaveUserRole_Call {
-	return &MockAuthRepo_SaveUserRole_Call{Call: _e.mock.On("SaveUserRole", ctx, userID, roleName)}
+//   - state string
+func (_e *MockAuthRepo_Expecter) TakeOIDCLogin(ctx interface{}, state interface{}) *MockAuthRepo_TakeOIDCLogin_Call {
+	return &MockAuthRepo_TakeOIDCLogin_Call{Call: _e.mock.On("TakeOIDCLogin", ctx, state)}
 }
 
-func (_c *MockAuthRepo_SaveUserRole_Call) Run(run func(ctx context.Context, userID string, roleName string)) *MockAuthRepo_SaveUserRole_Call {
+func (_c *MockAuthRepo_TakeOIDCLogin_Call) Run(run func(ctx context.Context, state string)) *MockAuthRepo_TakeOIDCLogin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -339,9 +1859,78 @@ func (_c *MockAuthRepo_SaveUserRole_Call) Run(run func(ctx context.Context, user
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepo_TakeOIDCLogin_Call) Return(oidcLogin *auth.OIDCLogin, err error) *MockAuthRepo_TakeOIDCLogin_Call {
+	_c.Call.Return(oidcLogin, err)
+	return _c
+}
+
+func (_c *MockAuthRepo_TakeOIDCLogin_Call) RunAndReturn(run func(ctx context.Context, state string) (*auth.OIDCLogin, error)) *MockAuthRepo_TakeOIDCLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TakeUserToken provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) TakeUserToken(ctx context.Context, hash string, purpose auth.UserTokenPurpose) (*auth.UserToken, error) {
+	ret := _mock.Called(ctx, hash, purpose)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeUserToken")
+	}
+
+	var r0 *auth.UserToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.UserTokenPurpose) (*auth.UserToken, error)); ok {
+		return returnFunc(ctx, hash, purpose)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.UserTokenPurpose) *auth.UserToken); ok {
+		r0 = returnFunc(ctx, hash, purpose)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.UserToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, auth.UserTokenPurpose) error); ok {
+		r1 = returnFunc(ctx, hash, purpose)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepo_TakeUserToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeUserToken'
+type MockAuthRepo_TakeUserToken_Call struct {
+	*mock.Call
+}
+
+// TakeUserToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hash string
+//   - purpose auth.UserTokenPurpose
+func (_e *MockAuthRepo_Expecter) TakeUserToken(ctx interface{}, hash interface{}, purpose interface{}) *MockAuthRepo_TakeUserToken_Call {
+	return &MockAuthRepo_TakeUserToken_Call{Call: _e.mock.On("TakeUserToken", ctx, hash, purpose)}
+}
+
+func (_c *MockAuthRepo_TakeUserToken_Call) Run(run func(ctx context.Context, hash string, purpose auth.UserTokenPurpose)) *MockAuthRepo_TakeUserToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 auth.UserTokenPurpose
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(auth.UserTokenPurpose)
 		}
 		run(
 			arg0,
@@ -352,69 +1941,75 @@ func (_c *MockAuthRepo_SaveUserRole_Call) Run(run func(ctx context.Context, user
 	return _c
 }
 
-func (_c *MockAuthRepo_SaveUserRole_Call) Return(err error) *MockAuthRepo_SaveUserRole_Call {
-	_c.Call.Return(err)
+func (_c *MockAuthRepo_TakeUserToken_Call) Return(userToken *auth.UserToken, err error) *MockAuthRepo_TakeUserToken_Call {
+	_c.Call.Return(userToken, err)
 	return _c
 }
 
-func (_c *MockAuthRepo_SaveUserRole_Call) RunAndReturn(run func(ctx context.Context, userID string, roleName string) error) *MockAuthRepo_SaveUserRole_Call {
+func (_c *MockAuthRepo_TakeUserToken_Call) RunAndReturn(run func(ctx context.Context, hash string, purpose auth.UserTokenPurpose) (*auth.UserToken, error)) *MockAuthRepo_TakeUserToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SyncPermissions provides a mock function for the type MockAuthRepo
-func (_mock *MockAuthRepo) SyncPermissions(ctx context.Context, permissions []string) error {
-	ret := _mock.Called(ctx, permissions)
+// TouchAPIKey provides a mock function for the type MockAuthRepo
+func (_mock *MockAuthRepo) TouchAPIKey(ctx context.Context, id string, usedAt time.Time) error {
+	ret := _mock.Called(ctx, id, usedAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SyncPermissions")
+		panic("no return value specified for TouchAPIKey")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) error); ok {
-		r0 = returnFunc(ctx, permissions)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, usedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockAuthRepo_SyncPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncPermissions'
-type MockAuthRepo_SyncPermissions_Call struct {
+// MockAuthRepo_TouchAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchAPIKey'
+type MockAuthRepo_TouchAPIKey_Call struct {
 	*mock.Call
 }
 
-// SyncPermissions is a helper method to define mock.On call
+// TouchAPIKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - permissions []string
-func (_e *MockAuthRepo_Expecter) SyncPermissions(ctx interface{}, permissions interface{}) *MockAuthRepo_SyncPermissions_Call {
-	return &MockAuthRepo_SyncPermissions_Call{Call: _e.mock.On("SyncPermissions", ctx, permissions)}
+//   - id string
+//   - usedAt time.Time
+func (_e *MockAuthRepo_Expecter) TouchAPIKey(ctx interface{}, id interface{}, usedAt interface{}) *MockAuthRepo_TouchAPIKey_Call {
+	return &MockAuthRepo_TouchAPIKey_Call{Call: _e.mock.On("TouchAPIKey", ctx, id, usedAt)}
 }
 
-func (_c *MockAuthRepo_SyncPermissions_Call) Run(run func(ctx context.Context, permissions []string)) *MockAuthRepo_SyncPermissions_Call {
+func (_c *MockAuthRepo_TouchAPIKey_Call) Run(run func(ctx context.Context, id string, usedAt time.Time)) *MockAuthRepo_TouchAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []string
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].([]string)
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAuthRepo_SyncPermissions_Call) Return(err error) *MockAuthRepo_SyncPermissions_Call {
+func (_c *MockAuthRepo_TouchAPIKey_Call) Return(err error) *MockAuthRepo_TouchAPIKey_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthRepo_SyncPermissions_Call) RunAndReturn(run func(ctx context.Context, permissions []string) error) *MockAuthRepo_SyncPermissions_Call {
+func (_c *MockAuthRepo_TouchAPIKey_Call) RunAndReturn(run func(ctx context.Context, id string, usedAt time.Time) error) *MockAuthRepo_TouchAPIKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,6 +7,7 @@ package repo
 import (
 	"context"
 
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -38,12 +39,86 @@ func (_m *MockJobRepo) EXPECT() *MockJobRepo_Expecter {
 	return &MockJobRepo_Expecter{mock: &_m.Mock}
 }
 
-// FindByVideoID provides a mock function for the type MockJobRepo
-func (_mock *MockJobRepo) FindByVideoID(ctx context.Context, id string) (*job.Job, error) {
+// FindAttempt provides a mock function for the type MockJobRepo
+func (_mock *MockJobRepo) FindAttempt(ctx context.Context, jobID string, number int) (*job.Attempt, error) {
+	ret := _mock.Called(ctx, jobID, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAttempt")
+	}
+
+	var r0 *job.Attempt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (*job.Attempt, error)); ok {
+		return returnFunc(ctx, jobID, number)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) *job.Attempt); ok {
+		r0 = returnFunc(ctx, jobID, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*job.Attempt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, jobID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJobRepo_FindAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAttempt'
+type MockJobRepo_FindAttempt_Call struct {
+	*mock.Call
+}
+
+// FindAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID string
+//   - number int
+func (_e *MockJobRepo_Expecter) FindAttempt(ctx interface{}, jobID interface{}, number interface{}) *MockJobRepo_FindAttempt_Call {
+	return &MockJobRepo_FindAttempt_Call{Call: _e.mock.On("FindAttempt", ctx, jobID, number)}
+}
+
+func (_c *MockJobRepo_FindAttempt_Call) Run(run func(ctx context.Context, jobID string, number int)) *MockJobRepo_FindAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobRepo_FindAttempt_Call) Return(attempt *job.Attempt, err error) *MockJobRepo_FindAttempt_Call {
+	_c.Call.Return(attempt, err)
+	return _c
+}
+
+func (_c *MockJobRepo_FindAttempt_Call) RunAndReturn(run func(ctx context.Context, jobID string, number int) (*job.Attempt, error)) *MockJobRepo_FindAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockJobRepo
+func (_mock *MockJobRepo) FindByID(ctx context.Context, id string) (*job.Job, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByVideoID")
+		panic("no return value specified for FindByID")
 	}
 
 	var r0 *job.Job
@@ -66,19 +141,19 @@ func (_mock *MockJobRepo) FindByVideoID(ctx context.Context, id string) (*job.Jo
 	return r0, r1
 }
 
-// MockJobRepo_FindByVideoID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByVideoID'
-type MockJobRepo_FindByVideoID_Call struct {
+// MockJobRepo_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockJobRepo_FindByID_Call struct {
 	*mock.Call
 }
 
-// FindByVideoID is a helper method to define mock.On call
+// FindByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockJobRepo_Expecter) FindByVideoID(ctx interface{}, id interface{}) *MockJobRepo_FindByVideoID_Call {
-	return &MockJobRepo_FindByVideoID_Call{Call: _e.mock.On("FindByVideoID", ctx, id)}
+func (_e *MockJobRepo_Expecter) FindByID(ctx interface{}, id interface{}) *MockJobRepo_FindByID_Call {
+	return &MockJobRepo_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
 }
 
-func (_c *MockJobRepo_FindByVideoID_Call) Run(run func(ctx context.Context, id string)) *MockJobRepo_FindByVideoID_Call {
+func (_c *MockJobRepo_FindByID_Call) Run(run func(ctx context.Context, id string)) *MockJobRepo_FindByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -96,19 +171,19 @@ func (_c *MockJobRepo_FindByVideoID_Call) Run(run func(ctx context.Context, id s
 	return _c
 }
 
-func (_c *MockJobRepo_FindByVideoID_Call) Return(job1 *job.Job, err error) *MockJobRepo_FindByVideoID_Call {
+func (_c *MockJobRepo_FindByID_Call) Return(job1 *job.Job, err error) *MockJobRepo_FindByID_Call {
 	_c.Call.Return(job1, err)
 	return _c
 }
 
-func (_c *MockJobRepo_FindByVideoID_Call) RunAndReturn(run func(ctx context.Context, id string) (*job.Job, error)) *MockJobRepo_FindByVideoID_Call {
+func (_c *MockJobRepo_FindByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*job.Job, error)) *MockJobRepo_FindByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // FindNextPendingTranscodeJob provides a mock function for the type MockJobRepo
-func (_mock *MockJobRepo) FindNextPendingTranscodeJob(ctx context.Context) (*job.Job, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockJobRepo) FindNextPendingTranscodeJob(ctx context.Context, workerID string) (*job.Job, error) {
+	ret := _mock.Called(ctx, workerID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindNextPendingTranscodeJob")
@@ -116,18 +191,18 @@ func (_mock *MockJobRepo) FindNextPendingTranscodeJob(ctx context.Context) (*job
 
 	var r0 *job.Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (*job.Job, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*job.Job, error)); ok {
+		return returnFunc(ctx, workerID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) *job.Job); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *job.Job); ok {
+		r0 = returnFunc(ctx, workerID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*job.Job)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, workerID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -141,18 +216,24 @@ type MockJobRepo_FindNextPendingTranscodeJob_Call struct {
 
 // FindNextPendingTranscodeJob is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockJobRepo_Expecter) FindNextPendingTranscodeJob(ctx interface{}) *MockJobRepo_FindNextPendingTranscodeJob_Call {
-	return &MockJobRepo_FindNextPendingTranscodeJob_Call{Call: _e.mock.On("FindNextPendingTranscodeJob", ctx)}
+//   - workerID string
+func (_e *MockJobRepo_Expecter) FindNextPendingTranscodeJob(ctx interface{}, workerID interface{}) *MockJobRepo_FindNextPendingTranscodeJob_Call {
+	return &MockJobRepo_FindNextPendingTranscodeJob_Call{Call: _e.mock.On("FindNextPendingTranscodeJob", ctx, workerID)}
 }
 
-func (_c *MockJobRepo_FindNextPendingTranscodeJob_Call) Run(run func(ctx context.Context)) *MockJobRepo_FindNextPendingTranscodeJob_Call {
+func (_c *MockJobRepo_FindNextPendingTranscodeJob_Call) Run(run func(ctx context.Context, workerID string)) *MockJobRepo_FindNextPendingTranscodeJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -163,7 +244,211 @@ func (_c *MockJobRepo_FindNextPendingTranscodeJob_Call) Return(job1 *job.Job, er
 	return _c
 }
 
-func (_c *MockJobRepo_FindNextPendingTranscodeJob_Call) RunAndReturn(run func(ctx context.Context) (*job.Job, error)) *MockJobRepo_FindNextPendingTranscodeJob_Call {
+func (_c *MockJobRepo_FindNextPendingTranscodeJob_Call) RunAndReturn(run func(ctx context.Context, workerID string) (*job.Job, error)) *MockJobRepo_FindNextPendingTranscodeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockJobRepo
+func (_mock *MockJobRepo) List(ctx context.Context, filter repo.JobFilter) ([]*job.Job, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*job.Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.JobFilter) ([]*job.Job, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.JobFilter) []*job.Job); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*job.Job)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.JobFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJobRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockJobRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter repo.JobFilter
+func (_e *MockJobRepo_Expecter) List(ctx interface{}, filter interface{}) *MockJobRepo_List_Call {
+	return &MockJobRepo_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *MockJobRepo_List_Call) Run(run func(ctx context.Context, filter repo.JobFilter)) *MockJobRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.JobFilter
+		if args[1] != nil {
+			arg1 = args[1].(repo.JobFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobRepo_List_Call) Return(jobs []*job.Job, err error) *MockJobRepo_List_Call {
+	_c.Call.Return(jobs, err)
+	return _c
+}
+
+func (_c *MockJobRepo_List_Call) RunAndReturn(run func(ctx context.Context, filter repo.JobFilter) ([]*job.Job, error)) *MockJobRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAttempts provides a mock function for the type MockJobRepo
+func (_mock *MockJobRepo) ListAttempts(ctx context.Context, jobID string) ([]*job.Attempt, error) {
+	ret := _mock.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAttempts")
+	}
+
+	var r0 []*job.Attempt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*job.Attempt, error)); ok {
+		return returnFunc(ctx, jobID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*job.Attempt); ok {
+		r0 = returnFunc(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*job.Attempt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJobRepo_ListAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAttempts'
+type MockJobRepo_ListAttempts_Call struct {
+	*mock.Call
+}
+
+// ListAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID string
+func (_e *MockJobRepo_Expecter) ListAttempts(ctx interface{}, jobID interface{}) *MockJobRepo_ListAttempts_Call {
+	return &MockJobRepo_ListAttempts_Call{Call: _e.mock.On("ListAttempts", ctx, jobID)}
+}
+
+func (_c *MockJobRepo_ListAttempts_Call) Run(run func(ctx context.Context, jobID string)) *MockJobRepo_ListAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobRepo_ListAttempts_Call) Return(attempts []*job.Attempt, err error) *MockJobRepo_ListAttempts_Call {
+	_c.Call.Return(attempts, err)
+	return _c
+}
+
+func (_c *MockJobRepo_ListAttempts_Call) RunAndReturn(run func(ctx context.Context, jobID string) ([]*job.Attempt, error)) *MockJobRepo_ListAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByVideoID provides a mock function for the type MockJobRepo
+func (_mock *MockJobRepo) ListByVideoID(ctx context.Context, videoID string) ([]*job.Job, error) {
+	ret := _mock.Called(ctx, videoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByVideoID")
+	}
+
+	var r0 []*job.Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*job.Job, error)); ok {
+		return returnFunc(ctx, videoID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*job.Job); ok {
+		r0 = returnFunc(ctx, videoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*job.Job)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, videoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJobRepo_ListByVideoID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByVideoID'
+type MockJobRepo_ListByVideoID_Call struct {
+	*mock.Call
+}
+
+// ListByVideoID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - videoID string
+func (_e *MockJobRepo_Expecter) ListByVideoID(ctx interface{}, videoID interface{}) *MockJobRepo_ListByVideoID_Call {
+	return &MockJobRepo_ListByVideoID_Call{Call: _e.mock.On("ListByVideoID", ctx, videoID)}
+}
+
+func (_c *MockJobRepo_ListByVideoID_Call) Run(run func(ctx context.Context, videoID string)) *MockJobRepo_ListByVideoID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobRepo_ListByVideoID_Call) Return(jobs []*job.Job, err error) *MockJobRepo_ListByVideoID_Call {
+	_c.Call.Return(jobs, err)
+	return _c
+}
+
+func (_c *MockJobRepo_ListByVideoID_Call) RunAndReturn(run func(ctx context.Context, videoID string) ([]*job.Job, error)) *MockJobRepo_ListByVideoID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -224,3 +509,123 @@ func (_c *MockJobRepo_Save_Call) RunAndReturn(run func(ctx context.Context, job1
 	_c.Call.Return(run)
 	return _c
 }
+
+// SaveAttempt provides a mock function for the type MockJobRepo
+func (_mock *MockJobRepo) SaveAttempt(ctx context.Context, attempt *job.Attempt) error {
+	ret := _mock.Called(ctx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAttempt")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *job.Attempt) error); ok {
+		r0 = returnFunc(ctx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJobRepo_SaveAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAttempt'
+type MockJobRepo_SaveAttempt_Call struct {
+	*mock.Call
+}
+
+// SaveAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attempt *job.Attempt
+func (_e *MockJobRepo_Expecter) SaveAttempt(ctx interface{}, attempt interface{}) *MockJobRepo_SaveAttempt_Call {
+	return &MockJobRepo_SaveAttempt_Call{Call: _e.mock.On("SaveAttempt", ctx, attempt)}
+}
+
+func (_c *MockJobRepo_SaveAttempt_Call) Run(run func(ctx context.Context, attempt *job.Attempt)) *MockJobRepo_SaveAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *job.Attempt
+		if args[1] != nil {
+			arg1 = args[1].(*job.Attempt)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobRepo_SaveAttempt_Call) Return(err error) *MockJobRepo_SaveAttempt_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJobRepo_SaveAttempt_Call) RunAndReturn(run func(ctx context.Context, attempt *job.Attempt) error) *MockJobRepo_SaveAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveCost provides a mock function for the type MockJobRepo
+func (_mock *MockJobRepo) SaveCost(ctx context.Context, jobID string, cost job.Cost) error {
+	ret := _mock.Called(ctx, jobID, cost)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveCost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, job.Cost) error); ok {
+		r0 = returnFunc(ctx, jobID, cost)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJobRepo_SaveCost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCost'
+type MockJobRepo_SaveCost_Call struct {
+	*mock.Call
+}
+
+// SaveCost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID string
+//   - cost job.Cost
+func (_e *MockJobRepo_Expecter) SaveCost(ctx interface{}, jobID interface{}, cost interface{}) *MockJobRepo_SaveCost_Call {
+	return &MockJobRepo_SaveCost_Call{Call: _e.mock.On("SaveCost", ctx, jobID, cost)}
+}
+
+func (_c *MockJobRepo_SaveCost_Call) Run(run func(ctx context.Context, jobID string, cost job.Cost)) *MockJobRepo_SaveCost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 job.Cost
+		if args[2] != nil {
+			arg2 = args[2].(job.Cost)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobRepo_SaveCost_Call) Return(err error) *MockJobRepo_SaveCost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJobRepo_SaveCost_Call) RunAndReturn(run func(ctx context.Context, jobID string, cost job.Cost) error) *MockJobRepo_SaveCost_Call {
+	_c.Call.Return(run)
+	return _c
+}
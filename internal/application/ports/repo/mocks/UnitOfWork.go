@@ -232,6 +232,52 @@ func (_c *MockUnitOfWork_JobRepo_Call) RunAndReturn(run func() repo.JobRepo) *Mo
 	return _c
 }
 
+// OutboxRepo provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) OutboxRepo() repo.OutboxRepo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OutboxRepo")
+	}
+
+	var r0 repo.OutboxRepo
+	if returnFunc, ok := ret.Get(0).(func() repo.OutboxRepo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repo.OutboxRepo)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWork_OutboxRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OutboxRepo'
+type MockUnitOfWork_OutboxRepo_Call struct {
+	*mock.Call
+}
+
+// OutboxRepo is a helper method to define mock.On call
+func (_e *MockUnitOfWork_Expecter) OutboxRepo() *MockUnitOfWork_OutboxRepo_Call {
+	return &MockUnitOfWork_OutboxRepo_Call{Call: _e.mock.On("OutboxRepo")}
+}
+
+func (_c *MockUnitOfWork_OutboxRepo_Call) Run(run func()) *MockUnitOfWork_OutboxRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_OutboxRepo_Call) Return(outboxRepo repo.OutboxRepo) *MockUnitOfWork_OutboxRepo_Call {
+	_c.Call.Return(outboxRepo)
+	return _c
+}
+
+func (_c *MockUnitOfWork_OutboxRepo_Call) RunAndReturn(run func() repo.OutboxRepo) *MockUnitOfWork_OutboxRepo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Rollback provides a mock function for the type MockUnitOfWork
 func (_mock *MockUnitOfWork) Rollback(ctx context.Context) error {
 	ret := _mock.Called(ctx)
@@ -283,6 +329,52 @@ func (_c *MockUnitOfWork_Rollback_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// TaskRepo provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) TaskRepo() repo.TaskRepo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TaskRepo")
+	}
+
+	var r0 repo.TaskRepo
+	if returnFunc, ok := ret.Get(0).(func() repo.TaskRepo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repo.TaskRepo)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWork_TaskRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskRepo'
+type MockUnitOfWork_TaskRepo_Call struct {
+	*mock.Call
+}
+
+// TaskRepo is a helper method to define mock.On call
+func (_e *MockUnitOfWork_Expecter) TaskRepo() *MockUnitOfWork_TaskRepo_Call {
+	return &MockUnitOfWork_TaskRepo_Call{Call: _e.mock.On("TaskRepo")}
+}
+
+func (_c *MockUnitOfWork_TaskRepo_Call) Run(run func()) *MockUnitOfWork_TaskRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_TaskRepo_Call) Return(taskRepo repo.TaskRepo) *MockUnitOfWork_TaskRepo_Call {
+	_c.Call.Return(taskRepo)
+	return _c
+}
+
+func (_c *MockUnitOfWork_TaskRepo_Call) RunAndReturn(run func() repo.TaskRepo) *MockUnitOfWork_TaskRepo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VideoRepo provides a mock function for the type MockUnitOfWork
 func (_mock *MockUnitOfWork) VideoRepo() repo.VideoRepo {
 	ret := _mock.Called()
@@ -328,3 +420,49 @@ func (_c *MockUnitOfWork_VideoRepo_Call) RunAndReturn(run func() repo.VideoRepo)
 	_c.Call.Return(run)
 	return _c
 }
+
+// WebhookRepo provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) WebhookRepo() repo.WebhookRepo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookRepo")
+	}
+
+	var r0 repo.WebhookRepo
+	if returnFunc, ok := ret.Get(0).(func() repo.WebhookRepo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repo.WebhookRepo)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWork_WebhookRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookRepo'
+type MockUnitOfWork_WebhookRepo_Call struct {
+	*mock.Call
+}
+
+// WebhookRepo is a helper method to define mock.On call
+func (_e *MockUnitOfWork_Expecter) WebhookRepo() *MockUnitOfWork_WebhookRepo_Call {
+	return &MockUnitOfWork_WebhookRepo_Call{Call: _e.mock.On("WebhookRepo")}
+}
+
+func (_c *MockUnitOfWork_WebhookRepo_Call) Run(run func()) *MockUnitOfWork_WebhookRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_WebhookRepo_Call) Return(webhookRepo repo.WebhookRepo) *MockUnitOfWork_WebhookRepo_Call {
+	_c.Call.Return(webhookRepo)
+	return _c
+}
+
+func (_c *MockUnitOfWork_WebhookRepo_Call) RunAndReturn(run func() repo.WebhookRepo) *MockUnitOfWork_WebhookRepo_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -7,6 +7,7 @@ package repo
 import (
 	"context"
 
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
 	"github.com/st-ember/streaming-api/internal/domain/video"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -107,8 +108,8 @@ func (_c *MockVideoRepo_FindByID_Call) RunAndReturn(run func(ctx context.Context
 }
 
 // List provides a mock function for the type MockVideoRepo
-func (_mock *MockVideoRepo) List(ctx context.Context, page int) ([]*video.Video, error) {
-	ret := _mock.Called(ctx, page)
+func (_mock *MockVideoRepo) List(ctx context.Context, filter repo.VideoFilter) ([]*video.Video, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
@@ -116,18 +117,18 @@ func (_mock *MockVideoRepo) List(ctx context.Context, page int) ([]*video.Video,
 
 	var r0 []*video.Video
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]*video.Video, error)); ok {
-		return returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.VideoFilter) ([]*video.Video, error)); ok {
+		return returnFunc(ctx, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []*video.Video); ok {
-		r0 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.VideoFilter) []*video.Video); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*video.Video)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.VideoFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -141,20 +142,20 @@ type MockVideoRepo_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
-//   - page int
-func (_e *MockVideoRepo_Expecter) List(ctx interface{}, page interface{}) *MockVideoRepo_List_Call {
-	return &MockVideoRepo_List_Call{Call: _e.mock.On("List", ctx, page)}
+//   - filter repo.VideoFilter
+func (_e *MockVideoRepo_Expecter) List(ctx interface{}, filter interface{}) *MockVideoRepo_List_Call {
+	return &MockVideoRepo_List_Call{Call: _e.mock.On("List", ctx, filter)}
 }
 
-func (_c *MockVideoRepo_List_Call) Run(run func(ctx context.Context, page int)) *MockVideoRepo_List_Call {
+func (_c *MockVideoRepo_List_Call) Run(run func(ctx context.Context, filter repo.VideoFilter)) *MockVideoRepo_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 repo.VideoFilter
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(repo.VideoFilter)
 		}
 		run(
 			arg0,
@@ -169,7 +170,75 @@ func (_c *MockVideoRepo_List_Call) Return(videos []*video.Video, err error) *Moc
 	return _c
 }
 
-func (_c *MockVideoRepo_List_Call) RunAndReturn(run func(ctx context.Context, page int) ([]*video.Video, error)) *MockVideoRepo_List_Call {
+func (_c *MockVideoRepo_List_Call) RunAndReturn(run func(ctx context.Context, filter repo.VideoFilter) ([]*video.Video, error)) *MockVideoRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockByID provides a mock function for the type MockVideoRepo
+func (_mock *MockVideoRepo) LockByID(ctx context.Context, id string) (*video.Video, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockByID")
+	}
+
+	var r0 *video.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*video.Video, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *video.Video); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*video.Video)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideoRepo_LockByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockByID'
+type MockVideoRepo_LockByID_Call struct {
+	*mock.Call
+}
+
+// LockByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockVideoRepo_Expecter) LockByID(ctx interface{}, id interface{}) *MockVideoRepo_LockByID_Call {
+	return &MockVideoRepo_LockByID_Call{Call: _e.mock.On("LockByID", ctx, id)}
+}
+
+func (_c *MockVideoRepo_LockByID_Call) Run(run func(ctx context.Context, id string)) *MockVideoRepo_LockByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideoRepo_LockByID_Call) Return(video1 *video.Video, err error) *MockVideoRepo_LockByID_Call {
+	_c.Call.Return(video1, err)
+	return _c
+}
+
+func (_c *MockVideoRepo_LockByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*video.Video, error)) *MockVideoRepo_LockByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/event"
+)
+
+type OutboxRepo interface {
+	// Save upserts an event, a new event is assigned the next sequence number
+	Save(ctx context.Context, e *event.Event) error
+	// ClaimPending locks up to limit unpublished events, oldest first.
+	// Only the oldest unpublished event of a video is claimed, so that
+	// the events of a video are published one after the other, in order.
+	ClaimPending(ctx context.Context, limit int) ([]*event.Event, error)
+	// DeletePublishedBefore deletes the events published before the given time
+	// and returns how many were deleted
+	DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,21 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+type TaskRepo interface {
+	Save(ctx context.Context, task *schedule.Task) error
+	FindByID(ctx context.Context, id string) (*schedule.Task, error)
+	// LockByID finds the task and locks it until the unit of work ends, sql.ErrNoRows when missing
+	LockByID(ctx context.Context, id string) (*schedule.Task, error)
+	// ClaimDue locks up to limit scheduled tasks due at now, oldest first.
+	// Tasks locked by another transaction are skipped.
+	ClaimDue(ctx context.Context, now time.Time, limit int) ([]*schedule.Task, error)
+	// DeleteFinishedBefore deletes the tasks that finished before the given time
+	// and returns how many were deleted
+	DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
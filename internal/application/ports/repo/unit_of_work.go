@@ -7,6 +7,9 @@ type UnitOfWork interface {
 	VideoRepo() VideoRepo
 	JobRepo() JobRepo
 	AuthRepo() AuthRepo
+	TaskRepo() TaskRepo
+	OutboxRepo() OutboxRepo
+	WebhookRepo() WebhookRepo
 
 	// Commit finalizes the transaction
 	Commit(ctx context.Context) error
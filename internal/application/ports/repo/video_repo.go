@@ -6,8 +6,17 @@ import (
 	"github.com/st-ember/streaming-api/internal/domain/video"
 )
 
+// VideoFilter narrows down listed videos, zero values are ignored
+type VideoFilter struct {
+	OwnerID string
+	Page    int
+}
+
 type VideoRepo interface {
 	Save(ctx context.Context, video *video.Video) error
 	FindByID(ctx context.Context, id string) (*video.Video, error)
-	List(ctx context.Context, page int) ([]*video.Video, error)
+	// LockByID finds the video and locks it until the unit of work ends,
+	// so changes to its pipeline are made one at a time, sql.ErrNoRows when missing
+	LockByID(ctx context.Context, id string) (*video.Video, error)
+	List(ctx context.Context, filter VideoFilter) ([]*video.Video, error)
 }
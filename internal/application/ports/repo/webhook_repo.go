@@ -0,0 +1,29 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+type WebhookRepo interface {
+	// SaveSubscription upserts a subscription
+	SaveSubscription(ctx context.Context, s *webhook.Subscription) error
+	FindSubscriptionByID(ctx context.Context, id string) (*webhook.Subscription, error)
+	// ListSubscriptions finds every subscription, oldest first
+	ListSubscriptions(ctx context.Context) ([]*webhook.Subscription, error)
+	// ListActiveSubscriptions finds the subscriptions receiving new events
+	ListActiveSubscriptions(ctx context.Context) ([]*webhook.Subscription, error)
+	// DeleteSubscription deletes a subscription along with its deliveries
+	DeleteSubscription(ctx context.Context, id string) error
+
+	// SaveDelivery upserts a delivery
+	SaveDelivery(ctx context.Context, d *webhook.Delivery) error
+	FindDeliveryByID(ctx context.Context, id string) (*webhook.Delivery, error)
+	// ListDeliveries finds the deliveries of a subscription, newest first
+	ListDeliveries(ctx context.Context, subscriptionID string, page int) ([]*webhook.Delivery, error)
+	// ClaimDueDeliveries locks up to limit pending deliveries due at now, oldest first.
+	// Deliveries locked by another transaction are skipped.
+	ClaimDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error)
+}
@@ -12,6 +12,9 @@ type AssetStorer interface {
 	// `content` is the file data to be written
 	Save(ctx context.Context, resourceID, assetPath string, content io.Reader) error
 
+	// Delete deletes the asset, or folder of assets, at `assetPath` within the `resourceID` folder
+	Delete(ctx context.Context, resourceID, assetPath string) error
+
 	// DeleteAll deletes all the content within the folder specified by the `resourceID`
 	DeleteAll(ctx context.Context, resourceID string) error
 }
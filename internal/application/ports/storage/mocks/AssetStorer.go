@@ -38,6 +38,69 @@ func (_m *MockAssetStorer) EXPECT() *MockAssetStorer_Expecter {
 	return &MockAssetStorer_Expecter{mock: &_m.Mock}
 }
 
+// Delete provides a mock function for the type MockAssetStorer
+func (_mock *MockAssetStorer) Delete(ctx context.Context, resourceID string, assetPath string) error {
+	ret := _mock.Called(ctx, resourceID, assetPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, resourceID, assetPath)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssetStorer_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAssetStorer_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceID string
+//   - assetPath string
+func (_e *MockAssetStorer_Expecter) Delete(ctx interface{}, resourceID interface{}, assetPath interface{}) *MockAssetStorer_Delete_Call {
+	return &MockAssetStorer_Delete_Call{Call: _e.mock.On("Delete", ctx, resourceID, assetPath)}
+}
+
+func (_c *MockAssetStorer_Delete_Call) Run(run func(ctx context.Context, resourceID string, assetPath string)) *MockAssetStorer_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssetStorer_Delete_Call) Return(err error) *MockAssetStorer_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssetStorer_Delete_Call) RunAndReturn(run func(ctx context.Context, resourceID string, assetPath string) error) *MockAssetStorer_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteAll provides a mock function for the type MockAssetStorer
 func (_mock *MockAssetStorer) DeleteAll(ctx context.Context, resourceID string) error {
 	ret := _mock.Called(ctx, resourceID)
@@ -6,6 +6,7 @@ package token
 
 import (
 	"github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -37,8 +38,8 @@ func (_m *MockToken) EXPECT() *MockToken_Expecter {
 }
 
 // GenerateAccess provides a mock function for the type MockToken
-func (_mock *MockToken) GenerateAccess(userID string, username string, permissions []string) (string, error) {
-	ret := _mock.Called(userID, username, permissions)
+func (_mock *MockToken) GenerateAccess(userID string, username string, sessionID string, permissions []string) (string, error) {
+	ret := _mock.Called(userID, username, sessionID, permissions)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateAccess")
@@ -46,16 +47,16 @@ func (_mock *MockToken) GenerateAccess(userID string, username string, permissio
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string, []string) (string, error)); ok {
-		return returnFunc(userID, username, permissions)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, []string) (string, error)); ok {
+		return returnFunc(userID, username, sessionID, permissions)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string, []string) string); ok {
-		r0 = returnFunc(userID, username, permissions)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, []string) string); ok {
+		r0 = returnFunc(userID, username, sessionID, permissions)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string, []string) error); ok {
-		r1 = returnFunc(userID, username, permissions)
+	if returnFunc, ok := ret.Get(1).(func(string, string, string, []string) error); ok {
+		r1 = returnFunc(userID, username, sessionID, permissions)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -70,12 +71,13 @@ type MockToken_GenerateAccess_Call struct {
 // GenerateAccess is a helper method to define mock.On call
 //   - userID string
 //   - username string
+//   - sessionID string
 //   - permissions []string
-func (_e *MockToken_Expecter) GenerateAccess(userID interface{}, username interface{}, permissions interface{}) *MockToken_GenerateAccess_Call {
-	return &MockToken_GenerateAccess_Call{Call: _e.mock.On("GenerateAccess", userID, username, permissions)}
+func (_e *MockToken_Expecter) GenerateAccess(userID interface{}, username interface{}, sessionID interface{}, permissions interface{}) *MockToken_GenerateAccess_Call {
+	return &MockToken_GenerateAccess_Call{Call: _e.mock.On("GenerateAccess", userID, username, sessionID, permissions)}
 }
 
-func (_c *MockToken_GenerateAccess_Call) Run(run func(userID string, username string, permissions []string)) *MockToken_GenerateAccess_Call {
+func (_c *MockToken_GenerateAccess_Call) Run(run func(userID string, username string, sessionID string, permissions []string)) *MockToken_GenerateAccess_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -85,14 +87,19 @@ func (_c *MockToken_GenerateAccess_Call) Run(run func(userID string, username st
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 []string
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].([]string)
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -103,14 +110,14 @@ func (_c *MockToken_GenerateAccess_Call) Return(s string, err error) *MockToken_
 	return _c
 }
 
-func (_c *MockToken_GenerateAccess_Call) RunAndReturn(run func(userID string, username string, permissions []string) (string, error)) *MockToken_GenerateAccess_Call {
+func (_c *MockToken_GenerateAccess_Call) RunAndReturn(run func(userID string, username string, sessionID string, permissions []string) (string, error)) *MockToken_GenerateAccess_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GenerateRefresh provides a mock function for the type MockToken
-func (_mock *MockToken) GenerateRefresh(userID string) (string, error) {
-	ret := _mock.Called(userID)
+func (_mock *MockToken) GenerateRefresh(userID string, familyID string, tokenID string) (string, error) {
+	ret := _mock.Called(userID, familyID, tokenID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateRefresh")
@@ -118,16 +125,16 @@ func (_mock *MockToken) GenerateRefresh(userID string) (string, error) {
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
-		return returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) (string, error)); ok {
+		return returnFunc(userID, familyID, tokenID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
-		r0 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = returnFunc(userID, familyID, tokenID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = returnFunc(userID, familyID, tokenID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -141,18 +148,30 @@ type MockToken_GenerateRefresh_Call struct {
 
 // GenerateRefresh is a helper method to define mock.On call
 //   - userID string
-func (_e *MockToken_Expecter) GenerateRefresh(userID interface{}) *MockToken_GenerateRefresh_Call {
-	return &MockToken_GenerateRefresh_Call{Call: _e.mock.On("GenerateRefresh", userID)}
+//   - familyID string
+//   - tokenID string
+func (_e *MockToken_Expecter) GenerateRefresh(userID interface{}, familyID interface{}, tokenID interface{}) *MockToken_GenerateRefresh_Call {
+	return &MockToken_GenerateRefresh_Call{Call: _e.mock.On("GenerateRefresh", userID, familyID, tokenID)}
 }
 
-func (_c *MockToken_GenerateRefresh_Call) Run(run func(userID string)) *MockToken_GenerateRefresh_Call {
+func (_c *MockToken_GenerateRefresh_Call) Run(run func(userID string, familyID string, tokenID string)) *MockToken_GenerateRefresh_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -163,7 +182,7 @@ func (_c *MockToken_GenerateRefresh_Call) Return(s string, err error) *MockToken
 	return _c
 }
 
-func (_c *MockToken_GenerateRefresh_Call) RunAndReturn(run func(userID string) (string, error)) *MockToken_GenerateRefresh_Call {
+func (_c *MockToken_GenerateRefresh_Call) RunAndReturn(run func(userID string, familyID string, tokenID string) (string, error)) *MockToken_GenerateRefresh_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -291,3 +310,100 @@ func (_c *MockToken_ParseRefresh_Call) RunAndReturn(run func(token1 string) (*to
 	_c.Call.Return(run)
 	return _c
 }
+
+// PublicKeys provides a mock function for the type MockToken
+func (_mock *MockToken) PublicKeys() []token.JWK {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublicKeys")
+	}
+
+	var r0 []token.JWK
+	if returnFunc, ok := ret.Get(0).(func() []token.JWK); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]token.JWK)
+		}
+	}
+	return r0
+}
+
+// MockToken_PublicKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublicKeys'
+type MockToken_PublicKeys_Call struct {
+	*mock.Call
+}
+
+// PublicKeys is a helper method to define mock.On call
+func (_e *MockToken_Expecter) PublicKeys() *MockToken_PublicKeys_Call {
+	return &MockToken_PublicKeys_Call{Call: _e.mock.On("PublicKeys")}
+}
+
+func (_c *MockToken_PublicKeys_Call) Run(run func()) *MockToken_PublicKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockToken_PublicKeys_Call) Return(jwks []token.JWK) *MockToken_PublicKeys_Call {
+	_c.Call.Return(jwks)
+	return _c
+}
+
+func (_c *MockToken_PublicKeys_Call) RunAndReturn(run func() []token.JWK) *MockToken_PublicKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSigningKeys provides a mock function for the type MockToken
+func (_mock *MockToken) SetSigningKeys(keys []*auth.SigningKey) error {
+	ret := _mock.Called(keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSigningKeys")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]*auth.SigningKey) error); ok {
+		r0 = returnFunc(keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockToken_SetSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSigningKeys'
+type MockToken_SetSigningKeys_Call struct {
+	*mock.Call
+}
+
+// SetSigningKeys is a helper method to define mock.On call
+//   - keys []*auth.SigningKey
+func (_e *MockToken_Expecter) SetSigningKeys(keys interface{}) *MockToken_SetSigningKeys_Call {
+	return &MockToken_SetSigningKeys_Call{Call: _e.mock.On("SetSigningKeys", keys)}
+}
+
+func (_c *MockToken_SetSigningKeys_Call) Run(run func(keys []*auth.SigningKey)) *MockToken_SetSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []*auth.SigningKey
+		if args[0] != nil {
+			arg0 = args[0].([]*auth.SigningKey)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockToken_SetSigningKeys_Call) Return(err error) *MockToken_SetSigningKeys_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockToken_SetSigningKeys_Call) RunAndReturn(run func(keys []*auth.SigningKey) error) *MockToken_SetSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -2,14 +2,30 @@ package token
 
 import (
 	"errors"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 )
 
+const (
+	// AccessLifetime bounds how long an access token is accepted,
+	// and so how long a revoked session must stay denied
+	AccessLifetime = 15 * time.Minute
+	// RefreshLifetime is how long a session may stay unused
+	RefreshLifetime = 7 * 24 * time.Hour
+)
+
+// AccessClaims identify an access token by its jti claim,
+// and the session it was issued for by SessionID.
+// Requests authenticated with an api key carry its id instead of a session,
+// it never appears in tokens.
 type AccessClaims struct {
 	UserID      string
 	Username    string
+	SessionID   string
 	Permissions []string
+	APIKeyID    string `json:"-"`
 	jwt.RegisteredClaims
 }
 
@@ -19,11 +35,22 @@ func (ac AccessClaims) Validate() error {
 		return errors.New("missing user id")
 	}
 
+	if ac.SessionID == "" {
+		return errors.New("missing session id")
+	}
+
+	if ac.ID == "" {
+		return errors.New("missing token id")
+	}
+
 	return nil
 }
 
+// RefreshClaims identify a refresh token within its family,
+// the token id is carried in the registered jti claim
 type RefreshClaims struct {
-	UserID string
+	UserID   string
+	FamilyID string
 	jwt.RegisteredClaims
 }
 
@@ -33,12 +60,48 @@ func (rc RefreshClaims) Validate() error {
 		return errors.New("missing user id")
 	}
 
+	if rc.FamilyID == "" {
+		return errors.New("missing family id")
+	}
+
+	if rc.ID == "" {
+		return errors.New("missing token id")
+	}
+
 	return nil
 }
 
+// JWK is a public key verifying access tokens, as published in the JWK set
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`   // RSA modulus
+	E   string `json:"e,omitempty"`   // RSA exponent
+	Crv string `json:"crv,omitempty"` // Edwards curve
+	X   string `json:"x,omitempty"`   // Edwards public key
+}
+
+// Token issues and parses the tokens of a session.
+// Access tokens are signed with asymmetric keys, so other services can verify them
+// from the published public keys without being able to issue any.
+// Refresh tokens are only read by the api and stay signed with a shared secret.
 type Token interface {
-	GenerateAccess(userID, username string, permissions []string) (string, error)
-	GenerateRefresh(userID string) (string, error)
+	GenerateAccess(userID, username, sessionID string, permissions []string) (string, error)
+	GenerateRefresh(userID, familyID, tokenID string) (string, error)
 	ParseAccess(token string) (*AccessClaims, error)
 	ParseRefresh(token string) (*RefreshClaims, error)
+
+	// SetSigningKeys replaces the keys signing and verifying access tokens
+	SetSigningKeys(keys []*auth.SigningKey) error
+	// PublicKeys returns the keys verifying access tokens, including the ones not signing yet
+	PublicKeys() []JWK
+}
+
+// KeyGenerator creates the private keys signing access tokens
+type KeyGenerator interface {
+	// Generate returns a new private key for the algorithm, PKCS #8 DER encoded
+	// and encrypted for storage, only the token can use it
+	Generate(algorithm string) ([]byte, error)
 }
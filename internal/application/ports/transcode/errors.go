@@ -0,0 +1,19 @@
+package transcode
+
+import "fmt"
+
+// ProcessError is returned when the transcoding process itself fails.
+// It carries the details needed to diagnose the run afterwards.
+type ProcessError struct {
+	ExitCode   int    // -1 if the process did not exit on its own
+	StderrTail string // The last lines written to stderr
+	Err        error
+}
+
+func (e *ProcessError) Error() string {
+	return fmt.Sprintf("transcoding process exited with code %d: %v", e.ExitCode, e.Err)
+}
+
+func (e *ProcessError) Unwrap() error {
+	return e.Err
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/st-ember/streaming-api/internal/application/ports/transcode"
+	"github.com/st-ember/streaming-api/internal/domain/job"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,6 +39,406 @@ func (_m *MockTranscoder) EXPECT() *MockTranscoder_Expecter {
 	return &MockTranscoder_Expecter{mock: &_m.Mock}
 }
 
+// Assemble provides a mock function for the type MockTranscoder
+func (_mock *MockTranscoder) Assemble(ctx context.Context, resourceID string, sourceFilename string, spec job.ChunkSpec, jobID string) (*transcode.TranscodeOutput, error) {
+	ret := _mock.Called(ctx, resourceID, sourceFilename, spec, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Assemble")
+	}
+
+	var r0 *transcode.TranscodeOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, job.ChunkSpec, string) (*transcode.TranscodeOutput, error)); ok {
+		return returnFunc(ctx, resourceID, sourceFilename, spec, jobID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, job.ChunkSpec, string) *transcode.TranscodeOutput); ok {
+		r0 = returnFunc(ctx, resourceID, sourceFilename, spec, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transcode.TranscodeOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, job.ChunkSpec, string) error); ok {
+		r1 = returnFunc(ctx, resourceID, sourceFilename, spec, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranscoder_Assemble_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Assemble'
+type MockTranscoder_Assemble_Call struct {
+	*mock.Call
+}
+
+// Assemble is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceID string
+//   - sourceFilename string
+//   - spec job.ChunkSpec
+//   - jobID string
+func (_e *MockTranscoder_Expecter) Assemble(ctx interface{}, resourceID interface{}, sourceFilename interface{}, spec interface{}, jobID interface{}) *MockTranscoder_Assemble_Call {
+	return &MockTranscoder_Assemble_Call{Call: _e.mock.On("Assemble", ctx, resourceID, sourceFilename, spec, jobID)}
+}
+
+func (_c *MockTranscoder_Assemble_Call) Run(run func(ctx context.Context, resourceID string, sourceFilename string, spec job.ChunkSpec, jobID string)) *MockTranscoder_Assemble_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 job.ChunkSpec
+		if args[3] != nil {
+			arg3 = args[3].(job.ChunkSpec)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranscoder_Assemble_Call) Return(transcodeOutput *transcode.TranscodeOutput, err error) *MockTranscoder_Assemble_Call {
+	_c.Call.Return(transcodeOutput, err)
+	return _c
+}
+
+func (_c *MockTranscoder_Assemble_Call) RunAndReturn(run func(ctx context.Context, resourceID string, sourceFilename string, spec job.ChunkSpec, jobID string) (*transcode.TranscodeOutput, error)) *MockTranscoder_Assemble_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EncodeChunk provides a mock function for the type MockTranscoder
+func (_mock *MockTranscoder) EncodeChunk(ctx context.Context, resourceID string, spec job.ChunkSpec, jobID string) (*transcode.TranscodeOutput, error) {
+	ret := _mock.Called(ctx, resourceID, spec, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EncodeChunk")
+	}
+
+	var r0 *transcode.TranscodeOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, job.ChunkSpec, string) (*transcode.TranscodeOutput, error)); ok {
+		return returnFunc(ctx, resourceID, spec, jobID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, job.ChunkSpec, string) *transcode.TranscodeOutput); ok {
+		r0 = returnFunc(ctx, resourceID, spec, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transcode.TranscodeOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, job.ChunkSpec, string) error); ok {
+		r1 = returnFunc(ctx, resourceID, spec, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranscoder_EncodeChunk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EncodeChunk'
+type MockTranscoder_EncodeChunk_Call struct {
+	*mock.Call
+}
+
+// EncodeChunk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceID string
+//   - spec job.ChunkSpec
+//   - jobID string
+func (_e *MockTranscoder_Expecter) EncodeChunk(ctx interface{}, resourceID interface{}, spec interface{}, jobID interface{}) *MockTranscoder_EncodeChunk_Call {
+	return &MockTranscoder_EncodeChunk_Call{Call: _e.mock.On("EncodeChunk", ctx, resourceID, spec, jobID)}
+}
+
+func (_c *MockTranscoder_EncodeChunk_Call) Run(run func(ctx context.Context, resourceID string, spec job.ChunkSpec, jobID string)) *MockTranscoder_EncodeChunk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 job.ChunkSpec
+		if args[2] != nil {
+			arg2 = args[2].(job.ChunkSpec)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranscoder_EncodeChunk_Call) Return(transcodeOutput *transcode.TranscodeOutput, err error) *MockTranscoder_EncodeChunk_Call {
+	_c.Call.Return(transcodeOutput, err)
+	return _c
+}
+
+func (_c *MockTranscoder_EncodeChunk_Call) RunAndReturn(run func(ctx context.Context, resourceID string, spec job.ChunkSpec, jobID string) (*transcode.TranscodeOutput, error)) *MockTranscoder_EncodeChunk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Probe provides a mock function for the type MockTranscoder
+func (_mock *MockTranscoder) Probe(ctx context.Context, resourceID string, assetPath string) (*transcode.SourceInfo, error) {
+	ret := _mock.Called(ctx, resourceID, assetPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Probe")
+	}
+
+	var r0 *transcode.SourceInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*transcode.SourceInfo, error)); ok {
+		return returnFunc(ctx, resourceID, assetPath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *transcode.SourceInfo); ok {
+		r0 = returnFunc(ctx, resourceID, assetPath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transcode.SourceInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, resourceID, assetPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranscoder_Probe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Probe'
+type MockTranscoder_Probe_Call struct {
+	*mock.Call
+}
+
+// Probe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceID string
+//   - assetPath string
+func (_e *MockTranscoder_Expecter) Probe(ctx interface{}, resourceID interface{}, assetPath interface{}) *MockTranscoder_Probe_Call {
+	return &MockTranscoder_Probe_Call{Call: _e.mock.On("Probe", ctx, resourceID, assetPath)}
+}
+
+func (_c *MockTranscoder_Probe_Call) Run(run func(ctx context.Context, resourceID string, assetPath string)) *MockTranscoder_Probe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranscoder_Probe_Call) Return(sourceInfo *transcode.SourceInfo, err error) *MockTranscoder_Probe_Call {
+	_c.Call.Return(sourceInfo, err)
+	return _c
+}
+
+func (_c *MockTranscoder_Probe_Call) RunAndReturn(run func(ctx context.Context, resourceID string, assetPath string) (*transcode.SourceInfo, error)) *MockTranscoder_Probe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Split provides a mock function for the type MockTranscoder
+func (_mock *MockTranscoder) Split(ctx context.Context, resourceID string, sourceFilename string, jobID string) (*transcode.TranscodeOutput, error) {
+	ret := _mock.Called(ctx, resourceID, sourceFilename, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Split")
+	}
+
+	var r0 *transcode.TranscodeOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*transcode.TranscodeOutput, error)); ok {
+		return returnFunc(ctx, resourceID, sourceFilename, jobID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *transcode.TranscodeOutput); ok {
+		r0 = returnFunc(ctx, resourceID, sourceFilename, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transcode.TranscodeOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, resourceID, sourceFilename, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranscoder_Split_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Split'
+type MockTranscoder_Split_Call struct {
+	*mock.Call
+}
+
+// Split is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceID string
+//   - sourceFilename string
+//   - jobID string
+func (_e *MockTranscoder_Expecter) Split(ctx interface{}, resourceID interface{}, sourceFilename interface{}, jobID interface{}) *MockTranscoder_Split_Call {
+	return &MockTranscoder_Split_Call{Call: _e.mock.On("Split", ctx, resourceID, sourceFilename, jobID)}
+}
+
+func (_c *MockTranscoder_Split_Call) Run(run func(ctx context.Context, resourceID string, sourceFilename string, jobID string)) *MockTranscoder_Split_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranscoder_Split_Call) Return(transcodeOutput *transcode.TranscodeOutput, err error) *MockTranscoder_Split_Call {
+	_c.Call.Return(transcodeOutput, err)
+	return _c
+}
+
+func (_c *MockTranscoder_Split_Call) RunAndReturn(run func(ctx context.Context, resourceID string, sourceFilename string, jobID string) (*transcode.TranscodeOutput, error)) *MockTranscoder_Split_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Thumbnail provides a mock function for the type MockTranscoder
+func (_mock *MockTranscoder) Thumbnail(ctx context.Context, resourceID string, sourceFilename string, jobID string) (*transcode.TranscodeOutput, error) {
+	ret := _mock.Called(ctx, resourceID, sourceFilename, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Thumbnail")
+	}
+
+	var r0 *transcode.TranscodeOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*transcode.TranscodeOutput, error)); ok {
+		return returnFunc(ctx, resourceID, sourceFilename, jobID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *transcode.TranscodeOutput); ok {
+		r0 = returnFunc(ctx, resourceID, sourceFilename, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transcode.TranscodeOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, resourceID, sourceFilename, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTranscoder_Thumbnail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Thumbnail'
+type MockTranscoder_Thumbnail_Call struct {
+	*mock.Call
+}
+
+// Thumbnail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceID string
+//   - sourceFilename string
+//   - jobID string
+func (_e *MockTranscoder_Expecter) Thumbnail(ctx interface{}, resourceID interface{}, sourceFilename interface{}, jobID interface{}) *MockTranscoder_Thumbnail_Call {
+	return &MockTranscoder_Thumbnail_Call{Call: _e.mock.On("Thumbnail", ctx, resourceID, sourceFilename, jobID)}
+}
+
+func (_c *MockTranscoder_Thumbnail_Call) Run(run func(ctx context.Context, resourceID string, sourceFilename string, jobID string)) *MockTranscoder_Thumbnail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTranscoder_Thumbnail_Call) Return(transcodeOutput *transcode.TranscodeOutput, err error) *MockTranscoder_Thumbnail_Call {
+	_c.Call.Return(transcodeOutput, err)
+	return _c
+}
+
+func (_c *MockTranscoder_Thumbnail_Call) RunAndReturn(run func(ctx context.Context, resourceID string, sourceFilename string, jobID string) (*transcode.TranscodeOutput, error)) *MockTranscoder_Thumbnail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Transcode provides a mock function for the type MockTranscoder
 func (_mock *MockTranscoder) Transcode(ctx context.Context, resourceID string, sourceFilename string, jobID string) (*transcode.TranscodeOutput, error) {
 	ret := _mock.Called(ctx, resourceID, sourceFilename, jobID)
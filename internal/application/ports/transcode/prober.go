@@ -0,0 +1,19 @@
+package transcode
+
+import (
+	"context"
+	"time"
+)
+
+// SourceInfo describes the video stream of an asset
+type SourceInfo struct {
+	Width    int
+	Height   int
+	Duration time.Duration
+}
+
+type Prober interface {
+	// Probe reads the properties of the video stream of an asset,
+	// `assetPath` being the path within the `resourceID` folder
+	Probe(ctx context.Context, resourceID, assetPath string) (*SourceInfo, error)
+}
@@ -2,10 +2,16 @@ package transcode
 
 import (
 	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/progress"
 )
 
 type TranscodeOutput struct {
 	Duration     time.Duration
-	ManifestPath string // The relative path to the generated manifest file
-	OutputFiles  []string
+	ManifestPath string   // The relative path to the generated manifest file
+	OutputDir    string   // The temporary directory holding the output files
+	OutputFiles  []string // The output files, relative to OutputDir
+	// Progress is left at the store stage for the worker to report once the output is stored,
+	// nil when the job reports no progress of its own
+	Progress *progress.Progress
 }
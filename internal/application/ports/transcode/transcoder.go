@@ -1,10 +1,36 @@
 package transcode
 
-import "context"
+import (
+	"context"
 
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+// ChunksDir is the folder of a resource holding the chunks of a chunked transcode
+const ChunksDir = "chunks"
+
+// ThumbnailFilename is the image of the video in its resource, written by Thumbnail
+const ThumbnailFilename = "thumbnail.jpg"
+
+// Transcoder runs the steps of the video pipeline, one method per job type
 type Transcoder interface {
+	Prober
+
 	// Transcode takes a source video asset, converts it into a streaming format,
 	// and places the output into the same resource location.
 	// it returns metadata about the transcoded assets
 	Transcode(ctx context.Context, resourceID, sourceFilename, jobID string) (*TranscodeOutput, error)
+
+	// Split cuts the video stream of the source on keyframes into chunks under ChunksDir.
+	// The chunks are listed in playback order in the output files
+	Split(ctx context.Context, resourceID, sourceFilename, jobID string) (*TranscodeOutput, error)
+
+	// EncodeChunk converts a single chunk into every rendition of the stream
+	EncodeChunk(ctx context.Context, resourceID string, spec job.ChunkSpec, jobID string) (*TranscodeOutput, error)
+
+	// Assemble joins the encoded chunks and the audio of the source into the streaming format
+	Assemble(ctx context.Context, resourceID, sourceFilename string, spec job.ChunkSpec, jobID string) (*TranscodeOutput, error)
+
+	// Thumbnail extracts a representative frame of the source into ThumbnailFilename
+	Thumbnail(ctx context.Context, resourceID, sourceFilename, jobID string) (*TranscodeOutput, error)
 }
@@ -0,0 +1,16 @@
+package webhooksender
+
+import "context"
+
+// Request is a signed webhook delivery
+type Request struct {
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
+
+type Sender interface {
+	// Send posts the request and returns the response status code.
+	// An error means no response was received.
+	Send(ctx context.Context, req Request) (int, error)
+}
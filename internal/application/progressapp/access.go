@@ -0,0 +1,16 @@
+package progressapp
+
+import (
+	"slices"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+)
+
+// canFollowVideo reports whether the user may follow the progress of the video,
+// only its owner and admins of videos or jobs can
+func canFollowVideo(v *video.Video, userID string, permissions []string) bool {
+	return v.IsOwnedBy(userID) ||
+		slices.Contains(permissions, auth.PermissionVideoAdmin) ||
+		slices.Contains(permissions, auth.PermissionJobAdmin)
+}
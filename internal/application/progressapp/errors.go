@@ -0,0 +1,8 @@
+package progressapp
+
+import "errors"
+
+var (
+	ErrUnknownTarget     = errors.New("unknown progress target")
+	ErrProgressForbidden = errors.New("not allowed to follow this progress")
+)
@@ -7,6 +7,7 @@ package progressapp
 import (
 	"context"
 
+	"github.com/st-ember/streaming-api/internal/application/progressapp"
 	"github.com/st-ember/streaming-api/internal/domain/progress"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,8 +40,8 @@ func (_m *MockVideoProgressUsecase) EXPECT() *MockVideoProgressUsecase_Expecter
 }
 
 // Execute provides a mock function for the type MockVideoProgressUsecase
-func (_mock *MockVideoProgressUsecase) Execute(ctx context.Context, id string) (<-chan *progress.Progress, error) {
-	ret := _mock.Called(ctx, id)
+func (_mock *MockVideoProgressUsecase) Execute(ctx context.Context, input progressapp.VideoProgressInput) (<-chan *progress.Progress, error) {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
@@ -48,18 +49,18 @@ func (_mock *MockVideoProgressUsecase) Execute(ctx context.Context, id string) (
 
 	var r0 <-chan *progress.Progress
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (<-chan *progress.Progress, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, progressapp.VideoProgressInput) (<-chan *progress.Progress, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) <-chan *progress.Progress); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, progressapp.VideoProgressInput) <-chan *progress.Progress); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(<-chan *progress.Progress)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, progressapp.VideoProgressInput) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,20 +74,20 @@ type MockVideoProgressUsecase_Execute_Call struct {
 
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockVideoProgressUsecase_Expecter) Execute(ctx interface{}, id interface{}) *MockVideoProgressUsecase_Execute_Call {
-	return &MockVideoProgressUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, id)}
+//   - input progressapp.VideoProgressInput
+func (_e *MockVideoProgressUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockVideoProgressUsecase_Execute_Call {
+	return &MockVideoProgressUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
 }
 
-func (_c *MockVideoProgressUsecase_Execute_Call) Run(run func(ctx context.Context, id string)) *MockVideoProgressUsecase_Execute_Call {
+func (_c *MockVideoProgressUsecase_Execute_Call) Run(run func(ctx context.Context, input progressapp.VideoProgressInput)) *MockVideoProgressUsecase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 progressapp.VideoProgressInput
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(progressapp.VideoProgressInput)
 		}
 		run(
 			arg0,
@@ -101,7 +102,7 @@ func (_c *MockVideoProgressUsecase_Execute_Call) Return(progressCh <-chan *progr
 	return _c
 }
 
-func (_c *MockVideoProgressUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, id string) (<-chan *progress.Progress, error)) *MockVideoProgressUsecase_Execute_Call {
+func (_c *MockVideoProgressUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, input progressapp.VideoProgressInput) (<-chan *progress.Progress, error)) *MockVideoProgressUsecase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,31 @@
+package progressapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+)
+
+// OpenProgressSubscriptionUsecase opens a subscription following no job yet,
+// the jobs found by ResolveProgressUsecase are then followed on it.
+type OpenProgressSubscriptionUsecase interface {
+	Execute(ctx context.Context) (progressstream.Subscription, error)
+}
+
+type openProgressSubscriptionUsecase struct {
+	streamer progressstream.ProgressStreamer
+}
+
+func NewOpenProgressSubscriptionUsecase(streamer progressstream.ProgressStreamer) OpenProgressSubscriptionUsecase {
+	return &openProgressSubscriptionUsecase{streamer}
+}
+
+func (u *openProgressSubscriptionUsecase) Execute(ctx context.Context) (progressstream.Subscription, error) {
+	sub, err := u.streamer.Subscribe(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("open progress subscription: %w", err)
+	}
+
+	return sub, nil
+}
@@ -0,0 +1,7 @@
+package progressapp
+
+type ProgressUsecase struct {
+	Video            VideoProgressUsecase
+	OpenSubscription OpenProgressSubscriptionUsecase
+	Resolve          ResolveProgressUsecase
+}
@@ -0,0 +1,80 @@
+package progressapp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+)
+
+// ResolveProgressUsecase finds the job reporting the progress of a video or job,
+// once the user is allowed to follow it. The job is then followed on a subscription.
+// Videos can be followed by their owner and admins, single jobs are only exposed to job admins.
+type ResolveProgressUsecase interface {
+	Execute(ctx context.Context, input ResolveProgressInput) (*ResolveProgressResult, error)
+}
+
+type resolveProgressUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewResolveProgressUsecase(uowFactory repo.UnitOfWorkFactory) ResolveProgressUsecase {
+	return &resolveProgressUsecase{uowFactory}
+}
+
+func (u *resolveProgressUsecase) Execute(ctx context.Context, input ResolveProgressInput) (*ResolveProgressResult, error) {
+	j, err := u.findJob(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ResolveProgressResult{JobID: j.ID, VideoID: j.VideoID}
+	if status, ok := finishedStatus(j); ok {
+		res.Finished = progress.NewFinishedProgress(status)
+	}
+
+	return res, nil
+}
+
+// findJob finds the job reporting the progress of the target, checking the user may follow it
+func (u *resolveProgressUsecase) findJob(ctx context.Context, input ResolveProgressInput) (*job.Job, error) {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Close(ctx)
+
+	switch input.Target {
+	case TargetVideo:
+		v, err := uow.VideoRepo().FindByID(ctx, input.ID)
+		if err != nil {
+			return nil, fmt.Errorf("find video %s: %w", input.ID, err)
+		}
+
+		if !canFollowVideo(v, input.UserID, input.Permissions) {
+			return nil, ErrProgressForbidden
+		}
+
+		j, err := uow.JobRepo().FindByID(ctx, v.ProgressJobID)
+		if err != nil {
+			return nil, fmt.Errorf("find progress job %s of video %s: %w", v.ProgressJobID, input.ID, err)
+		}
+		return j, nil
+	case TargetJob:
+		if !slices.Contains(input.Permissions, auth.PermissionJobAdmin) {
+			return nil, ErrProgressForbidden
+		}
+
+		j, err := uow.JobRepo().FindByID(ctx, input.ID)
+		if err != nil {
+			return nil, fmt.Errorf("find job %s: %w", input.ID, err)
+		}
+		return j, nil
+	default:
+		return nil, ErrUnknownTarget
+	}
+}
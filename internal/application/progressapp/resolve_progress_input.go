@@ -0,0 +1,17 @@
+package progressapp
+
+// ProgressTarget is the kind of resource whose progress is followed
+type ProgressTarget string
+
+const (
+	TargetVideo ProgressTarget = "video" // Follows the job reporting the progress of a video
+	TargetJob   ProgressTarget = "job"   // Follows a single job of a pipeline
+)
+
+// ResolveProgressInput names the progress to follow and the user asking
+type ResolveProgressInput struct {
+	Target      ProgressTarget
+	ID          string
+	UserID      string
+	Permissions []string
+}
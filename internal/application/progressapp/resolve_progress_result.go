@@ -0,0 +1,11 @@
+package progressapp
+
+import "github.com/st-ember/streaming-api/internal/domain/progress"
+
+// ResolveProgressResult is the job reporting the progress to follow.
+// Finished holds the final state of a job that no longer runs, which needs no following.
+type ResolveProgressResult struct {
+	JobID    string
+	VideoID  string
+	Finished *progress.Progress
+}
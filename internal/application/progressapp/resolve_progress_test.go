@@ -0,0 +1,145 @@
+package progressapp_test
+
+import (
+	"database/sql"
+	"testing"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/progressapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveProgress_FindsLatestJobOfVideo(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	v, _ := video.NewVideo("video-id", "owner-id", "Title", "", "source.mp4", "resource-id")
+	j, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	v.ProgressJobID = j.ID
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(v, nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(j, nil).Once()
+
+	usecase := progressapp.NewResolveProgressUsecase(mockUowFactory)
+	res, err := usecase.Execute(t.Context(), progressapp.ResolveProgressInput{
+		Target: progressapp.TargetVideo,
+		ID:     "video-id",
+		UserID: "owner-id",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, &progressapp.ResolveProgressResult{JobID: "job-id", VideoID: "video-id"}, res)
+}
+
+func TestResolveProgress_ReturnsFinalStateOfFinishedJob(t *testing.T) {
+	t.Parallel()
+	mockJobRepo := repomocks.NewMockJobRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	j, _ := job.NewJob("job-id", "video-id", job.TypeTranscode)
+	j.Status = job.StatusFailed
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-id").Return(j, nil).Once()
+
+	usecase := progressapp.NewResolveProgressUsecase(mockUowFactory)
+	res, err := usecase.Execute(t.Context(), progressapp.ResolveProgressInput{
+		Target:      progressapp.TargetJob,
+		ID:          "job-id",
+		Permissions: []string{auth.PermissionJobAdmin},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, progress.StatusError, res.Finished.Status)
+}
+
+func TestResolveProgress_FailsOnJobWithoutJobAdmin(t *testing.T) {
+	t.Parallel()
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+
+	usecase := progressapp.NewResolveProgressUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), progressapp.ResolveProgressInput{
+		Target:      progressapp.TargetJob,
+		ID:          "job-id",
+		Permissions: []string{auth.PermissionVideoUpload},
+	})
+
+	require.ErrorIs(t, err, progressapp.ErrProgressForbidden)
+}
+
+func TestResolveProgress_FailsOnVideoOfAnotherUser(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	v, _ := video.NewVideo("video-id", "owner-id", "Title", "", "source.mp4", "resource-id")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(v, nil).Once()
+
+	usecase := progressapp.NewResolveProgressUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), progressapp.ResolveProgressInput{
+		Target:      progressapp.TargetVideo,
+		ID:          "video-id",
+		UserID:      "someone-else",
+		Permissions: []string{auth.PermissionVideoUpload},
+	})
+
+	require.ErrorIs(t, err, progressapp.ErrProgressForbidden)
+}
+
+func TestResolveProgress_FailsOnUnknownVideo(t *testing.T) {
+	t.Parallel()
+	mockVideoRepo := repomocks.NewMockVideoRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, "video-id").Return(nil, sql.ErrNoRows).Once()
+
+	usecase := progressapp.NewResolveProgressUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), progressapp.ResolveProgressInput{
+		Target: progressapp.TargetVideo,
+		ID:     "video-id",
+	})
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestResolveProgress_FailsOnUnknownTarget(t *testing.T) {
+	t.Parallel()
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+
+	usecase := progressapp.NewResolveProgressUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), progressapp.ResolveProgressInput{
+		Target: "thumbnail",
+		ID:     "video-id",
+	})
+
+	require.ErrorIs(t, err, progressapp.ErrUnknownTarget)
+}
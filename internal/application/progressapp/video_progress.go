@@ -6,11 +6,16 @@ import (
 
 	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
 	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/job"
 	"github.com/st-ember/streaming-api/internal/domain/progress"
 )
 
+// VideoProgressUsecase streams the progress of the job of the pipeline reporting it.
+// The stream starts with the last progress reported, and a job that already
+// finished yields its final state only, instead of waiting for updates that never come.
+// Only the owner of the video and admins may follow it.
 type VideoProgressUsecase interface {
-	Execute(ctx context.Context, id string) (<-chan *progress.Progress, error)
+	Execute(ctx context.Context, input VideoProgressInput) (<-chan *progress.Progress, error)
 }
 
 type videoProgressUsecase struct {
@@ -28,7 +33,7 @@ func NewVideoProgressUsecase(
 	}
 }
 
-func (u *videoProgressUsecase) Execute(ctx context.Context, id string) (<-chan *progress.Progress, error) {
+func (u *videoProgressUsecase) Execute(ctx context.Context, input VideoProgressInput) (<-chan *progress.Progress, error) {
 	uow, err := u.uowFactory.NewUnitOfWork(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("initialize unit of work: %w", err)
@@ -36,13 +41,45 @@ func (u *videoProgressUsecase) Execute(ctx context.Context, id string) (<-chan *
 	defer uow.Close(ctx)
 
 	// Initialize repos
+	videoRepo := uow.VideoRepo()
 	jobRepo := uow.JobRepo()
 
-	// Find related job
-	j, err := jobRepo.FindByVideoID(ctx, id)
+	// Check the user may follow the video
+	v, err := videoRepo.FindByID(ctx, input.ID)
 	if err != nil {
-		return nil, fmt.Errorf("find job with id %s: %w", id, err)
+		return nil, fmt.Errorf("find video %s: %w", input.ID, err)
+	}
+
+	if !canFollowVideo(v, input.UserID, input.Permissions) {
+		return nil, ErrProgressForbidden
+	}
+
+	// Find the job reporting the progress of the video
+	j, err := jobRepo.FindByID(ctx, v.ProgressJobID)
+	if err != nil {
+		return nil, fmt.Errorf("find progress job %s of video %s: %w", v.ProgressJobID, input.ID, err)
+	}
+
+	if status, ok := finishedStatus(j); ok {
+		ch := make(chan *progress.Progress, 1)
+		ch <- progress.NewFinishedProgress(status)
+		close(ch)
+		return ch, nil
 	}
 
 	return u.streamer.Read(ctx, j.ID)
 }
+
+// finishedStatus maps the status of a job that stopped running to its final progress status
+func finishedStatus(j *job.Job) (progress.ProgressStatus, bool) {
+	switch {
+	case j.IsCompleted():
+		return progress.StatusEnd, true
+	case j.IsFailed():
+		return progress.StatusError, true
+	case j.IsCancelled():
+		return progress.StatusCancelled, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,8 @@
+package progressapp
+
+// VideoProgressInput names the video to follow and the user asking
+type VideoProgressInput struct {
+	ID          string
+	UserID      string
+	Permissions []string
+}
@@ -9,13 +9,17 @@ import (
 	"github.com/st-ember/streaming-api/internal/application/progressapp"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 	"github.com/st-ember/streaming-api/internal/domain/progress"
+	"github.com/st-ember/streaming-api/internal/domain/video"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestVideoProgress(t *testing.T) {
+	ownedVideo := &video.Video{ID: "test_video", OwnerID: "test_user", ProgressJobID: "test_job"}
+
 	t.Run("success case", func(t *testing.T) {
 		// Set up mocks
+		mockVideoRepo := repoMocks.NewMockVideoRepo(t)
 		mockJobRepo := repoMocks.NewMockJobRepo(t)
 		mockUow := repoMocks.NewMockUnitOfWork(t)
 		mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
@@ -28,7 +32,9 @@ func TestVideoProgress(t *testing.T) {
 			Once()
 
 		// Unit of Work expectation
+		mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 		mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+		mockVideoRepo.EXPECT().FindByID(mock.Anything, "test_video").Return(ownedVideo, nil).Once()
 		mockUow.EXPECT().Close(mock.Anything).Return(nil)
 
 		// Repo expectation
@@ -41,7 +47,7 @@ func TestVideoProgress(t *testing.T) {
 
 		mockJobRepo.
 			EXPECT().
-			FindByVideoID(mock.Anything, videoID).
+			FindByID(mock.Anything, "test_job").
 			Return(j, nil)
 
 		// Progress streamer expectation
@@ -52,13 +58,54 @@ func TestVideoProgress(t *testing.T) {
 		usecase := progressapp.NewVideoProgressUsecase(mockStreamer, mockUowFactory)
 
 		// Execute
-		resultCh, err := usecase.Execute(t.Context(), videoID)
+		resultCh, err := usecase.Execute(t.Context(), progressapp.VideoProgressInput{ID: videoID, UserID: "test_user"})
 
 		// Assert
 		require.NoError(t, err)
 		require.Equal(t, expectedCh, resultCh)
 	})
 
+	t.Run("finished job - yields the final state without reading the stream", func(t *testing.T) {
+		cases := map[job.JobStatus]progress.ProgressStatus{
+			job.StatusCompleted: progress.StatusEnd,
+			job.StatusFailed:    progress.StatusError,
+			job.StatusCancelled: progress.StatusCancelled,
+		}
+
+		for jobStatus, prgStatus := range cases {
+			// Set up mocks
+			mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+			mockJobRepo := repoMocks.NewMockJobRepo(t)
+			mockUow := repoMocks.NewMockUnitOfWork(t)
+			mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+			mockStreamer := streamerMocks.NewMockProgressStreamer(t)
+
+			mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+			mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+			mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+			mockVideoRepo.EXPECT().FindByID(mock.Anything, "test_video").Return(ownedVideo, nil).Once()
+			mockUow.EXPECT().Close(mock.Anything).Return(nil)
+
+			videoID := "test_video"
+			j := &job.Job{ID: "test_job", VideoID: videoID, Status: jobStatus}
+			mockJobRepo.EXPECT().FindByID(mock.Anything, "test_job").Return(j, nil).Once()
+
+			// Create usecase
+			usecase := progressapp.NewVideoProgressUsecase(mockStreamer, mockUowFactory)
+
+			// Execute
+			resultCh, err := usecase.Execute(t.Context(), progressapp.VideoProgressInput{ID: videoID, UserID: "test_user"})
+
+			// Assert
+			require.NoError(t, err)
+			prg, ok := <-resultCh
+			require.True(t, ok)
+			require.Equal(t, prgStatus, prg.Status)
+			_, ok = <-resultCh
+			require.False(t, ok, "channel should be closed after the final state")
+		}
+	})
+
 	t.Run("fails to initialize unit of work", func(t *testing.T) {
 		// Set up mocks
 		mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
@@ -75,7 +122,7 @@ func TestVideoProgress(t *testing.T) {
 		usecase := progressapp.NewVideoProgressUsecase(mockStreamer, mockUowFactory)
 
 		// Execute
-		resultCh, err := usecase.Execute(t.Context(), "test_video")
+		resultCh, err := usecase.Execute(t.Context(), progressapp.VideoProgressInput{ID: "test_video", UserID: "test_user"})
 
 		// Assert
 		require.ErrorContains(t, err, "initialize unit of work")
@@ -84,6 +131,7 @@ func TestVideoProgress(t *testing.T) {
 
 	t.Run("fails to find job", func(t *testing.T) {
 		// Set up mocks
+		mockVideoRepo := repoMocks.NewMockVideoRepo(t)
 		mockJobRepo := repoMocks.NewMockJobRepo(t)
 		mockUow := repoMocks.NewMockUnitOfWork(t)
 		mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
@@ -93,27 +141,30 @@ func TestVideoProgress(t *testing.T) {
 		mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 
 		// Unit of Work expectation
+		mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 		mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+		mockVideoRepo.EXPECT().FindByID(mock.Anything, "test_video").Return(ownedVideo, nil).Once()
 		mockUow.EXPECT().Close(mock.Anything).Return(nil)
 
 		// Repo expectation
 		videoID := "test_video"
 		expectedErr := errors.New("not found")
-		mockJobRepo.EXPECT().FindByVideoID(mock.Anything, videoID).Return(nil, expectedErr).Once()
+		mockJobRepo.EXPECT().FindByID(mock.Anything, "test_job").Return(nil, expectedErr).Once()
 
 		// Create usecase
 		usecase := progressapp.NewVideoProgressUsecase(mockStreamer, mockUowFactory)
 
 		// Execute
-		resultCh, err := usecase.Execute(t.Context(), videoID)
+		resultCh, err := usecase.Execute(t.Context(), progressapp.VideoProgressInput{ID: videoID, UserID: "test_user"})
 
 		// Assert
-		require.ErrorContains(t, err, "find job with id")
+		require.ErrorContains(t, err, "find progress job")
 		require.Nil(t, resultCh)
 	})
 
 	t.Run("fails to read from streamer", func(t *testing.T) {
 		// Set up mocks
+		mockVideoRepo := repoMocks.NewMockVideoRepo(t)
 		mockJobRepo := repoMocks.NewMockJobRepo(t)
 		mockUow := repoMocks.NewMockUnitOfWork(t)
 		mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
@@ -123,7 +174,9 @@ func TestVideoProgress(t *testing.T) {
 		mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 
 		// Unit of Work expectation
+		mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 		mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+		mockVideoRepo.EXPECT().FindByID(mock.Anything, "test_video").Return(ownedVideo, nil).Once()
 		mockUow.EXPECT().Close(mock.Anything).Return(nil)
 
 		// Repo expectation
@@ -131,7 +184,7 @@ func TestVideoProgress(t *testing.T) {
 		jobID := "test_job"
 		j := &job.Job{ID: jobID}
 
-		mockJobRepo.EXPECT().FindByVideoID(mock.Anything, videoID).Return(j, nil).Once()
+		mockJobRepo.EXPECT().FindByID(mock.Anything, "test_job").Return(j, nil).Once()
 
 		// Progress streamer expectation
 		expectedErr := errors.New("redis error")
@@ -141,10 +194,34 @@ func TestVideoProgress(t *testing.T) {
 		usecase := progressapp.NewVideoProgressUsecase(mockStreamer, mockUowFactory)
 
 		// Execute
-		resultCh, err := usecase.Execute(t.Context(), videoID)
+		resultCh, err := usecase.Execute(t.Context(), progressapp.VideoProgressInput{ID: videoID, UserID: "test_user"})
 
 		// Assert
 		require.ErrorIs(t, err, expectedErr)
 		require.Nil(t, resultCh)
 	})
+
+	t.Run("fails when the user neither owns the video nor is an admin", func(t *testing.T) {
+		// Set up mocks
+		mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+		mockUow := repoMocks.NewMockUnitOfWork(t)
+		mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+		mockStreamer := streamerMocks.NewMockProgressStreamer(t)
+
+		mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+		mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+		mockUow.EXPECT().JobRepo().Return(repoMocks.NewMockJobRepo(t))
+		mockUow.EXPECT().Close(mock.Anything).Return(nil)
+		mockVideoRepo.EXPECT().FindByID(mock.Anything, "test_video").Return(ownedVideo, nil).Once()
+
+		// Create usecase
+		usecase := progressapp.NewVideoProgressUsecase(mockStreamer, mockUowFactory)
+
+		// Execute
+		resultCh, err := usecase.Execute(t.Context(), progressapp.VideoProgressInput{ID: "test_video", UserID: "other_user"})
+
+		// Assert
+		require.ErrorIs(t, err, progressapp.ErrProgressForbidden)
+		require.Nil(t, resultCh)
+	})
 }
@@ -0,0 +1,28 @@
+package roleapp
+
+import (
+	"context"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// AddRolePermissionUsecase grants a permission to a role.
+// Users of the role get it in the next access token issued to them.
+type AddRolePermissionUsecase interface {
+	Execute(ctx context.Context, roleName, permission string) (*auth.Role, error)
+}
+
+type addRolePermissionUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewAddRolePermissionUsecase(uowFactory repo.UnitOfWorkFactory) AddRolePermissionUsecase {
+	return &addRolePermissionUsecase{uowFactory}
+}
+
+func (u *addRolePermissionUsecase) Execute(ctx context.Context, roleName, permission string) (*auth.Role, error) {
+	return updateRole(ctx, u.uowFactory, roleName, func(r *auth.Role) error {
+		return r.AddPermission(permission)
+	})
+}
@@ -0,0 +1,70 @@
+package roleapp_test
+
+import (
+	"database/sql"
+	"testing"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/roleapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddRolePermission_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	r, _ := auth.NewRole("role-1", "editor", []string{auth.PermissionVideoUpdate})
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindRoleByName(mock.Anything, "editor").Return(r, nil).Once()
+	mockAuthRepo.EXPECT().SaveRole(mock.Anything, r).Return(nil).Once()
+
+	usecase := roleapp.NewAddRolePermissionUsecase(mockUowFactory)
+	updated, err := usecase.Execute(t.Context(), "editor", auth.PermissionVideoArchive)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{auth.PermissionVideoUpdate, auth.PermissionVideoArchive}, updated.Permissions)
+}
+
+func TestAddRolePermission_UnknownPermission(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	r, _ := auth.NewRole("role-1", "editor", nil)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindRoleByName(mock.Anything, "editor").Return(r, nil).Once()
+
+	usecase := roleapp.NewAddRolePermissionUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), "editor", "video:delete")
+
+	require.ErrorIs(t, err, auth.ErrUnknownPermission)
+}
+
+func TestRemoveRolePermission_RoleNotFound(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindRoleByName(mock.Anything, "ghost").Return(nil, sql.ErrNoRows).Once()
+
+	usecase := roleapp.NewRemoveRolePermissionUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), "ghost", auth.PermissionVideoUpdate)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,51 @@
+package roleapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+)
+
+// AssignRoleUsecase gives a role to a user, assigning it twice has no effect.
+// The user gets its permissions in the next access token issued to them.
+type AssignRoleUsecase interface {
+	Execute(ctx context.Context, userID, roleName string) error
+}
+
+type assignRoleUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewAssignRoleUsecase(uowFactory repo.UnitOfWorkFactory) AssignRoleUsecase {
+	return &assignRoleUsecase{uowFactory}
+}
+
+func (u *assignRoleUsecase) Execute(ctx context.Context, userID, roleName string) error {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	// Both must exist, missing ones are reported with sql.ErrNoRows
+	if _, err := authRepo.FindUserByID(ctx, userID); err != nil {
+		return fmt.Errorf("find user %s: %w", userID, err)
+	}
+
+	if _, err := authRepo.FindRoleByName(ctx, roleName); err != nil {
+		return fmt.Errorf("find role %s: %w", roleName, err)
+	}
+
+	if err := authRepo.SaveUserRole(ctx, userID, roleName); err != nil {
+		return fmt.Errorf("assign role %s to user %s: %w", roleName, userID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package roleapp_test
+
+import (
+	"database/sql"
+	"testing"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/roleapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/user"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignRole_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	u, _ := user.NewUser("user-1", "user@test.com", "user", "hash")
+	r, _ := auth.NewRole("role-1", "editor", nil)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindUserByID(mock.Anything, "user-1").Return(u, nil).Once()
+	mockAuthRepo.EXPECT().FindRoleByName(mock.Anything, "editor").Return(r, nil).Once()
+	mockAuthRepo.EXPECT().SaveUserRole(mock.Anything, "user-1", "editor").Return(nil).Once()
+
+	usecase := roleapp.NewAssignRoleUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), "user-1", "editor")
+
+	require.NoError(t, err)
+}
+
+func TestAssignRole_UserNotFound(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindUserByID(mock.Anything, "ghost").Return(nil, sql.ErrNoRows).Once()
+
+	usecase := roleapp.NewAssignRoleUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), "ghost", "editor")
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,61 @@
+package roleapp
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// BootstrapAdminUsecase gives the admin role to the user of an email,
+// so a new install has someone to manage roles. It runs on startup, once roles are seeded.
+// Missing users are reported with sql.ErrNoRows, users who did not verify their email with ErrEmailNotVerified.
+type BootstrapAdminUsecase interface {
+	Execute(ctx context.Context, email string) error
+}
+
+type bootstrapAdminUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewBootstrapAdminUsecase(uowFactory repo.UnitOfWorkFactory) BootstrapAdminUsecase {
+	return &bootstrapAdminUsecase{uowFactory}
+}
+
+func (u *bootstrapAdminUsecase) Execute(ctx context.Context, email string) error {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	usr, err := authRepo.FindUserByKey(ctx, email)
+	if err != nil {
+		return fmt.Errorf("find user by email %s: %w", email, err)
+	}
+
+	// The key also matches usernames, which anyone may sign up with
+	if !strings.EqualFold(usr.Email, email) {
+		return fmt.Errorf("find user by email %s: %w", email, sql.ErrNoRows)
+	}
+
+	// Anyone may sign up with the email too, only its owner becomes admin
+	if !usr.IsEmailVerified() {
+		return ErrEmailNotVerified
+	}
+
+	if err := authRepo.SaveUserRole(ctx, usr.ID, auth.RoleAdmin); err != nil {
+		return fmt.Errorf("assign role %s to user %s: %w", auth.RoleAdmin, usr.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
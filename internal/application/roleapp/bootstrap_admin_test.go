@@ -0,0 +1,93 @@
+package roleapp_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/roleapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/user"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBootstrapAdmin_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	u, _ := user.NewUser("user-1", "admin@test.com", "admin", "hash")
+	u.VerifyEmail(time.Now())
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindUserByKey(mock.Anything, "admin@test.com").Return(u, nil).Once()
+	mockAuthRepo.EXPECT().SaveUserRole(mock.Anything, "user-1", auth.RoleAdmin).Return(nil).Once()
+
+	usecase := roleapp.NewBootstrapAdminUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), "admin@test.com")
+
+	require.NoError(t, err)
+}
+
+func TestBootstrapAdmin_UserNotFound(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindUserByKey(mock.Anything, "ghost").Return(nil, sql.ErrNoRows).Once()
+
+	usecase := roleapp.NewBootstrapAdminUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), "ghost")
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestBootstrapAdmin_RefusesUsername(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	// Someone took the username with an email of their own
+	u, _ := user.NewUser("user-1", "someone@test.com", "admin", "hash")
+	u.VerifyEmail(time.Now())
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindUserByKey(mock.Anything, "admin").Return(u, nil).Once()
+
+	usecase := roleapp.NewBootstrapAdminUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), "admin")
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestBootstrapAdmin_EmailNotVerified(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	u, _ := user.NewUser("user-1", "admin@test.com", "admin", "hash")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindUserByKey(mock.Anything, "admin@test.com").Return(u, nil).Once()
+
+	usecase := roleapp.NewBootstrapAdminUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), "admin@test.com")
+
+	require.ErrorIs(t, err, roleapp.ErrEmailNotVerified)
+}
@@ -0,0 +1,59 @@
+package roleapp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+type CreateRoleUsecase interface {
+	Execute(ctx context.Context, input CreateRoleInput) (*auth.Role, error)
+}
+
+type createRoleUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewCreateRoleUsecase(uowFactory repo.UnitOfWorkFactory) CreateRoleUsecase {
+	return &createRoleUsecase{uowFactory}
+}
+
+func (u *createRoleUsecase) Execute(ctx context.Context, input CreateRoleInput) (*auth.Role, error) {
+	r, err := auth.NewRole(uuid.NewString(), input.Name, input.Permissions)
+	if err != nil {
+		return nil, fmt.Errorf("create role entity: %w", err)
+	}
+
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	// Names identify roles, saving would otherwise fail on the unique name
+	_, err = authRepo.FindRoleByName(ctx, r.Name)
+	if err == nil {
+		return nil, ErrRoleExists
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("find role %s: %w", r.Name, err)
+	}
+
+	if err := authRepo.SaveRole(ctx, r); err != nil {
+		return nil, fmt.Errorf("save role %s in db: %w", r.Name, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,6 @@
+package roleapp
+
+type CreateRoleInput struct {
+	Name        string
+	Permissions []string
+}
@@ -0,0 +1,68 @@
+package roleapp_test
+
+import (
+	"database/sql"
+	"testing"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/roleapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRole_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindRoleByName(mock.Anything, "editor").Return(nil, sql.ErrNoRows).Once()
+	mockAuthRepo.EXPECT().SaveRole(mock.Anything, mock.AnythingOfType("*auth.Role")).Return(nil).Once()
+
+	usecase := roleapp.NewCreateRoleUsecase(mockUowFactory)
+	r, err := usecase.Execute(t.Context(), roleapp.CreateRoleInput{
+		Name:        "editor",
+		Permissions: []string{auth.PermissionVideoUpdate},
+	})
+
+	require.NoError(t, err)
+	require.NotEmpty(t, r.ID)
+	require.Equal(t, []string{auth.PermissionVideoUpdate}, r.Permissions)
+}
+
+func TestCreateRole_ExistingName(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	existing, _ := auth.NewRole("role-1", "editor", nil)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().FindRoleByName(mock.Anything, "editor").Return(existing, nil).Once()
+
+	usecase := roleapp.NewCreateRoleUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), roleapp.CreateRoleInput{Name: "editor"})
+
+	require.ErrorIs(t, err, roleapp.ErrRoleExists)
+}
+
+func TestCreateRole_UnknownPermission(t *testing.T) {
+	t.Parallel()
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	usecase := roleapp.NewCreateRoleUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), roleapp.CreateRoleInput{
+		Name:        "editor",
+		Permissions: []string{"video:delete"},
+	})
+
+	require.ErrorIs(t, err, auth.ErrUnknownPermission)
+}
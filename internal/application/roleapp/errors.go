@@ -0,0 +1,8 @@
+package roleapp
+
+import "errors"
+
+var (
+	ErrRoleExists       = errors.New("role already exists")
+	ErrEmailNotVerified = errors.New("email is not verified")
+)
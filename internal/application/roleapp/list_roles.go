@@ -0,0 +1,36 @@
+package roleapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+type ListRolesUsecase interface {
+	Execute(ctx context.Context) ([]*auth.Role, error)
+}
+
+type listRolesUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewListRolesUsecase(uowFactory repo.UnitOfWorkFactory) ListRolesUsecase {
+	return &listRolesUsecase{uowFactory}
+}
+
+func (u *listRolesUsecase) Execute(ctx context.Context) ([]*auth.Role, error) {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Close(ctx)
+
+	rs, err := uow.AuthRepo().ListRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list roles: %w", err)
+	}
+
+	return rs, nil
+}
@@ -0,0 +1,29 @@
+package roleapp
+
+import (
+	"context"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// RemoveRolePermissionUsecase stops granting a permission to a role.
+// Users of the role lose it in the next access token issued to them.
+type RemoveRolePermissionUsecase interface {
+	Execute(ctx context.Context, roleName, permission string) (*auth.Role, error)
+}
+
+type removeRolePermissionUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewRemoveRolePermissionUsecase(uowFactory repo.UnitOfWorkFactory) RemoveRolePermissionUsecase {
+	return &removeRolePermissionUsecase{uowFactory}
+}
+
+func (u *removeRolePermissionUsecase) Execute(ctx context.Context, roleName, permission string) (*auth.Role, error) {
+	return updateRole(ctx, u.uowFactory, roleName, func(r *auth.Role) error {
+		r.RemovePermission(permission)
+		return nil
+	})
+}
@@ -0,0 +1,10 @@
+package roleapp
+
+type RoleUsecase struct {
+	Create           CreateRoleUsecase
+	List             ListRolesUsecase
+	AddPermission    AddRolePermissionUsecase
+	RemovePermission RemoveRolePermissionUsecase
+	Assign           AssignRoleUsecase
+	Unassign         UnassignRoleUsecase
+}
@@ -0,0 +1,53 @@
+package roleapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// SeedRolesUsecase creates the default roles missing from the db and grants the existing ones
+// the default permissions added to the code since they were last seeded.
+// Permissions role admins granted or removed are kept as they are.
+// It runs on startup, once permissions are synced.
+type SeedRolesUsecase interface {
+	Execute(ctx context.Context) error
+}
+
+type seedRolesUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewSeedRolesUsecase(uowFactory repo.UnitOfWorkFactory) SeedRolesUsecase {
+	return &seedRolesUsecase{uowFactory}
+}
+
+func (u *seedRolesUsecase) Execute(ctx context.Context) error {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	for name, permissions := range auth.DefaultRoles() {
+		r, err := auth.NewRole(uuid.NewString(), name, permissions)
+		if err != nil {
+			return fmt.Errorf("create role entity %s: %w", name, err)
+		}
+
+		if err := authRepo.SeedRole(ctx, r); err != nil {
+			return fmt.Errorf("seed role %s: %w", name, err)
+		}
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
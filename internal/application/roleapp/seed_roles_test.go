@@ -0,0 +1,38 @@
+package roleapp_test
+
+import (
+	"context"
+	"testing"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/roleapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedRoles_SeedsEveryDefaultRole(t *testing.T) {
+	t.Parallel()
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	seeded := map[string][]string{}
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockAuthRepo.EXPECT().SeedRole(mock.Anything, mock.AnythingOfType("*auth.Role")).
+		Run(func(_ context.Context, r *auth.Role) {
+			seeded[r.Name] = r.Permissions
+		}).
+		Return(nil).
+		Times(len(auth.DefaultRoles()))
+
+	usecase := roleapp.NewSeedRolesUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context())
+
+	require.NoError(t, err)
+	require.Equal(t, auth.DefaultRoles(), seeded)
+}
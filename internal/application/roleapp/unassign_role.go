@@ -0,0 +1,40 @@
+package roleapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+)
+
+// UnassignRoleUsecase takes a role from a user, taking a role the user lacks has no effect.
+// The user loses its permissions in the next access token issued to them.
+type UnassignRoleUsecase interface {
+	Execute(ctx context.Context, userID, roleName string) error
+}
+
+type unassignRoleUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewUnassignRoleUsecase(uowFactory repo.UnitOfWorkFactory) UnassignRoleUsecase {
+	return &unassignRoleUsecase{uowFactory}
+}
+
+func (u *unassignRoleUsecase) Execute(ctx context.Context, userID, roleName string) error {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	if err := uow.AuthRepo().DeleteUserRole(ctx, userID, roleName); err != nil {
+		return fmt.Errorf("unassign role %s from user %s: %w", roleName, userID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
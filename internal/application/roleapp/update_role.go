@@ -0,0 +1,44 @@
+package roleapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// updateRole applies a change to the role found by name and saves it
+func updateRole(
+	ctx context.Context,
+	uowFactory repo.UnitOfWorkFactory,
+	roleName string,
+	change func(r *auth.Role) error,
+) (*auth.Role, error) {
+	uow, err := uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	authRepo := uow.AuthRepo()
+
+	r, err := authRepo.FindRoleByName(ctx, roleName)
+	if err != nil {
+		return nil, fmt.Errorf("find role %s: %w", roleName, err)
+	}
+
+	if err := change(r); err != nil {
+		return nil, fmt.Errorf("update role %s: %w", roleName, err)
+	}
+
+	if err := authRepo.SaveRole(ctx, r); err != nil {
+		return nil, fmt.Errorf("save role %s in db: %w", roleName, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return r, nil
+}
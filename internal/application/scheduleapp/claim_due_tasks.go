@@ -0,0 +1,62 @@
+package scheduleapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+// ClaimDueTasksUsecase marks due tasks as running for the calling instance.
+// Tasks are locked while claimed and committed as running before they are
+// handed out, so each run fires on exactly one instance.
+// A run is leased: when its outcome is not recorded before the lease expires,
+// the instance is presumed gone and the run is claimed again.
+type ClaimDueTasksUsecase interface {
+	Execute(ctx context.Context, now time.Time, limit int) ([]*schedule.Task, error)
+}
+
+type claimDueTasksUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	lease      time.Duration
+}
+
+func NewClaimDueTasksUsecase(uowFactory repo.UnitOfWorkFactory, lease time.Duration) ClaimDueTasksUsecase {
+	return &claimDueTasksUsecase{uowFactory, lease}
+}
+
+func (u *claimDueTasksUsecase) Execute(ctx context.Context, now time.Time, limit int) ([]*schedule.Task, error) {
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Initialize repos
+	taskRepo := uow.TaskRepo()
+
+	// Lock due tasks
+	ts, err := taskRepo.ClaimDue(ctx, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("claim due tasks: %w", err)
+	}
+
+	// Update and persist entities
+	for _, t := range ts {
+		if err := t.Fire(now, u.lease); err != nil {
+			return nil, fmt.Errorf("fire task %s: %w", t.ID, err)
+		}
+		if err := taskRepo.Save(ctx, t); err != nil {
+			return nil, fmt.Errorf("save task %s in db: %w", t.ID, err)
+		}
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return ts, nil
+}
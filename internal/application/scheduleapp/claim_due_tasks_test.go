@@ -0,0 +1,72 @@
+package scheduleapp_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimDueTasks_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	now := time.Date(2026, time.January, 14, 3, 0, 0, 0, time.UTC)
+	oneOff, err := schedule.NewTask("task-1", "video.publish", "video-1", now.Add(-time.Minute))
+	require.NoError(t, err)
+	recurring, err := schedule.NewRecurringTask("task-2", "gc", "", "0 3 * * *", now.Add(-time.Hour))
+	require.NoError(t, err)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockTaskRepo.EXPECT().ClaimDue(mock.Anything, now, 10).Return([]*schedule.Task{oneOff, recurring}, nil).Once()
+	mockTaskRepo.EXPECT().Save(mock.Anything, oneOff).Return(nil).Once()
+	mockTaskRepo.EXPECT().Save(mock.Anything, recurring).Return(nil).Once()
+
+	usecase := scheduleapp.NewClaimDueTasksUsecase(mockUowFactory, time.Minute)
+	ts, err := usecase.Execute(t.Context(), now, 10)
+
+	require.NoError(t, err)
+	require.Len(t, ts, 2)
+	require.Equal(t, schedule.StatusRunning, oneOff.Status)
+	require.Equal(t, schedule.StatusRunning, recurring.Status)
+	require.Equal(t, now.Add(24*time.Hour), recurring.RunAt)
+	require.Equal(t, now.Add(time.Minute), *oneOff.LockedUntil)
+}
+
+func TestClaimDueTasks_FailsOnCommit(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	now := time.Now()
+	task, err := schedule.NewTask("task-1", "video.publish", "video-1", now)
+	require.NoError(t, err)
+	expectedErr := errors.New("commit failed")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(expectedErr).Once()
+
+	mockTaskRepo.EXPECT().ClaimDue(mock.Anything, now, 10).Return([]*schedule.Task{task}, nil).Once()
+	mockTaskRepo.EXPECT().Save(mock.Anything, task).Return(nil).Once()
+
+	usecase := scheduleapp.NewClaimDueTasksUsecase(mockUowFactory, time.Minute)
+	ts, err := usecase.Execute(t.Context(), now, 10)
+
+	// Nothing is handed out if the claim was not committed
+	require.Nil(t, ts)
+	require.ErrorIs(t, err, expectedErr)
+}
@@ -0,0 +1,24 @@
+package scheduleapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+// lockClaimedRun locks the stored task and checks that the run of t still holds it.
+// A run outliving its lease may have been claimed again by another instance, which then owns the task.
+func lockClaimedRun(ctx context.Context, taskRepo repo.TaskRepo, t *schedule.Task) (*schedule.Task, error) {
+	stored, err := taskRepo.LockByID(ctx, t.ID)
+	if err != nil {
+		return nil, fmt.Errorf("lock task %s: %w", t.ID, err)
+	}
+
+	if !stored.HoldsRun(t.LastRunAt) {
+		return nil, fmt.Errorf("task %s: %w", t.ID, ErrTaskNotClaimed)
+	}
+
+	return stored, nil
+}
@@ -0,0 +1,57 @@
+package scheduleapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+)
+
+// CollectGarbageUsecase deletes the records no longer needed: the outbox events
+// and the tasks finished longer than the retention ago, and the sign ins
+// abandoned at the identity provider. It runs the recurring garbage collection task.
+type CollectGarbageUsecase interface {
+	Execute(ctx context.Context, now time.Time) (*CollectGarbageResult, error)
+}
+
+type collectGarbageUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	retention  time.Duration
+}
+
+func NewCollectGarbageUsecase(uowFactory repo.UnitOfWorkFactory, retention time.Duration) CollectGarbageUsecase {
+	return &collectGarbageUsecase{uowFactory, retention}
+}
+
+func (u *collectGarbageUsecase) Execute(ctx context.Context, now time.Time) (*CollectGarbageResult, error) {
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	before := now.Add(-u.retention)
+
+	events, err := uow.OutboxRepo().DeletePublishedBefore(ctx, before)
+	if err != nil {
+		return nil, fmt.Errorf("delete published events: %w", err)
+	}
+
+	tasks, err := uow.TaskRepo().DeleteFinishedBefore(ctx, before)
+	if err != nil {
+		return nil, fmt.Errorf("delete finished tasks: %w", err)
+	}
+
+	if err := uow.AuthRepo().DeleteExpiredOIDCLogins(ctx, now.Add(-auth.OIDCLoginLifetime)); err != nil {
+		return nil, fmt.Errorf("delete expired oidc logins: %w", err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return &CollectGarbageResult{Events: events, Tasks: tasks}, nil
+}
@@ -0,0 +1,7 @@
+package scheduleapp
+
+// CollectGarbageResult counts the records deleted by a collection
+type CollectGarbageResult struct {
+	Events int64
+	Tasks  int64
+}
@@ -0,0 +1,62 @@
+package scheduleapp_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectGarbage_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockAuthRepo := repomocks.NewMockAuthRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	now := time.Date(2026, time.January, 14, 3, 0, 0, 0, time.UTC)
+	before := now.Add(-7 * 24 * time.Hour)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().AuthRepo().Return(mockAuthRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockOutboxRepo.EXPECT().DeletePublishedBefore(mock.Anything, before).Return(12, nil).Once()
+	mockTaskRepo.EXPECT().DeleteFinishedBefore(mock.Anything, before).Return(3, nil).Once()
+	mockAuthRepo.EXPECT().DeleteExpiredOIDCLogins(mock.Anything, now.Add(-auth.OIDCLoginLifetime)).Return(nil).Once()
+
+	usecase := scheduleapp.NewCollectGarbageUsecase(mockUowFactory, 7*24*time.Hour)
+	res, err := usecase.Execute(t.Context(), now)
+
+	require.NoError(t, err)
+	require.Equal(t, &scheduleapp.CollectGarbageResult{Events: 12, Tasks: 3}, res)
+}
+
+func TestCollectGarbage_FailsOnDeleteEvents(t *testing.T) {
+	t.Parallel()
+	mockOutboxRepo := repomocks.NewMockOutboxRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	expectedErr := errors.New("db down")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockOutboxRepo.EXPECT().DeletePublishedBefore(mock.Anything, mock.Anything).Return(0, expectedErr).Once()
+
+	usecase := scheduleapp.NewCollectGarbageUsecase(mockUowFactory, time.Hour)
+	res, err := usecase.Execute(t.Context(), time.Now())
+
+	require.ErrorIs(t, err, expectedErr)
+	require.Nil(t, res)
+}
@@ -0,0 +1,54 @@
+package scheduleapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+type CompleteTaskUsecase interface {
+	Execute(ctx context.Context, task *schedule.Task) error
+}
+
+type completeTaskUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewCompleteTaskUsecase(uowFactory repo.UnitOfWorkFactory) CompleteTaskUsecase {
+	return &completeTaskUsecase{uowFactory}
+}
+
+func (u *completeTaskUsecase) Execute(ctx context.Context, t *schedule.Task) error {
+	// Update task entity
+	if err := t.Complete(); err != nil {
+		return fmt.Errorf("complete task %s: %w", t.ID, err)
+	}
+
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Initialize repos
+	taskRepo := uow.TaskRepo()
+
+	// The outcome of a run claimed again by another instance is dropped
+	if _, err := lockClaimedRun(ctx, taskRepo, t); err != nil {
+		return err
+	}
+
+	// Persist entity
+	if err := taskRepo.Save(ctx, t); err != nil {
+		return fmt.Errorf("save task %s in db: %w", t.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
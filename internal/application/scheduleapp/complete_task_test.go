@@ -0,0 +1,77 @@
+package scheduleapp_test
+
+import (
+	"testing"
+	"time"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// firedTask returns a one-off task claimed by the scheduler
+func firedTask(t *testing.T) *schedule.Task {
+	now := time.Now()
+	task, err := schedule.NewTask("task-1", "video.publish", "video-1", now)
+	require.NoError(t, err)
+	require.NoError(t, task.Fire(now, time.Minute))
+	return task
+}
+
+func TestCompleteTask_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	task := firedTask(t)
+	stored := *task
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockTaskRepo.EXPECT().LockByID(mock.Anything, task.ID).Return(&stored, nil).Once()
+	mockTaskRepo.EXPECT().Save(mock.Anything, task).Return(nil).Once()
+
+	usecase := scheduleapp.NewCompleteTaskUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), task)
+
+	require.NoError(t, err)
+	require.Equal(t, schedule.StatusCompleted, task.Status)
+}
+
+func TestCompleteTask_FailsIfTaskNotRunning(t *testing.T) {
+	t.Parallel()
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	task, err := schedule.NewTask("task-1", "video.publish", "video-1", time.Now())
+	require.NoError(t, err)
+
+	usecase := scheduleapp.NewCompleteTaskUsecase(mockUowFactory)
+	err = usecase.Execute(t.Context(), task)
+
+	require.ErrorIs(t, err, schedule.ErrCannotBeCompleted)
+}
+
+func TestCompleteTask_FailsIfClaimedAgain(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	task := firedTask(t)
+
+	// The run outlived its lease and another instance claimed the task
+	stored := *task
+	require.NoError(t, stored.Fire(task.LockedUntil.Add(time.Second), time.Minute))
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockTaskRepo.EXPECT().LockByID(mock.Anything, task.ID).Return(&stored, nil).Once()
+
+	usecase := scheduleapp.NewCompleteTaskUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), task)
+
+	require.ErrorIs(t, err, scheduleapp.ErrTaskNotClaimed)
+}
@@ -0,0 +1,67 @@
+package scheduleapp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+// EnsureRecurringTaskUsecase keeps a single recurring task of a kind on the cron expression.
+// The task id is derived from the kind, so instances starting side by side share the task.
+// A task with another expression is rescheduled, unless it is running.
+type EnsureRecurringTaskUsecase interface {
+	Execute(ctx context.Context, kind schedule.TaskKind, cron string) (*schedule.Task, error)
+}
+
+type ensureRecurringTaskUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewEnsureRecurringTaskUsecase(uowFactory repo.UnitOfWorkFactory) EnsureRecurringTaskUsecase {
+	return &ensureRecurringTaskUsecase{uowFactory}
+}
+
+func (u *ensureRecurringTaskUsecase) Execute(ctx context.Context, kind schedule.TaskKind, cron string) (*schedule.Task, error) {
+	id := uuid.NewSHA1(uuid.NameSpaceURL, []byte("task:"+string(kind))).String()
+
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Initialize repos
+	taskRepo := uow.TaskRepo()
+
+	existing, err := taskRepo.FindByID(ctx, id)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("find task %s: %w", id, err)
+	}
+	if existing != nil && (existing.Cron == cron || existing.IsRunning()) {
+		return existing, nil
+	}
+
+	// Create task entity
+	t, err := schedule.NewRecurringTask(id, kind, "", cron, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("create recurring task %s: %w", id, err)
+	}
+
+	// Persist entity
+	if err := taskRepo.Save(ctx, t); err != nil {
+		return nil, fmt.Errorf("save task %s in db: %w", t.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return t, nil
+}
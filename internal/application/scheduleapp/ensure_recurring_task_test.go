@@ -0,0 +1,67 @@
+package scheduleapp_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnsureRecurringTask_CreatesMissingTask(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockTaskRepo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows).Once()
+	mockTaskRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*schedule.Task")).Return(nil).Once()
+
+	usecase := scheduleapp.NewEnsureRecurringTaskUsecase(mockUowFactory)
+	task, err := usecase.Execute(t.Context(), schedule.KindCollectGarbage, "0 3 * * *")
+	require.NoError(t, err)
+	require.Equal(t, "0 3 * * *", task.Cron)
+
+	// Every instance derives the same id
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockTaskRepo.EXPECT().FindByID(mock.Anything, task.ID).Return(task, nil).Once()
+
+	again, err := usecase.Execute(t.Context(), schedule.KindCollectGarbage, "0 3 * * *")
+	require.NoError(t, err)
+	require.Same(t, task, again)
+}
+
+func TestEnsureRecurringTask_ReschedulesChangedCron(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	existing, err := schedule.NewRecurringTask("task-1", schedule.KindCollectGarbage, "", "0 3 * * *", time.Now())
+	require.NoError(t, err)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockTaskRepo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(existing, nil).Once()
+	mockTaskRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(task *schedule.Task) bool {
+		return task.Cron == "30 4 * * *"
+	})).Return(nil).Once()
+
+	usecase := scheduleapp.NewEnsureRecurringTaskUsecase(mockUowFactory)
+	task, err := usecase.Execute(t.Context(), schedule.KindCollectGarbage, "30 4 * * *")
+
+	require.NoError(t, err)
+	require.Equal(t, 4, task.RunAt.Hour())
+}
@@ -0,0 +1,5 @@
+package scheduleapp
+
+import "errors"
+
+var ErrTaskNotClaimed = errors.New("task run is no longer claimed")
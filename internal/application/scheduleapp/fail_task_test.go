@@ -0,0 +1,56 @@
+package scheduleapp_test
+
+import (
+	"errors"
+	"testing"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailTask_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	task := firedTask(t)
+	stored := *task
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockTaskRepo.EXPECT().LockByID(mock.Anything, task.ID).Return(&stored, nil).Once()
+	mockTaskRepo.EXPECT().Save(mock.Anything, task).Return(nil).Once()
+
+	usecase := scheduleapp.NewFailTaskUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), task, "video not found")
+
+	require.NoError(t, err)
+	require.Equal(t, schedule.StatusFailed, task.Status)
+	require.Equal(t, "video not found", task.LastError)
+}
+
+func TestFailTask_FailsOnSave(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	task := firedTask(t)
+	stored := *task
+	expectedErr := errors.New("db down")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockTaskRepo.EXPECT().LockByID(mock.Anything, task.ID).Return(&stored, nil).Once()
+	mockTaskRepo.EXPECT().Save(mock.Anything, task).Return(expectedErr).Once()
+
+	usecase := scheduleapp.NewFailTaskUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), task, "video not found")
+
+	require.ErrorIs(t, err, expectedErr)
+}
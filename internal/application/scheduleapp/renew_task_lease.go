@@ -0,0 +1,58 @@
+package scheduleapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+// RenewTaskLeaseUsecase extends the lease of a task run claimed by ClaimDueTasksUsecase,
+// it is called while the run lasts so the task is not claimed again.
+// A run already claimed again by another instance is reported with ErrTaskNotClaimed.
+type RenewTaskLeaseUsecase interface {
+	Execute(ctx context.Context, task *schedule.Task, now time.Time) error
+}
+
+type renewTaskLeaseUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	lease      time.Duration
+}
+
+func NewRenewTaskLeaseUsecase(uowFactory repo.UnitOfWorkFactory, lease time.Duration) RenewTaskLeaseUsecase {
+	return &renewTaskLeaseUsecase{uowFactory, lease}
+}
+
+func (u *renewTaskLeaseUsecase) Execute(ctx context.Context, t *schedule.Task, now time.Time) error {
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Initialize repos
+	taskRepo := uow.TaskRepo()
+
+	// The stored task is renewed, the running copy stays with its handler
+	stored, err := lockClaimedRun(ctx, taskRepo, t)
+	if err != nil {
+		return err
+	}
+
+	// Update and persist entity
+	if err := stored.Renew(now, u.lease); err != nil {
+		return fmt.Errorf("renew task %s lease: %w", t.ID, err)
+	}
+	if err := taskRepo.Save(ctx, stored); err != nil {
+		return fmt.Errorf("save task %s in db: %w", t.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package scheduleapp_test
+
+import (
+	"testing"
+	"time"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenewTaskLease_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	task := firedTask(t)
+	stored := *task
+	now := task.LockedUntil.Add(-time.Second)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockTaskRepo.EXPECT().LockByID(mock.Anything, task.ID).Return(&stored, nil).Once()
+	mockTaskRepo.EXPECT().Save(mock.Anything, &stored).Return(nil).Once()
+
+	usecase := scheduleapp.NewRenewTaskLeaseUsecase(mockUowFactory, time.Minute)
+	err := usecase.Execute(t.Context(), task, now)
+
+	require.NoError(t, err)
+	require.Equal(t, now.UTC().Add(time.Minute), *stored.LockedUntil)
+	require.Equal(t, schedule.StatusRunning, stored.Status)
+}
+
+func TestRenewTaskLease_FailsIfClaimedAgain(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	task := firedTask(t)
+
+	// The run outlived its lease and another instance claimed the task
+	stored := *task
+	require.NoError(t, stored.Fire(task.LockedUntil.Add(time.Second), time.Minute))
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockTaskRepo.EXPECT().LockByID(mock.Anything, task.ID).Return(&stored, nil).Once()
+
+	usecase := scheduleapp.NewRenewTaskLeaseUsecase(mockUowFactory, time.Minute)
+	err := usecase.Execute(t.Context(), task, time.Now())
+
+	require.ErrorIs(t, err, scheduleapp.ErrTaskNotClaimed)
+}
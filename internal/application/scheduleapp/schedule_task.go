@@ -0,0 +1,57 @@
+package scheduleapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+// ScheduleTaskUsecase persists a task for the task scheduler to run later
+type ScheduleTaskUsecase interface {
+	Execute(ctx context.Context, input ScheduleTaskInput) (*schedule.Task, error)
+}
+
+type scheduleTaskUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewScheduleTaskUsecase(uowFactory repo.UnitOfWorkFactory) ScheduleTaskUsecase {
+	return &scheduleTaskUsecase{uowFactory}
+}
+
+func (u *scheduleTaskUsecase) Execute(ctx context.Context, input ScheduleTaskInput) (*schedule.Task, error) {
+	// Create task entity
+	id := uuid.NewString()
+	var t *schedule.Task
+	var err error
+	if input.Cron != "" {
+		t, err = schedule.NewRecurringTask(id, input.Kind, input.Payload, input.Cron, time.Now())
+	} else {
+		t, err = schedule.NewTask(id, input.Kind, input.Payload, input.RunAt)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create new task %s: %w", id, err)
+	}
+
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Persist entity
+	if err := uow.TaskRepo().Save(ctx, t); err != nil {
+		return nil, fmt.Errorf("save task %s in db: %w", t.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,16 @@
+package scheduleapp
+
+import (
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+// ScheduleTaskInput describes a task to run once at RunAt,
+// or on every match of Cron when it is set
+type ScheduleTaskInput struct {
+	Kind    schedule.TaskKind
+	Payload string
+	RunAt   time.Time
+	Cron    string
+}
@@ -0,0 +1,91 @@
+package scheduleapp_test
+
+import (
+	"testing"
+	"time"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleTask_OneOff(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	runAt := time.Now().Add(time.Hour)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockTaskRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*schedule.Task")).Return(nil).Once()
+
+	usecase := scheduleapp.NewScheduleTaskUsecase(mockUowFactory)
+	task, err := usecase.Execute(t.Context(), scheduleapp.ScheduleTaskInput{
+		Kind:    "video.publish",
+		Payload: "video-1",
+		RunAt:   runAt,
+	})
+
+	require.NoError(t, err)
+	require.NotEmpty(t, task.ID)
+	require.Equal(t, runAt.UTC(), task.RunAt)
+	require.False(t, task.IsRecurring())
+}
+
+func TestScheduleTask_Recurring(t *testing.T) {
+	t.Parallel()
+	mockTaskRepo := repomocks.NewMockTaskRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockTaskRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*schedule.Task")).Return(nil).Once()
+
+	usecase := scheduleapp.NewScheduleTaskUsecase(mockUowFactory)
+	task, err := usecase.Execute(t.Context(), scheduleapp.ScheduleTaskInput{
+		Kind: "gc",
+		Cron: "0 3 * * *",
+	})
+
+	require.NoError(t, err)
+	require.True(t, task.IsRecurring())
+	require.Equal(t, 3, task.RunAt.Hour())
+	require.True(t, task.RunAt.After(time.Now()))
+}
+
+func TestScheduleTask_FailsOnInvalidCron(t *testing.T) {
+	t.Parallel()
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	usecase := scheduleapp.NewScheduleTaskUsecase(mockUowFactory)
+	task, err := usecase.Execute(t.Context(), scheduleapp.ScheduleTaskInput{
+		Kind: "gc",
+		Cron: "nightly",
+	})
+
+	require.Nil(t, task)
+	require.ErrorIs(t, err, schedule.ErrCronInvalid)
+}
+
+func TestScheduleTask_FailsOnKindWithoutHandler(t *testing.T) {
+	t.Parallel()
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	usecase := scheduleapp.NewScheduleTaskUsecase(mockUowFactory)
+	task, err := usecase.Execute(t.Context(), scheduleapp.ScheduleTaskInput{
+		Kind:  "cold.refresh",
+		RunAt: time.Now(),
+	})
+
+	require.ErrorIs(t, err, schedule.ErrTaskKindInvalid)
+	require.Nil(t, task)
+}
@@ -0,0 +1,5 @@
+package scheduleapp
+
+type TaskUsecase struct {
+	Schedule ScheduleTaskUsecase
+}
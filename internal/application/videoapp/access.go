@@ -0,0 +1,18 @@
+package videoapp
+
+import (
+	"slices"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+)
+
+// canManage reports whether the user may change the video,
+// only its owner and video admins can
+func canManage(v *video.Video, userID string, permissions []string) bool {
+	return v.IsOwnedBy(userID) || isVideoAdmin(permissions)
+}
+
+func isVideoAdmin(permissions []string) bool {
+	return slices.Contains(permissions, auth.PermissionVideoAdmin)
+}
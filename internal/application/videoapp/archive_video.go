@@ -5,12 +5,13 @@ import (
 	"fmt"
 
 	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
 )
 
 // ArchiveVideoUsecase marks the entity as archived
 // but does not delete the related files in storage
 type ArchiveVideoUsecase interface {
-	Execute(ctx context.Context, id string) error
+	Execute(ctx context.Context, input ArchiveVideoInput) error
 }
 
 type archiveVideoUsecase struct {
@@ -21,7 +22,7 @@ func NewArchiveVideoUsecase(uowFactory repo.UnitOfWorkFactory) ArchiveVideoUseca
 	return &archiveVideoUsecase{uowFactory}
 }
 
-func (u *archiveVideoUsecase) Execute(ctx context.Context, id string) error {
+func (u *archiveVideoUsecase) Execute(ctx context.Context, input ArchiveVideoInput) error {
 	uow, err := u.uowFactory.NewUnitOfWork(ctx)
 	if err != nil {
 		return fmt.Errorf("initialize unit of work: %w", err)
@@ -29,18 +30,27 @@ func (u *archiveVideoUsecase) Execute(ctx context.Context, id string) error {
 	defer uow.Rollback(ctx)
 
 	videoRepo := uow.VideoRepo()
+	outboxRepo := uow.OutboxRepo()
 
-	v, err := videoRepo.FindByID(ctx, id)
+	v, err := videoRepo.FindByID(ctx, input.ID)
 	if err != nil {
-		return fmt.Errorf("find video %s: %w", id, err)
+		return fmt.Errorf("find video %s: %w", input.ID, err)
+	}
+
+	if !canManage(v, input.UserID, input.Permissions) {
+		return fmt.Errorf("archive video %s: %w", v.ID, ErrVideoForbidden)
 	}
 
 	if err := v.Archive(); err != nil {
-		return fmt.Errorf("archive video %s: %w", id, err)
+		return fmt.Errorf("archive video %s: %w", v.ID, err)
 	}
 
 	if err := videoRepo.Save(ctx, v); err != nil {
-		return fmt.Errorf("save video %s: %w", id, err)
+		return fmt.Errorf("save video %s: %w", v.ID, err)
+	}
+
+	if err := recordVideoEvent(ctx, outboxRepo, event.TypeVideoArchived, v); err != nil {
+		return err
 	}
 
 	if err := uow.Commit(ctx); err != nil {
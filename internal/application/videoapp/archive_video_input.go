@@ -0,0 +1,8 @@
+package videoapp
+
+// ArchiveVideoInput names the video to archive and the user asking
+type ArchiveVideoInput struct {
+	ID          string
+	UserID      string
+	Permissions []string
+}
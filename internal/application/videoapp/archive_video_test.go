@@ -6,6 +6,7 @@ import (
 
 	repoMocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
 	"github.com/st-ember/streaming-api/internal/application/videoapp"
+	"github.com/st-ember/streaming-api/internal/domain/event"
 	"github.com/st-ember/streaming-api/internal/domain/video"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -17,11 +18,12 @@ func TestArchiveVideo_SuccessCase(t *testing.T) {
 	// Set up mocks
 	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
 	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
 	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
 
 	videoID := "video-123"
 	resourceID := "resource-123"
-	testVideo, _ := video.NewVideo(videoID, "Test", "Test", "test.mp4", resourceID)
+	testVideo, _ := video.NewVideo(videoID, "owner-id", "Test", "Test", "test.mp4", resourceID)
 	testVideo.Status = video.StatusPublished
 
 	// Unit of Work Factory expectations
@@ -32,6 +34,8 @@ func TestArchiveVideo_SuccessCase(t *testing.T) {
 
 	// Unit of Work expectations
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	expectEvents(mockOutboxRepo, event.TypeVideoArchived)
 	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Maybe()
 
@@ -43,7 +47,7 @@ func TestArchiveVideo_SuccessCase(t *testing.T) {
 	usecase := videoapp.NewArchiveVideoUsecase(mockUowFactory)
 
 	// Execute
-	err := usecase.Execute(t.Context(), videoID)
+	err := usecase.Execute(t.Context(), videoapp.ArchiveVideoInput{ID: videoID, UserID: "owner-id"})
 
 	// Assert
 	require.NoError(t, err)
@@ -55,18 +59,20 @@ func TestArchiveVideo_VideoNotFound(t *testing.T) {
 
 	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
 	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
 	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
 
 	videoID := "non-existent"
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
 
 	mockVideoRepo.EXPECT().FindByID(mock.Anything, videoID).Return(nil, errors.New("not found")).Once()
 
 	usecase := videoapp.NewArchiveVideoUsecase(mockUowFactory)
-	err := usecase.Execute(t.Context(), videoID)
+	err := usecase.Execute(t.Context(), videoapp.ArchiveVideoInput{ID: videoID, UserID: "owner-id"})
 
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "find video")
@@ -77,21 +83,49 @@ func TestArchiveVideo_InvalidStateTransition(t *testing.T) {
 
 	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
 	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
 	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
 
 	videoID := "video-123"
 	// Video is in 'Pending' state (cannot be archived yet)
-	testVideo, _ := video.NewVideo(videoID, "Test", "Test", "test.mp4", "res-123")
+	testVideo, _ := video.NewVideo(videoID, "owner-id", "Test", "Test", "test.mp4", "res-123")
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
 
 	mockVideoRepo.EXPECT().FindByID(mock.Anything, videoID).Return(testVideo, nil).Once()
 
 	usecase := videoapp.NewArchiveVideoUsecase(mockUowFactory)
-	err := usecase.Execute(t.Context(), videoID)
+	err := usecase.Execute(t.Context(), videoapp.ArchiveVideoInput{ID: videoID, UserID: "owner-id"})
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, video.ErrCannotBeArchived)
 }
+
+func TestArchiveVideo_NotOwner(t *testing.T) {
+	t.Parallel()
+
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+
+	videoID := "video-123"
+	testVideo, _ := video.NewVideo(videoID, "owner-id", "Test", "Test", "test.mp4", "res-123")
+	testVideo.Status = video.StatusPublished
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, videoID).Return(testVideo, nil).Once()
+
+	usecase := videoapp.NewArchiveVideoUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), videoapp.ArchiveVideoInput{ID: videoID, UserID: "someone-else"})
+
+	require.ErrorIs(t, err, videoapp.ErrVideoForbidden)
+	require.Equal(t, video.StatusPublished, testVideo.Status)
+}
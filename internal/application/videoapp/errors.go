@@ -0,0 +1,5 @@
+package videoapp
+
+import "errors"
+
+var ErrVideoForbidden = errors.New("not allowed to manage this video")
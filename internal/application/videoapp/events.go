@@ -0,0 +1,26 @@
+package videoapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+)
+
+// recordVideoEvent adds an event of the video to the outbox,
+// it is published only if the transaction commits
+func recordVideoEvent(ctx context.Context, outboxRepo repo.OutboxRepo, eventType event.EventType, v *video.Video) error {
+	e, err := event.NewVideoEvent(uuid.NewString(), eventType, v)
+	if err != nil {
+		return fmt.Errorf("create %s event of video %s: %w", eventType, v.ID, err)
+	}
+
+	if err := outboxRepo.Save(ctx, e); err != nil {
+		return fmt.Errorf("save %s event of video %s in outbox: %w", eventType, v.ID, err)
+	}
+
+	return nil
+}
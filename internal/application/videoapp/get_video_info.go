@@ -34,9 +34,10 @@ func (u *getVideoInfoUsecase) Execute(ctx context.Context, id string) (*GetVideo
 		return nil, fmt.Errorf("find video %s: %w", id, err)
 	}
 
-	j, err := jobRepo.FindByVideoID(ctx, id)
+	// The result of the processing is the one of the job reporting its progress
+	j, err := jobRepo.FindByID(ctx, v.ProgressJobID)
 	if err != nil {
-		return nil, fmt.Errorf("find job with video id %s: %w", id, err)
+		return nil, fmt.Errorf("find progress job %s of video %s: %w", v.ProgressJobID, id, err)
 	}
 
 	res := &GetVideoInfoResult{
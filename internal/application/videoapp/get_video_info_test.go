@@ -23,7 +23,7 @@ func TestGetVideoInfo_SuccessCase(t *testing.T) {
 
 	videoID := "video-123"
 	resourceID := "resource-123"
-	testVideo, _ := video.NewVideo(videoID, "Test Title", "Test Desc", "test.mp4", resourceID)
+	testVideo, _ := video.NewVideo(videoID, "owner-id", "Test Title", "Test Desc", "test.mp4", resourceID)
 	testJob := &job.Job{
 		ID:       "job-123",
 		VideoID:  videoID,
@@ -31,6 +31,7 @@ func TestGetVideoInfo_SuccessCase(t *testing.T) {
 		Result:   "storage/video-123/manifest.m3u8",
 		ErrorMsg: "",
 	}
+	testVideo.ProgressJobID = testJob.ID
 
 	// Unit of Work Factory expectations
 	mockUowFactory.EXPECT().
@@ -45,7 +46,7 @@ func TestGetVideoInfo_SuccessCase(t *testing.T) {
 
 	// Repo expectations
 	mockVideoRepo.EXPECT().FindByID(mock.Anything, videoID).Return(testVideo, nil).Once()
-	mockJobRepo.EXPECT().FindByVideoID(mock.Anything, videoID).Return(testJob, nil).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-123").Return(testJob, nil).Once()
 
 	// Create usecase
 	usecase := videoapp.NewGetVideoInfoUsecase(mockUowFactory)
@@ -97,7 +98,8 @@ func TestGetVideoInfo_JobNotFound(t *testing.T) {
 
 	videoID := "video-123"
 	resourceID := "resource-123"
-	testVideo, _ := video.NewVideo(videoID, "Test", "Test", "test.mp4", resourceID)
+	testVideo, _ := video.NewVideo(videoID, "owner-id", "Test", "Test", "test.mp4", resourceID)
+	testVideo.ProgressJobID = "job-123"
 	expectedErr := errors.New("job not found")
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
@@ -106,7 +108,7 @@ func TestGetVideoInfo_JobNotFound(t *testing.T) {
 	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
 
 	mockVideoRepo.EXPECT().FindByID(mock.Anything, videoID).Return(testVideo, nil).Once()
-	mockJobRepo.EXPECT().FindByVideoID(mock.Anything, videoID).Return(nil, expectedErr).Once()
+	mockJobRepo.EXPECT().FindByID(mock.Anything, "job-123").Return(nil, expectedErr).Once()
 
 	usecase := videoapp.NewGetVideoInfoUsecase(mockUowFactory)
 	result, err := usecase.Execute(t.Context(), videoID)
@@ -9,7 +9,7 @@ import (
 )
 
 type ListVideosUsecase interface {
-	Execute(ctx context.Context, page int) ([]*video.Video, error)
+	Execute(ctx context.Context, input ListVideosInput) ([]*video.Video, error)
 }
 
 type listVideoUsecase struct {
@@ -20,14 +20,23 @@ func NewListVideoUsecase(uowFactory repo.UnitOfWorkFactory) ListVideosUsecase {
 	return &listVideoUsecase{uowFactory}
 }
 
-func (u *listVideoUsecase) Execute(ctx context.Context, page int) ([]*video.Video, error) {
+func (u *listVideoUsecase) Execute(ctx context.Context, input ListVideosInput) ([]*video.Video, error) {
 	uow, err := u.uowFactory.NewUnitOfWork(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("initialize unit of work")
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
 	}
 	defer uow.Close(ctx)
 
 	videoRepo := uow.VideoRepo()
 
-	return videoRepo.List(ctx, page)
+	filter := repo.VideoFilter{Page: input.Page}
+	if input.Mine || !isVideoAdmin(input.Permissions) {
+		// without a user, an empty owner would match every video
+		if input.UserID == "" {
+			return nil, ErrVideoForbidden
+		}
+		filter.OwnerID = input.UserID
+	}
+
+	return videoRepo.List(ctx, filter)
 }
@@ -0,0 +1,10 @@
+package videoapp
+
+// ListVideosInput names the page to list and the user asking.
+// Users only list their own videos, video admins list every video unless Mine is set.
+type ListVideosInput struct {
+	Page        int
+	UserID      string
+	Permissions []string
+	Mine        bool
+}
@@ -4,9 +4,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
 	repoMocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
 	"github.com/st-ember/streaming-api/internal/application/videoapp"
-
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 	"github.com/st-ember/streaming-api/internal/domain/video"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -29,10 +30,11 @@ func TestListVideos_Success(t *testing.T) {
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
 
-	mockVideoRepo.EXPECT().List(mock.Anything, page).Return(expectedVideos, nil).Once()
+	filter := repo.VideoFilter{OwnerID: "user-id", Page: page}
+	mockVideoRepo.EXPECT().List(mock.Anything, filter).Return(expectedVideos, nil).Once()
 
 	usecase := videoapp.NewListVideoUsecase(mockUowFactory)
-	result, err := usecase.Execute(t.Context(), page)
+	result, err := usecase.Execute(t.Context(), videoapp.ListVideosInput{Page: page, UserID: "user-id"})
 
 	require.NoError(t, err)
 	require.Len(t, result, 2)
@@ -53,12 +55,60 @@ func TestListVideos_RepoError(t *testing.T) {
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
 
-	mockVideoRepo.EXPECT().List(mock.Anything, page).Return(nil, expectedErr).Once()
+	mockVideoRepo.EXPECT().List(mock.Anything, mock.Anything).Return(nil, expectedErr).Once()
 
 	usecase := videoapp.NewListVideoUsecase(mockUowFactory)
-	result, err := usecase.Execute(t.Context(), page)
+	result, err := usecase.Execute(t.Context(), videoapp.ListVideosInput{Page: page, UserID: "user-id"})
 
 	require.Error(t, err)
 	require.Nil(t, result)
 	require.ErrorIs(t, err, expectedErr)
 }
+
+func TestListVideos_AdminListsEveryVideo(t *testing.T) {
+	t.Parallel()
+
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Twice()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Twice()
+
+	mockVideoRepo.EXPECT().List(mock.Anything, repo.VideoFilter{Page: 2}).Return([]*video.Video{}, nil).Once()
+	mockVideoRepo.EXPECT().List(mock.Anything, repo.VideoFilter{OwnerID: "admin-id", Page: 2}).Return([]*video.Video{}, nil).Once()
+
+	usecase := videoapp.NewListVideoUsecase(mockUowFactory)
+	input := videoapp.ListVideosInput{
+		Page:        2,
+		UserID:      "admin-id",
+		Permissions: []string{auth.PermissionVideoAdmin},
+	}
+
+	_, err := usecase.Execute(t.Context(), input)
+	require.NoError(t, err)
+
+	// Admins may still narrow the listing down to their own videos
+	input.Mine = true
+	_, err = usecase.Execute(t.Context(), input)
+	require.NoError(t, err)
+}
+
+func TestListVideos_NoUser(t *testing.T) {
+	t.Parallel()
+
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().Close(mock.Anything).Return(nil).Once()
+
+	usecase := videoapp.NewListVideoUsecase(mockUowFactory)
+	result, err := usecase.Execute(t.Context(), videoapp.ListVideosInput{Page: 1})
+
+	require.ErrorIs(t, err, videoapp.ErrVideoForbidden)
+	require.Nil(t, result)
+}
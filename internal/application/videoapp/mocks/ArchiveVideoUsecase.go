@@ -7,6 +7,7 @@ package videoapp
 import (
 	"context"
 
+	"github.com/st-ember/streaming-api/internal/application/videoapp"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,16 +39,16 @@ func (_m *MockArchiveVideoUsecase) EXPECT() *MockArchiveVideoUsecase_Expecter {
 }
 
 // Execute provides a mock function for the type MockArchiveVideoUsecase
-func (_mock *MockArchiveVideoUsecase) Execute(ctx context.Context, id string) error {
-	ret := _mock.Called(ctx, id)
+func (_mock *MockArchiveVideoUsecase) Execute(ctx context.Context, input videoapp.ArchiveVideoInput) error {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, videoapp.ArchiveVideoInput) error); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -61,20 +62,20 @@ type MockArchiveVideoUsecase_Execute_Call struct {
 
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockArchiveVideoUsecase_Expecter) Execute(ctx interface{}, id interface{}) *MockArchiveVideoUsecase_Execute_Call {
-	return &MockArchiveVideoUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, id)}
+//   - input videoapp.ArchiveVideoInput
+func (_e *MockArchiveVideoUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockArchiveVideoUsecase_Execute_Call {
+	return &MockArchiveVideoUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
 }
 
-func (_c *MockArchiveVideoUsecase_Execute_Call) Run(run func(ctx context.Context, id string)) *MockArchiveVideoUsecase_Execute_Call {
+func (_c *MockArchiveVideoUsecase_Execute_Call) Run(run func(ctx context.Context, input videoapp.ArchiveVideoInput)) *MockArchiveVideoUsecase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 videoapp.ArchiveVideoInput
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(videoapp.ArchiveVideoInput)
 		}
 		run(
 			arg0,
@@ -89,7 +90,7 @@ func (_c *MockArchiveVideoUsecase_Execute_Call) Return(err error) *MockArchiveVi
 	return _c
 }
 
-func (_c *MockArchiveVideoUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockArchiveVideoUsecase_Execute_Call {
+func (_c *MockArchiveVideoUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, input videoapp.ArchiveVideoInput) error) *MockArchiveVideoUsecase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,6 +7,7 @@ package videoapp
 import (
 	"context"
 
+	"github.com/st-ember/streaming-api/internal/application/videoapp"
 	"github.com/st-ember/streaming-api/internal/domain/video"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,8 +40,8 @@ func (_m *MockListVideosUsecase) EXPECT() *MockListVideosUsecase_Expecter {
 }
 
 // Execute provides a mock function for the type MockListVideosUsecase
-func (_mock *MockListVideosUsecase) Execute(ctx context.Context, page int) ([]*video.Video, error) {
-	ret := _mock.Called(ctx, page)
+func (_mock *MockListVideosUsecase) Execute(ctx context.Context, input videoapp.ListVideosInput) ([]*video.Video, error) {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
@@ -48,18 +49,18 @@ func (_mock *MockListVideosUsecase) Execute(ctx context.Context, page int) ([]*v
 
 	var r0 []*video.Video
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]*video.Video, error)); ok {
-		return returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, videoapp.ListVideosInput) ([]*video.Video, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []*video.Video); ok {
-		r0 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, videoapp.ListVideosInput) []*video.Video); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*video.Video)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, videoapp.ListVideosInput) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,20 +74,20 @@ type MockListVideosUsecase_Execute_Call struct {
 
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
-//   - page int
-func (_e *MockListVideosUsecase_Expecter) Execute(ctx interface{}, page interface{}) *MockListVideosUsecase_Execute_Call {
-	return &MockListVideosUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, page)}
+//   - input videoapp.ListVideosInput
+func (_e *MockListVideosUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockListVideosUsecase_Execute_Call {
+	return &MockListVideosUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
 }
 
-func (_c *MockListVideosUsecase_Execute_Call) Run(run func(ctx context.Context, page int)) *MockListVideosUsecase_Execute_Call {
+func (_c *MockListVideosUsecase_Execute_Call) Run(run func(ctx context.Context, input videoapp.ListVideosInput)) *MockListVideosUsecase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 videoapp.ListVideosInput
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(videoapp.ListVideosInput)
 		}
 		run(
 			arg0,
@@ -101,7 +102,7 @@ func (_c *MockListVideosUsecase_Execute_Call) Return(videos []*video.Video, err
 	return _c
 }
 
-func (_c *MockListVideosUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, page int) ([]*video.Video, error)) *MockListVideosUsecase_Execute_Call {
+func (_c *MockListVideosUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, input videoapp.ListVideosInput) ([]*video.Video, error)) *MockListVideosUsecase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,66 @@
+package videoapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+)
+
+// PublishVideoUsecase publishes a processed video held until its publish time.
+// A video still processing is published when its pipeline ends, and a video
+// whose publication was moved later stays held, so neither is changed.
+type PublishVideoUsecase interface {
+	Execute(ctx context.Context, id string) error
+}
+
+type publishVideoUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewPublishVideoUsecase(uowFactory repo.UnitOfWorkFactory) PublishVideoUsecase {
+	return &publishVideoUsecase{uowFactory}
+}
+
+func (u *publishVideoUsecase) Execute(ctx context.Context, id string) error {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	videoRepo := uow.VideoRepo()
+	outboxRepo := uow.OutboxRepo()
+
+	v, err := videoRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("find video %s: %w", id, err)
+	}
+
+	if !v.IsReady() {
+		return nil
+	}
+
+	if err := v.Publish(time.Now()); err != nil {
+		return fmt.Errorf("publish video %s: %w", v.ID, err)
+	}
+	if !v.IsPublished() {
+		return nil
+	}
+
+	if err := videoRepo.Save(ctx, v); err != nil {
+		return fmt.Errorf("save video %s: %w", v.ID, err)
+	}
+
+	if err := recordVideoEvent(ctx, outboxRepo, event.TypeVideoPublished, v); err != nil {
+		return err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
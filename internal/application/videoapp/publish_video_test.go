@@ -0,0 +1,99 @@
+package videoapp_test
+
+import (
+	"testing"
+	"time"
+
+	repoMocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/videoapp"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// heldVideo returns a processed video held until publishAt
+func heldVideo(t *testing.T, publishAt time.Time) *video.Video {
+	v, err := video.NewVideo("video-123", "owner-id", "Test", "Test", "test.mp4", "resource-123")
+	require.NoError(t, err)
+	require.NoError(t, v.SchedulePublish(publishAt))
+	v.Status = video.StatusReady
+	return v
+}
+
+func TestPublishVideo_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+
+	testVideo := heldVideo(t, time.Now().Add(-time.Second))
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	expectEvents(mockOutboxRepo, event.TypeVideoPublished)
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Maybe()
+
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, testVideo.ID).Return(testVideo, nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, testVideo).Return(nil).Once()
+
+	usecase := videoapp.NewPublishVideoUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), testVideo.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, video.StatusPublished, testVideo.Status)
+}
+
+func TestPublishVideo_LeavesVideoMovedLater(t *testing.T) {
+	t.Parallel()
+
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+
+	// The task of the earlier publish time fires
+	testVideo := heldVideo(t, time.Now().Add(time.Hour))
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, testVideo.ID).Return(testVideo, nil).Once()
+
+	usecase := videoapp.NewPublishVideoUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), testVideo.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, video.StatusReady, testVideo.Status)
+}
+
+func TestPublishVideo_LeavesVideoStillProcessing(t *testing.T) {
+	t.Parallel()
+
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+
+	testVideo := heldVideo(t, time.Now().Add(-time.Second))
+	testVideo.Status = video.StatusProcessing
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, testVideo.ID).Return(testVideo, nil).Once()
+
+	usecase := videoapp.NewPublishVideoUsecase(mockUowFactory)
+	err := usecase.Execute(t.Context(), testVideo.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, video.StatusProcessing, testVideo.Status)
+}
@@ -0,0 +1,68 @@
+package videoapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+)
+
+// SchedulePublishUsecase holds the publication of a video until the given time
+// and schedules the task publishing it then.
+// Rescheduling leaves the earlier task, which finds the video held and does nothing.
+type SchedulePublishUsecase interface {
+	Execute(ctx context.Context, input SchedulePublishInput) (*video.Video, error)
+}
+
+type schedulePublishUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewSchedulePublishUsecase(uowFactory repo.UnitOfWorkFactory) SchedulePublishUsecase {
+	return &schedulePublishUsecase{uowFactory}
+}
+
+func (u *schedulePublishUsecase) Execute(ctx context.Context, input SchedulePublishInput) (*video.Video, error) {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	videoRepo := uow.VideoRepo()
+	taskRepo := uow.TaskRepo()
+
+	v, err := videoRepo.FindByID(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("find video %s: %w", input.ID, err)
+	}
+
+	if !canManage(v, input.UserID, input.Permissions) {
+		return nil, fmt.Errorf("schedule publication of video %s: %w", v.ID, ErrVideoForbidden)
+	}
+
+	if err := v.SchedulePublish(input.PublishAt); err != nil {
+		return nil, fmt.Errorf("schedule publication of video %s: %w", v.ID, err)
+	}
+
+	t, err := schedule.NewTask(uuid.NewString(), schedule.KindPublishVideo, v.ID, input.PublishAt)
+	if err != nil {
+		return nil, fmt.Errorf("create publish task of video %s: %w", v.ID, err)
+	}
+
+	if err := videoRepo.Save(ctx, v); err != nil {
+		return nil, fmt.Errorf("save video %s: %w", v.ID, err)
+	}
+	if err := taskRepo.Save(ctx, t); err != nil {
+		return nil, fmt.Errorf("save task %s: %w", t.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return v, nil
+}
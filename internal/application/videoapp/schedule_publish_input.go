@@ -0,0 +1,11 @@
+package videoapp
+
+import "time"
+
+// SchedulePublishInput names the video to publish at PublishAt and the user asking
+type SchedulePublishInput struct {
+	ID          string
+	UserID      string
+	Permissions []string
+	PublishAt   time.Time
+}
@@ -0,0 +1,98 @@
+package videoapp_test
+
+import (
+	"testing"
+	"time"
+
+	repoMocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/videoapp"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulePublish_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	// Set up mocks
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockTaskRepo := repoMocks.NewMockTaskRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+
+	videoID := "video-123"
+	publishAt := time.Now().Add(time.Hour).UTC()
+	testVideo, _ := video.NewVideo(videoID, "owner-id", "Test", "Test", "test.mp4", "resource-123")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo)
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Maybe()
+
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, videoID).Return(testVideo, nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, testVideo).Return(nil).Once()
+
+	// The task fires at the publish time with the video as payload
+	mockTaskRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(task *schedule.Task) bool {
+		return task.Kind == schedule.KindPublishVideo && task.Payload == videoID && task.RunAt.Equal(publishAt)
+	})).Return(nil).Once()
+
+	usecase := videoapp.NewSchedulePublishUsecase(mockUowFactory)
+	v, err := usecase.Execute(t.Context(), videoapp.SchedulePublishInput{ID: videoID, UserID: "owner-id", PublishAt: publishAt})
+
+	require.NoError(t, err)
+	require.Equal(t, publishAt, *v.PublishAt)
+}
+
+func TestSchedulePublish_FailsIfNotOwner(t *testing.T) {
+	t.Parallel()
+
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockTaskRepo := repoMocks.NewMockTaskRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+
+	videoID := "video-123"
+	testVideo, _ := video.NewVideo(videoID, "owner-id", "Test", "Test", "test.mp4", "resource-123")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, videoID).Return(testVideo, nil).Once()
+
+	usecase := videoapp.NewSchedulePublishUsecase(mockUowFactory)
+	v, err := usecase.Execute(t.Context(), videoapp.SchedulePublishInput{ID: videoID, UserID: "someone-else", PublishAt: time.Now()})
+
+	require.ErrorIs(t, err, videoapp.ErrVideoForbidden)
+	require.Nil(t, v)
+	require.Nil(t, testVideo.PublishAt)
+}
+
+func TestSchedulePublish_FailsIfPublished(t *testing.T) {
+	t.Parallel()
+
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockTaskRepo := repoMocks.NewMockTaskRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+
+	videoID := "video-123"
+	testVideo, _ := video.NewVideo(videoID, "owner-id", "Test", "Test", "test.mp4", "resource-123")
+	testVideo.Status = video.StatusPublished
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().TaskRepo().Return(mockTaskRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, videoID).Return(testVideo, nil).Once()
+
+	usecase := videoapp.NewSchedulePublishUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), videoapp.SchedulePublishInput{ID: videoID, UserID: "owner-id", PublishAt: time.Now()})
+
+	require.ErrorIs(t, err, video.ErrCannotBeScheduled)
+}
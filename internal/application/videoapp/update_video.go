@@ -34,6 +34,10 @@ func (u *updateVideuoUsecase) Execute(ctx context.Context, input UpdateVideoInpu
 		return nil, fmt.Errorf("find video %s: %w", input.ID, err)
 	}
 
+	if !canManage(v, input.UserID, input.Permissions) {
+		return nil, fmt.Errorf("update video %s: %w", v.ID, ErrVideoForbidden)
+	}
+
 	if input.Title != nil {
 		if err := v.UpdateTitle(*input.Title); err != nil {
 			return nil, fmt.Errorf("update video entity %s title: %w", v.ID, err)
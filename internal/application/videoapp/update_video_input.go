@@ -2,6 +2,8 @@ package videoapp
 
 type UpdateVideoInput struct {
 	ID          string
+	UserID      string
+	Permissions []string
 	Title       *string
 	Description *string
 }
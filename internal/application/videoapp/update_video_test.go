@@ -6,6 +6,7 @@ import (
 
 	repoMocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
 	"github.com/st-ember/streaming-api/internal/application/videoapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
 	"github.com/st-ember/streaming-api/internal/domain/video"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -25,7 +26,7 @@ func TestUpdateVideo_SuccessPartial(t *testing.T) {
 	oldDesc := "Old Desc"
 
 	// Existing video state
-	testVideo, _ := video.NewVideo(videoID, oldTitle, oldDesc, "test.mp4", resourceID)
+	testVideo, _ := video.NewVideo(videoID, "owner-id", oldTitle, oldDesc, "test.mp4", resourceID)
 
 	// Unit of Work Factory expectations
 	mockUowFactory.EXPECT().
@@ -45,8 +46,9 @@ func TestUpdateVideo_SuccessPartial(t *testing.T) {
 	// Partial Update: Only new title
 	newTitle := "New Awesome Title"
 	input := videoapp.UpdateVideoInput{
-		ID:    videoID,
-		Title: &newTitle,
+		ID:     videoID,
+		UserID: "owner-id",
+		Title:  &newTitle,
 	}
 
 	usecase := videoapp.NewUpdateVideoUsecase(mockUowFactory)
@@ -92,7 +94,7 @@ func TestUpdateVideo_DomainValidationError(t *testing.T) {
 	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
 
 	videoID := "video-123"
-	testVideo, _ := video.NewVideo(videoID, "Title", "Desc", "test.mp4", "res-123")
+	testVideo, _ := video.NewVideo(videoID, "owner-id", "Title", "Desc", "test.mp4", "res-123")
 
 	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
@@ -103,8 +105,9 @@ func TestUpdateVideo_DomainValidationError(t *testing.T) {
 	// Trying to update to an empty title (domain validation should fail)
 	invalidTitle := ""
 	input := videoapp.UpdateVideoInput{
-		ID:    videoID,
-		Title: &invalidTitle,
+		ID:     videoID,
+		UserID: "owner-id",
+		Title:  &invalidTitle,
 	}
 
 	usecase := videoapp.NewUpdateVideoUsecase(mockUowFactory)
@@ -114,3 +117,68 @@ func TestUpdateVideo_DomainValidationError(t *testing.T) {
 	require.Nil(t, result)
 	require.ErrorIs(t, err, video.ErrTitleEmpty) // Should reflect domain error
 }
+
+func TestUpdateVideo_NotOwner(t *testing.T) {
+	t.Parallel()
+
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+
+	videoID := "video-123"
+	testVideo, _ := video.NewVideo(videoID, "owner-id", "Title", "Desc", "test.mp4", "res-123")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, videoID).Return(testVideo, nil).Once()
+
+	newTitle := "Hijacked Title"
+	input := videoapp.UpdateVideoInput{
+		ID:          videoID,
+		UserID:      "someone-else",
+		Permissions: []string{auth.PermissionVideoUpdate},
+		Title:       &newTitle,
+	}
+
+	usecase := videoapp.NewUpdateVideoUsecase(mockUowFactory)
+	result, err := usecase.Execute(t.Context(), input)
+
+	require.ErrorIs(t, err, videoapp.ErrVideoForbidden)
+	require.Nil(t, result)
+	require.Equal(t, "Title", testVideo.Title)
+}
+
+func TestUpdateVideo_AdminUpdatesAnyVideo(t *testing.T) {
+	t.Parallel()
+
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+
+	videoID := "video-123"
+	testVideo, _ := video.NewVideo(videoID, "owner-id", "Title", "Desc", "test.mp4", "res-123")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	mockVideoRepo.EXPECT().FindByID(mock.Anything, videoID).Return(testVideo, nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, testVideo).Return(nil).Once()
+
+	newTitle := "Moderated Title"
+	input := videoapp.UpdateVideoInput{
+		ID:          videoID,
+		UserID:      "admin-id",
+		Permissions: []string{auth.PermissionVideoUpdate, auth.PermissionVideoAdmin},
+		Title:       &newTitle,
+	}
+
+	usecase := videoapp.NewUpdateVideoUsecase(mockUowFactory)
+	result, err := usecase.Execute(t.Context(), input)
+
+	require.NoError(t, err)
+	require.Equal(t, newTitle, result.Title)
+}
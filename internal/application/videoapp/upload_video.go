@@ -8,6 +8,7 @@ import (
 	"github.com/st-ember/streaming-api/internal/application/ports/log"
 	"github.com/st-ember/streaming-api/internal/application/ports/repo"
 	"github.com/st-ember/streaming-api/internal/application/ports/storage"
+	"github.com/st-ember/streaming-api/internal/domain/event"
 	"github.com/st-ember/streaming-api/internal/domain/job"
 	"github.com/st-ember/streaming-api/internal/domain/video"
 )
@@ -20,17 +21,20 @@ type uploadVideoUsecase struct {
 	assetStorer storage.AssetStorer
 	uowFactory  repo.UnitOfWorkFactory
 	logger      log.Logger
+	pipeline    []job.PipelineStep
 }
 
 func NewUploadVideoUsecase(
 	assetStorer storage.AssetStorer,
 	uow repo.UnitOfWorkFactory,
 	logger log.Logger,
+	pipeline []job.PipelineStep,
 ) *uploadVideoUsecase {
 	return &uploadVideoUsecase{
 		assetStorer,
 		uow,
 		logger,
+		pipeline,
 	}
 }
 
@@ -53,16 +57,22 @@ func (u *uploadVideoUsecase) Execute(ctx context.Context, input UploadVideoInput
 
 	// create video entity
 	videoID := uuid.NewString()
-	v, err := video.NewVideo(videoID, input.Title, input.Description, input.FileName, resourceID)
+	v, err := video.NewVideo(videoID, input.OwnerID, input.Title, input.Description, input.FileName, resourceID)
 	if err != nil {
 		return nil, fmt.Errorf("create new video %s: %w", videoID, err)
 	}
 
-	// create job entity
-	jobID := uuid.NewString()
-	j, err := job.NewJob(jobID, videoID, job.TypeTranscode)
+	// create the jobs of the processing pipeline
+	js, err := job.NewPipeline(videoID, u.pipeline, uuid.NewString)
 	if err != nil {
-		return nil, fmt.Errorf("create new job %s: %w", jobID, err)
+		return nil, fmt.Errorf("create pipeline for video %s: %w", videoID, err)
+	}
+
+	// follow the progress of the video on the job reporting it
+	if pj := job.ProgressJob(u.pipeline, js); pj != nil {
+		if err = v.TrackProgress(pj.ID); err != nil {
+			return nil, fmt.Errorf("track progress of video %s: %w", videoID, err)
+		}
 	}
 
 	// initialize unit of work
@@ -75,6 +85,7 @@ func (u *uploadVideoUsecase) Execute(ctx context.Context, input UploadVideoInput
 	// initialize repos
 	videoRepo := uow.VideoRepo()
 	jobRepo := uow.JobRepo()
+	outboxRepo := uow.OutboxRepo()
 
 	// save to video repo
 	err = videoRepo.Save(ctx, v)
@@ -82,10 +93,18 @@ func (u *uploadVideoUsecase) Execute(ctx context.Context, input UploadVideoInput
 		return nil, fmt.Errorf("save video %s in db: %w", videoID, err)
 	}
 
-	// save to job repo
-	err = jobRepo.Save(ctx, j)
+	// save to job repo, dependencies first
+	for _, j := range js {
+		err = jobRepo.Save(ctx, j)
+		if err != nil {
+			return nil, fmt.Errorf("save job %s in db: %w", j.ID, err)
+		}
+	}
+
+	// record the upload, in the same transaction
+	err = recordVideoEvent(ctx, outboxRepo, event.TypeVideoUploaded, v)
 	if err != nil {
-		return nil, fmt.Errorf("save job %s in db: %w", jobID, err)
+		return nil, err
 	}
 
 	err = uow.Commit(ctx)
@@ -93,5 +112,5 @@ func (u *uploadVideoUsecase) Execute(ctx context.Context, input UploadVideoInput
 		return nil, fmt.Errorf("finalize transaction: %w", err)
 	}
 
-	return &UploadVideoResult{Video: v, Job: j}, nil
+	return &UploadVideoResult{Video: v, Jobs: js}, nil
 }
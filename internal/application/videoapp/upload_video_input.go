@@ -3,6 +3,7 @@ package videoapp
 import "io"
 
 type UploadVideoInput struct {
+	OwnerID      string
 	Title        string
 	Description  string
 	FileName     string
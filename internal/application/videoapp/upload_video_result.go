@@ -7,5 +7,6 @@ import (
 
 type UploadVideoResult struct {
 	Video *video.Video
-	Job   *job.Job
+	// Jobs of the processing pipeline, dependencies first
+	Jobs []*job.Job
 }
@@ -9,10 +9,21 @@ import (
 	repoMocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
 	storageMocks "github.com/st-ember/streaming-api/internal/application/ports/storage/mocks"
 	"github.com/st-ember/streaming-api/internal/application/videoapp"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/job"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+// expectEvents expects the events of the specified types to be recorded in the outbox
+func expectEvents(m *repoMocks.MockOutboxRepo, types ...event.EventType) {
+	for _, eventType := range types {
+		m.EXPECT().Save(mock.Anything, mock.MatchedBy(func(e *event.Event) bool {
+			return e.Type == eventType
+		})).Return(nil).Once()
+	}
+}
+
 func TestUploadVideo_SuccessCase(t *testing.T) {
 	t.Parallel()
 
@@ -21,6 +32,7 @@ func TestUploadVideo_SuccessCase(t *testing.T) {
 	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
 	mockJobRepo := repoMocks.NewMockJobRepo(t)
 	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
 	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
 	mockLogger := logMocks.NewMockLogger(t)
 
@@ -39,23 +51,26 @@ func TestUploadVideo_SuccessCase(t *testing.T) {
 	// Unit of Work expectations
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	expectEvents(mockOutboxRepo, event.TypeVideoUploaded)
 
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil) // will not run but expected due to defer func
 	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
 
 	// Repo expectations
 	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
-	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Times(3)
 
 	// Mock input
 	input := videoapp.UploadVideoInput{
+		OwnerID:      "owner-id",
 		Title:        "My Test Video",
 		Description:  "A video for testing.",
 		FileName:     "test.mp4",
 		VideoContent: strings.NewReader("fake video data"),
 	}
 	// Create usecase
-	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger)
+	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger, job.VideoPipeline)
 
 	// Execute usecase
 	resp, err := usecase.Execute(t.Context(), input)
@@ -63,6 +78,56 @@ func TestUploadVideo_SuccessCase(t *testing.T) {
 	// --- Assert ---
 	require.NoError(t, err)
 	require.NotNil(t, resp)
+	require.Equal(t, "owner-id", resp.Video.OwnerID)
+	// Progress is followed on the transcode job
+	require.Equal(t, resp.Jobs[2].ID, resp.Video.ProgressJobID)
+	require.Equal(t, job.TypeTranscode, resp.Jobs[2].Type)
+}
+
+func TestUploadVideo_ChunkedPipelineQueuesNoChunk(t *testing.T) {
+	t.Parallel()
+
+	// Set up mocks
+	mockAsssetStorer := storageMocks.NewMockAssetStorer(t)
+	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
+	mockJobRepo := repoMocks.NewMockJobRepo(t)
+	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
+	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
+	mockLogger := logMocks.NewMockLogger(t)
+
+	mockAsssetStorer.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
+	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	expectEvents(mockOutboxRepo, event.TypeVideoUploaded)
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil)
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
+
+	// Chunk jobs are only created once the source has been split, the assemble job waits on the split
+	for _, jobType := range []job.JobType{job.TypeProbe, job.TypeThumbnail, job.TypeSplit, job.TypeAssemble} {
+		mockJobRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(j *job.Job) bool {
+			return j.Type == jobType
+		})).Return(nil).Once()
+	}
+
+	input := videoapp.UploadVideoInput{
+		OwnerID:      "owner-id",
+		Title:        "My Test Video",
+		FileName:     "test.mp4",
+		VideoContent: strings.NewReader("fake video data"),
+	}
+	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger, job.ChunkedVideoPipeline)
+
+	resp, err := usecase.Execute(t.Context(), input)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Jobs, 4)
+	// Progress of the chunks is followed on the assemble job from the start
+	require.Equal(t, resp.Jobs[3].ID, resp.Video.ProgressJobID)
+	require.Equal(t, job.TypeAssemble, resp.Jobs[3].Type)
 }
 
 func TestUploadVideo_AssetStorerSaveFail(t *testing.T) {
@@ -82,13 +147,14 @@ func TestUploadVideo_AssetStorerSaveFail(t *testing.T) {
 
 	// Mock input
 	input := videoapp.UploadVideoInput{
+		OwnerID:      "owner-id",
 		Title:        "My Test Video",
 		Description:  "A video for testing.",
 		FileName:     "test.mp4",
 		VideoContent: strings.NewReader("fake video data"),
 	}
 	// Create usecase
-	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger)
+	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger, job.VideoPipeline)
 
 	// Execute usecase
 	resp, err := usecase.Execute(t.Context(), input)
@@ -126,13 +192,14 @@ func TestUploadVideo_UOWFactoryReturnsError(t *testing.T) {
 
 	// Mock input
 	input := videoapp.UploadVideoInput{
+		OwnerID:      "owner-id",
 		Title:        "My Test Video",
 		Description:  "A video for testing.",
 		FileName:     "test.mp4",
 		VideoContent: strings.NewReader("fake video data"),
 	}
 	// Create usecase
-	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger)
+	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger, job.VideoPipeline)
 
 	// Execute usecase
 	resp, err := usecase.Execute(t.Context(), input)
@@ -151,6 +218,7 @@ func TestUploadVideo_VideoRepoSaveFail(t *testing.T) {
 	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
 	mockJobRepo := repoMocks.NewMockJobRepo(t)
 	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
 	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
 	mockLogger := logMocks.NewMockLogger(t)
 
@@ -172,6 +240,7 @@ func TestUploadVideo_VideoRepoSaveFail(t *testing.T) {
 	// Unit of Work expectations
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
 
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil)
 
@@ -183,13 +252,14 @@ func TestUploadVideo_VideoRepoSaveFail(t *testing.T) {
 
 	// Mock input
 	input := videoapp.UploadVideoInput{
+		OwnerID:      "owner-id",
 		Title:        "My Test Video",
 		Description:  "A video for testing.",
 		FileName:     "test.mp4",
 		VideoContent: strings.NewReader("fake video data"),
 	}
 	// Create usecase
-	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger)
+	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger, job.VideoPipeline)
 
 	// Execute usecase
 	resp, err := usecase.Execute(t.Context(), input)
@@ -208,6 +278,7 @@ func TestUploadVideo_JobRepoSaveFail(t *testing.T) {
 	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
 	mockJobRepo := repoMocks.NewMockJobRepo(t)
 	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
 	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
 	mockLogger := logMocks.NewMockLogger(t)
 
@@ -229,6 +300,7 @@ func TestUploadVideo_JobRepoSaveFail(t *testing.T) {
 	// Unit of Work expectations
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
 
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil)
 
@@ -242,13 +314,14 @@ func TestUploadVideo_JobRepoSaveFail(t *testing.T) {
 
 	// Mock input
 	input := videoapp.UploadVideoInput{
+		OwnerID:      "owner-id",
 		Title:        "My Test Video",
 		Description:  "A video for testing.",
 		FileName:     "test.mp4",
 		VideoContent: strings.NewReader("fake video data"),
 	}
 	// Create usecase
-	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger)
+	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger, job.VideoPipeline)
 
 	// Execute usecase
 	resp, err := usecase.Execute(t.Context(), input)
@@ -267,6 +340,7 @@ func TestUploadVideo_UOWCommitFail(t *testing.T) {
 	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
 	mockJobRepo := repoMocks.NewMockJobRepo(t)
 	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
 	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
 	mockLogger := logMocks.NewMockLogger(t)
 
@@ -288,6 +362,8 @@ func TestUploadVideo_UOWCommitFail(t *testing.T) {
 	// Unit of Work expectations
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	expectEvents(mockOutboxRepo, event.TypeVideoUploaded)
 
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil) // will not run but expected due to defer func
 
@@ -296,17 +372,18 @@ func TestUploadVideo_UOWCommitFail(t *testing.T) {
 
 	// Repo expectations
 	mockVideoRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*video.Video")).Return(nil).Once()
-	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Once()
+	mockJobRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*job.Job")).Return(nil).Times(3)
 
 	// Mock input
 	input := videoapp.UploadVideoInput{
+		OwnerID:      "owner-id",
 		Title:        "My Test Video",
 		Description:  "A video for testing.",
 		FileName:     "test.mp4",
 		VideoContent: strings.NewReader("fake video data"),
 	}
 	// Create usecase
-	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger)
+	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger, job.VideoPipeline)
 
 	// Execute usecase
 	resp, err := usecase.Execute(t.Context(), input)
@@ -325,6 +402,7 @@ func TestUploadVideo_CleanupFailsAfterRepoSaveFails(t *testing.T) {
 	mockVideoRepo := repoMocks.NewMockVideoRepo(t)
 	mockJobRepo := repoMocks.NewMockJobRepo(t)
 	mockUow := repoMocks.NewMockUnitOfWork(t)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepo(t)
 	mockUowFactory := repoMocks.NewMockUnitOfWorkFactory(t)
 	mockLogger := logMocks.NewMockLogger(t)
 
@@ -349,6 +427,7 @@ func TestUploadVideo_CleanupFailsAfterRepoSaveFails(t *testing.T) {
 	// Unit of Work expectations
 	mockUow.EXPECT().VideoRepo().Return(mockVideoRepo)
 	mockUow.EXPECT().JobRepo().Return(mockJobRepo)
+	mockUow.EXPECT().OutboxRepo().Return(mockOutboxRepo)
 
 	mockUow.EXPECT().Rollback(mock.Anything).Return(nil) // will not run but expected due to defer func
 
@@ -361,13 +440,14 @@ func TestUploadVideo_CleanupFailsAfterRepoSaveFails(t *testing.T) {
 
 	// Mock input
 	input := videoapp.UploadVideoInput{
+		OwnerID:      "owner-id",
 		Title:        "My Test Video",
 		Description:  "A video for testing.",
 		FileName:     "test.mp4",
 		VideoContent: strings.NewReader("fake video data"),
 	}
 	// Create usecase
-	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger)
+	usecase := videoapp.NewUploadVideoUsecase(mockAsssetStorer, mockUowFactory, mockLogger, job.VideoPipeline)
 
 	// Execute usecase
 	resp, err := usecase.Execute(t.Context(), input)
@@ -1,9 +1,10 @@
 package videoapp
 
 type VideoUsecase struct {
-	Upload  UploadVideoUsecase
-	GetInfo GetVideoInfoUsecase
-	Update  UpdateVideoUsecase
-	Archive ArchiveVideoUsecase
-	List    ListVideosUsecase
+	Upload          UploadVideoUsecase
+	GetInfo         GetVideoInfoUsecase
+	Update          UpdateVideoUsecase
+	Archive         ArchiveVideoUsecase
+	List            ListVideosUsecase
+	SchedulePublish SchedulePublishUsecase
 }
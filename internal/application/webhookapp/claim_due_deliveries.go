@@ -0,0 +1,72 @@
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+// ClaimDueDeliveriesUsecase leases the due deliveries for an attempt.
+// The lease is committed before anything is sent, so a delivery is not sent twice at once;
+// an attempt whose result is never recorded is retried once the lease expires.
+type ClaimDueDeliveriesUsecase interface {
+	Execute(ctx context.Context, now time.Time, limit int) ([]*ClaimedDelivery, error)
+}
+
+type claimDueDeliveriesUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	lease      time.Duration
+}
+
+func NewClaimDueDeliveriesUsecase(uowFactory repo.UnitOfWorkFactory, lease time.Duration) ClaimDueDeliveriesUsecase {
+	return &claimDueDeliveriesUsecase{uowFactory, lease}
+}
+
+func (u *claimDueDeliveriesUsecase) Execute(ctx context.Context, now time.Time, limit int) ([]*ClaimedDelivery, error) {
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Initialize repos
+	webhookRepo := uow.WebhookRepo()
+
+	ds, err := webhookRepo.ClaimDueDeliveries(ctx, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("claim due deliveries: %w", err)
+	}
+
+	subscriptions := map[string]*webhook.Subscription{}
+	claimed := make([]*ClaimedDelivery, 0, len(ds))
+	for _, d := range ds {
+		s, ok := subscriptions[d.SubscriptionID]
+		if !ok {
+			s, err = webhookRepo.FindSubscriptionByID(ctx, d.SubscriptionID)
+			if err != nil {
+				return nil, fmt.Errorf("find subscription %s: %w", d.SubscriptionID, err)
+			}
+			subscriptions[d.SubscriptionID] = s
+		}
+
+		// Update and persist entities
+		if err := d.Claim(now, u.lease); err != nil {
+			return nil, fmt.Errorf("claim delivery %s: %w", d.ID, err)
+		}
+		if err := webhookRepo.SaveDelivery(ctx, d); err != nil {
+			return nil, fmt.Errorf("save delivery %s in db: %w", d.ID, err)
+		}
+
+		claimed = append(claimed, &ClaimedDelivery{Delivery: d, Subscription: s})
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return claimed, nil
+}
@@ -0,0 +1,9 @@
+package webhookapp
+
+import "github.com/st-ember/streaming-api/internal/domain/webhook"
+
+// ClaimedDelivery is a delivery leased for an attempt, along with its subscription
+type ClaimedDelivery struct {
+	Delivery     *webhook.Delivery
+	Subscription *webhook.Subscription
+}
@@ -0,0 +1,66 @@
+package webhookapp_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimDueDeliveries_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	s, _ := webhook.NewSubscription("sub-1", "https://example.com/hook", "secret", nil)
+	uploaded, _ := event.NewEvent("event-1", event.TypeVideoUploaded, "video-1", nil)
+	published, _ := event.NewEvent("event-2", event.TypeVideoPublished, "video-1", nil)
+	first, _ := webhook.NewDelivery("delivery-1", s, uploaded)
+	second, _ := webhook.NewDelivery("delivery-2", s, published)
+	now := time.Now()
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().ClaimDueDeliveries(mock.Anything, now, 10).Return([]*webhook.Delivery{first, second}, nil).Once()
+	// The subscription is loaded once for both deliveries
+	mockWebhookRepo.EXPECT().FindSubscriptionByID(mock.Anything, "sub-1").Return(s, nil).Once()
+	mockWebhookRepo.EXPECT().SaveDelivery(mock.Anything, first).Return(nil).Once()
+	mockWebhookRepo.EXPECT().SaveDelivery(mock.Anything, second).Return(nil).Once()
+
+	usecase := webhookapp.NewClaimDueDeliveriesUsecase(mockUowFactory, time.Minute)
+	claimed, err := usecase.Execute(t.Context(), now, 10)
+
+	require.NoError(t, err)
+	require.Len(t, claimed, 2)
+	require.Same(t, s, claimed[0].Subscription)
+	require.Equal(t, 1, first.Attempts)
+	require.Equal(t, now.Add(time.Minute).UTC(), first.NextAttemptAt)
+}
+
+func TestClaimDueDeliveries_FailsOnCommit(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	expectedErr := errors.New("commit failed")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(expectedErr).Once()
+	mockWebhookRepo.EXPECT().ClaimDueDeliveries(mock.Anything, mock.Anything, 10).Return([]*webhook.Delivery{}, nil).Once()
+
+	usecase := webhookapp.NewClaimDueDeliveriesUsecase(mockUowFactory, time.Minute)
+	_, err := usecase.Execute(t.Context(), time.Now(), 10)
+
+	require.ErrorIs(t, err, expectedErr)
+}
@@ -0,0 +1,61 @@
+package webhookapp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+// secretBytes is the size of generated secrets, before hex encoding
+const secretBytes = 32
+
+type CreateSubscriptionUsecase interface {
+	Execute(ctx context.Context, input CreateSubscriptionInput) (*webhook.Subscription, error)
+}
+
+type createSubscriptionUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewCreateSubscriptionUsecase(uowFactory repo.UnitOfWorkFactory) CreateSubscriptionUsecase {
+	return &createSubscriptionUsecase{uowFactory}
+}
+
+func (u *createSubscriptionUsecase) Execute(ctx context.Context, input CreateSubscriptionInput) (*webhook.Subscription, error) {
+	secret := input.Secret
+	if secret == "" {
+		b := make([]byte, secretBytes)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("generate subscription secret: %w", err)
+		}
+		secret = hex.EncodeToString(b)
+	}
+
+	s, err := webhook.NewSubscription(uuid.NewString(), input.URL, secret, input.EventTypes)
+	if err != nil {
+		return nil, fmt.Errorf("create subscription entity: %w", err)
+	}
+
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Persist entities
+	if err := uow.WebhookRepo().SaveSubscription(ctx, s); err != nil {
+		return nil, fmt.Errorf("save subscription %s in db: %w", s.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return s, nil
+}
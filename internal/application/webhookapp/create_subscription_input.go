@@ -0,0 +1,9 @@
+package webhookapp
+
+import "github.com/st-ember/streaming-api/internal/domain/event"
+
+type CreateSubscriptionInput struct {
+	URL        string
+	Secret     string // Generated when empty
+	EventTypes []event.EventType
+}
@@ -0,0 +1,87 @@
+package webhookapp_test
+
+import (
+	"errors"
+	"testing"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSubscription_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().SaveSubscription(mock.Anything, mock.AnythingOfType("*webhook.Subscription")).Return(nil).Once()
+
+	usecase := webhookapp.NewCreateSubscriptionUsecase(mockUowFactory)
+	s, err := usecase.Execute(t.Context(), webhookapp.CreateSubscriptionInput{
+		URL:        "https://example.com/hook",
+		EventTypes: []event.EventType{event.TypeVideoPublished},
+	})
+
+	require.NoError(t, err)
+	require.NotEmpty(t, s.ID)
+	require.Len(t, s.Secret, 64)
+	require.True(t, s.Active)
+}
+
+func TestCreateSubscription_KeepsProvidedSecret(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().SaveSubscription(mock.Anything, mock.Anything).Return(nil).Once()
+
+	usecase := webhookapp.NewCreateSubscriptionUsecase(mockUowFactory)
+	s, err := usecase.Execute(t.Context(), webhookapp.CreateSubscriptionInput{
+		URL:    "https://example.com/hook",
+		Secret: "my-secret",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "my-secret", s.Secret)
+}
+
+func TestCreateSubscription_InvalidURL(t *testing.T) {
+	t.Parallel()
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	usecase := webhookapp.NewCreateSubscriptionUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), webhookapp.CreateSubscriptionInput{URL: "ftp://example.com"})
+
+	require.ErrorIs(t, err, webhook.ErrURLInvalid)
+}
+
+func TestCreateSubscription_FailsOnSave(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	expectedErr := errors.New("db error")
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().SaveSubscription(mock.Anything, mock.Anything).Return(expectedErr).Once()
+
+	usecase := webhookapp.NewCreateSubscriptionUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), webhookapp.CreateSubscriptionInput{URL: "https://example.com/hook"})
+
+	require.ErrorIs(t, err, expectedErr)
+}
@@ -0,0 +1,39 @@
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+)
+
+type DeleteSubscriptionUsecase interface {
+	Execute(ctx context.Context, id string) error
+}
+
+type deleteSubscriptionUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewDeleteSubscriptionUsecase(uowFactory repo.UnitOfWorkFactory) DeleteSubscriptionUsecase {
+	return &deleteSubscriptionUsecase{uowFactory}
+}
+
+// Execute deletes the subscription along with its delivery log
+func (u *deleteSubscriptionUsecase) Execute(ctx context.Context, id string) error {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	if err := uow.WebhookRepo().DeleteSubscription(ctx, id); err != nil {
+		return fmt.Errorf("delete subscription %s: %w", id, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
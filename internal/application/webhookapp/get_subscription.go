@@ -0,0 +1,36 @@
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+type GetSubscriptionUsecase interface {
+	Execute(ctx context.Context, id string) (*webhook.Subscription, error)
+}
+
+type getSubscriptionUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewGetSubscriptionUsecase(uowFactory repo.UnitOfWorkFactory) GetSubscriptionUsecase {
+	return &getSubscriptionUsecase{uowFactory}
+}
+
+func (u *getSubscriptionUsecase) Execute(ctx context.Context, id string) (*webhook.Subscription, error) {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Close(ctx)
+
+	s, err := uow.WebhookRepo().FindSubscriptionByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("find subscription %s: %w", id, err)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,44 @@
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+type ListDeliveriesUsecase interface {
+	// Execute returns a page of the delivery log of the subscription, newest first
+	Execute(ctx context.Context, subscriptionID string, page int) ([]*webhook.Delivery, error)
+}
+
+type listDeliveriesUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewListDeliveriesUsecase(uowFactory repo.UnitOfWorkFactory) ListDeliveriesUsecase {
+	return &listDeliveriesUsecase{uowFactory}
+}
+
+func (u *listDeliveriesUsecase) Execute(ctx context.Context, subscriptionID string, page int) ([]*webhook.Delivery, error) {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Close(ctx)
+
+	webhookRepo := uow.WebhookRepo()
+
+	// Distinguish an unknown subscription from an empty log
+	if _, err := webhookRepo.FindSubscriptionByID(ctx, subscriptionID); err != nil {
+		return nil, fmt.Errorf("find subscription %s: %w", subscriptionID, err)
+	}
+
+	ds, err := webhookRepo.ListDeliveries(ctx, subscriptionID, page)
+	if err != nil {
+		return nil, fmt.Errorf("list deliveries of subscription %s: %w", subscriptionID, err)
+	}
+
+	return ds, nil
+}
@@ -0,0 +1,36 @@
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+type ListSubscriptionsUsecase interface {
+	Execute(ctx context.Context) ([]*webhook.Subscription, error)
+}
+
+type listSubscriptionsUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewListSubscriptionsUsecase(uowFactory repo.UnitOfWorkFactory) ListSubscriptionsUsecase {
+	return &listSubscriptionsUsecase{uowFactory}
+}
+
+func (u *listSubscriptionsUsecase) Execute(ctx context.Context) ([]*webhook.Subscription, error) {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Close(ctx)
+
+	ss, err := uow.WebhookRepo().ListSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w", err)
+	}
+
+	return ss, nil
+}
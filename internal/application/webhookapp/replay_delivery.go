@@ -0,0 +1,54 @@
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+type ReplayDeliveryUsecase interface {
+	// Execute schedules the delivery to be sent again right away
+	Execute(ctx context.Context, subscriptionID, deliveryID string) (*webhook.Delivery, error)
+}
+
+type replayDeliveryUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewReplayDeliveryUsecase(uowFactory repo.UnitOfWorkFactory) ReplayDeliveryUsecase {
+	return &replayDeliveryUsecase{uowFactory}
+}
+
+func (u *replayDeliveryUsecase) Execute(ctx context.Context, subscriptionID, deliveryID string) (*webhook.Delivery, error) {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	webhookRepo := uow.WebhookRepo()
+
+	d, err := webhookRepo.FindDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("find delivery %s: %w", deliveryID, err)
+	}
+
+	if d.SubscriptionID != subscriptionID {
+		return nil, fmt.Errorf("replay delivery %s: %w", deliveryID, webhook.ErrDeliveryOfOtherSubscription)
+	}
+
+	d.Replay(time.Now())
+
+	if err := webhookRepo.SaveDelivery(ctx, d); err != nil {
+		return nil, fmt.Errorf("save delivery %s in db: %w", d.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return d, nil
+}
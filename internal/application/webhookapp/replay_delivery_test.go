@@ -0,0 +1,73 @@
+package webhookapp_test
+
+import (
+	"testing"
+	"time"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newFailedDelivery(t *testing.T, s *webhook.Subscription) *webhook.Delivery {
+	t.Helper()
+	e, _ := event.NewEvent("event-1", event.TypeVideoPublished, "video-1", nil)
+	d, err := webhook.NewDelivery("delivery-1", s, e)
+	require.NoError(t, err)
+
+	for d.IsPending() {
+		now := d.NextAttemptAt
+		require.NoError(t, d.Claim(now, time.Minute))
+		require.NoError(t, d.Fail(500, "boom", now))
+	}
+
+	return d
+}
+
+func TestReplayDelivery_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	s, _ := webhook.NewSubscription("sub-1", "https://example.com/hook", "secret", nil)
+	d := newFailedDelivery(t, s)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().FindDeliveryByID(mock.Anything, "delivery-1").Return(d, nil).Once()
+	mockWebhookRepo.EXPECT().SaveDelivery(mock.Anything, d).Return(nil).Once()
+
+	usecase := webhookapp.NewReplayDeliveryUsecase(mockUowFactory)
+	replayed, err := usecase.Execute(t.Context(), "sub-1", "delivery-1")
+
+	require.NoError(t, err)
+	require.True(t, replayed.IsPending())
+	require.Zero(t, replayed.Attempts)
+}
+
+func TestReplayDelivery_OfOtherSubscription(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	s, _ := webhook.NewSubscription("sub-1", "https://example.com/hook", "secret", nil)
+	d := newFailedDelivery(t, s)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().FindDeliveryByID(mock.Anything, "delivery-1").Return(d, nil).Once()
+
+	usecase := webhookapp.NewReplayDeliveryUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), "sub-2", "delivery-1")
+
+	require.ErrorIs(t, err, webhook.ErrDeliveryOfOtherSubscription)
+	require.True(t, d.IsFailed())
+}
@@ -0,0 +1,87 @@
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/application/ports/webhooksender"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+// Headers of a delivery request
+const (
+	HeaderID        = "X-Webhook-ID"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// SendDeliveryUsecase makes an attempt of a claimed delivery and records its result.
+// Any 2xx response is a success, other responses and transport errors are retried.
+type SendDeliveryUsecase interface {
+	Execute(ctx context.Context, cd *ClaimedDelivery) error
+}
+
+type sendDeliveryUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+	sender     webhooksender.Sender
+}
+
+func NewSendDeliveryUsecase(uowFactory repo.UnitOfWorkFactory, sender webhooksender.Sender) SendDeliveryUsecase {
+	return &sendDeliveryUsecase{uowFactory, sender}
+}
+
+func (u *sendDeliveryUsecase) Execute(ctx context.Context, cd *ClaimedDelivery) error {
+	d, s := cd.Delivery, cd.Subscription
+
+	// Send outside of the transaction, the claim keeps other dispatchers away
+	body := []byte(d.Payload)
+	timestamp := time.Now()
+	code, sendErr := u.sender.Send(ctx, webhooksender.Request{
+		URL: s.URL,
+		Headers: map[string]string{
+			"Content-Type":  "application/json",
+			HeaderID:        d.EventID,
+			HeaderEvent:     string(d.EventType),
+			HeaderTimestamp: strconv.FormatInt(timestamp.Unix(), 10),
+			HeaderSignature: webhook.Sign(s.Secret, timestamp, body),
+		},
+		Body: body,
+	})
+
+	// Update entities
+	now := time.Now()
+	var err error
+	switch {
+	case sendErr != nil:
+		err = d.Fail(0, sendErr.Error(), now)
+	case code < 200 || code > 299:
+		err = d.Fail(code, fmt.Sprintf("unexpected status code %d", code), now)
+	default:
+		err = d.Succeed(code, now)
+	}
+	if err != nil {
+		return fmt.Errorf("record result of delivery %s: %w", d.ID, err)
+	}
+
+	// Initialize unit of work
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	// Persist entities
+	if err := uow.WebhookRepo().SaveDelivery(ctx, d); err != nil {
+		return fmt.Errorf("save delivery %s in db: %w", d.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return nil
+}
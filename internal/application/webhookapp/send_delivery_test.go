@@ -0,0 +1,113 @@
+package webhookapp_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/ports/webhooksender"
+	sendermocks "github.com/st-ember/streaming-api/internal/application/ports/webhooksender/mocks"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newClaimedDelivery(t *testing.T) *webhookapp.ClaimedDelivery {
+	t.Helper()
+	s, _ := webhook.NewSubscription("sub-1", "https://example.com/hook", "secret", nil)
+	e, _ := event.NewEvent("event-1", event.TypeVideoPublished, "video-1", nil)
+	d, _ := webhook.NewDelivery("delivery-1", s, e)
+	require.NoError(t, d.Claim(time.Now(), time.Minute))
+
+	return &webhookapp.ClaimedDelivery{Delivery: d, Subscription: s}
+}
+
+func TestSendDelivery_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockSender := sendermocks.NewMockSender(t)
+
+	cd := newClaimedDelivery(t)
+
+	mockSender.EXPECT().Send(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req webhooksender.Request) (int, error) {
+			require.Equal(t, "https://example.com/hook", req.URL)
+			require.Equal(t, "event-1", req.Headers[webhookapp.HeaderID])
+			require.Equal(t, "video.published", req.Headers[webhookapp.HeaderEvent])
+
+			// The signature verifies against the timestamp header
+			unix, err := strconv.ParseInt(req.Headers[webhookapp.HeaderTimestamp], 10, 64)
+			require.NoError(t, err)
+			require.Equal(t, webhook.Sign("secret", time.Unix(unix, 0), req.Body), req.Headers[webhookapp.HeaderSignature])
+
+			return 204, nil
+		}).Once()
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().SaveDelivery(mock.Anything, cd.Delivery).Return(nil).Once()
+
+	usecase := webhookapp.NewSendDeliveryUsecase(mockUowFactory, mockSender)
+	err := usecase.Execute(t.Context(), cd)
+
+	require.NoError(t, err)
+	require.True(t, cd.Delivery.IsSucceeded())
+	require.Equal(t, 204, cd.Delivery.LastStatusCode)
+}
+
+func TestSendDelivery_SchedulesRetryOnErrorStatus(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockSender := sendermocks.NewMockSender(t)
+
+	cd := newClaimedDelivery(t)
+
+	mockSender.EXPECT().Send(mock.Anything, mock.Anything).Return(503, nil).Once()
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().SaveDelivery(mock.Anything, cd.Delivery).Return(nil).Once()
+
+	usecase := webhookapp.NewSendDeliveryUsecase(mockUowFactory, mockSender)
+	err := usecase.Execute(t.Context(), cd)
+
+	require.NoError(t, err)
+	require.True(t, cd.Delivery.IsPending())
+	require.Equal(t, 503, cd.Delivery.LastStatusCode)
+	require.True(t, cd.Delivery.NextAttemptAt.After(time.Now()))
+}
+
+func TestSendDelivery_RecordsTransportError(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+	mockSender := sendermocks.NewMockSender(t)
+
+	cd := newClaimedDelivery(t)
+
+	mockSender.EXPECT().Send(mock.Anything, mock.Anything).Return(0, errors.New("connection refused")).Once()
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().SaveDelivery(mock.Anything, cd.Delivery).Return(nil).Once()
+
+	usecase := webhookapp.NewSendDeliveryUsecase(mockUowFactory, mockSender)
+	err := usecase.Execute(t.Context(), cd)
+
+	require.NoError(t, err)
+	require.Equal(t, "connection refused", cd.Delivery.LastError)
+	require.Zero(t, cd.Delivery.LastStatusCode)
+}
@@ -0,0 +1,62 @@
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+)
+
+type UpdateSubscriptionUsecase interface {
+	Execute(ctx context.Context, input UpdateSubscriptionInput) (*webhook.Subscription, error)
+}
+
+type updateSubscriptionUsecase struct {
+	uowFactory repo.UnitOfWorkFactory
+}
+
+func NewUpdateSubscriptionUsecase(uowFactory repo.UnitOfWorkFactory) UpdateSubscriptionUsecase {
+	return &updateSubscriptionUsecase{uowFactory}
+}
+
+func (u *updateSubscriptionUsecase) Execute(ctx context.Context, input UpdateSubscriptionInput) (*webhook.Subscription, error) {
+	uow, err := u.uowFactory.NewUnitOfWork(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialize unit of work: %w", err)
+	}
+	defer uow.Rollback(ctx)
+
+	webhookRepo := uow.WebhookRepo()
+
+	s, err := webhookRepo.FindSubscriptionByID(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("find subscription %s: %w", input.ID, err)
+	}
+
+	if input.URL != nil {
+		if err := s.UpdateURL(*input.URL); err != nil {
+			return nil, fmt.Errorf("update subscription entity %s url: %w", s.ID, err)
+		}
+	}
+
+	if input.EventTypes != nil {
+		if err := s.UpdateEventTypes(*input.EventTypes); err != nil {
+			return nil, fmt.Errorf("update subscription entity %s event types: %w", s.ID, err)
+		}
+	}
+
+	if input.Active != nil {
+		s.SetActive(*input.Active)
+	}
+
+	if err := webhookRepo.SaveSubscription(ctx, s); err != nil {
+		return nil, fmt.Errorf("save subscription %s: %w", s.ID, err)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("finalize transaction %w", err)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,10 @@
+package webhookapp
+
+import "github.com/st-ember/streaming-api/internal/domain/event"
+
+type UpdateSubscriptionInput struct {
+	ID         string
+	URL        *string
+	EventTypes *[]event.EventType
+	Active     *bool
+}
@@ -0,0 +1,84 @@
+package webhookapp_test
+
+import (
+	"database/sql"
+	"testing"
+
+	repomocks "github.com/st-ember/streaming-api/internal/application/ports/repo/mocks"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSubscription_SuccessCase(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	s, _ := webhook.NewSubscription("sub-1", "https://example.com/hook", "secret", nil)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockUow.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().FindSubscriptionByID(mock.Anything, "sub-1").Return(s, nil).Once()
+	mockWebhookRepo.EXPECT().SaveSubscription(mock.Anything, s).Return(nil).Once()
+
+	url := "https://example.com/other"
+	eventTypes := []event.EventType{event.TypeVideoFailed}
+	active := false
+
+	usecase := webhookapp.NewUpdateSubscriptionUsecase(mockUowFactory)
+	updated, err := usecase.Execute(t.Context(), webhookapp.UpdateSubscriptionInput{
+		ID:         "sub-1",
+		URL:        &url,
+		EventTypes: &eventTypes,
+		Active:     &active,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, url, updated.URL)
+	require.Equal(t, eventTypes, updated.EventTypes)
+	require.False(t, updated.Active)
+}
+
+func TestUpdateSubscription_InvalidEventType(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	s, _ := webhook.NewSubscription("sub-1", "https://example.com/hook", "secret", nil)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().FindSubscriptionByID(mock.Anything, "sub-1").Return(s, nil).Once()
+
+	eventTypes := []event.EventType{"video.unknown"}
+
+	usecase := webhookapp.NewUpdateSubscriptionUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), webhookapp.UpdateSubscriptionInput{ID: "sub-1", EventTypes: &eventTypes})
+
+	require.ErrorIs(t, err, webhook.ErrEventTypeInvalid)
+}
+
+func TestUpdateSubscription_NotFound(t *testing.T) {
+	t.Parallel()
+	mockWebhookRepo := repomocks.NewMockWebhookRepo(t)
+	mockUow := repomocks.NewMockUnitOfWork(t)
+	mockUowFactory := repomocks.NewMockUnitOfWorkFactory(t)
+
+	mockUowFactory.EXPECT().NewUnitOfWork(mock.Anything).Return(mockUow, nil).Once()
+	mockUow.EXPECT().WebhookRepo().Return(mockWebhookRepo).Once()
+	mockUow.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	mockWebhookRepo.EXPECT().FindSubscriptionByID(mock.Anything, "sub-1").Return(nil, sql.ErrNoRows).Once()
+
+	usecase := webhookapp.NewUpdateSubscriptionUsecase(mockUowFactory)
+	_, err := usecase.Execute(t.Context(), webhookapp.UpdateSubscriptionInput{ID: "sub-1"})
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
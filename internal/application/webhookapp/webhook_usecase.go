@@ -0,0 +1,11 @@
+package webhookapp
+
+type WebhookUsecase struct {
+	Create         CreateSubscriptionUsecase
+	Get            GetSubscriptionUsecase
+	List           ListSubscriptionsUsecase
+	Update         UpdateSubscriptionUsecase
+	Delete         DeleteSubscriptionUsecase
+	ListDeliveries ListDeliveriesUsecase
+	Replay         ReplayDeliveryUsecase
+}
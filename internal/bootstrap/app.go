@@ -0,0 +1,480 @@
+// Package bootstrap wires the adapters and usecases shared by the api and worker commands.
+package bootstrap
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/adapter/driven/config"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/memorydenylist"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/denylist/redisdenylist"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/eventbus/logeventbus"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/eventbus/rediseventbus"
+	exec "github.com/st-ember/streaming-api/internal/adapter/driven/exec/os"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/hash"
+	redislogger "github.com/st-ember/streaming-api/internal/adapter/driven/log/redis_logger"
+	stdlogger "github.com/st-ember/streaming-api/internal/adapter/driven/log/std_logger"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/loginattempts/memoryloginattempts"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/loginattempts/redisloginattempts"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/mailsender/logmailsender"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/mailsender/smtpsender"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/oidc/httpoidc"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/progressstream/memoryprogressstream"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/progressstream/redisprogressstream"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/redis"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/repo/postgres"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/storage/local"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/token"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/transcode/ffmpeg"
+	"github.com/st-ember/streaming-api/internal/adapter/driven/webhooksender/httpsender"
+	adpHttp "github.com/st-ember/streaming-api/internal/adapter/driving/http"
+	"github.com/st-ember/streaming-api/internal/adapter/driving/worker"
+	"github.com/st-ember/streaming-api/internal/application/apikeyapp"
+	"github.com/st-ember/streaming-api/internal/application/authapp"
+	"github.com/st-ember/streaming-api/internal/application/eventapp"
+	"github.com/st-ember/streaming-api/internal/application/jobapp"
+	"github.com/st-ember/streaming-api/internal/application/ports/denylist"
+	"github.com/st-ember/streaming-api/internal/application/ports/eventbus"
+	logport "github.com/st-ember/streaming-api/internal/application/ports/log"
+	"github.com/st-ember/streaming-api/internal/application/ports/loginattempts"
+	"github.com/st-ember/streaming-api/internal/application/ports/mailsender"
+	"github.com/st-ember/streaming-api/internal/application/ports/progressstream"
+	"github.com/st-ember/streaming-api/internal/application/ports/repo"
+	"github.com/st-ember/streaming-api/internal/application/ports/storage"
+	tokenport "github.com/st-ember/streaming-api/internal/application/ports/token"
+	"github.com/st-ember/streaming-api/internal/application/progressapp"
+	"github.com/st-ember/streaming-api/internal/application/roleapp"
+	"github.com/st-ember/streaming-api/internal/application/scheduleapp"
+	"github.com/st-ember/streaming-api/internal/application/videoapp"
+	"github.com/st-ember/streaming-api/internal/application/webhookapp"
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+)
+
+// signingKeySyncInterval is how often api nodes reload the signing keys,
+// well within authapp.SigningKeyPropagation
+const signingKeySyncInterval = time.Minute
+
+// oidcTimeout bounds the requests to the OpenID Connect provider
+const oidcTimeout = 10 * time.Second
+
+// smtpTimeout bounds sending one mail, from connecting to the mail server to its reply
+const smtpTimeout = 10 * time.Second
+
+// App holds the driven adapters every node needs.
+// Driving adapters are built on demand so each command only starts its own.
+type App struct {
+	Config         *config.Config
+	DB             *postgres.DB
+	Logger         logport.Logger
+	Storer         storage.AssetStorer
+	ProgressStream progressstream.ProgressStreamer
+	ChunkProgress  progressstream.ChunkProgressStore
+	EventBus       eventbus.Publisher
+	Token          tokenport.Token
+	KeyGenerator   tokenport.KeyGenerator
+	Denylist       denylist.Denylist
+	LoginAttempts  loginattempts.Store
+	UowFactory     repo.UnitOfWorkFactory
+	AuthRepo       repo.AuthRepo
+}
+
+func New(cfg *config.Config) (*App, error) {
+	if cfg.ProgressStreamer != config.ProgressStreamerRedis && cfg.ProgressStreamer != config.ProgressStreamerMemory {
+		return nil, fmt.Errorf("unknown progress streamer %q", cfg.ProgressStreamer)
+	}
+
+	// Progress streamed in memory never leaves the process, so API nodes would not see the progress of workers
+	if cfg.ProgressStreamer == config.ProgressStreamerMemory && !cfg.Standalone {
+		return nil, fmt.Errorf("progress streamer %q only runs in standalone mode, use %q", cfg.ProgressStreamer, config.ProgressStreamerRedis)
+	}
+
+	if cfg.JwtAlgorithm != auth.SigningAlgorithmRS256 && cfg.JwtAlgorithm != auth.SigningAlgorithmEdDSA {
+		return nil, fmt.Errorf("unknown jwt algorithm %q", cfg.JwtAlgorithm)
+	}
+
+	// A key must outlive its propagation, or it would be replaced before signing
+	if cfg.JwtKeyRotation <= authapp.SigningKeyPropagation {
+		return nil, fmt.Errorf("jwt key rotation %s must exceed %s", cfg.JwtKeyRotation, authapp.SigningKeyPropagation)
+	}
+
+	// Signing keys are stored encrypted, every node opens them with the same key
+	kek, err := base64.StdEncoding.DecodeString(cfg.JwtKeyEncryption)
+	if err != nil {
+		return nil, fmt.Errorf("decode jwt key encryption key: %w", err)
+	}
+	sealer, err := token.NewKeySealer(kek)
+	if err != nil {
+		return nil, fmt.Errorf("jwt key encryption key: %w", err)
+	}
+
+	if cfg.UsesOIDC() && (cfg.OIDCClientID == "" || cfg.OIDCRedirectURL == "") {
+		return nil, fmt.Errorf("oidc issuer %s needs a client id and redirect url", cfg.OIDCIssuerURL)
+	}
+
+	// Driven adapter (Repo)
+	db, err := postgres.NewDB(cfg.ConnStr)
+	if err != nil {
+		return nil, fmt.Errorf("start db connection: %w", err)
+	}
+
+	// Driven adapters (Logger, Event Bus, Denylist, Login Attempts)
+	// Nodes running without Redis log to stderr, keep events to the outbox and webhooks,
+	// only reject the tokens of sessions they revoked themselves and count the failed logins they received
+	var (
+		rdb      *redis.Client
+		logger   logport.Logger
+		eventBus eventbus.Publisher
+		dl       denylist.Denylist
+		attempts loginattempts.Store
+	)
+	if cfg.UsesRedis() {
+		rdb, err = redis.NewClient(cfg.RedisAddrs, cfg.RedisPassword)
+		if err != nil {
+			db.Conn.Close()
+			return nil, fmt.Errorf("connect to redis client: %w", err)
+		}
+		logger = redislogger.NewRedisLogger(rdb)
+		eventBus = rediseventbus.NewRedisStreamPublisher(rdb)
+		dl = redisdenylist.NewRedisDenylist(rdb)
+		attempts = redisloginattempts.NewRedisStore(rdb)
+	} else {
+		logger = stdlogger.NewStdLogger()
+		eventBus = logeventbus.NewLogPublisher(logger)
+		dl = memorydenylist.NewMemoryDenylist()
+		attempts = memoryloginattempts.NewMemoryStore()
+	}
+
+	// Driven adapter (Progress Streamer)
+	// Progress streamed in memory only reaches clients of the same process
+	var progressStream progressstream.ProgressStreamer
+	var chunkProgress progressstream.ChunkProgressStore
+	switch cfg.ProgressStreamer {
+	case config.ProgressStreamerMemory:
+		progressStream = memoryprogressstream.NewMemoryProgressStreamer(logger)
+		chunkProgress = memoryprogressstream.NewMemoryChunkProgressStore()
+	default:
+		progressStream = redisprogressstream.NewRedisProgressStreamer(rdb, logger)
+		chunkProgress = redisprogressstream.NewRedisChunkProgressStore(rdb)
+	}
+
+	// Driven adapter (Storer)
+	storer, err := local.NewLocalAssetStorer(cfg.StoragePath)
+	if err != nil {
+		db.Conn.Close()
+		return nil, fmt.Errorf("start storer: %w", err)
+	}
+
+	return &App{
+		Config:         cfg,
+		DB:             db,
+		Logger:         logger,
+		Storer:         storer,
+		ProgressStream: progressStream,
+		ChunkProgress:  chunkProgress,
+		EventBus:       eventBus,
+		Token:          token.NewJwtToken(cfg.RefreshSecret, sealer),
+		KeyGenerator:   token.NewKeyGenerator(sealer),
+		Denylist:       dl,
+		LoginAttempts:  attempts,
+		UowFactory:     postgres.NewPostgresUnitOfWorkFactory(db.Conn),
+		AuthRepo:       postgres.NewPostgresAuthRepo(db.Conn),
+	}, nil
+}
+
+// Close releases the db connection pool
+func (a *App) Close() {
+	a.DB.Conn.Close()
+}
+
+// SyncPermissions makes sure every permission known to the code exists in the db
+func (a *App) SyncPermissions(ctx context.Context) error {
+	return a.AuthRepo.SyncPermissions(ctx, auth.AllPermissions())
+}
+
+// SeedRoles creates the default roles missing from the db and grants them the default permissions new to them.
+// Permissions must be synced first.
+func (a *App) SeedRoles(ctx context.Context) error {
+	return roleapp.NewSeedRolesUsecase(a.UowFactory).Execute(ctx)
+}
+
+// BootstrapAdmin gives the admin role to the user set by BOOTSTRAP_ADMIN, roles must be seeded first.
+// A user who did not sign up or verify their email yet is only logged, they become admin on a later startup.
+func (a *App) BootstrapAdmin(ctx context.Context) error {
+	email := a.Config.BootstrapAdmin
+	if email == "" {
+		return nil
+	}
+
+	err := roleapp.NewBootstrapAdminUsecase(a.UowFactory).Execute(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, roleapp.ErrEmailNotVerified) {
+		a.Logger.Warnf(ctx, logport.CategoryAudit, email, "bootstrap admin not granted: %v", err)
+		return nil
+	}
+
+	return err
+}
+
+// SyncSigningKeys loads the keys signing access tokens, creating the first one if needed.
+// It must succeed before the api serves requests.
+func (a *App) SyncSigningKeys(ctx context.Context) error {
+	return a.syncSigningKeysUsecase().Execute(ctx)
+}
+
+// SigningKeySyncer builds the loop keeping the signing keys up to date and rotating them
+func (a *App) SigningKeySyncer() *worker.SigningKeySyncer {
+	return worker.NewSigningKeySyncer(a.syncSigningKeysUsecase(), a.Logger, signingKeySyncInterval)
+}
+
+func (a *App) syncSigningKeysUsecase() authapp.SyncSigningKeysUsecase {
+	return authapp.NewSyncSigningKeysUsecase(
+		a.UowFactory, a.Token, a.KeyGenerator,
+		a.Config.JwtAlgorithm, a.Config.JwtKeyRotation,
+	)
+}
+
+// APIRouter builds the public HTTP API
+func (a *App) APIRouter() *adpHttp.Router {
+	// Job Usecases
+	jobUCs := jobapp.JobUsecase{
+		Get:            jobapp.NewGetJobUsecase(a.UowFactory),
+		List:           jobapp.NewListJobsUsecase(a.UowFactory),
+		ListAttempts:   jobapp.NewListJobAttemptsUsecase(a.UowFactory),
+		Cancel:         jobapp.NewCancelJobUsecase(a.UowFactory),
+		Retry:          jobapp.NewRetryJobUsecase(a.UowFactory, a.ProgressStream),
+		UpdatePriority: jobapp.NewUpdateJobPriorityUsecase(a.UowFactory),
+	}
+
+	// Video Usecases
+	videoUCs := videoapp.VideoUsecase{
+		Upload:  videoapp.NewUploadVideoUsecase(a.Storer, a.UowFactory, a.Logger, a.pipeline()),
+		GetInfo: videoapp.NewGetVideoInfoUsecase(a.UowFactory),
+		Update:  videoapp.NewUpdateVideoUsecase(a.UowFactory),
+		Archive: videoapp.NewArchiveVideoUsecase(a.UowFactory),
+		List:    videoapp.NewListVideoUsecase(a.UowFactory),
+
+		SchedulePublish: videoapp.NewSchedulePublishUsecase(a.UowFactory),
+	}
+
+	// Task Usecases
+	taskUCs := scheduleapp.TaskUsecase{
+		Schedule: scheduleapp.NewScheduleTaskUsecase(a.UowFactory),
+	}
+
+	// Webhook Usecases
+	webhookUCs := webhookapp.WebhookUsecase{
+		Create:         webhookapp.NewCreateSubscriptionUsecase(a.UowFactory),
+		Get:            webhookapp.NewGetSubscriptionUsecase(a.UowFactory),
+		List:           webhookapp.NewListSubscriptionsUsecase(a.UowFactory),
+		Update:         webhookapp.NewUpdateSubscriptionUsecase(a.UowFactory),
+		Delete:         webhookapp.NewDeleteSubscriptionUsecase(a.UowFactory),
+		ListDeliveries: webhookapp.NewListDeliveriesUsecase(a.UowFactory),
+		Replay:         webhookapp.NewReplayDeliveryUsecase(a.UowFactory),
+	}
+
+	// Progress Usecases
+	progressUCs := progressapp.ProgressUsecase{
+		Video:            progressapp.NewVideoProgressUsecase(a.ProgressStream, a.UowFactory),
+		OpenSubscription: progressapp.NewOpenProgressSubscriptionUsecase(a.ProgressStream),
+		Resolve:          progressapp.NewResolveProgressUsecase(a.UowFactory),
+	}
+
+	// Auth Usecases
+	hasher := hash.NewArgon2Hasher()
+	mailer := authapp.NewUserMailer(a.mailSender(), authapp.MailLinks{
+		VerifyEmailURL:   a.Config.VerifyEmailURL,
+		ResetPasswordURL: a.Config.ResetPasswordURL,
+	})
+	authUCs := authapp.AuthUsecase{
+		Login:                    authapp.NewLoginUsecase(a.AuthRepo, hasher, a.Token, a.LoginAttempts, a.Logger),
+		Signup:                   authapp.NewSignupUsecase(a.UowFactory, a.AuthRepo, hasher, a.Token, mailer, a.Logger),
+		Refresh:                  authapp.NewRefreshUsecase(a.UowFactory, a.Token, a.Denylist),
+		ListSessions:             authapp.NewListSessionsUsecase(a.AuthRepo),
+		RevokeSession:            authapp.NewRevokeSessionUsecase(a.UowFactory, a.Denylist),
+		PublicKeys:               authapp.NewListPublicKeysUsecase(a.Token),
+		RequestEmailVerification: authapp.NewRequestEmailVerificationUsecase(a.UowFactory, mailer),
+		VerifyEmail:              authapp.NewVerifyEmailUsecase(a.UowFactory),
+		RequestPasswordReset:     authapp.NewRequestPasswordResetUsecase(a.UowFactory, mailer, a.Logger),
+		ResetPassword:            authapp.NewResetPasswordUsecase(a.UowFactory, hasher, a.Denylist),
+	}
+
+	// Users sign in through the identity provider when one is configured
+	if a.Config.UsesOIDC() {
+		provider := httpoidc.NewHTTPProvider(httpoidc.Config{
+			IssuerURL:    a.Config.OIDCIssuerURL,
+			ClientID:     a.Config.OIDCClientID,
+			ClientSecret: a.Config.OIDCClientSecret,
+			RedirectURL:  a.Config.OIDCRedirectURL,
+			Scopes:       a.Config.OIDCScopes,
+			GroupsClaim:  a.Config.OIDCGroupsClaim,
+		}, oidcTimeout)
+		mapping := auth.GroupRoleMapping{
+			DefaultRole: a.Config.OIDCDefaultRole,
+			GroupRoles:  a.Config.OIDCGroupRoles,
+		}
+		authUCs.OIDCLogin = authapp.NewOIDCLoginUsecase(a.AuthRepo, provider)
+		authUCs.OIDCCallback = authapp.NewOIDCCallbackUsecase(a.UowFactory, a.AuthRepo, provider, hasher, a.Token, mapping)
+	}
+
+	// Role Usecases
+	roleUCs := roleapp.RoleUsecase{
+		Create:           roleapp.NewCreateRoleUsecase(a.UowFactory),
+		List:             roleapp.NewListRolesUsecase(a.UowFactory),
+		AddPermission:    roleapp.NewAddRolePermissionUsecase(a.UowFactory),
+		RemovePermission: roleapp.NewRemoveRolePermissionUsecase(a.UowFactory),
+		Assign:           roleapp.NewAssignRoleUsecase(a.UowFactory),
+		Unassign:         roleapp.NewUnassignRoleUsecase(a.UowFactory),
+	}
+
+	// API Key Usecases
+	apiKeyUCs := apikeyapp.APIKeyUsecase{
+		Create:       apikeyapp.NewCreateAPIKeyUsecase(a.UowFactory, hasher),
+		List:         apikeyapp.NewListAPIKeysUsecase(a.AuthRepo),
+		Revoke:       apikeyapp.NewRevokeAPIKeyUsecase(a.UowFactory),
+		Authenticate: apikeyapp.NewAuthenticateAPIKeyUsecase(a.AuthRepo, hasher),
+	}
+
+	return adpHttp.NewRouter(
+		videoUCs, progressUCs, jobUCs, taskUCs, webhookUCs, authUCs, roleUCs, apiKeyUCs,
+		a.Config.StoragePath, a.Config.CorsAllowedOrigin,
+		a.Logger, a.Token, a.Denylist,
+	)
+}
+
+// mailSender picks how mails reach users.
+// Without a mail server they are logged, so links can be followed in development.
+func (a *App) mailSender() mailsender.Sender {
+	if !a.Config.UsesSMTP() {
+		return logmailsender.NewLogSender(a.Logger)
+	}
+
+	return smtpsender.NewSMTPSender(smtpsender.Config{
+		Addr:     a.Config.SMTPAddr,
+		Username: a.Config.SMTPUsername,
+		Password: a.Config.SMTPPassword,
+		From:     a.Config.MailFrom,
+	}, smtpTimeout)
+}
+
+// pipeline picks the processing steps of uploaded videos.
+// Chunked transcoding is enabled by setting a chunk duration.
+func (a *App) pipeline() []job.PipelineStep {
+	if a.Config.ChunkDuration > 0 {
+		return job.ChunkedVideoPipeline
+	}
+
+	return job.VideoPipeline
+}
+
+// WorkerPool builds the transcoding workers, the only place ffmpeg is wired
+func (a *App) WorkerPool() *worker.WorkerPool {
+	// Driven adapter (Transcoder)
+	execCommander := exec.NewOsCommander()
+	transcoder := ffmpeg.NewFFMPEGTranscoder(a.Config.StoragePath, execCommander, a.ProgressStream, a.ChunkProgress, a.Logger, ffmpeg.Options{
+		ChunkDuration: a.Config.ChunkDuration,
+		Nice:          a.Config.FFmpegNice,
+		Threads:       a.Config.FFmpegThreads,
+	})
+
+	// Jobs are admitted while their estimated cost fits in the resources of the node
+	budget := worker.NewResourceBudget(float64(a.Config.WorkerCPUs), a.Config.WorkerMemory)
+
+	return worker.NewWorkerPool(
+		jobapp.NewFindNextPendingTranscodeJobUsecase(a.UowFactory),
+		jobapp.NewEstimateJobCostUsecase(a.UowFactory, transcoder, transcoder.Renditions()),
+		jobapp.NewStartTranscodeJobUsecase(a.UowFactory),
+		jobapp.NewCompleteTranscodeJobUsecase(a.UowFactory),
+		jobapp.NewCompleteSplitJobUsecase(a.UowFactory),
+		jobapp.NewFailTranscodeJobUsecase(a.UowFactory),
+		jobapp.NewReleaseJobUsecase(a.UowFactory),
+		jobapp.NewGetJobUsecase(a.UowFactory),
+		a.Storer, a.Logger, transcoder, a.ProgressStream, a.Config.PollInterval, a.Config.WorkerLimit, budget,
+	)
+}
+
+// TaskScheduler builds the scheduler of one-off and recurring tasks,
+// with a handler registered for every task kind.
+func (a *App) TaskScheduler() *worker.TaskScheduler {
+	s := worker.NewTaskScheduler(
+		scheduleapp.NewClaimDueTasksUsecase(a.UowFactory, a.Config.TaskLease),
+		scheduleapp.NewRenewTaskLeaseUsecase(a.UowFactory, a.Config.TaskLease),
+		scheduleapp.NewCompleteTaskUsecase(a.UowFactory),
+		scheduleapp.NewFailTaskUsecase(a.UowFactory),
+		scheduleapp.NewEnsureRecurringTaskUsecase(a.UowFactory),
+		a.Logger, a.Config.TaskPollInterval, a.Config.TaskLease, a.Config.TaskBatchSize,
+	)
+
+	// Videos held until their publish time, the payload is the video id
+	publishUC := videoapp.NewPublishVideoUsecase(a.UowFactory)
+	s.Handle(schedule.KindPublishVideo, worker.TaskHandlerFunc(func(ctx context.Context, t *schedule.Task) error {
+		return publishUC.Execute(ctx, t.Payload)
+	}))
+
+	// Published events, finished tasks and abandoned sign-ins pile up otherwise
+	gcUC := scheduleapp.NewCollectGarbageUsecase(a.UowFactory, a.Config.GCRetention)
+	s.HandleRecurring(schedule.KindCollectGarbage, a.Config.GCCron, worker.TaskHandlerFunc(func(ctx context.Context, t *schedule.Task) error {
+		res, err := gcUC.Execute(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+		a.Logger.Infof(ctx, logport.CategoryTask, t.ID, "collected %d events and %d tasks", res.Events, res.Tasks)
+		return nil
+	}))
+
+	return s
+}
+
+// EventRelay builds the relay publishing outbox events to the event bus
+func (a *App) EventRelay() *worker.EventRelay {
+	return worker.NewEventRelay(
+		eventapp.NewRelayEventsUsecase(a.UowFactory, a.EventBus),
+		a.Logger, a.Config.EventPollInterval, a.Config.EventBatchSize,
+	)
+}
+
+// WebhookDispatcher builds the dispatcher sending webhook deliveries.
+// A delivery is leased for a few sender timeouts, so an attempt cut short
+// by a crash is retried without racing an attempt still in flight.
+func (a *App) WebhookDispatcher() *worker.WebhookDispatcher {
+	sender := httpsender.NewHTTPSender(a.Config.WebhookTimeout)
+
+	return worker.NewWebhookDispatcher(
+		webhookapp.NewClaimDueDeliveriesUsecase(a.UowFactory, 3*a.Config.WebhookTimeout),
+		webhookapp.NewSendDeliveryUsecase(a.UowFactory, sender),
+		a.Logger, a.Config.WebhookPollInterval, a.Config.WebhookBatchSize,
+	)
+}
+
+// HealthRouter builds the health endpoint of a node
+func (a *App) HealthRouter(role string) http.Handler {
+	return adpHttp.NewHealthRouter(role, a.DB.Conn, a.Logger)
+}
+
+// Serve runs the HTTP server until ctx is done, then shuts it down gracefully.
+// A server that fails to start calls stop so the whole node shuts down.
+func (a *App) Serve(ctx context.Context, stop context.CancelFunc, srv *http.Server) error {
+	go func() {
+		a.Logger.Infof(ctx, logport.CategoryDefault, "", "Now listening on %s\n", srv.Addr)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			stop() // initiate graceful shutdown
+			a.Logger.Errorf(ctx, logport.CategoryDefault, "", "listen: %s\n", err)
+		}
+	}()
+
+	<-ctx.Done()
+
+	// Shutdown server with timeout
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("server forced to shutdown: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// APIKeyPrefix starts every api key, so leaked keys are easy to recognize
+	APIKeyPrefix = "sak_"
+	// APIKeyUsageResolution is how stale the last use of a key may get,
+	// so a busy key is not written on every request
+	APIKeyUsageResolution = time.Minute
+)
+
+// APIKey lets a service call the api on behalf of the user who created it,
+// with a subset of the permissions of that user.
+// Keys are made of their prefix, identifying them, and a secret of which only the hash is stored.
+type APIKey struct {
+	ID          string
+	UserID      string
+	Name        string
+	Prefix      string // APIKeyPrefix followed by the lookup id of the key
+	Hash        string
+	Permissions []string
+	ExpiresAt   *time.Time // Never expires when nil
+	LastUsedAt  *time.Time
+	RevokedAt   *time.Time
+	CreatedAt   time.Time
+}
+
+func NewAPIKey(id, userID, name, prefix, hash string, permissions []string, expiresAt *time.Time) (*APIKey, error) {
+	if id == "" {
+		return nil, ErrAPIKeyIDEmpty
+	}
+
+	if userID == "" {
+		return nil, ErrUserIDEmpty
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return nil, ErrAPIKeyNameEmpty
+	}
+
+	if !strings.HasPrefix(prefix, APIKeyPrefix) || len(prefix) == len(APIKeyPrefix) {
+		return nil, ErrAPIKeyPrefixInvalid
+	}
+
+	if hash == "" {
+		return nil, ErrAPIKeyHashEmpty
+	}
+
+	all := AllPermissions()
+	for _, p := range permissions {
+		if !slices.Contains(all, p) {
+			return nil, ErrUnknownPermission
+		}
+	}
+
+	now := time.Now().UTC()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, ErrAPIKeyExpiryPast
+	}
+
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	return &APIKey{
+		ID:          id,
+		UserID:      userID,
+		Name:        name,
+		Prefix:      prefix,
+		Hash:        hash,
+		Permissions: permissions,
+		ExpiresAt:   expiresAt,
+		CreatedAt:   now,
+	}, nil
+}
+
+// IsUsable reports whether the key authenticates requests at the given time
+func (k *APIKey) IsUsable(now time.Time) bool {
+	if k.IsRevoked() {
+		return false
+	}
+
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// MarkUsed records a use of the key, reporting whether the last use changed
+// as it is only moved once older than APIKeyUsageResolution
+func (k *APIKey) MarkUsed(now time.Time) bool {
+	if k.LastUsedAt != nil && now.Sub(*k.LastUsedAt) < APIKeyUsageResolution {
+		return false
+	}
+
+	k.LastUsedAt = &now
+
+	return true
+}
+
+// Revoke stops the key from authenticating, revoking it again has no effect
+func (k *APIKey) Revoke() {
+	if k.IsRevoked() {
+		return
+	}
+
+	now := time.Now().UTC()
+	k.RevokedAt = &now
+}
+
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
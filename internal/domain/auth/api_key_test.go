@@ -0,0 +1,63 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPIKey_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	past := time.Now().Add(-time.Minute)
+
+	_, err := auth.NewAPIKey("", "user-1", "ingest", "sak_abc", "hash", nil, nil)
+	require.ErrorIs(t, err, auth.ErrAPIKeyIDEmpty)
+
+	_, err = auth.NewAPIKey("key-1", "", "ingest", "sak_abc", "hash", nil, nil)
+	require.ErrorIs(t, err, auth.ErrUserIDEmpty)
+
+	_, err = auth.NewAPIKey("key-1", "user-1", " ", "sak_abc", "hash", nil, nil)
+	require.ErrorIs(t, err, auth.ErrAPIKeyNameEmpty)
+
+	_, err = auth.NewAPIKey("key-1", "user-1", "ingest", "abc", "hash", nil, nil)
+	require.ErrorIs(t, err, auth.ErrAPIKeyPrefixInvalid)
+
+	_, err = auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", "", nil, nil)
+	require.ErrorIs(t, err, auth.ErrAPIKeyHashEmpty)
+
+	_, err = auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", "hash", []string{"video:delete"}, nil)
+	require.ErrorIs(t, err, auth.ErrUnknownPermission)
+
+	_, err = auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", "hash", nil, &past)
+	require.ErrorIs(t, err, auth.ErrAPIKeyExpiryPast)
+}
+
+func TestAPIKey_IsUsable(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	k, err := auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", "hash", []string{auth.PermissionVideoUpload}, &expiresAt)
+	require.NoError(t, err)
+
+	require.True(t, k.IsUsable(now))
+	require.False(t, k.IsUsable(expiresAt))
+
+	k.Revoke()
+	require.False(t, k.IsUsable(now))
+}
+
+func TestAPIKey_MarkUsed(t *testing.T) {
+	t.Parallel()
+
+	k, _ := auth.NewAPIKey("key-1", "user-1", "ingest", "sak_abc", "hash", nil, nil)
+	now := time.Now()
+
+	require.True(t, k.MarkUsed(now))
+	require.False(t, k.MarkUsed(now.Add(auth.APIKeyUsageResolution/2)))
+	require.True(t, k.MarkUsed(now.Add(auth.APIKeyUsageResolution)))
+	require.Equal(t, now.Add(auth.APIKeyUsageResolution), *k.LastUsedAt)
+}
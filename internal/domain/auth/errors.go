@@ -0,0 +1,27 @@
+package auth
+
+import "errors"
+
+var (
+	ErrRefreshFamilyIDEmpty = errors.New("refresh family id cannot be empty")
+	ErrUserIDEmpty          = errors.New("user id cannot be empty")
+	ErrRefreshTokenIDEmpty  = errors.New("refresh token id cannot be empty")
+	ErrRefreshFamilyRevoked = errors.New("refresh family is revoked")
+	ErrRefreshTokenReused   = errors.New("refresh token was already used")
+	ErrSigningKeyIDEmpty    = errors.New("signing key id cannot be empty")
+	ErrSigningKeyEmpty      = errors.New("signing key cannot be empty")
+	ErrSigningAlgorithm     = errors.New("unsupported signing algorithm")
+	ErrRoleIDEmpty          = errors.New("role id cannot be empty")
+	ErrRoleNameInvalid      = errors.New("role name must be lowercase letters, digits, - or _")
+	ErrUnknownPermission    = errors.New("unknown permission")
+	ErrAPIKeyIDEmpty        = errors.New("api key id cannot be empty")
+	ErrAPIKeyNameEmpty      = errors.New("api key name cannot be empty")
+	ErrAPIKeyHashEmpty      = errors.New("api key hash cannot be empty")
+	ErrAPIKeyPrefixInvalid  = errors.New("api key prefix is invalid")
+	ErrAPIKeyExpiryPast     = errors.New("api key expiry must be in the future")
+	ErrOIDCStateEmpty       = errors.New("oidc state cannot be empty")
+	ErrOIDCNonceEmpty       = errors.New("oidc nonce cannot be empty")
+	ErrOIDCVerifierEmpty    = errors.New("oidc code verifier cannot be empty")
+	ErrUserTokenHashEmpty   = errors.New("user token hash cannot be empty")
+	ErrUserTokenPurpose     = errors.New("unknown user token purpose")
+)
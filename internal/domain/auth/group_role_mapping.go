@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"slices"
+	"sort"
+)
+
+// GroupRoleMapping grants local roles to the users of an identity provider from their groups.
+// The roles groups map to are managed by the provider, users leaving the groups lose them at their next sign in.
+// Other roles, such as those assigned through the api, are left alone.
+type GroupRoleMapping struct {
+	DefaultRole string            // Granted to every user of the provider, empty for none
+	GroupRoles  map[string]string // Group to role
+}
+
+// Resolve returns the roles a user of the groups must hold, and the managed roles they must not hold
+func (m GroupRoleMapping) Resolve(groups []string) (granted, revoked []string) {
+	if m.DefaultRole != "" {
+		granted = append(granted, m.DefaultRole)
+	}
+
+	for _, g := range groups {
+		if r, ok := m.GroupRoles[g]; ok && !slices.Contains(granted, r) {
+			granted = append(granted, r)
+		}
+	}
+
+	for _, r := range m.GroupRoles {
+		if !slices.Contains(granted, r) && !slices.Contains(revoked, r) {
+			revoked = append(revoked, r)
+		}
+	}
+
+	sort.Strings(granted)
+	sort.Strings(revoked)
+
+	return granted, revoked
+}
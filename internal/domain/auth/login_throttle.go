@@ -0,0 +1,66 @@
+package auth
+
+import "time"
+
+// LoginFailures are the failed logins recorded for an account or a client address
+type LoginFailures struct {
+	Count  int
+	LastAt time.Time
+}
+
+// LoginThrottle slows down guessing passwords. Past a few failures, each attempt waits
+// twice as long after the last failure as the one before, up to MaxDelay.
+// Reaching LockoutAfter failures locks logins out for LockoutDuration.
+// Failures are forgotten once LockoutDuration passes without another one.
+type LoginThrottle struct {
+	FreeAttempts    int // Failures allowed without delay
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	LockoutAfter    int
+	LockoutDuration time.Duration
+}
+
+var (
+	// AccountLoginThrottle applies to the failed logins of a username or email
+	AccountLoginThrottle = LoginThrottle{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        30 * time.Second,
+		LockoutAfter:    10,
+		LockoutDuration: 15 * time.Minute,
+	}
+
+	// AddressLoginThrottle applies to the failed logins of a client address, whatever the account.
+	// It allows more failures, as clients behind the same address share it.
+	AddressLoginThrottle = LoginThrottle{
+		FreeAttempts:    10,
+		BaseDelay:       time.Second,
+		MaxDelay:        30 * time.Second,
+		LockoutAfter:    50,
+		LockoutDuration: 15 * time.Minute,
+	}
+)
+
+// Wait returns how long to wait before attempting a login again, zero when allowed now
+func (t LoginThrottle) Wait(f LoginFailures, now time.Time) time.Duration {
+	var delay time.Duration
+	switch {
+	case f.Count >= t.LockoutAfter:
+		delay = t.LockoutDuration
+	case f.Count > t.FreeAttempts:
+		delay = t.MaxDelay
+		// Shifting by large counts overflows, the cap is reached well before
+		if shift := f.Count - t.FreeAttempts - 1; shift < 32 {
+			delay = min(t.BaseDelay<<shift, t.MaxDelay)
+		}
+	default:
+		return 0
+	}
+
+	return max(f.LastAt.Add(delay).Sub(now), 0)
+}
+
+// LocksOut reports whether the failure just recorded started a lockout
+func (t LoginThrottle) LocksOut(f LoginFailures) bool {
+	return f.Count == t.LockoutAfter
+}
@@ -0,0 +1,53 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginThrottle_Wait(t *testing.T) {
+	throttle := auth.LoginThrottle{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        8 * time.Second,
+		LockoutAfter:    10,
+		LockoutDuration: 15 * time.Minute,
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		count int
+		since time.Duration // since the last failure
+		want  time.Duration
+	}{
+		{"no failures", 0, 0, 0},
+		{"free attempts", 3, 0, 0},
+		{"first delay", 4, 0, time.Second},
+		{"doubling delay", 6, 0, 4 * time.Second},
+		{"delay capped", 9, 0, 8 * time.Second},
+		{"delay partly elapsed", 6, 3 * time.Second, time.Second},
+		{"delay elapsed", 6, 5 * time.Second, 0},
+		{"locked out", 10, time.Minute, 14 * time.Minute},
+		{"lockout elapsed", 12, 15 * time.Minute, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := auth.LoginFailures{Count: tt.count, LastAt: now.Add(-tt.since)}
+			require.Equal(t, tt.want, throttle.Wait(f, now))
+		})
+	}
+}
+
+func TestLoginThrottle_LocksOut(t *testing.T) {
+	throttle := auth.AccountLoginThrottle
+
+	require.False(t, throttle.LocksOut(auth.LoginFailures{Count: throttle.LockoutAfter - 1}))
+	require.True(t, throttle.LocksOut(auth.LoginFailures{Count: throttle.LockoutAfter}))
+	// Failures recorded during the lockout do not start another one
+	require.False(t, throttle.LocksOut(auth.LoginFailures{Count: throttle.LockoutAfter + 1}))
+}
@@ -0,0 +1,41 @@
+package auth
+
+import "time"
+
+// OIDCLoginLifetime is how long a user has to sign in at the identity provider
+const OIDCLoginLifetime = 10 * time.Minute
+
+// OIDCLogin is a sign in started at the identity provider, until the provider redirects back.
+// State ties the callback to the login, Nonce ties the id token to it,
+// and CodeVerifier proves to the provider that the code is redeemed by whoever started it (PKCE).
+type OIDCLogin struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+	CreatedAt    time.Time
+}
+
+func NewOIDCLogin(state, nonce, codeVerifier string) (*OIDCLogin, error) {
+	if state == "" {
+		return nil, ErrOIDCStateEmpty
+	}
+
+	if nonce == "" {
+		return nil, ErrOIDCNonceEmpty
+	}
+
+	if codeVerifier == "" {
+		return nil, ErrOIDCVerifierEmpty
+	}
+
+	return &OIDCLogin{
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		CreatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+func (l *OIDCLogin) IsExpired(now time.Time) bool {
+	return !now.Before(l.CreatedAt.Add(OIDCLoginLifetime))
+}
@@ -0,0 +1,53 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOIDCLogin_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := auth.NewOIDCLogin("", "nonce", "verifier")
+	require.ErrorIs(t, err, auth.ErrOIDCStateEmpty)
+
+	_, err = auth.NewOIDCLogin("state", "", "verifier")
+	require.ErrorIs(t, err, auth.ErrOIDCNonceEmpty)
+
+	_, err = auth.NewOIDCLogin("state", "nonce", "")
+	require.ErrorIs(t, err, auth.ErrOIDCVerifierEmpty)
+}
+
+func TestOIDCLogin_IsExpired(t *testing.T) {
+	t.Parallel()
+
+	l, err := auth.NewOIDCLogin("state", "nonce", "verifier")
+	require.NoError(t, err)
+
+	require.False(t, l.IsExpired(l.CreatedAt.Add(auth.OIDCLoginLifetime-time.Second)))
+	require.True(t, l.IsExpired(l.CreatedAt.Add(auth.OIDCLoginLifetime)))
+}
+
+func TestGroupRoleMapping_Resolve(t *testing.T) {
+	t.Parallel()
+
+	m := auth.GroupRoleMapping{
+		DefaultRole: auth.RoleViewer,
+		GroupRoles: map[string]string{
+			"streaming-admins":    auth.RoleAdmin,
+			"streaming-uploaders": auth.RoleUploader,
+			"media-team":          auth.RoleUploader,
+		},
+	}
+
+	granted, revoked := m.Resolve([]string{"media-team", "engineering"})
+	require.Equal(t, []string{auth.RoleUploader, auth.RoleViewer}, granted)
+	require.Equal(t, []string{auth.RoleAdmin}, revoked)
+
+	granted, revoked = m.Resolve(nil)
+	require.Equal(t, []string{auth.RoleViewer}, granted)
+	require.Equal(t, []string{auth.RoleAdmin, auth.RoleUploader}, revoked)
+}
@@ -4,6 +4,10 @@ const (
 	PermissionVideoUpload  = "video:upload"
 	PermissionVideoUpdate  = "video:update"
 	PermissionVideoArchive = "video:archive"
+	PermissionVideoAdmin   = "video:admin"
+	PermissionJobAdmin     = "job:admin"
+	PermissionWebhookAdmin = "webhook:admin"
+	PermissionRoleAdmin    = "role:admin"
 )
 
 // AllPermissions returns a slice containing all defined permissions.
@@ -12,5 +16,9 @@ func AllPermissions() []string {
 		PermissionVideoUpload,
 		PermissionVideoUpdate,
 		PermissionVideoArchive,
+		PermissionVideoAdmin,
+		PermissionJobAdmin,
+		PermissionWebhookAdmin,
+		PermissionRoleAdmin,
 	}
 }
@@ -0,0 +1,81 @@
+package auth
+
+import "time"
+
+// RefreshFamily is the chain of refresh tokens issued from one login,
+// and so the session listed to the user and revoked on logout.
+// Every refresh rotates the family to a new token, so only its latest token is valid.
+// A token presented again after its rotation was stolen or replayed,
+// the whole family is then revoked and its holder must log in again.
+type RefreshFamily struct {
+	ID             string
+	UserID         string
+	CurrentTokenID string // Id of the only token that may be exchanged
+	Rotations      int
+	RevokedAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewRefreshFamily starts a family with its first token
+func NewRefreshFamily(id, userID, tokenID string) (*RefreshFamily, error) {
+	if id == "" {
+		return nil, ErrRefreshFamilyIDEmpty
+	}
+
+	if userID == "" {
+		return nil, ErrUserIDEmpty
+	}
+
+	if tokenID == "" {
+		return nil, ErrRefreshTokenIDEmpty
+	}
+
+	now := time.Now().UTC()
+
+	return &RefreshFamily{
+		ID:             id,
+		UserID:         userID,
+		CurrentTokenID: tokenID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// Rotate exchanges the current token of the family for the next one.
+// Presenting any other token revokes the family and returns ErrRefreshTokenReused.
+func (f *RefreshFamily) Rotate(tokenID, nextTokenID string) error {
+	if f.IsRevoked() {
+		return ErrRefreshFamilyRevoked
+	}
+
+	if nextTokenID == "" {
+		return ErrRefreshTokenIDEmpty
+	}
+
+	if tokenID != f.CurrentTokenID {
+		f.Revoke()
+		return ErrRefreshTokenReused
+	}
+
+	f.CurrentTokenID = nextTokenID
+	f.Rotations++
+	f.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// Revoke invalidates every token of the family
+func (f *RefreshFamily) Revoke() {
+	if f.IsRevoked() {
+		return
+	}
+
+	now := time.Now().UTC()
+	f.RevokedAt = &now
+	f.UpdatedAt = now
+}
+
+func (f *RefreshFamily) IsRevoked() bool {
+	return f.RevokedAt != nil
+}
@@ -0,0 +1,51 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRefreshFamily_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := auth.NewRefreshFamily("", "user-1", "token-1")
+	require.ErrorIs(t, err, auth.ErrRefreshFamilyIDEmpty)
+
+	_, err = auth.NewRefreshFamily("family-1", "", "token-1")
+	require.ErrorIs(t, err, auth.ErrUserIDEmpty)
+
+	_, err = auth.NewRefreshFamily("family-1", "user-1", "")
+	require.ErrorIs(t, err, auth.ErrRefreshTokenIDEmpty)
+}
+
+func TestRefreshFamily_Rotate(t *testing.T) {
+	t.Run("should move to the next token", func(t *testing.T) {
+		t.Parallel()
+
+		f, _ := auth.NewRefreshFamily("family-1", "user-1", "token-1")
+
+		require.NoError(t, f.Rotate("token-1", "token-2"))
+		require.NoError(t, f.Rotate("token-2", "token-3"))
+
+		require.Equal(t, "token-3", f.CurrentTokenID)
+		require.Equal(t, 2, f.Rotations)
+		require.False(t, f.IsRevoked())
+	})
+
+	t.Run("should revoke the family when a used token is replayed", func(t *testing.T) {
+		t.Parallel()
+
+		f, _ := auth.NewRefreshFamily("family-1", "user-1", "token-1")
+		require.NoError(t, f.Rotate("token-1", "token-2"))
+
+		err := f.Rotate("token-1", "token-3")
+
+		require.ErrorIs(t, err, auth.ErrRefreshTokenReused)
+		require.True(t, f.IsRevoked())
+
+		// The latest token is no longer valid either
+		require.ErrorIs(t, f.Rotate("token-2", "token-4"), auth.ErrRefreshFamilyRevoked)
+	})
+}
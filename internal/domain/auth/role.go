@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"regexp"
+	"slices"
+)
+
+// Default roles, seeded at startup
+const (
+	RoleViewer   = "viewer"
+	RoleUploader = "uploader"
+	RoleAdmin    = "admin"
+)
+
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,63}$`)
+
+// Role grants its permissions to the users it is assigned to.
+// Users get the permissions of their roles in the access tokens issued after a change.
+type Role struct {
+	ID          string
+	Name        string
+	Permissions []string
+}
+
+func NewRole(id, name string, permissions []string) (*Role, error) {
+	if id == "" {
+		return nil, ErrRoleIDEmpty
+	}
+
+	if !roleNamePattern.MatchString(name) {
+		return nil, ErrRoleNameInvalid
+	}
+
+	r := &Role{ID: id, Name: name, Permissions: []string{}}
+	for _, p := range permissions {
+		if err := r.AddPermission(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// DefaultRoles returns the roles seeded at startup, each permission is granted the first time it is seeded
+func DefaultRoles() map[string][]string {
+	return map[string][]string{
+		RoleViewer: {},
+		RoleUploader: {
+			PermissionVideoUpload,
+			PermissionVideoUpdate,
+			PermissionVideoArchive,
+		},
+		RoleAdmin: AllPermissions(),
+	}
+}
+
+// IsSignupRole reports whether users may pick the role when signing up,
+// other roles are assigned by role admins
+func IsSignupRole(name string) bool {
+	return name == RoleViewer || name == RoleUploader
+}
+
+// AddPermission grants a permission known to the code, granting it twice has no effect
+func (r *Role) AddPermission(permission string) error {
+	if !slices.Contains(AllPermissions(), permission) {
+		return ErrUnknownPermission
+	}
+
+	if !r.HasPermission(permission) {
+		r.Permissions = append(r.Permissions, permission)
+	}
+
+	return nil
+}
+
+// RemovePermission stops granting a permission
+func (r *Role) RemovePermission(permission string) {
+	r.Permissions = slices.DeleteFunc(r.Permissions, func(p string) bool {
+		return p == permission
+	})
+}
+
+func (r *Role) HasPermission(permission string) bool {
+	return slices.Contains(r.Permissions, permission)
+}
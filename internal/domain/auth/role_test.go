@@ -0,0 +1,58 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRole_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := auth.NewRole("", "editor", nil)
+	require.ErrorIs(t, err, auth.ErrRoleIDEmpty)
+
+	_, err = auth.NewRole("role-1", "", nil)
+	require.ErrorIs(t, err, auth.ErrRoleNameInvalid)
+
+	_, err = auth.NewRole("role-1", "Editor Role", nil)
+	require.ErrorIs(t, err, auth.ErrRoleNameInvalid)
+
+	_, err = auth.NewRole("role-1", "editor", []string{"video:delete"})
+	require.ErrorIs(t, err, auth.ErrUnknownPermission)
+}
+
+func TestRole_Permissions(t *testing.T) {
+	t.Parallel()
+
+	r, err := auth.NewRole("role-1", "editor", []string{auth.PermissionVideoUpdate, auth.PermissionVideoUpdate})
+	require.NoError(t, err)
+	require.Equal(t, []string{auth.PermissionVideoUpdate}, r.Permissions)
+
+	require.NoError(t, r.AddPermission(auth.PermissionVideoArchive))
+	require.True(t, r.HasPermission(auth.PermissionVideoArchive))
+
+	r.RemovePermission(auth.PermissionVideoUpdate)
+	require.Equal(t, []string{auth.PermissionVideoArchive}, r.Permissions)
+
+	// Removing a permission the role lacks has no effect
+	r.RemovePermission(auth.PermissionJobAdmin)
+	require.Equal(t, []string{auth.PermissionVideoArchive}, r.Permissions)
+}
+
+func TestDefaultRoles(t *testing.T) {
+	t.Parallel()
+
+	roles := auth.DefaultRoles()
+	require.ElementsMatch(t, auth.AllPermissions(), roles[auth.RoleAdmin])
+	require.Empty(t, roles[auth.RoleViewer])
+
+	for name, permissions := range roles {
+		_, err := auth.NewRole("role-id", name, permissions)
+		require.NoError(t, err, name)
+	}
+
+	require.True(t, auth.IsSignupRole(auth.RoleUploader))
+	require.False(t, auth.IsSignupRole(auth.RoleAdmin))
+}
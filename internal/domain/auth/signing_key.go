@@ -0,0 +1,80 @@
+package auth
+
+import "time"
+
+// Algorithms signing access tokens
+const (
+	SigningAlgorithmRS256 = "RS256"
+	SigningAlgorithmEdDSA = "EdDSA"
+)
+
+// SigningKey signs access tokens, which name it by its id in their kid header.
+// A new key is published before it starts signing, so verifiers learn it first.
+// Once replaced, a key keeps verifying until the tokens it signed have expired.
+type SigningKey struct {
+	ID         string
+	Algorithm  string
+	PrivateKey []byte // PKCS #8, DER encoded, encrypted by the token adapter
+	CreatedAt  time.Time
+	ActiveFrom time.Time  // Start of signing
+	ExpiresAt  *time.Time // End of verification, set once the key is replaced
+}
+
+func NewSigningKey(id, algorithm string, privateKey []byte, activeFrom time.Time) (*SigningKey, error) {
+	if id == "" {
+		return nil, ErrSigningKeyIDEmpty
+	}
+
+	if algorithm != SigningAlgorithmRS256 && algorithm != SigningAlgorithmEdDSA {
+		return nil, ErrSigningAlgorithm
+	}
+
+	if len(privateKey) == 0 {
+		return nil, ErrSigningKeyEmpty
+	}
+
+	return &SigningKey{
+		ID:         id,
+		Algorithm:  algorithm,
+		PrivateKey: privateKey,
+		CreatedAt:  time.Now().UTC(),
+		ActiveFrom: activeFrom.UTC(),
+	}, nil
+}
+
+// Retire ends verification with the key at expiresAt.
+// The key keeps signing until its successor becomes active.
+func (k *SigningKey) Retire(expiresAt time.Time) {
+	if k.IsRetired() {
+		return
+	}
+
+	expiresAt = expiresAt.UTC()
+	k.ExpiresAt = &expiresAt
+}
+
+func (k *SigningKey) IsRetired() bool {
+	return k.ExpiresAt != nil
+}
+
+// Verifies reports whether tokens signed by the key are still accepted
+func (k *SigningKey) Verifies(now time.Time) bool {
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// CurrentSigningKey picks the key signing new tokens, the most recently activated one.
+// It returns nil when no key is active yet.
+func CurrentSigningKey(keys []*SigningKey, now time.Time) *SigningKey {
+	var current *SigningKey
+	for _, k := range keys {
+		if !k.Verifies(now) || now.Before(k.ActiveFrom) {
+			continue
+		}
+
+		if current == nil || k.ActiveFrom.After(current.ActiveFrom) {
+			current = k
+		}
+	}
+
+	return current
+}
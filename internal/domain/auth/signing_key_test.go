@@ -0,0 +1,75 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSigningKey_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	_, err := auth.NewSigningKey("", auth.SigningAlgorithmRS256, []byte("key"), now)
+	require.ErrorIs(t, err, auth.ErrSigningKeyIDEmpty)
+
+	_, err = auth.NewSigningKey("key-1", "HS256", []byte("key"), now)
+	require.ErrorIs(t, err, auth.ErrSigningAlgorithm)
+
+	_, err = auth.NewSigningKey("key-1", auth.SigningAlgorithmEdDSA, nil, now)
+	require.ErrorIs(t, err, auth.ErrSigningKeyEmpty)
+}
+
+func TestSigningKey_Retire(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	k, _ := auth.NewSigningKey("key-1", auth.SigningAlgorithmRS256, []byte("key"), now)
+	require.True(t, k.Verifies(now))
+
+	k.Retire(now.Add(time.Minute))
+	require.True(t, k.IsRetired())
+	require.True(t, k.Verifies(now))
+	require.False(t, k.Verifies(now.Add(time.Minute)))
+
+	// The first expiry is kept
+	k.Retire(now.Add(time.Hour))
+	require.False(t, k.Verifies(now.Add(time.Minute)))
+}
+
+func TestCurrentSigningKey(t *testing.T) {
+	now := time.Now()
+
+	t.Run("should pick the most recently activated key", func(t *testing.T) {
+		t.Parallel()
+
+		older, _ := auth.NewSigningKey("key-1", auth.SigningAlgorithmRS256, []byte("key"), now.Add(-2*time.Hour))
+		newer, _ := auth.NewSigningKey("key-2", auth.SigningAlgorithmRS256, []byte("key"), now.Add(-time.Hour))
+
+		require.Equal(t, newer, auth.CurrentSigningKey([]*auth.SigningKey{newer, older}, now))
+	})
+
+	t.Run("should keep a retired key signing until its successor is active", func(t *testing.T) {
+		t.Parallel()
+
+		older, _ := auth.NewSigningKey("key-1", auth.SigningAlgorithmRS256, []byte("key"), now.Add(-time.Hour))
+		pending, _ := auth.NewSigningKey("key-2", auth.SigningAlgorithmRS256, []byte("key"), now.Add(time.Minute))
+		older.Retire(now.Add(time.Hour))
+
+		keys := []*auth.SigningKey{older, pending}
+		require.Equal(t, older, auth.CurrentSigningKey(keys, now))
+		require.Equal(t, pending, auth.CurrentSigningKey(keys, now.Add(2*time.Minute)))
+	})
+
+	t.Run("should return nil without an active key", func(t *testing.T) {
+		t.Parallel()
+
+		pending, _ := auth.NewSigningKey("key-1", auth.SigningAlgorithmEdDSA, []byte("key"), now.Add(time.Minute))
+
+		require.Nil(t, auth.CurrentSigningKey([]*auth.SigningKey{pending}, now))
+		require.Nil(t, auth.CurrentSigningKey(nil, now))
+	})
+}
@@ -0,0 +1,66 @@
+package auth
+
+import "time"
+
+// UserTokenPurpose is what a user token proves when presented
+type UserTokenPurpose string
+
+const (
+	// PurposeVerifyEmail proves owning the email of the user
+	PurposeVerifyEmail UserTokenPurpose = "verify_email"
+	// PurposeResetPassword lets whoever owns the email of the user choose a new password
+	PurposeResetPassword UserTokenPurpose = "reset_password"
+)
+
+// Lifetime is how long tokens of the purpose may be used.
+// Reset tokens are short lived as they give the account away.
+func (p UserTokenPurpose) Lifetime() time.Duration {
+	switch p {
+	case PurposeResetPassword:
+		return time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+func (p UserTokenPurpose) IsValid() bool {
+	return p == PurposeVerifyEmail || p == PurposeResetPassword
+}
+
+// UserToken is a single use secret mailed to a user. Only a hash of it is stored,
+// so the stored tokens cannot be used by whoever reads them.
+type UserToken struct {
+	Hash      string
+	UserID    string
+	Purpose   UserTokenPurpose
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func NewUserToken(hash, userID string, purpose UserTokenPurpose) (*UserToken, error) {
+	if hash == "" {
+		return nil, ErrUserTokenHashEmpty
+	}
+
+	if userID == "" {
+		return nil, ErrUserIDEmpty
+	}
+
+	if !purpose.IsValid() {
+		return nil, ErrUserTokenPurpose
+	}
+
+	now := time.Now().UTC()
+
+	return &UserToken{
+		Hash:      hash,
+		UserID:    userID,
+		Purpose:   purpose,
+		ExpiresAt: now.Add(purpose.Lifetime()),
+		CreatedAt: now,
+	}, nil
+}
+
+func (t *UserToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,31 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserToken(t *testing.T) {
+	t.Run("success case - expires after the lifetime of its purpose", func(t *testing.T) {
+		tk, err := auth.NewUserToken("hash", "user-1", auth.PurposeResetPassword)
+
+		require.NoError(t, err)
+		require.Equal(t, time.Hour, tk.ExpiresAt.Sub(tk.CreatedAt))
+		require.False(t, tk.IsExpired(tk.CreatedAt))
+		require.True(t, tk.IsExpired(tk.ExpiresAt))
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := auth.NewUserToken("", "user-1", auth.PurposeVerifyEmail)
+		require.ErrorIs(t, err, auth.ErrUserTokenHashEmpty)
+
+		_, err = auth.NewUserToken("hash", "", auth.PurposeVerifyEmail)
+		require.ErrorIs(t, err, auth.ErrUserIDEmpty)
+
+		_, err = auth.NewUserToken("hash", "user-1", "login")
+		require.ErrorIs(t, err, auth.ErrUserTokenPurpose)
+	})
+}
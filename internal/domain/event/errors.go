@@ -0,0 +1,10 @@
+package event
+
+import "errors"
+
+var (
+	ErrEventIDEmpty          = errors.New("event id cannot be empty")
+	ErrVideoIDEmpty          = errors.New("video id cannot be empty")
+	ErrEventTypeInvalid      = errors.New("event type is invalid")
+	ErrEventAlreadyPublished = errors.New("event has already been published")
+)
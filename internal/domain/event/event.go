@@ -0,0 +1,106 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+)
+
+// Event is a state change recorded in the outbox, in the transaction making the change.
+// Events of the same video are published in the order they were recorded.
+type Event struct {
+	ID          string
+	Type        EventType
+	VideoID     string // Ordering key
+	Payload     string // JSON body delivered to subscribers
+	Sequence    int64  // Assigned by the outbox, orders the events
+	CreatedAt   time.Time
+	PublishedAt *time.Time
+}
+
+// VideoData is the payload of video events
+type VideoData struct {
+	VideoID  string            `json:"video_id"`
+	Title    string            `json:"title"`
+	Status   video.VideoStatus `json:"status"`
+	Duration float64           `json:"duration"` // Seconds
+}
+
+// JobData is the payload of job events
+type JobData struct {
+	JobID    string        `json:"job_id"`
+	VideoID  string        `json:"video_id"`
+	Type     job.JobType   `json:"type"`
+	Step     string        `json:"step"`
+	Status   job.JobStatus `json:"status"`
+	ErrorMsg string        `json:"error_msg,omitempty"`
+}
+
+// NewEvent creates an event of the video with the data marshalled as payload
+func NewEvent(id string, eventType EventType, videoID string, data any) (*Event, error) {
+	if id == "" {
+		return nil, ErrEventIDEmpty
+	}
+
+	if !eventType.IsValid() {
+		return nil, ErrEventTypeInvalid
+	}
+
+	if videoID == "" {
+		return nil, ErrVideoIDEmpty
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s payload: %w", eventType, err)
+	}
+
+	return &Event{
+		ID:        id,
+		Type:      eventType,
+		VideoID:   videoID,
+		Payload:   string(payload),
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// NewVideoEvent records the current state of the video
+func NewVideoEvent(id string, eventType EventType, v *video.Video) (*Event, error) {
+	return NewEvent(id, eventType, v.ID, VideoData{
+		VideoID:  v.ID,
+		Title:    v.Title,
+		Status:   v.Status,
+		Duration: v.Duration.Seconds(),
+	})
+}
+
+// NewJobEvent records the current state of the job, ordered with the events of its video
+func NewJobEvent(id string, eventType EventType, j *job.Job) (*Event, error) {
+	return NewEvent(id, eventType, j.VideoID, JobData{
+		JobID:    j.ID,
+		VideoID:  j.VideoID,
+		Type:     j.Type,
+		Step:     j.Step,
+		Status:   j.Status,
+		ErrorMsg: j.ErrorMsg,
+	})
+}
+
+// MarkAsPublished records that the event has been handed to the bus
+func (e *Event) MarkAsPublished(now time.Time) error {
+	if e.IsPublished() {
+		return ErrEventAlreadyPublished
+	}
+
+	publishedAt := now.UTC()
+	e.PublishedAt = &publishedAt
+
+	return nil
+}
+
+func (e *Event) IsPublished() bool {
+	return e.PublishedAt != nil
+}
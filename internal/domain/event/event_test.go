@@ -0,0 +1,75 @@
+package event_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/job"
+	"github.com/st-ember/streaming-api/internal/domain/video"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := event.NewEvent("", event.TypeVideoPublished, "video-1", nil)
+	require.ErrorIs(t, err, event.ErrEventIDEmpty)
+
+	_, err = event.NewEvent("event-1", "video.deleted", "video-1", nil)
+	require.ErrorIs(t, err, event.ErrEventTypeInvalid)
+
+	_, err = event.NewEvent("event-1", event.TypeVideoPublished, "", nil)
+	require.ErrorIs(t, err, event.ErrVideoIDEmpty)
+}
+
+func TestNewVideoEvent_RecordsVideoState(t *testing.T) {
+	t.Parallel()
+
+	v, err := video.NewVideo("video-1", "owner-id", "Title", "Description", "source.mp4", "resource-1")
+	require.NoError(t, err)
+	v.Status = video.StatusPublished
+	v.Duration = 90 * time.Second
+
+	e, err := event.NewVideoEvent("event-1", event.TypeVideoPublished, v)
+
+	require.NoError(t, err)
+	require.Equal(t, "video-1", e.VideoID)
+	require.False(t, e.IsPublished())
+
+	var data event.VideoData
+	require.NoError(t, json.Unmarshal([]byte(e.Payload), &data))
+	require.Equal(t, event.VideoData{VideoID: "video-1", Title: "Title", Status: video.StatusPublished, Duration: 90}, data)
+}
+
+func TestNewJobEvent_IsOrderedWithItsVideo(t *testing.T) {
+	t.Parallel()
+
+	j, err := job.NewJob("job-1", "video-1", job.TypeTranscode)
+	require.NoError(t, err)
+	j.Status = job.StatusFailed
+	j.ErrorMsg = "ffmpeg exited with code 1"
+
+	e, err := event.NewJobEvent("event-1", event.TypeJobFailed, j)
+
+	require.NoError(t, err)
+	require.Equal(t, "video-1", e.VideoID)
+
+	var data event.JobData
+	require.NoError(t, json.Unmarshal([]byte(e.Payload), &data))
+	require.Equal(t, "job-1", data.JobID)
+	require.Equal(t, job.StatusFailed, data.Status)
+	require.Equal(t, "ffmpeg exited with code 1", data.ErrorMsg)
+}
+
+func TestMarkAsPublished(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	e, _ := event.NewEvent("event-1", event.TypeVideoArchived, "video-1", nil)
+
+	require.NoError(t, e.MarkAsPublished(now))
+	require.Equal(t, now, *e.PublishedAt)
+	require.ErrorIs(t, e.MarkAsPublished(now), event.ErrEventAlreadyPublished)
+}
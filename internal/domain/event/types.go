@@ -0,0 +1,25 @@
+package event
+
+// EventType names a state change other systems may react to
+type EventType string
+
+const (
+	TypeVideoUploaded   EventType = "video.uploaded"
+	TypeVideoProcessing EventType = "video.processing"
+	TypeVideoPublished  EventType = "video.published"
+	TypeVideoFailed     EventType = "video.failed"
+	TypeVideoArchived   EventType = "video.archived"
+	TypeJobCompleted    EventType = "job.completed"
+	TypeJobFailed       EventType = "job.failed"
+	TypeJobCancelled    EventType = "job.cancelled"
+)
+
+func (t EventType) IsValid() bool {
+	switch t {
+	case TypeVideoUploaded, TypeVideoProcessing, TypeVideoPublished, TypeVideoFailed, TypeVideoArchived,
+		TypeJobCompleted, TypeJobFailed, TypeJobCancelled:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,79 @@
+package job
+
+import "time"
+
+// Attempt records a single run of a job by a worker.
+// A job gets a new attempt each time it is started.
+type Attempt struct {
+	JobID      string
+	Number     int
+	WorkerID   string
+	Status     JobStatus
+	ExitCode   *int
+	ErrorMsg   string
+	StderrTail string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// NewAttempt records the run a job has just been started for.
+func NewAttempt(j *Job) (*Attempt, error) {
+	if !j.IsRunning() || j.StartedAt == nil {
+		return nil, ErrAttemptJobNotRunning
+	}
+
+	return &Attempt{
+		JobID:     j.ID,
+		Number:    j.Attempts,
+		WorkerID:  j.WorkerID,
+		Status:    StatusRunning,
+		StartedAt: *j.StartedAt,
+	}, nil
+}
+
+func (a *Attempt) Complete() error {
+	return a.finish(StatusCompleted)
+}
+
+// Fail records why the attempt failed. exitCode is nil when the failure
+// did not come from the transcoding process itself.
+func (a *Attempt) Fail(errMsg string, exitCode *int, stderrTail string) error {
+	if err := a.finish(StatusFailed); err != nil {
+		return err
+	}
+
+	a.ErrorMsg = errMsg
+	a.ExitCode = exitCode
+	a.StderrTail = stderrTail
+
+	return nil
+}
+
+func (a *Attempt) Cancel() error {
+	return a.finish(StatusCancelled)
+}
+
+// Duration returns how long the attempt ran, or zero if it has not finished.
+func (a *Attempt) Duration() time.Duration {
+	if a.FinishedAt == nil {
+		return 0
+	}
+
+	return a.FinishedAt.Sub(a.StartedAt)
+}
+
+func (a *Attempt) IsRunning() bool {
+	return a.Status == StatusRunning
+}
+
+func (a *Attempt) finish(status JobStatus) error {
+	if !a.IsRunning() {
+		return ErrAttemptAlreadyFinished
+	}
+
+	now := time.Now().UTC()
+	a.Status = status
+	a.FinishedAt = &now
+
+	return nil
+}
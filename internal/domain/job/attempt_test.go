@@ -0,0 +1,76 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+func TestNewAttempt_SuccessCase(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+	h.NoError(err)
+	h.NoError(j.Start("worker-1"))
+
+	a, err := job.NewAttempt(j)
+
+	h.NoError(err)
+	h.Equal(h.mockID, a.JobID)
+	h.Equal(1, a.Number)
+	h.Equal("worker-1", a.WorkerID)
+	h.Equal(job.StatusRunning, a.Status)
+	h.Equal(*j.StartedAt, a.StartedAt)
+}
+
+func TestNewAttempt_FailsIfJobNotRunning(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+	h.NoError(err)
+
+	a, err := job.NewAttempt(j)
+
+	h.Nil(a)
+	h.ErrorIs(err, job.ErrAttemptJobNotRunning)
+}
+
+func TestAttemptFail_RecordsDetails(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+	h.NoError(err)
+	h.NoError(j.Start("worker-1"))
+	a, err := job.NewAttempt(j)
+	h.NoError(err)
+
+	exitCode := 1
+	err = a.Fail("ffmpeg exited", &exitCode, "Invalid data found when processing input")
+
+	h.NoError(err)
+	h.Equal(job.StatusFailed, a.Status)
+	h.Equal("ffmpeg exited", a.ErrorMsg)
+	h.Equal(1, *a.ExitCode)
+	h.Equal("Invalid data found when processing input", a.StderrTail)
+	h.NotNil(a.FinishedAt)
+}
+
+func TestAttemptFinish_FailsIfAlreadyFinished(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+	h.NoError(err)
+	h.NoError(j.Start("worker-1"))
+	a, err := job.NewAttempt(j)
+	h.NoError(err)
+	h.NoError(a.Complete())
+
+	h.ErrorIs(a.Complete(), job.ErrAttemptAlreadyFinished)
+	h.ErrorIs(a.Fail("late failure", nil, ""), job.ErrAttemptAlreadyFinished)
+	h.ErrorIs(a.Cancel(), job.ErrAttemptAlreadyFinished)
+	h.Equal(job.StatusCompleted, a.Status)
+}
@@ -0,0 +1,107 @@
+package job
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ChunkedVideoPipeline splits the source so that its chunks can be encoded in parallel.
+// The split job adds the chunk encode jobs once the chunks are known, and the assemble job
+// waits on them. It exists from the start so that the progress of the chunks is reported under it.
+var ChunkedVideoPipeline = []PipelineStep{
+	{Name: "probe", Type: TypeProbe},
+	{Name: "thumbnail", Type: TypeThumbnail, DependsOn: []string{"probe"}, Optional: true},
+	{Name: "split", Type: TypeSplit, DependsOn: []string{"probe"}},
+	{Name: "assemble", Type: TypeAssemble, DependsOn: []string{"split"}, Progress: true},
+}
+
+// ChunkSpec tells a chunk encode or assemble job which chunks it works on.
+// It is stored as the job payload.
+type ChunkSpec struct {
+	Index   int    `json:"index"`
+	Count   int    `json:"count"`
+	Path    string `json:"path,omitempty"` // The source chunk, relative to the video's resource
+	GroupID string `json:"group_id"`       // The assemble job, progress of every chunk is reported under it
+}
+
+// FindAssembleJob finds the assemble job waiting on the split job among the jobs of its video
+func FindAssembleJob(jobs []*Job, split *Job) (*Job, error) {
+	for _, j := range jobs {
+		if j.Type == TypeAssemble && slices.Contains(j.DependsOn, split.ID) {
+			return j, nil
+		}
+	}
+
+	return nil, ErrAssembleJobMissing
+}
+
+// NewChunkJobs creates a chunk encode job for every chunk cut by the split job,
+// and makes the assemble job wait on all of them.
+func NewChunkJobs(split, assemble *Job, chunks []string, newID func() string) ([]*Job, error) {
+	if split.Type != TypeSplit {
+		return nil, ErrNotSplitJob
+	}
+
+	if assemble.Type != TypeAssemble || !slices.Contains(assemble.DependsOn, split.ID) {
+		return nil, ErrNotAssembleJob
+	}
+
+	if len(chunks) == 0 {
+		return nil, ErrChunksEmpty
+	}
+
+	jobs := make([]*Job, 0, len(chunks))
+	for i, path := range chunks {
+		j, err := NewJob(newID(), split.VideoID, TypeChunkEncode)
+		if err != nil {
+			return nil, fmt.Errorf("create chunk %d job: %w", i, err)
+		}
+		j.Step = fmt.Sprintf("encode-%d", i)
+		j.DependsOn = []string{split.ID}
+		j.Priority = split.Priority
+		if err := j.setPayload(ChunkSpec{Index: i, Count: len(chunks), Path: path, GroupID: assemble.ID}); err != nil {
+			return nil, err
+		}
+
+		assemble.DependsOn = append(assemble.DependsOn, j.ID)
+		jobs = append(jobs, j)
+	}
+
+	if err := assemble.setPayload(ChunkSpec{Count: len(chunks), GroupID: assemble.ID}); err != nil {
+		return nil, err
+	}
+	assemble.UpdatedAt = time.Now().UTC()
+
+	return jobs, nil
+}
+
+// ChunkSpec reads the chunks the job works on from its payload
+func (j *Job) ChunkSpec() (*ChunkSpec, error) {
+	if j.Type != TypeChunkEncode && j.Type != TypeAssemble {
+		return nil, ErrChunkSpecInvalid
+	}
+
+	var spec ChunkSpec
+	if err := json.Unmarshal([]byte(j.Payload), &spec); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrChunkSpecInvalid, err)
+	}
+
+	if spec.Count <= 0 || spec.GroupID == "" {
+		return nil, ErrChunkSpecInvalid
+	}
+
+	return &spec, nil
+}
+
+func (j *Job) setPayload(spec ChunkSpec) error {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("marshal chunk spec: %w", err)
+	}
+
+	j.Payload = string(data)
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+// splitAndAssemble returns the split job of a chunked pipeline and the assemble job waiting on it
+func splitAndAssemble(h *jobTestHelper) (*job.Job, *job.Job) {
+	split, _ := job.NewJob(h.mockID, h.mockVideoID, job.TypeSplit)
+	split.Priority = 3
+	assemble, _ := job.NewJob("assemble-id", h.mockVideoID, job.TypeAssemble)
+	assemble.DependsOn = []string{split.ID}
+	return split, assemble
+}
+
+func TestNewChunkJobs_SuccessCase(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	split, assemble := splitAndAssemble(h)
+	chunks := []string{"chunks/0000.mp4", "chunks/0001.mp4"}
+
+	jobs, err := job.NewChunkJobs(split, assemble, chunks, sequentialIDs())
+
+	h.NoError(err)
+	h.Len(jobs, 2)
+
+	// The assemble job now waits on every chunk
+	h.Equal([]string{split.ID, "job-1", "job-2"}, assemble.DependsOn)
+
+	for i, j := range jobs {
+		h.Equal(job.TypeChunkEncode, j.Type)
+		h.Equal(h.mockVideoID, j.VideoID)
+		h.Equal([]string{split.ID}, j.DependsOn)
+		h.Equal(3, j.Priority)
+
+		spec, err := j.ChunkSpec()
+		h.NoError(err)
+		h.Equal(job.ChunkSpec{Index: i, Count: 2, Path: chunks[i], GroupID: assemble.ID}, *spec)
+	}
+
+	spec, err := assemble.ChunkSpec()
+	h.NoError(err)
+	h.Equal(2, spec.Count)
+	h.Equal(assemble.ID, spec.GroupID)
+}
+
+func TestNewChunkJobs_FailsOnNonSplitJob(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, _ := job.NewJob(h.mockID, h.mockVideoID, job.TypeTranscode)
+	_, assemble := splitAndAssemble(h)
+
+	jobs, err := job.NewChunkJobs(j, assemble, []string{"chunks/0000.mp4"}, sequentialIDs())
+
+	h.Nil(jobs)
+	h.ErrorIs(err, job.ErrNotSplitJob)
+}
+
+func TestNewChunkJobs_FailsOnUnrelatedAssembleJob(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	split, _ := splitAndAssemble(h)
+	other, _ := job.NewJob("other-id", h.mockVideoID, job.TypeAssemble)
+
+	jobs, err := job.NewChunkJobs(split, other, []string{"chunks/0000.mp4"}, sequentialIDs())
+
+	h.Nil(jobs)
+	h.ErrorIs(err, job.ErrNotAssembleJob)
+}
+
+func TestNewChunkJobs_FailsOnNoChunks(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	split, assemble := splitAndAssemble(h)
+
+	jobs, err := job.NewChunkJobs(split, assemble, nil, sequentialIDs())
+
+	h.Nil(jobs)
+	h.ErrorIs(err, job.ErrChunksEmpty)
+}
+
+func TestFindAssembleJob(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	split, assemble := splitAndAssemble(h)
+	probe, _ := job.NewJob("probe-id", h.mockVideoID, job.TypeProbe)
+
+	found, err := job.FindAssembleJob([]*job.Job{probe, split, assemble}, split)
+	h.NoError(err)
+	h.Same(assemble, found)
+
+	found, err = job.FindAssembleJob([]*job.Job{probe, split}, split)
+	h.Nil(found)
+	h.ErrorIs(err, job.ErrAssembleJobMissing)
+}
+
+func TestChunkSpec_FailsOnInvalidPayload(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, _ := job.NewJob(h.mockID, h.mockVideoID, job.TypeChunkEncode)
+	j.Payload = "not json"
+
+	spec, err := j.ChunkSpec()
+
+	h.Nil(spec)
+	h.ErrorIs(err, job.ErrChunkSpecInvalid)
+}
+
+func TestChunkSpec_FailsOnOtherJobType(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, _ := job.NewJob(h.mockID, h.mockVideoID, job.TypeTranscode)
+
+	spec, err := j.ChunkSpec()
+
+	h.Nil(spec)
+	h.ErrorIs(err, job.ErrChunkSpecInvalid)
+}
@@ -0,0 +1,64 @@
+package job
+
+import "time"
+
+// Cost is the estimated share of a worker's resources a job uses while it runs
+type Cost struct {
+	CPU    float64       // Cores kept busy
+	Memory int64         // Bytes held at peak
+	Work   time.Duration // CPU time of the whole run, CPU multiplied by the video duration
+}
+
+const (
+	// A 720p rendition, the largest one, keeps about one core busy while encoding
+	referencePixels = 1280 * 720
+	// Decoding and scaling the source costs a fraction of encoding it
+	decodeShare = 0.25
+	// Frames held by the decoder and scaler, and by the encoder lookahead of each rendition
+	decodedFrames   = 16
+	lookaheadFrames = 60
+	// A yuv420p frame takes one and a half bytes per pixel
+	bytesPerPixel = 1.5
+	// Memory of an ffmpeg process before any frame is held
+	baseMemory int64 = 64 << 20
+)
+
+// copyCost is the cost of the steps that copy streams without encoding video,
+// or that read or decode only a few frames of it
+var copyCost = Cost{CPU: 0.5, Memory: baseMemory}
+
+// Encodes reports whether jobs of the type encode the video stream,
+// their cost depends on the video
+func (jt JobType) Encodes() bool {
+	return jt == TypeTranscode || jt == TypeChunkEncode
+}
+
+// EstimateCost estimates the resources a job needs from the video it processes.
+// Encoding scales with the source resolution and the number of renditions encoded at once,
+// the work of the whole run with its duration.
+// Transcode jobs encode the renditions one after another, decoding the source for each,
+// chunk encode jobs encode them all in a single run.
+func EstimateCost(jobType JobType, width, height int, duration time.Duration, renditions int) Cost {
+	if !jobType.Encodes() {
+		return copyCost
+	}
+
+	concurrent, runs := renditions, 1
+	if jobType == TypeTranscode {
+		concurrent, runs = min(renditions, 1), renditions
+	}
+
+	srcPixels := float64(width * height)
+	encPixels := min(srcPixels, referencePixels)
+
+	cpu := decodeShare*srcPixels/referencePixels + float64(concurrent)*encPixels/referencePixels
+	memory := baseMemory +
+		int64(srcPixels*bytesPerPixel*decodedFrames) +
+		int64(float64(concurrent)*encPixels*bytesPerPixel*lookaheadFrames)
+
+	return Cost{
+		CPU:    cpu,
+		Memory: memory,
+		Work:   time.Duration(float64(runs) * cpu * float64(duration)),
+	}
+}
@@ -0,0 +1,62 @@
+package job_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+func TestEstimateCost_ScalesWithResolutionAndRenditions(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	hd := job.EstimateCost(job.TypeChunkEncode, 1280, 720, time.Minute, 2)
+	uhd := job.EstimateCost(job.TypeChunkEncode, 3840, 2160, time.Minute, 2)
+	single := job.EstimateCost(job.TypeChunkEncode, 1280, 720, time.Minute, 1)
+
+	// Two 720p renditions and the decoding of a 720p source
+	h.InDelta(2.25, hd.CPU, 0.001)
+	h.Greater(uhd.CPU, hd.CPU)
+	h.Greater(uhd.Memory, hd.Memory)
+	h.Less(single.CPU, hd.CPU)
+	h.Less(single.Memory, hd.Memory)
+}
+
+func TestEstimateCost_TranscodeEncodesRenditionsOneAfterAnother(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	transcode := job.EstimateCost(job.TypeTranscode, 1280, 720, time.Minute, 2)
+	single := job.EstimateCost(job.TypeChunkEncode, 1280, 720, time.Minute, 1)
+
+	// One rendition at a time, each run decoding the source again
+	h.Equal(single.CPU, transcode.CPU)
+	h.Equal(single.Memory, transcode.Memory)
+	h.Equal(2*single.Work, transcode.Work)
+}
+
+func TestEstimateCost_WorkScalesWithDuration(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	short := job.EstimateCost(job.TypeChunkEncode, 1920, 1080, time.Minute, 2)
+	long := job.EstimateCost(job.TypeChunkEncode, 1920, 1080, 10*time.Minute, 2)
+
+	h.Equal(short.CPU, long.CPU)
+	h.Equal(short.Memory, long.Memory)
+	h.Equal(10*short.Work, long.Work)
+}
+
+func TestEstimateCost_CopyStepsAreCheap(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	transcode := job.EstimateCost(job.TypeTranscode, 3840, 2160, time.Hour, 2)
+
+	for _, jt := range []job.JobType{job.TypeProbe, job.TypeSplit, job.TypeAssemble, job.TypeThumbnail} {
+		cost := job.EstimateCost(jt, 3840, 2160, time.Hour, 2)
+		h.Less(cost.CPU, transcode.CPU)
+		h.Less(cost.Memory, transcode.Memory)
+	}
+}
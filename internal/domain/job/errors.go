@@ -3,10 +3,29 @@ package job
 import "errors"
 
 var (
-	ErrJobIDEmpty             = errors.New("job id cannot be empty")
-	ErrVideoIDEmpty           = errors.New("video id cannot be empty")
-	ErrJobTypeInvalid         = errors.New("job type is invalid")
-	ErrCannotBeStarted        = errors.New("job cannot be started")
-	ErrCannotBeCompleted      = errors.New("job cannot be completed")
-	ErrCannotBeMarkedAsFailed = errors.New("job cannot be marked as failed")
+	ErrJobIDEmpty                = errors.New("job id cannot be empty")
+	ErrVideoIDEmpty              = errors.New("video id cannot be empty")
+	ErrJobTypeInvalid            = errors.New("job type is invalid")
+	ErrJobStatusInvalid          = errors.New("job status is invalid")
+	ErrCannotBeStarted           = errors.New("job cannot be started")
+	ErrCannotBeCompleted         = errors.New("job cannot be completed")
+	ErrCannotBeMarkedAsFailed    = errors.New("job cannot be marked as failed")
+	ErrCannotBeCancelled         = errors.New("job cannot be cancelled")
+	ErrCannotBeRetried           = errors.New("job cannot be retried")
+	ErrCannotBeReleased          = errors.New("job cannot be released")
+	ErrPriorityCannotBeChanged   = errors.New("job priority can only be changed while pending")
+	ErrAttemptJobNotRunning      = errors.New("attempt can only be recorded for a running job")
+	ErrAttemptAlreadyFinished    = errors.New("attempt has already finished")
+	ErrPipelineEmpty             = errors.New("pipeline must have at least one step")
+	ErrPipelineStepNameEmpty     = errors.New("pipeline step name cannot be empty")
+	ErrPipelineStepDuplicate     = errors.New("pipeline step name is used more than once")
+	ErrPipelineStepUnknown       = errors.New("pipeline step depends on an unknown step")
+	ErrPipelineStepOptional      = errors.New("required pipeline step cannot depend on an optional step")
+	ErrPipelineCycle             = errors.New("pipeline steps depend on each other in a cycle")
+	ErrPipelineProgressDuplicate = errors.New("only one pipeline step can report the progress")
+	ErrNotSplitJob               = errors.New("chunk jobs can only be created from a split job")
+	ErrNotAssembleJob            = errors.New("chunks can only be assembled by an assemble job depending on their split job")
+	ErrAssembleJobMissing        = errors.New("split job has no assemble job")
+	ErrChunksEmpty               = errors.New("split job must produce at least one chunk")
+	ErrChunkSpecInvalid          = errors.New("job payload is not a valid chunk spec")
 )
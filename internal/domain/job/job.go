@@ -3,14 +3,24 @@ package job
 import "time"
 
 type Job struct {
-	ID        string
-	VideoID   string
-	Type      JobType
-	Status    JobStatus
-	Result    string
-	ErrorMsg  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID         string
+	VideoID    string
+	Type       JobType
+	Step       string
+	DependsOn  []string
+	Optional   bool
+	Payload    string // Type specific parameters, see ChunkSpec
+	Status     JobStatus
+	Result     string
+	ErrorMsg   string
+	Priority   int
+	Attempts   int
+	WorkerID   string
+	Cost       *Cost // Estimated once the job is first claimed, nil until then
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
 func NewJob(id, videoID string, jobType JobType) (*Job, error) {
@@ -37,13 +47,18 @@ func NewJob(id, videoID string, jobType JobType) (*Job, error) {
 }
 
 // Lifycycle management
-func (j *Job) Start() error {
+func (j *Job) Start(workerID string) error {
 	if !j.CanBeStarted() {
 		return ErrCannotBeStarted
 	}
 
+	now := time.Now().UTC()
 	j.Status = StatusRunning
-	j.UpdatedAt = time.Now().UTC()
+	j.Attempts++
+	j.WorkerID = workerID
+	j.StartedAt = &now
+	j.FinishedAt = nil
+	j.UpdatedAt = now
 
 	return nil
 }
@@ -53,9 +68,11 @@ func (j *Job) Complete(result string) error {
 		return ErrCannotBeCompleted
 	}
 
+	now := time.Now().UTC()
 	j.Status = StatusCompleted
 	j.Result = result
-	j.UpdatedAt = time.Now().UTC()
+	j.FinishedAt = &now
+	j.UpdatedAt = now
 
 	return nil
 }
@@ -65,13 +82,83 @@ func (j *Job) MarkAsFailed(errMsg string) error {
 		return ErrCannotBeMarkedAsFailed
 	}
 
+	now := time.Now().UTC()
 	j.Status = StatusFailed
 	j.ErrorMsg = errMsg
+	j.FinishedAt = &now
+	j.UpdatedAt = now
+
+	return nil
+}
+
+// Release gives back a job claimed by a worker that did not run it,
+// it returns to the queue as if it had never been claimed.
+func (j *Job) Release() error {
+	if !j.IsRunning() {
+		return ErrCannotBeReleased
+	}
+
+	j.Status = StatusPending
+	j.Attempts--
+	j.WorkerID = ""
+	j.StartedAt = nil
 	j.UpdatedAt = time.Now().UTC()
 
 	return nil
 }
 
+// Cancel stops a queued or running job.
+// A running job is expected to be interrupted by its worker.
+func (j *Job) Cancel() error {
+	if !j.CanBeCancelled() {
+		return ErrCannotBeCancelled
+	}
+
+	now := time.Now().UTC()
+	j.Status = StatusCancelled
+	j.FinishedAt = &now
+	j.UpdatedAt = now
+
+	return nil
+}
+
+// Retry puts a failed or cancelled job back in the queue.
+func (j *Job) Retry() error {
+	if !j.CanBeRetried() {
+		return ErrCannotBeRetried
+	}
+
+	j.Status = StatusPending
+	j.Result = ""
+	j.ErrorMsg = ""
+	j.FinishedAt = nil
+	j.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// SetPriority changes the position of a queued job.
+// Jobs with a higher priority are picked up first.
+func (j *Job) SetPriority(priority int) error {
+	if !j.IsPending() {
+		return ErrPriorityCannotBeChanged
+	}
+
+	j.Priority = priority
+	j.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// Duration returns how long the last attempt ran, or zero if it has not finished.
+func (j *Job) Duration() time.Duration {
+	if j.StartedAt == nil || j.FinishedAt == nil {
+		return 0
+	}
+
+	return j.FinishedAt.Sub(*j.StartedAt)
+}
+
 // Status access
 func (j *Job) IsPending() bool {
 	return j.Status == StatusPending
@@ -89,6 +176,18 @@ func (j *Job) IsFailed() bool {
 	return j.Status == StatusFailed
 }
 
+func (j *Job) IsCancelled() bool {
+	return j.Status == StatusCancelled
+}
+
 func (j *Job) CanBeStarted() bool {
 	return j.Status == StatusPending || j.Status == StatusFailed
 }
+
+func (j *Job) CanBeRetried() bool {
+	return j.Status == StatusFailed || j.Status == StatusCancelled
+}
+
+func (j *Job) CanBeCancelled() bool {
+	return j.Status == StatusPending || j.Status == StatusRunning
+}
@@ -78,9 +78,13 @@ func TestStart_SuccessCaseFromPending(t *testing.T) {
 	h.NoError(err)
 	h.Equal(job.StatusPending, j.Status)
 
-	err = j.Start()
+	err = j.Start("worker-1")
 	h.NoError(err)
 	h.Equal(job.StatusRunning, j.Status)
+	h.Equal(1, j.Attempts)
+	h.Equal("worker-1", j.WorkerID)
+	h.NotNil(j.StartedAt)
+	h.Nil(j.FinishedAt)
 }
 
 func TestStart_SuccessCaseFromFailed(t *testing.T) {
@@ -91,7 +95,7 @@ func TestStart_SuccessCaseFromFailed(t *testing.T) {
 	h.NoError(err)
 	j.Status = job.StatusFailed // Manually set state for test
 
-	err = j.Start()
+	err = j.Start("worker-1")
 	h.NoError(err)
 	h.Equal(job.StatusRunning, j.Status)
 }
@@ -104,7 +108,7 @@ func TestStart_FailsIfNotPendingOrFailed(t *testing.T) {
 	h.NoError(err)
 	j.Status = job.StatusRunning // Set to a non-startable state
 
-	err = j.Start()
+	err = j.Start("worker-1")
 	h.ErrorIs(err, job.ErrCannotBeStarted)
 }
 
@@ -169,3 +173,148 @@ func TestMarkAsFailed_FailsIfNotRunning(t *testing.T) {
 	err = j.MarkAsFailed("some error")
 	h.ErrorIs(err, job.ErrCannotBeMarkedAsFailed)
 }
+
+func TestRelease_ReturnsJobToQueue(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+	h.NoError(err)
+	h.NoError(j.Start("worker-1"))
+
+	err = j.Release()
+	h.NoError(err)
+	h.Equal(job.StatusPending, j.Status)
+	h.Zero(j.Attempts)
+	h.Empty(j.WorkerID)
+	h.Nil(j.StartedAt)
+}
+
+func TestRelease_FailsIfNotRunning(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	for _, status := range []job.JobStatus{job.StatusPending, job.StatusCompleted, job.StatusFailed, job.StatusCancelled} {
+		j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+		h.NoError(err)
+		j.Status = status
+
+		err = j.Release()
+		h.ErrorIs(err, job.ErrCannotBeReleased)
+	}
+}
+
+func TestCancel_SuccessCaseFromPending(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+	h.NoError(err)
+
+	err = j.Cancel()
+	h.NoError(err)
+	h.Equal(job.StatusCancelled, j.Status)
+	h.True(j.IsCancelled())
+}
+
+func TestCancel_SuccessCaseFromRunning(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+	h.NoError(err)
+	j.Status = job.StatusRunning // Manually set state for test
+
+	err = j.Cancel()
+	h.NoError(err)
+	h.Equal(job.StatusCancelled, j.Status)
+}
+
+func TestCancel_FailsIfFinished(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	for _, status := range []job.JobStatus{job.StatusCompleted, job.StatusFailed, job.StatusCancelled} {
+		j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+		h.NoError(err)
+		j.Status = status
+
+		err = j.Cancel()
+		h.ErrorIs(err, job.ErrCannotBeCancelled)
+	}
+}
+
+func TestRetry_SuccessCase(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	for _, status := range []job.JobStatus{job.StatusFailed, job.StatusCancelled} {
+		j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+		h.NoError(err)
+		h.NoError(j.Start("worker-1"))
+		h.NoError(j.MarkAsFailed("transcoding failed"))
+		j.Status = status
+
+		err = j.Retry()
+		h.NoError(err)
+		h.Equal(job.StatusPending, j.Status)
+		h.Empty(j.ErrorMsg)
+		h.Nil(j.FinishedAt)
+		h.Equal(1, j.Attempts) // Attempts are kept across retries
+	}
+}
+
+func TestRetry_FailsIfNotFailedOrCancelled(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	for _, status := range []job.JobStatus{job.StatusPending, job.StatusRunning, job.StatusCompleted} {
+		j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+		h.NoError(err)
+		j.Status = status
+
+		err = j.Retry()
+		h.ErrorIs(err, job.ErrCannotBeRetried)
+	}
+}
+
+func TestSetPriority_SuccessCase(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+	h.NoError(err)
+
+	err = j.SetPriority(10)
+	h.NoError(err)
+	h.Equal(10, j.Priority)
+}
+
+func TestSetPriority_FailsIfNotPending(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+	h.NoError(err)
+	j.Status = job.StatusRunning
+
+	err = j.SetPriority(10)
+	h.ErrorIs(err, job.ErrPriorityCannotBeChanged)
+	h.Equal(0, j.Priority)
+}
+
+func TestDuration(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	j, err := job.NewJob(h.mockID, h.mockVideoID, h.mockJobType)
+	h.NoError(err)
+	h.Zero(j.Duration()) // Not started
+
+	started := time.Now().UTC()
+	finished := started.Add(90 * time.Second)
+	j.StartedAt = &started
+	j.FinishedAt = &finished
+
+	h.Equal(90*time.Second, j.Duration())
+}
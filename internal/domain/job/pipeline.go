@@ -0,0 +1,192 @@
+package job
+
+import "fmt"
+
+// PipelineStep describes one job of a processing pipeline.
+// DependsOn holds the names of the steps that must complete before it runs.
+// The progress of the video is followed on the job of the step marked with Progress.
+type PipelineStep struct {
+	Name      string
+	Type      JobType
+	DependsOn []string
+	Optional  bool
+	Progress  bool
+}
+
+// VideoPipeline lists the steps run for every uploaded video.
+// The video is published once all required steps have completed,
+// publishing is the end of the pipeline rather than a step of its own.
+// The thumbnail is optional, a video without one is still published.
+var VideoPipeline = []PipelineStep{
+	{Name: "probe", Type: TypeProbe},
+	{Name: "thumbnail", Type: TypeThumbnail, DependsOn: []string{"probe"}, Optional: true},
+	{Name: "transcode", Type: TypeTranscode, DependsOn: []string{"probe"}, Progress: true},
+}
+
+// NewPipeline creates the jobs of a pipeline for the specified video,
+// ordered so that every job comes after the jobs it depends on.
+func NewPipeline(videoID string, steps []PipelineStep, newID func() string) ([]*Job, error) {
+	if len(steps) == 0 {
+		return nil, ErrPipelineEmpty
+	}
+
+	byName := make(map[string]PipelineStep, len(steps))
+	progressSteps := 0
+	for _, s := range steps {
+		if s.Name == "" {
+			return nil, ErrPipelineStepNameEmpty
+		}
+		if _, ok := byName[s.Name]; ok {
+			return nil, fmt.Errorf("step %s: %w", s.Name, ErrPipelineStepDuplicate)
+		}
+		if s.Progress {
+			progressSteps++
+		}
+		byName[s.Name] = s
+	}
+	if progressSteps > 1 {
+		return nil, ErrPipelineProgressDuplicate
+	}
+
+	// Count the unmet dependencies of each step
+	waiting := make(map[string]int, len(steps))
+	dependents := make(map[string][]string, len(steps))
+	for _, s := range steps {
+		for _, dep := range s.DependsOn {
+			d, ok := byName[dep]
+			if !ok {
+				return nil, fmt.Errorf("step %s depends on %s: %w", s.Name, dep, ErrPipelineStepUnknown)
+			}
+			// A failed optional step never unblocks its dependents
+			if d.Optional && !s.Optional {
+				return nil, fmt.Errorf("step %s depends on %s: %w", s.Name, dep, ErrPipelineStepOptional)
+			}
+			waiting[s.Name]++
+			dependents[dep] = append(dependents[dep], s.Name)
+		}
+	}
+
+	// Walk the steps in dependency order, keeping the declared order among ready steps
+	var ready []string
+	for _, s := range steps {
+		if waiting[s.Name] == 0 {
+			ready = append(ready, s.Name)
+		}
+	}
+
+	ids := make(map[string]string, len(steps))
+	jobs := make([]*Job, 0, len(steps))
+	for len(ready) > 0 {
+		s := byName[ready[0]]
+		ready = ready[1:]
+
+		j, err := NewJob(newID(), videoID, s.Type)
+		if err != nil {
+			return nil, fmt.Errorf("step %s: %w", s.Name, err)
+		}
+		j.Step = s.Name
+		j.Optional = s.Optional
+		for _, dep := range s.DependsOn {
+			j.DependsOn = append(j.DependsOn, ids[dep])
+		}
+
+		ids[s.Name] = j.ID
+		jobs = append(jobs, j)
+
+		for _, next := range dependents[s.Name] {
+			waiting[next]--
+			if waiting[next] == 0 {
+				ready = append(ready, next)
+			}
+		}
+	}
+
+	if len(jobs) != len(steps) {
+		return nil, ErrPipelineCycle
+	}
+
+	return jobs, nil
+}
+
+// ProgressJob finds the job of the step reporting the progress of a pipeline,
+// nil when no step does.
+func ProgressJob(steps []PipelineStep, jobs []*Job) *Job {
+	for _, s := range steps {
+		if !s.Progress {
+			continue
+		}
+		for _, j := range jobs {
+			if j.Step == s.Name {
+				return j
+			}
+		}
+	}
+
+	return nil
+}
+
+// PipelineSucceeded reports whether every required job of a pipeline has completed
+// and no optional job is still waiting to run.
+func PipelineSucceeded(jobs []*Job) bool {
+	byID := make(map[string]*Job, len(jobs))
+	for _, j := range jobs {
+		byID[j.ID] = j
+	}
+
+	for _, j := range jobs {
+		if j.IsCompleted() {
+			continue
+		}
+		if !j.Optional {
+			return false
+		}
+		if j.IsRunning() || (j.IsPending() && !isBlocked(j, byID)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Dependents returns the jobs of a pipeline that depend on the job with the given id,
+// directly or through other jobs.
+func Dependents(jobs []*Job, id string) []*Job {
+	var dependents []*Job
+	found := map[string]bool{id: true}
+
+	// Jobs are listed after their dependencies, but a pipeline may be listed in any order
+	for added := true; added; {
+		added = false
+		for _, j := range jobs {
+			if found[j.ID] {
+				continue
+			}
+			for _, dep := range j.DependsOn {
+				if found[dep] {
+					found[j.ID] = true
+					dependents = append(dependents, j)
+					added = true
+					break
+				}
+			}
+		}
+	}
+
+	return dependents
+}
+
+// isBlocked reports whether a pending job can never run because
+// one of its dependencies failed or was cancelled.
+func isBlocked(j *Job, byID map[string]*Job) bool {
+	for _, id := range j.DependsOn {
+		dep, ok := byID[id]
+		if !ok {
+			continue
+		}
+		if dep.IsFailed() || dep.IsCancelled() || (dep.IsPending() && isBlocked(dep, byID)) {
+			return true
+		}
+	}
+
+	return false
+}
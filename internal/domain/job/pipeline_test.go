@@ -0,0 +1,174 @@
+package job_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/domain/job"
+)
+
+// sequentialIDs returns an id generator yielding job-1, job-2, ...
+func sequentialIDs() func() string {
+	n := 0
+	return func() string {
+		n++
+		return fmt.Sprintf("job-%d", n)
+	}
+}
+
+func TestNewPipeline_OrdersJobsByDependency(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	// Declared out of order on purpose
+	steps := []job.PipelineStep{
+		{Name: "publish", Type: job.TypeTranscode, DependsOn: []string{"transcode", "thumbnails"}},
+		{Name: "thumbnails", Type: job.TypeTranscode, DependsOn: []string{"probe"}},
+		{Name: "transcode", Type: job.TypeTranscode, DependsOn: []string{"probe"}},
+		{Name: "probe", Type: job.TypeTranscode},
+	}
+
+	jobs, err := job.NewPipeline(h.mockVideoID, steps, sequentialIDs())
+
+	h.NoError(err)
+	h.Len(jobs, 4)
+	h.Equal("probe", jobs[0].Step)
+	h.Empty(jobs[0].DependsOn)
+	h.Equal("thumbnails", jobs[1].Step)
+	h.Equal([]string{jobs[0].ID}, jobs[1].DependsOn)
+	h.Equal("transcode", jobs[2].Step)
+	h.Equal("publish", jobs[3].Step)
+	h.Equal([]string{jobs[2].ID, jobs[1].ID}, jobs[3].DependsOn)
+	for _, j := range jobs {
+		h.Equal(h.mockVideoID, j.VideoID)
+		h.Equal(job.StatusPending, j.Status)
+	}
+}
+
+func TestNewPipeline_VideoPipelines(t *testing.T) {
+	t.Parallel()
+
+	for _, steps := range [][]job.PipelineStep{job.VideoPipeline, job.ChunkedVideoPipeline} {
+		h := setupJobTestHelper(t)
+
+		jobs, err := job.NewPipeline(h.mockVideoID, steps, sequentialIDs())
+
+		// The source is probed first, every job type has a step of the workers
+		h.NoError(err)
+		h.Equal(job.TypeProbe, jobs[0].Type)
+		for _, j := range jobs {
+			h.True(j.Type.IsValid())
+			h.Equal(j.Type == job.TypeThumbnail, j.Optional)
+		}
+
+		// The progress is reported by the job producing the stream
+		progressJob := job.ProgressJob(steps, jobs)
+		h.NotNil(progressJob)
+		h.Contains([]job.JobType{job.TypeTranscode, job.TypeAssemble}, progressJob.Type)
+	}
+}
+
+func TestNewPipeline_FailsOnInvalidGraph(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		steps []job.PipelineStep
+		err   error
+	}{
+		{"empty", nil, job.ErrPipelineEmpty},
+		{"unnamed step", []job.PipelineStep{{Type: job.TypeTranscode}}, job.ErrPipelineStepNameEmpty},
+		{"duplicate step", []job.PipelineStep{
+			{Name: "a", Type: job.TypeTranscode},
+			{Name: "a", Type: job.TypeTranscode},
+		}, job.ErrPipelineStepDuplicate},
+		{"unknown dependency", []job.PipelineStep{
+			{Name: "a", Type: job.TypeTranscode, DependsOn: []string{"b"}},
+		}, job.ErrPipelineStepUnknown},
+		{"required after optional", []job.PipelineStep{
+			{Name: "a", Type: job.TypeTranscode, Optional: true},
+			{Name: "b", Type: job.TypeTranscode, DependsOn: []string{"a"}},
+		}, job.ErrPipelineStepOptional},
+		{"cycle", []job.PipelineStep{
+			{Name: "a", Type: job.TypeTranscode, DependsOn: []string{"c"}},
+			{Name: "b", Type: job.TypeTranscode, DependsOn: []string{"a"}},
+			{Name: "c", Type: job.TypeTranscode, DependsOn: []string{"b"}},
+		}, job.ErrPipelineCycle},
+		{"invalid type", []job.PipelineStep{{Name: "a", Type: "unknown"}}, job.ErrJobTypeInvalid},
+		{"two progress steps", []job.PipelineStep{
+			{Name: "a", Type: job.TypeTranscode, Progress: true},
+			{Name: "b", Type: job.TypeTranscode, Progress: true},
+		}, job.ErrPipelineProgressDuplicate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := setupJobTestHelper(t)
+
+			jobs, err := job.NewPipeline(h.mockVideoID, tt.steps, sequentialIDs())
+
+			h.Nil(jobs)
+			h.ErrorIs(err, tt.err)
+		})
+	}
+}
+
+func TestPipelineSucceeded(t *testing.T) {
+	t.Parallel()
+
+	steps := []job.PipelineStep{
+		{Name: "transcode", Type: job.TypeTranscode},
+		{Name: "thumbnails", Type: job.TypeTranscode, DependsOn: []string{"transcode"}, Optional: true},
+		{Name: "captions", Type: job.TypeTranscode, DependsOn: []string{"thumbnails"}, Optional: true},
+	}
+
+	tests := []struct {
+		name     string
+		statuses []job.JobStatus
+		want     bool
+	}{
+		{"all completed", []job.JobStatus{job.StatusCompleted, job.StatusCompleted, job.StatusCompleted}, true},
+		{"required step pending", []job.JobStatus{job.StatusPending, job.StatusPending, job.StatusPending}, false},
+		{"required step failed", []job.JobStatus{job.StatusFailed, job.StatusPending, job.StatusPending}, false},
+		{"optional step still running", []job.JobStatus{job.StatusCompleted, job.StatusRunning, job.StatusPending}, false},
+		{"optional step waiting to run", []job.JobStatus{job.StatusCompleted, job.StatusCompleted, job.StatusPending}, false},
+		{"optional step failed and blocks its dependents", []job.JobStatus{job.StatusCompleted, job.StatusFailed, job.StatusPending}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := setupJobTestHelper(t)
+
+			jobs, err := job.NewPipeline(h.mockVideoID, steps, sequentialIDs())
+			h.NoError(err)
+			for i, status := range tt.statuses {
+				jobs[i].Status = status
+			}
+
+			h.Equal(tt.want, job.PipelineSucceeded(jobs))
+		})
+	}
+}
+
+func TestDependents(t *testing.T) {
+	t.Parallel()
+	h := setupJobTestHelper(t)
+
+	jobs, err := job.NewPipeline(h.mockVideoID, []job.PipelineStep{
+		{Name: "probe", Type: job.TypeProbe},
+		{Name: "thumbnail", Type: job.TypeThumbnail, DependsOn: []string{"probe"}, Optional: true},
+		{Name: "transcode", Type: job.TypeTranscode, DependsOn: []string{"probe"}},
+		{Name: "captions", Type: job.TypeTranscode, DependsOn: []string{"thumbnail"}, Optional: true},
+	}, sequentialIDs())
+	h.NoError(err)
+	byStep := map[string]*job.Job{}
+	for _, j := range jobs {
+		byStep[j.Step] = j
+	}
+
+	h.ElementsMatch([]*job.Job{byStep["captions"]}, job.Dependents(jobs, byStep["thumbnail"].ID))
+	h.ElementsMatch([]*job.Job{byStep["thumbnail"], byStep["transcode"], byStep["captions"]}, job.Dependents(jobs, byStep["probe"].ID))
+	h.Empty(job.Dependents(jobs, byStep["transcode"].ID))
+}
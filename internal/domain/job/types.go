@@ -7,16 +7,33 @@ const (
 	StatusRunning   JobStatus = "running"
 	StatusCompleted JobStatus = "completed"
 	StatusFailed    JobStatus = "failed"
+	StatusCancelled JobStatus = "cancelled"
 )
 
+func (js JobStatus) IsValid() bool {
+	switch js {
+	case StatusPending, StatusRunning, StatusCompleted, StatusFailed, StatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
 type JobType string
 
-// Potential type for thumbnail generation
-const TypeTranscode JobType = "transcode"
+// Every type is run by the workers, each by its own step of the transcoder
+const (
+	TypeProbe       JobType = "probe" // Reads the resolution and duration of the source
+	TypeTranscode   JobType = "transcode"
+	TypeSplit       JobType = "split"        // Cuts the source into chunks
+	TypeChunkEncode JobType = "chunk_encode" // Encodes a single chunk
+	TypeAssemble    JobType = "assemble"     // Joins the encoded chunks into the stream
+	TypeThumbnail   JobType = "thumbnail"    // Extracts a representative frame of the source
+)
 
 func (jt JobType) IsValid() bool {
 	switch jt {
-	case TypeTranscode:
+	case TypeProbe, TypeTranscode, TypeSplit, TypeChunkEncode, TypeAssemble, TypeThumbnail:
 		return true
 	default:
 		return false
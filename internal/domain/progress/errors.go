@@ -7,4 +7,7 @@ var (
 	ErrCannotBeUpdated       = errors.New("current frames cannot be updated")
 	ErrCannotBeMarkedAsEnd   = errors.New("progress cannot be marked as ended")
 	ErrCannotBeMarkedAsError = errors.New("progress cannot be marked as error")
+	ErrCannotBeCancelled     = errors.New("progress cannot be cancelled")
+	ErrStageUnknown          = errors.New("stage is not part of the progress")
+	ErrStageAlreadyPassed    = errors.New("stage has already been passed")
 )
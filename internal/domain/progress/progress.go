@@ -1,12 +1,28 @@
 package progress
 
+import "strings"
+
 // Progress represents the transcoding progress of a video.
 // It tracks the number of processed frames relative to the total.
+// A staged progress also splits the processing into named stages,
+// its percentage then covers every stage and not only the encoding.
 type Progress struct {
-	TotalFrames   int64
-	CurrentFrames int64
-	Status        ProgressStatus
-	Percentage    int
+	TotalFrames      int64
+	CurrentFrames    int64
+	Status           ProgressStatus
+	Percentage       int
+	Sequence         int64 // Orders the updates of a job, assigned by the streamer when pushed
+	Stage            Stage // Stage running, empty when the progress has no stages
+	Stages           []StageProgress
+	FPS              float64 // Frames encoded per second
+	Speed            float64 // Encoding speed relative to playback, 2 is twice as fast
+	RemainingSeconds float64 // Estimated time until the encoding finishes, 0 when unknown
+}
+
+// StageProgress is the percentage of a single stage.
+type StageProgress struct {
+	Name       Stage
+	Percentage int
 }
 
 // NewProgress creates a new Progress instance with the specified total frames.
@@ -22,6 +38,31 @@ func NewProgress(totalFrames int64) (*Progress, error) {
 	}, nil
 }
 
+// NewStagedProgress creates a Progress split into the given stages, starting with the first one.
+// The total frames are set once known, with SetTotalFrames.
+func NewStagedProgress(stages ...Stage) *Progress {
+	p := &Progress{Status: StatusContinue}
+	for _, s := range stages {
+		p.Stages = append(p.Stages, StageProgress{Name: s})
+	}
+	if len(stages) > 0 {
+		p.Stage = stages[0]
+	}
+
+	return p
+}
+
+// NewFinishedProgress creates the final progress of a job that no longer runs,
+// for when the progress it reported is not available anymore.
+func NewFinishedProgress(status ProgressStatus) *Progress {
+	p := &Progress{Status: status}
+	if status == StatusEnd {
+		p.Percentage = 100
+	}
+
+	return p
+}
+
 // UpdateCurrentFrames updates the number of processed frames and recalculates the percentage.
 // It caps the percentage at 100 to handle potential inaccuracies in FFmpeg's frame reporting.
 func (p *Progress) UpdateCurrentFrames(currentFrames int64) error {
@@ -29,6 +70,10 @@ func (p *Progress) UpdateCurrentFrames(currentFrames int64) error {
 		return ErrCannotBeUpdated
 	}
 
+	if p.TotalFrames == 0 {
+		return ErrTotalFramesZero
+	}
+
 	// Update current processed frames
 	p.CurrentFrames = currentFrames
 
@@ -36,11 +81,136 @@ func (p *Progress) UpdateCurrentFrames(currentFrames int64) error {
 	// min() is used to cap the value at 100 to handle FFmpeg metadata variances.
 	pct := min(int((p.CurrentFrames*100)/p.TotalFrames), 100)
 
-	p.Percentage = pct
+	if p.FPS > 0 {
+		p.RemainingSeconds = float64(max(p.TotalFrames-p.CurrentFrames, 0)) / p.FPS
+	}
+
+	if len(p.Stages) == 0 {
+		p.Percentage = pct
+		return nil
+	}
+
+	return p.UpdateStage(pct)
+}
+
+// SetTotalFrames sets the number of frames to encode, once the source has been probed.
+func (p *Progress) SetTotalFrames(totalFrames int64) error {
+	if totalFrames == 0 {
+		return ErrTotalFramesZero
+	}
+
+	p.TotalFrames = totalFrames
+
+	return nil
+}
+
+// UpdateThroughput records the encoding speed reported by FFmpeg
+// and estimates the time remaining from the frames left to encode.
+func (p *Progress) UpdateThroughput(fps, speed float64) error {
+	if p.Status != StatusContinue {
+		return ErrCannotBeUpdated
+	}
+
+	p.FPS = fps
+	p.Speed = speed
+	p.RemainingSeconds = 0
+
+	if fps > 0 && p.TotalFrames > p.CurrentFrames {
+		p.RemainingSeconds = float64(p.TotalFrames-p.CurrentFrames) / fps
+	}
+
+	return nil
+}
+
+// StartStage completes the stages before the given one and starts it.
+func (p *Progress) StartStage(stage Stage) error {
+	if p.Status != StatusContinue {
+		return ErrCannotBeUpdated
+	}
+
+	idx := p.stageIndex(stage)
+	if idx < 0 {
+		return ErrStageUnknown
+	}
+	if idx < p.stageIndex(p.Stage) {
+		return ErrStageAlreadyPassed
+	}
+
+	for i := range idx {
+		p.Stages[i].Percentage = 100
+	}
+	p.Stage = stage
+	p.FPS, p.Speed, p.RemainingSeconds = 0, 0, 0
+	p.updatePercentage()
+
+	return nil
+}
+
+// UpdateStage sets the percentage of the running stage and recalculates the overall percentage.
+func (p *Progress) UpdateStage(percentage int) error {
+	if p.Status != StatusContinue {
+		return ErrCannotBeUpdated
+	}
+
+	idx := p.stageIndex(p.Stage)
+	if idx < 0 {
+		return ErrStageUnknown
+	}
+
+	p.Stages[idx].Percentage = max(0, min(percentage, 100))
+	p.updatePercentage()
 
 	return nil
 }
 
+// Advance completes the running stage and starts the next one.
+// A progress without stages, or on its last stage, ends instead.
+func (p *Progress) Advance() error {
+	idx := p.stageIndex(p.Stage)
+	if idx < 0 || idx == len(p.Stages)-1 {
+		return p.End()
+	}
+
+	return p.StartStage(p.Stages[idx+1].Name)
+}
+
+func (p *Progress) stageIndex(stage Stage) int {
+	for i, s := range p.Stages {
+		if s.Name == stage {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// updatePercentage sets the overall percentage to the weighted sum of the stages.
+func (p *Progress) updatePercentage() {
+	var total, done int
+	for _, s := range p.Stages {
+		w := stageWeight(s.Name)
+		total += w
+		done += w * s.Percentage
+	}
+
+	if total > 0 {
+		p.Percentage = done / total
+	}
+}
+
+// stageWeight returns the share of a stage in the overall percentage.
+// Stages without a weight count as much as the smallest known one.
+func stageWeight(stage Stage) int {
+	if w, ok := stageWeights[stage]; ok {
+		return w
+	}
+	if strings.HasPrefix(string(stage), renditionStagePrefix) {
+		return renditionWeight
+	}
+
+	return 5
+}
+
 // End marks the progress as successfully completed.
 func (p *Progress) End() error {
 	if p.Status != StatusContinue {
@@ -49,6 +219,14 @@ func (p *Progress) End() error {
 
 	p.Status = StatusEnd
 
+	if len(p.Stages) > 0 {
+		for i := range p.Stages {
+			p.Stages[i].Percentage = 100
+		}
+		p.Percentage = 100
+		p.RemainingSeconds = 0
+	}
+
 	return nil
 }
 
@@ -62,3 +240,63 @@ func (p *Progress) MarkAsError() error {
 
 	return nil
 }
+
+// Cancel marks the progress as stopped because its job was cancelled.
+func (p *Progress) Cancel() error {
+	if p.Status != StatusContinue {
+		return ErrCannotBeCancelled
+	}
+
+	p.Status = StatusCancelled
+
+	return nil
+}
+
+// IsFinished reports whether no further updates will follow.
+func (p *Progress) IsFinished() bool {
+	return p.Status != StatusContinue
+}
+
+// Aggregate combines the progress of a job split into count parts
+// into the encode stage of a chunked transcode.
+// Parts that have not reported yet are assumed to be as long as the average reported part.
+// Parts encoded at the same time add up their throughput.
+// The combined progress fails when a part fails, but never leaves the encode stage on its own:
+// the job joining the parts reports the following stages.
+func Aggregate(parts []*Progress, count int) *Progress {
+	agg := NewStagedProgress(ChunkedTranscodeStages...)
+	if len(parts) == 0 {
+		return agg
+	}
+
+	for _, p := range parts {
+		agg.TotalFrames += p.TotalFrames
+		agg.CurrentFrames += p.CurrentFrames
+
+		switch p.Status {
+		case StatusContinue:
+			agg.FPS += p.FPS
+			agg.Speed += p.Speed
+		case StatusError:
+			agg.Status = StatusError
+		case StatusCancelled:
+			if agg.Status != StatusError {
+				agg.Status = StatusCancelled
+			}
+		}
+	}
+
+	if len(parts) < count {
+		agg.TotalFrames = agg.TotalFrames * int64(count) / int64(len(parts))
+	}
+
+	if agg.TotalFrames > 0 {
+		agg.Stages[0].Percentage = min(int((agg.CurrentFrames*100)/agg.TotalFrames), 100)
+		agg.updatePercentage()
+	}
+	if agg.FPS > 0 {
+		agg.RemainingSeconds = float64(max(agg.TotalFrames-agg.CurrentFrames, 0)) / agg.FPS
+	}
+
+	return agg
+}
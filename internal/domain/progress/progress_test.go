@@ -0,0 +1,120 @@
+package progress_test
+
+import (
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/domain/progress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateCurrentFrames_CapsPercentage(t *testing.T) {
+	t.Parallel()
+
+	p, err := progress.NewProgress(200)
+	require.NoError(t, err)
+
+	require.NoError(t, p.UpdateCurrentFrames(50))
+	require.Equal(t, 25, p.Percentage)
+
+	require.NoError(t, p.UpdateCurrentFrames(250))
+	require.Equal(t, 100, p.Percentage)
+}
+
+func TestTranscodeStages_StagePerRendition(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []progress.Stage{
+		progress.StageProbe,
+		"encode_480p",
+		"encode_720p",
+		progress.StagePackage,
+		progress.StageStore,
+	}, progress.TranscodeStages("480p", "720p"))
+}
+
+func TestStagedProgress_WeighsStagesIntoOverallPercentage(t *testing.T) {
+	t.Parallel()
+
+	p := progress.NewStagedProgress(progress.TranscodeStages("480p", "720p")...)
+	require.Equal(t, progress.StageProbe, p.Stage)
+	require.Zero(t, p.Percentage)
+
+	require.NoError(t, p.StartStage(progress.RenditionStage("720p")))
+	require.Equal(t, 100, p.Stages[0].Percentage)
+	require.Equal(t, 100, p.Stages[1].Percentage)
+	require.Equal(t, 44, p.Percentage)
+
+	require.NoError(t, p.SetTotalFrames(100))
+	require.NoError(t, p.UpdateCurrentFrames(40))
+	require.Equal(t, 40, p.Stages[2].Percentage)
+	require.Equal(t, 60, p.Percentage)
+
+	require.NoError(t, p.Advance())
+	require.Equal(t, progress.StagePackage, p.Stage)
+	require.Equal(t, 83, p.Percentage)
+
+	require.NoError(t, p.StartStage(progress.StageStore))
+	require.NoError(t, p.UpdateStage(50))
+	require.Equal(t, 94, p.Percentage)
+
+	require.NoError(t, p.Advance())
+	require.Equal(t, progress.StatusEnd, p.Status)
+	require.Equal(t, 100, p.Percentage)
+}
+
+func TestStartStage_RejectsUnknownAndPassedStages(t *testing.T) {
+	t.Parallel()
+
+	p := progress.NewStagedProgress(progress.StageProbe, progress.StageEncode)
+	require.NoError(t, p.StartStage(progress.StageEncode))
+
+	require.ErrorIs(t, p.StartStage(progress.StageStore), progress.ErrStageUnknown)
+	require.ErrorIs(t, p.StartStage(progress.StageProbe), progress.ErrStageAlreadyPassed)
+}
+
+func TestUpdateThroughput_EstimatesRemainingTime(t *testing.T) {
+	t.Parallel()
+
+	p, err := progress.NewProgress(1000)
+	require.NoError(t, err)
+	require.NoError(t, p.UpdateCurrentFrames(400))
+
+	require.NoError(t, p.UpdateThroughput(120, 4.8))
+
+	require.Equal(t, 120.0, p.FPS)
+	require.Equal(t, 4.8, p.Speed)
+	require.Equal(t, 5.0, p.RemainingSeconds)
+
+	require.NoError(t, p.UpdateThroughput(0, 0))
+	require.Zero(t, p.RemainingSeconds)
+}
+
+func TestAdvance_EndsProgressWithoutStages(t *testing.T) {
+	t.Parallel()
+
+	p, err := progress.NewProgress(10)
+	require.NoError(t, err)
+
+	require.NoError(t, p.Advance())
+	require.Equal(t, progress.StatusEnd, p.Status)
+	require.ErrorIs(t, p.UpdateThroughput(1, 1), progress.ErrCannotBeUpdated)
+}
+
+func TestAggregate_AddsUpThroughputOfRunningParts(t *testing.T) {
+	t.Parallel()
+
+	done := &progress.Progress{TotalFrames: 100, CurrentFrames: 100, Status: progress.StatusEnd, FPS: 80}
+	running := &progress.Progress{TotalFrames: 100, CurrentFrames: 50, Status: progress.StatusContinue, FPS: 25, Speed: 1}
+	other := &progress.Progress{TotalFrames: 100, CurrentFrames: 0, Status: progress.StatusContinue, FPS: 25, Speed: 1}
+
+	agg := progress.Aggregate([]*progress.Progress{done, running, other}, 4)
+
+	require.Equal(t, 50.0, agg.FPS)
+	require.Equal(t, 2.0, agg.Speed)
+	require.Equal(t, 5.0, agg.RemainingSeconds)
+
+	// The parts make up the encode stage of the chunked transcode
+	require.Equal(t, progress.StageEncode, agg.Stage)
+	require.Equal(t, 37, agg.Stages[0].Percentage)
+	require.Equal(t, 30, agg.Percentage)
+}
@@ -3,7 +3,53 @@ package progress
 type ProgressStatus string
 
 const (
-	StatusContinue ProgressStatus = "continue"
-	StatusEnd      ProgressStatus = "end"
-	StatusError    ProgressStatus = "error"
+	StatusContinue  ProgressStatus = "continue"
+	StatusEnd       ProgressStatus = "end"
+	StatusError     ProgressStatus = "error"
+	StatusCancelled ProgressStatus = "cancelled"
 )
+
+// Stage is a step of the processing of a video
+type Stage string
+
+const (
+	StageProbe   Stage = "probe"   // Counting the frames of the source
+	StageEncode  Stage = "encode"  // Encoding the chunks into every rendition, across workers
+	StagePackage Stage = "package" // Packaging the renditions and the audio into the DASH stream
+	StageStore   Stage = "store"   // Copying the output to storage
+)
+
+// renditionStagePrefix starts the name of the stages encoding a single rendition
+const renditionStagePrefix = "encode_"
+
+// RenditionStage is the stage encoding the named rendition, such as "encode_720p"
+func RenditionStage(rendition string) Stage {
+	return Stage(renditionStagePrefix + rendition)
+}
+
+// TranscodeStages are the stages of a transcode job encoding the renditions one after another, in order
+func TranscodeStages(renditions ...string) []Stage {
+	stages := []Stage{StageProbe}
+	for _, r := range renditions {
+		stages = append(stages, RenditionStage(r))
+	}
+
+	return append(stages, StagePackage, StageStore)
+}
+
+// ChunkedTranscodeStages are the stages of a chunked transcode, in order.
+// The chunks are encoded by many workers at once, so their renditions share the encode stage.
+var ChunkedTranscodeStages = []Stage{StageEncode, StagePackage, StageStore}
+
+// stageWeights is the share of each stage in the overall percentage,
+// roughly the share of the processing time spent in it
+var stageWeights = map[Stage]int{
+	StageProbe:   5,
+	StageEncode:  75,
+	StagePackage: 5,
+	StageStore:   10,
+}
+
+// renditionWeight is the share of the stage of each rendition,
+// every rendition taking about as long to encode
+const renditionWeight = 35
@@ -0,0 +1,77 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronNext(t *testing.T) {
+	t.Parallel()
+
+	// A Wednesday
+	from := time.Date(2026, time.January, 14, 10, 30, 45, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, time.January, 14, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, time.January, 14, 10, 45, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2026, time.January, 15, 3, 0, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2026, time.January, 15, 10, 30, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2026, time.January, 14, 13, 0, 0, 0, time.UTC)},
+		{"0 0 * * 1,5", time.Date(2026, time.January, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// Either day field matches when both are restricted
+		{"0 0 20 * 5", time.Date(2026, time.January, 16, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := schedule.ParseCron(tt.expr)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, c.Next(from))
+		})
+	}
+}
+
+func TestCronNext_NeverFires(t *testing.T) {
+	t.Parallel()
+
+	c, err := schedule.ParseCron("0 0 30 2 *")
+	require.NoError(t, err)
+
+	require.True(t, c.Next(time.Now()).IsZero())
+}
+
+func TestParseCron_FailsOnInvalidExpression(t *testing.T) {
+	t.Parallel()
+
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 7",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := schedule.ParseCron(expr)
+
+			require.Nil(t, c)
+			require.ErrorIs(t, err, schedule.ErrCronInvalid)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package schedule
+
+import "errors"
+
+var (
+	ErrTaskIDEmpty            = errors.New("task id cannot be empty")
+	ErrTaskKindEmpty          = errors.New("task kind cannot be empty")
+	ErrTaskKindInvalid        = errors.New("task kind is invalid")
+	ErrRunAtEmpty             = errors.New("task run time cannot be empty")
+	ErrCronInvalid            = errors.New("cron expression is invalid")
+	ErrCronNeverFires         = errors.New("cron expression never fires")
+	ErrTaskNotDue             = errors.New("task is not due yet")
+	ErrCannotBeFired          = errors.New("task cannot be fired")
+	ErrCannotBeRenewed        = errors.New("task lease cannot be renewed")
+	ErrCannotBeCompleted      = errors.New("task cannot be completed")
+	ErrCannotBeMarkedAsFailed = errors.New("task cannot be marked as failed")
+	ErrCannotBeCancelled      = errors.New("task cannot be cancelled")
+)
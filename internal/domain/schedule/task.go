@@ -0,0 +1,210 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+)
+
+// Task is work scheduled to run at a given time, once or on a cron schedule.
+// A task is claimed by a single scheduler instance when it fires.
+type Task struct {
+	ID        string
+	Kind      TaskKind
+	Payload   string
+	Cron      string
+	RunAt     time.Time
+	Status    TaskStatus
+	LastRunAt *time.Time
+	// LockedUntil is the end of the lease of a running task,
+	// another instance fires the run again once it expires
+	LockedUntil *time.Time
+	LastError   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewTask creates a task that runs once at runAt
+func NewTask(id string, kind TaskKind, payload string, runAt time.Time) (*Task, error) {
+	if id == "" {
+		return nil, ErrTaskIDEmpty
+	}
+
+	if kind == "" {
+		return nil, ErrTaskKindEmpty
+	}
+
+	if !kind.IsValid() {
+		return nil, ErrTaskKindInvalid
+	}
+
+	if runAt.IsZero() {
+		return nil, ErrRunAtEmpty
+	}
+
+	return &Task{
+		ID:        id,
+		Kind:      kind,
+		Payload:   payload,
+		RunAt:     runAt.UTC(),
+		Status:    StatusScheduled,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// NewRecurringTask creates a task that runs every time the cron expression matches
+func NewRecurringTask(id string, kind TaskKind, payload, cron string, now time.Time) (*Task, error) {
+	runAt, err := nextRun(cron, now)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := NewTask(id, kind, payload, runAt)
+	if err != nil {
+		return nil, err
+	}
+	t.Cron = cron
+
+	return t, nil
+}
+
+// Lifecycle management
+
+// Fire marks a due task as running until the lease expires. A recurring task is rescheduled right away,
+// runs missed while no scheduler was up are collapsed into this one.
+// A running task whose lease expired was interrupted, it fires again for the same run.
+func (t *Task) Fire(now time.Time, lease time.Duration) error {
+	switch {
+	case t.IsScheduled():
+		if !t.IsDue(now) {
+			return ErrTaskNotDue
+		}
+
+		if t.IsRecurring() {
+			runAt, err := nextRun(t.Cron, now)
+			if err != nil {
+				return err
+			}
+			t.RunAt = runAt
+		}
+	case t.IsRunning():
+		if !t.IsLeaseExpired(now) {
+			return ErrCannotBeFired
+		}
+	default:
+		return ErrCannotBeFired
+	}
+
+	// The start of the run tells it apart from later runs, kept to the microsecond as Postgres does
+	now = now.UTC().Truncate(time.Microsecond)
+	lockedUntil := now.Add(lease)
+	t.Status = StatusRunning
+	t.LastRunAt = &now
+	t.LockedUntil = &lockedUntil
+	t.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// Renew extends the lease of a running task, so a long run is not presumed gone
+func (t *Task) Renew(now time.Time, lease time.Duration) error {
+	if !t.IsRunning() {
+		return ErrCannotBeRenewed
+	}
+
+	lockedUntil := now.UTC().Add(lease)
+	t.LockedUntil = &lockedUntil
+	t.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// Complete finishes a run. A recurring task waits for its next run.
+func (t *Task) Complete() error {
+	if !t.IsRunning() {
+		return ErrCannotBeCompleted
+	}
+
+	t.Status = t.statusAfterRun(StatusCompleted)
+	t.LockedUntil = nil
+	t.LastError = ""
+	t.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// Fail finishes a run with an error. A recurring task waits for its next run.
+func (t *Task) Fail(errMsg string) error {
+	if !t.IsRunning() {
+		return ErrCannotBeMarkedAsFailed
+	}
+
+	t.Status = t.statusAfterRun(StatusFailed)
+	t.LockedUntil = nil
+	t.LastError = errMsg
+	t.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// Cancel stops a task from firing again
+func (t *Task) Cancel() error {
+	if !t.IsScheduled() {
+		return ErrCannotBeCancelled
+	}
+
+	t.Status = StatusCancelled
+	t.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+func (t *Task) statusAfterRun(status TaskStatus) TaskStatus {
+	if t.IsRecurring() {
+		return StatusScheduled
+	}
+
+	return status
+}
+
+// Status access
+func (t *Task) IsRecurring() bool {
+	return t.Cron != ""
+}
+
+func (t *Task) IsDue(now time.Time) bool {
+	return !t.RunAt.After(now)
+}
+
+// IsLeaseExpired reports whether the instance running the task stopped renewing its lease
+func (t *Task) IsLeaseExpired(now time.Time) bool {
+	return t.LockedUntil != nil && !t.LockedUntil.After(now)
+}
+
+// HoldsRun reports whether the task is still running the run started at lastRunAt,
+// a run claimed again once its lease expired is another run
+func (t *Task) HoldsRun(lastRunAt *time.Time) bool {
+	return t.IsRunning() && t.LastRunAt != nil && lastRunAt != nil && t.LastRunAt.Equal(*lastRunAt)
+}
+
+func (t *Task) IsScheduled() bool {
+	return t.Status == StatusScheduled
+}
+
+func (t *Task) IsRunning() bool {
+	return t.Status == StatusRunning
+}
+
+func nextRun(cron string, now time.Time) (time.Time, error) {
+	c, err := ParseCron(cron)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := c.Next(now)
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("%q: %w", cron, ErrCronNeverFires)
+	}
+
+	return next, nil
+}
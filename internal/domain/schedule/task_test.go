@@ -0,0 +1,185 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/schedule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTask_SuccessCase(t *testing.T) {
+	t.Parallel()
+	runAt := time.Now().Add(time.Hour)
+
+	task, err := schedule.NewTask("task-1", "video.publish", "video-1", runAt)
+
+	require.NoError(t, err)
+	require.Equal(t, schedule.StatusScheduled, task.Status)
+	require.Equal(t, runAt.UTC(), task.RunAt)
+	require.False(t, task.IsRecurring())
+}
+
+func TestNewTask_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := schedule.NewTask("", "video.publish", "", time.Now())
+	require.ErrorIs(t, err, schedule.ErrTaskIDEmpty)
+
+	_, err = schedule.NewTask("task-1", "", "", time.Now())
+	require.ErrorIs(t, err, schedule.ErrTaskKindEmpty)
+
+	_, err = schedule.NewTask("task-1", "cold.refresh", "", time.Now())
+	require.ErrorIs(t, err, schedule.ErrTaskKindInvalid)
+
+	_, err = schedule.NewTask("task-1", "video.publish", "", time.Time{})
+	require.ErrorIs(t, err, schedule.ErrRunAtEmpty)
+}
+
+func TestNewRecurringTask_SchedulesFirstRun(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, time.January, 14, 10, 30, 0, 0, time.UTC)
+
+	task, err := schedule.NewRecurringTask("task-1", "gc", "", "0 3 * * *", now)
+
+	require.NoError(t, err)
+	require.True(t, task.IsRecurring())
+	require.Equal(t, time.Date(2026, time.January, 15, 3, 0, 0, 0, time.UTC), task.RunAt)
+}
+
+func TestNewRecurringTask_FailsOnInvalidCron(t *testing.T) {
+	t.Parallel()
+
+	_, err := schedule.NewRecurringTask("task-1", "gc", "", "every night", time.Now())
+	require.ErrorIs(t, err, schedule.ErrCronInvalid)
+
+	_, err = schedule.NewRecurringTask("task-1", "gc", "", "0 0 31 4 *", time.Now())
+	require.ErrorIs(t, err, schedule.ErrCronNeverFires)
+}
+
+func TestTaskFire_OneOff(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	task, err := schedule.NewTask("task-1", "video.publish", "video-1", now.Add(-time.Minute))
+	require.NoError(t, err)
+
+	require.NoError(t, task.Fire(now, time.Minute))
+	require.Equal(t, schedule.StatusRunning, task.Status)
+	require.NotNil(t, task.LastRunAt)
+
+	// A running task cannot fire twice
+	require.ErrorIs(t, task.Fire(now, time.Minute), schedule.ErrCannotBeFired)
+
+	require.NoError(t, task.Complete())
+	require.Equal(t, schedule.StatusCompleted, task.Status)
+	require.Nil(t, task.LockedUntil)
+}
+
+func TestTaskFire_ReclaimsExpiredLease(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2026, time.January, 14, 10, 30, 0, 0, time.UTC)
+	task, err := schedule.NewRecurringTask("task-1", "gc", "", "0 3 * * *", created)
+	require.NoError(t, err)
+
+	now := time.Date(2026, time.January, 15, 3, 0, 0, 0, time.UTC)
+	require.NoError(t, task.Fire(now, time.Minute))
+	require.Equal(t, now.Add(time.Minute), *task.LockedUntil)
+
+	// The instance running the task is still within its lease
+	require.ErrorIs(t, task.Fire(now.Add(30*time.Second), time.Minute), schedule.ErrCannotBeFired)
+
+	// The instance stopped, the same run fires again without skipping the next one
+	later := now.Add(2 * time.Minute)
+	require.NoError(t, task.Fire(later, time.Minute))
+	require.Equal(t, schedule.StatusRunning, task.Status)
+	require.Equal(t, time.Date(2026, time.January, 16, 3, 0, 0, 0, time.UTC), task.RunAt)
+	require.Equal(t, later.Add(time.Minute), *task.LockedUntil)
+}
+
+func TestTaskRenew_ExtendsLease(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, time.January, 15, 3, 0, 0, 0, time.UTC)
+	task, err := schedule.NewTask("task-1", "video.publish", "video-1", now)
+	require.NoError(t, err)
+
+	// Only a running task holds a lease
+	require.ErrorIs(t, task.Renew(now, time.Minute), schedule.ErrCannotBeRenewed)
+
+	require.NoError(t, task.Fire(now, time.Minute))
+	require.NoError(t, task.Renew(now.Add(50*time.Second), time.Minute))
+	require.Equal(t, now.Add(110*time.Second), *task.LockedUntil)
+	require.False(t, task.IsLeaseExpired(now.Add(time.Minute)))
+}
+
+func TestTaskHoldsRun_TellsRunsApart(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, time.January, 15, 3, 0, 0, 123456789, time.UTC)
+	task, err := schedule.NewTask("task-1", "video.publish", "video-1", now)
+	require.NoError(t, err)
+
+	require.NoError(t, task.Fire(now, time.Minute))
+	firstRun := *task.LastRunAt
+	// The start of the run survives a round trip to Postgres
+	require.Equal(t, now.Truncate(time.Microsecond), firstRun)
+	require.True(t, task.HoldsRun(&firstRun))
+
+	// The lease expired and another instance claimed the task
+	require.NoError(t, task.Fire(now.Add(2*time.Minute), time.Minute))
+	require.False(t, task.HoldsRun(&firstRun))
+	require.True(t, task.HoldsRun(task.LastRunAt))
+
+	require.NoError(t, task.Complete())
+	require.False(t, task.HoldsRun(task.LastRunAt))
+}
+
+func TestTaskFire_FailsIfNotDue(t *testing.T) {
+	t.Parallel()
+	task, err := schedule.NewTask("task-1", "video.publish", "video-1", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	require.ErrorIs(t, task.Fire(time.Now(), time.Minute), schedule.ErrTaskNotDue)
+	require.Equal(t, schedule.StatusScheduled, task.Status)
+}
+
+func TestTaskFire_RecurringIsRescheduled(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2026, time.January, 14, 10, 30, 0, 0, time.UTC)
+	task, err := schedule.NewRecurringTask("task-1", "gc", "", "0 3 * * *", created)
+	require.NoError(t, err)
+
+	// The scheduler was down for two days, the missed runs collapse into one
+	now := time.Date(2026, time.January, 17, 8, 0, 0, 0, time.UTC)
+	require.NoError(t, task.Fire(now, time.Minute))
+	require.Equal(t, schedule.StatusRunning, task.Status)
+	require.Equal(t, time.Date(2026, time.January, 18, 3, 0, 0, 0, time.UTC), task.RunAt)
+
+	require.NoError(t, task.Fail("disk busy"))
+	require.Equal(t, schedule.StatusScheduled, task.Status)
+	require.Equal(t, "disk busy", task.LastError)
+}
+
+func TestTaskFail_OneOff(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	task, err := schedule.NewTask("task-1", "video.publish", "video-1", now)
+	require.NoError(t, err)
+	require.ErrorIs(t, task.Fail("boom"), schedule.ErrCannotBeMarkedAsFailed)
+
+	require.NoError(t, task.Fire(now, time.Minute))
+	require.NoError(t, task.Fail("boom"))
+
+	require.Equal(t, schedule.StatusFailed, task.Status)
+	require.Equal(t, "boom", task.LastError)
+}
+
+func TestTaskCancel(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	task, err := schedule.NewTask("task-1", "video.publish", "video-1", now)
+	require.NoError(t, err)
+
+	require.NoError(t, task.Cancel())
+	require.Equal(t, schedule.StatusCancelled, task.Status)
+	require.ErrorIs(t, task.Fire(now, time.Minute), schedule.ErrCannotBeFired)
+	require.ErrorIs(t, task.Cancel(), schedule.ErrCannotBeCancelled)
+}
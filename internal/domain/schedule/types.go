@@ -0,0 +1,29 @@
+package schedule
+
+type TaskStatus string
+
+const (
+	StatusScheduled TaskStatus = "scheduled"
+	StatusRunning   TaskStatus = "running"
+	StatusCompleted TaskStatus = "completed"
+	StatusFailed    TaskStatus = "failed"
+	StatusCancelled TaskStatus = "cancelled"
+)
+
+// TaskKind selects the handler that runs a task
+type TaskKind string
+
+// Every kind has a handler registered with the task scheduler of the workers
+const (
+	KindPublishVideo   TaskKind = "video.publish" // Publishes the video of the payload held until its publish time
+	KindCollectGarbage TaskKind = "gc"            // Deletes the records kept past their retention
+)
+
+func (k TaskKind) IsValid() bool {
+	switch k {
+	case KindPublishVideo, KindCollectGarbage:
+		return true
+	default:
+		return false
+	}
+}
@@ -6,13 +6,14 @@ import (
 )
 
 type User struct {
-	ID           string
-	Email        string
-	Username     string
-	PasswordHash string
-	Permissions  []string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID              string
+	Email           string
+	Username        string
+	PasswordHash    string
+	Permissions     []string
+	EmailVerifiedAt *time.Time // Nil until the user proves owning the email
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 func NewUser(id, email, username, passwordHash string) (*User, error) {
@@ -51,6 +52,32 @@ func (u *User) UpdateUsername(username string) *User {
 	return u
 }
 
+// UpdatePasswordHash replaces the password, the hash is of the new password
+func (u *User) UpdatePasswordHash(passwordHash string) error {
+	if passwordHash == "" {
+		return ErrPwdHashEmpty
+	}
+
+	u.PasswordHash = passwordHash
+	u.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// VerifyEmail records that the user owns the email, verifying again keeps the first time
+func (u *User) VerifyEmail(now time.Time) {
+	if u.EmailVerifiedAt != nil {
+		return
+	}
+
+	u.EmailVerifiedAt = &now
+	u.UpdatedAt = now
+}
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
 func (u *User) Can(permission string) bool {
 	return slices.Contains(u.Permissions, permission)
 }
@@ -2,6 +2,7 @@ package user_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/st-ember/streaming-api/internal/domain/user"
 	"github.com/stretchr/testify/require"
@@ -40,6 +41,27 @@ func TestUser(t *testing.T) {
 		require.True(t, u.UpdatedAt.After(oldUpdateAt) || u.UpdatedAt.Equal(oldUpdateAt))
 	})
 
+	t.Run("UpdatePasswordHash should replace the hash", func(t *testing.T) {
+		u, _ := user.NewUser("user-1", "test@test.com", "tester", "hashed-pwd")
+
+		require.NoError(t, u.UpdatePasswordHash("new-hash"))
+		require.Equal(t, "new-hash", u.PasswordHash)
+		require.ErrorIs(t, u.UpdatePasswordHash(""), user.ErrPwdHashEmpty)
+		require.Equal(t, "new-hash", u.PasswordHash)
+	})
+
+	t.Run("VerifyEmail should keep the first verification", func(t *testing.T) {
+		u, _ := user.NewUser("user-1", "test@test.com", "tester", "hashed-pwd")
+		require.False(t, u.IsEmailVerified())
+
+		first := time.Now()
+		u.VerifyEmail(first)
+		u.VerifyEmail(first.Add(time.Hour))
+
+		require.True(t, u.IsEmailVerified())
+		require.Equal(t, first, *u.EmailVerifiedAt)
+	})
+
 	t.Run("Should correctly identify permissions", func(t *testing.T) {
 		u := &user.User{
 			Permissions: []string{"video:upload", "video:delete"},
@@ -5,6 +5,7 @@ type VideoStatus string
 const (
 	StatusPending    VideoStatus = "pending"
 	StatusProcessing VideoStatus = "processing"
+	StatusReady      VideoStatus = "ready" // Processed, held until its publish time
 	StatusPublished  VideoStatus = "published"
 	StatusFailed     VideoStatus = "failed"
 	StatusArchived   VideoStatus = "archived"
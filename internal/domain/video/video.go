@@ -3,22 +3,29 @@ package video
 import "time"
 
 type Video struct {
-	ID          string
-	Title       string
-	Description string
-	Duration    time.Duration
-	Filename    string
-	ResourceID  string
-	Status      VideoStatus
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-}
-
-func NewVideo(id, title, description, filename, resourceID string) (*Video, error) {
+	ID            string
+	OwnerID       string
+	Title         string
+	Description   string
+	Duration      time.Duration
+	Filename      string
+	ResourceID    string
+	Status        VideoStatus
+	PublishAt     *time.Time // The publication is held until then when set
+	ProgressJobID string     // The job of the pipeline reporting the progress and result of the processing
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewVideo(id, ownerID, title, description, filename, resourceID string) (*Video, error) {
 	if id == "" {
 		return nil, ErrVideoIDEmpty
 	}
 
+	if ownerID == "" {
+		return nil, ErrOwnerIDEmpty
+	}
+
 	if filename == "" {
 		return nil, ErrFilenameEmpty
 	}
@@ -29,6 +36,7 @@ func NewVideo(id, title, description, filename, resourceID string) (*Video, erro
 
 	return &Video{
 		ID:          id,
+		OwnerID:     ownerID,
 		Title:       title,
 		Description: description,
 		Filename:    filename,
@@ -62,12 +70,33 @@ func (v *Video) MarkAsFailed() error {
 	return nil
 }
 
-func (v *Video) Publish() error {
-	if !v.IsProcessing() {
+// Publish makes a processed video available, or holds it as ready until its publish time
+func (v *Video) Publish(now time.Time) error {
+	if !v.IsProcessing() && !v.IsReady() {
 		return ErrCannotBePublished
 	}
 
 	v.Status = StatusPublished
+	if v.PublishAt != nil && v.PublishAt.After(now) {
+		v.Status = StatusReady
+	}
+	v.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// SchedulePublish holds the publication of a video not yet published until at
+func (v *Video) SchedulePublish(at time.Time) error {
+	if at.IsZero() {
+		return ErrPublishAtEmpty
+	}
+
+	if v.IsPublished() || v.IsArchived() {
+		return ErrCannotBeScheduled
+	}
+
+	at = at.UTC()
+	v.PublishAt = &at
 	v.UpdatedAt = time.Now().UTC()
 
 	return nil
@@ -122,6 +151,23 @@ func (v *Video) UpdateDuration(duration time.Duration) error {
 	return nil
 }
 
+// TrackProgress names the job of the pipeline reporting the progress of the video
+func (v *Video) TrackProgress(jobID string) error {
+	if jobID == "" {
+		return ErrProgressJobIDEmpty
+	}
+
+	v.ProgressJobID = jobID
+	v.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// Ownership
+func (v *Video) IsOwnedBy(userID string) bool {
+	return userID != "" && v.OwnerID == userID
+}
+
 // Status access
 func (v *Video) IsPending() bool {
 	return v.Status == StatusPending
@@ -131,6 +177,10 @@ func (v *Video) IsProcessing() bool {
 	return v.Status == StatusProcessing
 }
 
+func (v *Video) IsReady() bool {
+	return v.Status == StatusReady
+}
+
 func (v *Video) IsPublished() bool {
 	return v.Status == StatusPublished
 }
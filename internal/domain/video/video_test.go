@@ -12,6 +12,7 @@ import (
 type videoTestHelper struct {
 	*require.Assertions
 	mockID          string
+	mockOwnerID     string
 	mockTitle       string
 	mockDescription string
 	mockFilename    string
@@ -23,6 +24,7 @@ func setupVideoTestHelper(t *testing.T) *videoTestHelper {
 	return &videoTestHelper{
 		Assertions:      require.New(t),
 		mockID:          "mock_id",
+		mockOwnerID:     "mock_owner_id",
 		mockTitle:       "mock_title",
 		mockDescription: "mock_description",
 		mockFilename:    "mock_filename",
@@ -35,11 +37,12 @@ func TestNewVideo_SuccessCase(t *testing.T) {
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 
 	h.NoError(err)
 	h.NotNil(v)
 	h.Equal(v.ID, h.mockID)
+	h.Equal(v.OwnerID, h.mockOwnerID)
 	h.Equal(v.Title, h.mockTitle)
 	h.Equal(v.Description, h.mockDescription)
 	h.Equal(v.ResourceID, h.mockResourceID)
@@ -51,18 +54,29 @@ func TestNewVideo_EmptyID(t *testing.T) {
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo("", h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo("", h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 
 	h.Nil(v)
 	h.ErrorIs(err, video.ErrVideoIDEmpty)
 }
 
+func TestNewVideo_EmptyOwnerID(t *testing.T) {
+	t.Parallel()
+
+	h := setupVideoTestHelper(t)
+
+	v, err := video.NewVideo(h.mockID, "", h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+
+	h.Nil(v)
+	h.ErrorIs(err, video.ErrOwnerIDEmpty)
+}
+
 func TestNewVideo_EmptyFilename(t *testing.T) {
 	t.Parallel()
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, "", h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, "", h.mockResourceID)
 
 	h.Nil(v)
 	h.ErrorIs(err, video.ErrFilenameEmpty)
@@ -73,18 +87,31 @@ func TestNewVideo_EmptyResourceID(t *testing.T) {
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, "")
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, "")
 
 	h.Nil(v)
 	h.ErrorIs(err, video.ErrResourceIDEmpty)
 }
 
+func TestIsOwnedBy(t *testing.T) {
+	t.Parallel()
+
+	h := setupVideoTestHelper(t)
+
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	h.NoError(err)
+
+	h.True(v.IsOwnedBy(h.mockOwnerID))
+	h.False(v.IsOwnedBy("someone_else"))
+	h.False(v.IsOwnedBy(""))
+}
+
 func TestMarkAsProcessing_SuccessCaseFromPending(t *testing.T) {
 	t.Parallel()
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	h.NoError(err)
 
 	err = v.MarkAsProcessing()
@@ -97,7 +124,7 @@ func TestMarkAsProcessing_SuccessCaseFromFailed(t *testing.T) {
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	h.NoError(err)
 	v.Status = video.StatusFailed // Manually set state for test
 
@@ -111,7 +138,7 @@ func TestMarkAsProcessing_CannotProcessIfPublished(t *testing.T) {
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	h.NoError(err)
 	v.Status = video.StatusPublished
 
@@ -124,7 +151,7 @@ func TestMarkAsProcessing_CannotProcessIfArchived(t *testing.T) {
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	h.NoError(err)
 	v.Status = video.StatusArchived
 
@@ -137,7 +164,7 @@ func TestMarkAsFailed_SuccessCase(t *testing.T) {
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	h.NoError(err)
 	v.Status = video.StatusProcessing // Can only fail if processing
 
@@ -151,7 +178,7 @@ func TestMarkAsFailed_CannotFailIfNotProcessing(t *testing.T) {
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	h.NoError(err)
 	h.Equal(video.StatusPending, v.Status) // Starts as pending
 
@@ -164,11 +191,11 @@ func TestPublish_SuccessCase(t *testing.T) {
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	h.NoError(err)
 	v.Status = video.StatusProcessing // Can only publish if processing
 
-	err = v.Publish()
+	err = v.Publish(time.Now())
 	h.NoError(err)
 	h.Equal(video.StatusPublished, v.Status)
 }
@@ -178,20 +205,54 @@ func TestPublish_CannotPublishIfNotProcessing(t *testing.T) {
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	h.NoError(err)
 	h.Equal(video.StatusPending, v.Status) // Starts as pending
 
-	err = v.Publish()
+	err = v.Publish(time.Now())
 	h.ErrorIs(err, video.ErrCannotBePublished)
 }
 
+func TestPublish_HoldsVideoUntilPublishTime(t *testing.T) {
+	t.Parallel()
+
+	h := setupVideoTestHelper(t)
+
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	h.NoError(err)
+	now := time.Now()
+	h.NoError(v.SchedulePublish(now.Add(time.Hour)))
+	v.Status = video.StatusProcessing
+
+	// Processed before its publish time
+	h.NoError(v.Publish(now))
+	h.Equal(video.StatusReady, v.Status)
+
+	// Published once the time has come
+	h.NoError(v.Publish(now.Add(time.Hour)))
+	h.Equal(video.StatusPublished, v.Status)
+}
+
+func TestSchedulePublish_CannotScheduleIfPublished(t *testing.T) {
+	t.Parallel()
+
+	h := setupVideoTestHelper(t)
+
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	h.NoError(err)
+	h.ErrorIs(v.SchedulePublish(time.Time{}), video.ErrPublishAtEmpty)
+
+	v.Status = video.StatusPublished
+	h.ErrorIs(v.SchedulePublish(time.Now()), video.ErrCannotBeScheduled)
+	h.Nil(v.PublishAt)
+}
+
 func TestArchive_SuccessCase(t *testing.T) {
 	t.Parallel()
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	h.NoError(err)
 	v.Status = video.StatusPublished // Can only archive if published
 
@@ -205,7 +266,7 @@ func TestArchive_CannotArchiveIfNotPublished(t *testing.T) {
 
 	h := setupVideoTestHelper(t)
 
-	v, err := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, err := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	h.NoError(err)
 	h.Equal(video.StatusPending, v.Status) // Starts as pending
 
@@ -217,7 +278,7 @@ func TestUpdateTitle_SuccessCase(t *testing.T) {
 	t.Parallel()
 
 	h := setupVideoTestHelper(t)
-	v, _ := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, _ := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	updatedAt := v.UpdatedAt
 
 	newTitle := "new_title"
@@ -233,7 +294,7 @@ func TestUpdateTitle_FailsOnEmptyTitle(t *testing.T) {
 	t.Parallel()
 
 	h := setupVideoTestHelper(t)
-	v, _ := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, _ := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 
 	err := v.UpdateTitle("")
 	h.ErrorIs(err, video.ErrTitleEmpty)
@@ -243,7 +304,7 @@ func TestUpdateDescription_SuccessCase(t *testing.T) {
 	t.Parallel()
 
 	h := setupVideoTestHelper(t)
-	v, _ := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, _ := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	updatedAt := v.UpdatedAt
 
 	newDescription := "new_description"
@@ -259,7 +320,7 @@ func TestUpdateDescription_FailsOnEmptyDescription(t *testing.T) {
 	t.Parallel()
 
 	h := setupVideoTestHelper(t)
-	v, _ := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, _ := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 
 	err := v.UpdateDescription("")
 	h.ErrorIs(err, video.ErrDescriptionEmpty)
@@ -269,7 +330,7 @@ func TestUpdateDuration_SuccessCase(t *testing.T) {
 	t.Parallel()
 
 	h := setupVideoTestHelper(t)
-	v, _ := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, _ := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 
 	duration := 120 * time.Second
 	err := v.UpdateDuration(duration)
@@ -282,7 +343,7 @@ func TestUpdateDuration_FailsIfAlreadySet(t *testing.T) {
 	t.Parallel()
 
 	h := setupVideoTestHelper(t)
-	v, _ := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, _ := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 	v.Duration = 100 * time.Second // Pre-set duration
 
 	err := v.UpdateDuration(120 * time.Second)
@@ -293,8 +354,30 @@ func TestUpdateDuration_FailsOnNegativeDuration(t *testing.T) {
 	t.Parallel()
 
 	h := setupVideoTestHelper(t)
-	v, _ := video.NewVideo(h.mockID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+	v, _ := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
 
 	err := v.UpdateDuration(-10 * time.Second)
 	h.ErrorIs(err, video.ErrDurationNegative)
 }
+
+func TestTrackProgress_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	h := setupVideoTestHelper(t)
+	v, _ := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+
+	err := v.TrackProgress("job-id")
+
+	h.NoError(err)
+	h.Equal("job-id", v.ProgressJobID)
+}
+
+func TestTrackProgress_FailsOnEmptyJobID(t *testing.T) {
+	t.Parallel()
+
+	h := setupVideoTestHelper(t)
+	v, _ := video.NewVideo(h.mockID, h.mockOwnerID, h.mockTitle, h.mockDescription, h.mockFilename, h.mockResourceID)
+
+	err := v.TrackProgress("")
+	h.ErrorIs(err, video.ErrProgressJobIDEmpty)
+}
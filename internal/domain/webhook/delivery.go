@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/event"
+)
+
+const (
+	// MaxAttempts is the number of times a delivery is tried before giving up
+	MaxAttempts = 8
+	// Retries wait twice as long after every failed attempt, up to retryMaxDelay
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = 6 * time.Hour
+)
+
+// Delivery is the notification of an event to a subscription, retried until it succeeds
+type Delivery struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	EventType      event.EventType
+	Payload        string // Body sent to the subscription, the same on every attempt
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// envelope is the body of a delivery
+type envelope struct {
+	ID        string          `json:"id"`
+	Type      event.EventType `json:"type"`
+	VideoID   string          `json:"video_id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// NewDelivery creates the delivery of an event to a subscription, due right away
+func NewDelivery(id string, s *Subscription, e *event.Event) (*Delivery, error) {
+	if id == "" {
+		return nil, ErrDeliveryIDEmpty
+	}
+
+	if !s.Matches(e.Type) {
+		return nil, ErrEventNotSubscribed
+	}
+
+	payload, err := json.Marshal(envelope{
+		ID:        e.ID,
+		Type:      e.Type,
+		VideoID:   e.VideoID,
+		CreatedAt: e.CreatedAt,
+		Data:      json.RawMessage(e.Payload),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal delivery payload: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	return &Delivery{
+		ID:             id,
+		SubscriptionID: s.ID,
+		EventID:        e.ID,
+		EventType:      e.Type,
+		Payload:        string(payload),
+		Status:         DeliveryStatusPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// Lifecycle management
+
+// Claim starts an attempt. The delivery is due again once the lease expires,
+// so an attempt interrupted before its result is recorded is retried.
+func (d *Delivery) Claim(now time.Time, lease time.Duration) error {
+	if !d.IsPending() {
+		return ErrCannotBeClaimed
+	}
+
+	if now.Before(d.NextAttemptAt) {
+		return ErrDeliveryNotDue
+	}
+
+	d.Attempts++
+	d.NextAttemptAt = now.Add(lease).UTC()
+	d.UpdatedAt = now.UTC()
+
+	return nil
+}
+
+// Succeed records that the subscription accepted the delivery
+func (d *Delivery) Succeed(statusCode int, now time.Time) error {
+	if !d.IsPending() {
+		return ErrDeliveryNotClaimed
+	}
+
+	deliveredAt := now.UTC()
+	d.Status = DeliveryStatusSucceeded
+	d.LastStatusCode = statusCode
+	d.LastError = ""
+	d.DeliveredAt = &deliveredAt
+	d.UpdatedAt = deliveredAt
+
+	return nil
+}
+
+// Fail records a failed attempt and schedules the next one with exponential backoff,
+// the delivery fails for good after MaxAttempts
+func (d *Delivery) Fail(statusCode int, errMsg string, now time.Time) error {
+	if !d.IsPending() {
+		return ErrDeliveryNotClaimed
+	}
+
+	d.LastStatusCode = statusCode
+	d.LastError = errMsg
+	d.UpdatedAt = now.UTC()
+
+	if d.Attempts >= MaxAttempts {
+		d.Status = DeliveryStatusFailed
+		return nil
+	}
+
+	d.NextAttemptAt = now.Add(retryDelay(d.Attempts)).UTC()
+
+	return nil
+}
+
+// Replay sends the delivery again from scratch, whatever its outcome
+func (d *Delivery) Replay(now time.Time) {
+	d.Status = DeliveryStatusPending
+	d.Attempts = 0
+	d.NextAttemptAt = now.UTC()
+	d.LastStatusCode = 0
+	d.LastError = ""
+	d.DeliveredAt = nil
+	d.UpdatedAt = now.UTC()
+}
+
+// retryDelay is the wait after the specified number of failed attempts
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for range attempts - 1 {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+
+	return delay
+}
+
+// Status access
+func (d *Delivery) IsPending() bool {
+	return d.Status == DeliveryStatusPending
+}
+
+func (d *Delivery) IsSucceeded() bool {
+	return d.Status == DeliveryStatusSucceeded
+}
+
+func (d *Delivery) IsFailed() bool {
+	return d.Status == DeliveryStatusFailed
+}
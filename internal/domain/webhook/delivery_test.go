@@ -0,0 +1,133 @@
+package webhook_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/require"
+)
+
+func newDelivery(t *testing.T) *webhook.Delivery {
+	t.Helper()
+
+	s, err := webhook.NewSubscription("sub-1", "https://cms.example.com", "secret", nil)
+	require.NoError(t, err)
+	e, err := event.NewEvent("event-1", event.TypeVideoPublished, "video-1", map[string]string{"video_id": "video-1"})
+	require.NoError(t, err)
+	d, err := webhook.NewDelivery("delivery-1", s, e)
+	require.NoError(t, err)
+
+	return d
+}
+
+func TestNewDelivery_WrapsEventInEnvelope(t *testing.T) {
+	t.Parallel()
+
+	d := newDelivery(t)
+
+	require.True(t, d.IsPending())
+	require.Equal(t, "event-1", d.EventID)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal([]byte(d.Payload), &body))
+	require.Equal(t, "event-1", body["id"])
+	require.Equal(t, "video.published", body["type"])
+	require.Equal(t, map[string]any{"video_id": "video-1"}, body["data"])
+}
+
+func TestNewDelivery_FailsOnUnsubscribedEvent(t *testing.T) {
+	t.Parallel()
+
+	s, _ := webhook.NewSubscription("sub-1", "https://cms.example.com", "secret", []event.EventType{event.TypeVideoPublished})
+	e, _ := event.NewEvent("event-1", event.TypeVideoFailed, "video-1", nil)
+
+	_, err := webhook.NewDelivery("delivery-1", s, e)
+	require.ErrorIs(t, err, webhook.ErrEventNotSubscribed)
+}
+
+func TestDelivery_Claim(t *testing.T) {
+	t.Parallel()
+	d := newDelivery(t)
+	now := time.Now()
+
+	require.NoError(t, d.Claim(now, time.Minute))
+	require.Equal(t, 1, d.Attempts)
+
+	// Claimed again only once the lease expires
+	require.ErrorIs(t, d.Claim(now, time.Minute), webhook.ErrDeliveryNotDue)
+	require.NoError(t, d.Claim(now.Add(time.Minute), time.Minute))
+	require.Equal(t, 2, d.Attempts)
+}
+
+func TestDelivery_Succeed(t *testing.T) {
+	t.Parallel()
+	d := newDelivery(t)
+	now := time.Now()
+	require.NoError(t, d.Claim(now, time.Minute))
+
+	require.NoError(t, d.Succeed(204, now))
+	require.True(t, d.IsSucceeded())
+	require.Equal(t, 204, d.LastStatusCode)
+	require.NotNil(t, d.DeliveredAt)
+
+	require.ErrorIs(t, d.Claim(now.Add(time.Hour), time.Minute), webhook.ErrCannotBeClaimed)
+	require.ErrorIs(t, d.Succeed(200, now), webhook.ErrDeliveryNotClaimed)
+}
+
+func TestDelivery_FailBacksOffThenGivesUp(t *testing.T) {
+	t.Parallel()
+	d := newDelivery(t)
+	now := time.Now()
+
+	var delays []time.Duration
+	for range webhook.MaxAttempts - 1 {
+		require.NoError(t, d.Claim(d.NextAttemptAt, time.Minute))
+		claimedAt := d.NextAttemptAt.Add(-time.Minute)
+		require.NoError(t, d.Fail(500, "internal error", claimedAt))
+		require.True(t, d.IsPending())
+		delays = append(delays, d.NextAttemptAt.Sub(claimedAt))
+	}
+
+	// Every retry waits longer than the previous one
+	require.Equal(t, 30*time.Second, delays[0])
+	for i := 1; i < len(delays); i++ {
+		require.Greater(t, delays[i], delays[i-1])
+	}
+
+	// The last attempt fails the delivery for good
+	require.NoError(t, d.Claim(d.NextAttemptAt, time.Minute))
+	require.NoError(t, d.Fail(0, "connection refused", now))
+	require.True(t, d.IsFailed())
+	require.Equal(t, "connection refused", d.LastError)
+}
+
+func TestDelivery_Replay(t *testing.T) {
+	t.Parallel()
+	d := newDelivery(t)
+	now := time.Now()
+	require.NoError(t, d.Claim(now, time.Minute))
+	require.NoError(t, d.Succeed(200, now))
+
+	d.Replay(now)
+
+	require.True(t, d.IsPending())
+	require.Equal(t, 0, d.Attempts)
+	require.Nil(t, d.DeliveredAt)
+	require.NoError(t, d.Claim(now, time.Minute))
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	timestamp := time.Unix(1767225600, 0)
+	body := []byte(`{"id":"event-1"}`)
+
+	signature := webhook.Sign("secret", timestamp, body)
+
+	require.Regexp(t, "^sha256=[0-9a-f]{64}$", signature)
+	require.Equal(t, signature, webhook.Sign("secret", timestamp, body))
+	require.NotEqual(t, signature, webhook.Sign("other", timestamp, body))
+	require.NotEqual(t, signature, webhook.Sign("secret", timestamp.Add(time.Second), body))
+}
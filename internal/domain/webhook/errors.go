@@ -0,0 +1,16 @@
+package webhook
+
+import "errors"
+
+var (
+	ErrSubscriptionIDEmpty         = errors.New("subscription id cannot be empty")
+	ErrURLInvalid                  = errors.New("webhook url must be an absolute http or https url")
+	ErrSecretEmpty                 = errors.New("webhook secret cannot be empty")
+	ErrEventTypeInvalid            = errors.New("webhook event filter contains an invalid event type")
+	ErrDeliveryIDEmpty             = errors.New("delivery id cannot be empty")
+	ErrEventNotSubscribed          = errors.New("subscription does not match the event")
+	ErrDeliveryNotDue              = errors.New("delivery is not due yet")
+	ErrCannotBeClaimed             = errors.New("delivery cannot be claimed")
+	ErrDeliveryNotClaimed          = errors.New("delivery result can only be recorded for a pending delivery")
+	ErrDeliveryOfOtherSubscription = errors.New("delivery belongs to another subscription")
+)
@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/st-ember/streaming-api/internal/domain/event"
+)
+
+// Subscription is an endpoint notified of the events it filters on
+type Subscription struct {
+	ID         string
+	URL        string
+	Secret     string            // Key of the HMAC signature of the deliveries
+	EventTypes []event.EventType // Empty to receive every event
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewSubscription(id, rawURL, secret string, eventTypes []event.EventType) (*Subscription, error) {
+	if id == "" {
+		return nil, ErrSubscriptionIDEmpty
+	}
+
+	if err := validateURL(rawURL); err != nil {
+		return nil, err
+	}
+
+	if secret == "" {
+		return nil, ErrSecretEmpty
+	}
+
+	if err := validateEventTypes(eventTypes); err != nil {
+		return nil, err
+	}
+
+	return &Subscription{
+		ID:         id,
+		URL:        rawURL,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		Active:     true,
+		CreatedAt:  time.Now().UTC(),
+		UpdatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// Update fields
+func (s *Subscription) UpdateURL(rawURL string) error {
+	if err := validateURL(rawURL); err != nil {
+		return err
+	}
+
+	s.URL = rawURL
+	s.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+func (s *Subscription) UpdateEventTypes(eventTypes []event.EventType) error {
+	if err := validateEventTypes(eventTypes); err != nil {
+		return err
+	}
+
+	s.EventTypes = eventTypes
+	s.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// SetActive pauses or resumes the deliveries of new events
+func (s *Subscription) SetActive(active bool) {
+	s.Active = active
+	s.UpdatedAt = time.Now().UTC()
+}
+
+// Matches reports whether an event of the type is delivered to the subscription
+func (s *Subscription) Matches(eventType event.EventType) bool {
+	if !s.Active {
+		return false
+	}
+
+	return len(s.EventTypes) == 0 || slices.Contains(s.EventTypes, eventType)
+}
+
+func validateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrURLInvalid
+	}
+
+	return nil
+}
+
+func validateEventTypes(eventTypes []event.EventType) error {
+	for _, t := range eventTypes {
+		if !t.IsValid() {
+			return ErrEventTypeInvalid
+		}
+	}
+
+	return nil
+}
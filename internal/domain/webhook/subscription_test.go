@@ -0,0 +1,63 @@
+package webhook_test
+
+import (
+	"testing"
+
+	"github.com/st-ember/streaming-api/internal/domain/event"
+	"github.com/st-ember/streaming-api/internal/domain/webhook"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSubscription_SuccessCase(t *testing.T) {
+	t.Parallel()
+
+	s, err := webhook.NewSubscription("sub-1", "https://cms.example.com/hooks", "secret", []event.EventType{event.TypeVideoPublished})
+
+	require.NoError(t, err)
+	require.True(t, s.Active)
+	require.True(t, s.Matches(event.TypeVideoPublished))
+	require.False(t, s.Matches(event.TypeVideoFailed))
+}
+
+func TestNewSubscription_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := webhook.NewSubscription("", "https://cms.example.com", "secret", nil)
+	require.ErrorIs(t, err, webhook.ErrSubscriptionIDEmpty)
+
+	for _, rawURL := range []string{"", "cms.example.com/hooks", "ftp://cms.example.com", "https://"} {
+		_, err = webhook.NewSubscription("sub-1", rawURL, "secret", nil)
+		require.ErrorIs(t, err, webhook.ErrURLInvalid, rawURL)
+	}
+
+	_, err = webhook.NewSubscription("sub-1", "https://cms.example.com", "", nil)
+	require.ErrorIs(t, err, webhook.ErrSecretEmpty)
+
+	_, err = webhook.NewSubscription("sub-1", "https://cms.example.com", "secret", []event.EventType{"video.deleted"})
+	require.ErrorIs(t, err, webhook.ErrEventTypeInvalid)
+}
+
+func TestSubscription_Matches(t *testing.T) {
+	t.Parallel()
+
+	// No filter receives every event
+	s, _ := webhook.NewSubscription("sub-1", "https://cms.example.com", "secret", nil)
+	require.True(t, s.Matches(event.TypeJobFailed))
+
+	// Paused subscriptions receive nothing
+	s.SetActive(false)
+	require.False(t, s.Matches(event.TypeJobFailed))
+}
+
+func TestSubscription_Update(t *testing.T) {
+	t.Parallel()
+	s, _ := webhook.NewSubscription("sub-1", "https://cms.example.com", "secret", nil)
+
+	require.NoError(t, s.UpdateURL("http://cms.internal/hooks"))
+	require.Equal(t, "http://cms.internal/hooks", s.URL)
+	require.ErrorIs(t, s.UpdateURL("not a url"), webhook.ErrURLInvalid)
+
+	require.NoError(t, s.UpdateEventTypes([]event.EventType{event.TypeVideoFailed}))
+	require.Equal(t, []event.EventType{event.TypeVideoFailed}, s.EventTypes)
+	require.ErrorIs(t, s.UpdateEventTypes([]event.EventType{""}), webhook.ErrEventTypeInvalid)
+}
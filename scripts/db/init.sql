@@ -1,32 +1,151 @@
 CREATE TABLE IF NOT EXISTS videos (
     id TEXT PRIMARY KEY,
+    owner_id TEXT NOT NULL,
     title TEXT,
     description TEXT,
     duration BIGINT,
     filename TEXT,
     resource_id TEXT,
     status TEXT,
+    publish_at TIMESTAMPTZ,
+    progress_job_id TEXT,
     created_at TIMESTAMPTZ,
     updated_at TIMESTAMPTZ
 );
 
+-- Databases created before publications could be scheduled
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ;
+
+-- Databases created before videos had owners, those videos belong to no one
+-- and only admins can manage them
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS owner_id TEXT;
+UPDATE videos SET owner_id = '' WHERE owner_id IS NULL;
+ALTER TABLE videos ALTER COLUMN owner_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS videos_owner_idx ON videos (owner_id, created_at);
+
 CREATE TABLE IF NOT EXISTS jobs (
     id TEXT PRIMARY KEY,
     video_id TEXT,
     type TEXT,
+    step TEXT,
+    optional BOOLEAN NOT NULL DEFAULT FALSE,
+    payload TEXT,
     status TEXT,
     result TEXT,
     error_msg TEXT,
+    priority INT NOT NULL DEFAULT 0,
+    attempts INT NOT NULL DEFAULT 0,
+    worker_id TEXT,
+    started_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+-- Estimated cost of a job, stored the first time it is claimed so the source is probed once
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS cost_cpu DOUBLE PRECISION;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS cost_memory BIGINT;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS cost_work BIGINT; -- Nanoseconds
+
+-- A job only runs once every job it depends on has completed
+CREATE TABLE IF NOT EXISTS job_dependencies (
+    job_id TEXT REFERENCES jobs(id) ON DELETE CASCADE,
+    depends_on_id TEXT REFERENCES jobs(id) ON DELETE CASCADE,
+    PRIMARY KEY (job_id, depends_on_id)
+);
+
+-- Databases created before videos named the job reporting their progress,
+-- which was the latest job of the video
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS progress_job_id TEXT;
+UPDATE videos SET progress_job_id = (
+    SELECT j.id FROM jobs j WHERE j.video_id = videos.id ORDER BY j.created_at DESC LIMIT 1
+) WHERE progress_job_id IS NULL;
+
+CREATE TABLE IF NOT EXISTS job_attempts (
+    job_id TEXT REFERENCES jobs(id) ON DELETE CASCADE,
+    number INT,
+    worker_id TEXT NOT NULL,
+    status TEXT NOT NULL,
+    exit_code INT,
+    error_msg TEXT NOT NULL DEFAULT '',
+    stderr_tail TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    PRIMARY KEY (job_id, number)
+);
+
+-- Work scheduled for later, once (run_at) or recurring (cron)
+CREATE TABLE IF NOT EXISTS scheduled_tasks (
+    id TEXT PRIMARY KEY,
+    kind TEXT NOT NULL,
+    payload TEXT NOT NULL DEFAULT '',
+    cron TEXT NOT NULL DEFAULT '',
+    run_at TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL,
+    last_run_at TIMESTAMPTZ,
+    locked_until TIMESTAMPTZ,
+    last_error TEXT NOT NULL DEFAULT '',
     created_at TIMESTAMPTZ,
     updated_at TIMESTAMPTZ
 );
 
+-- Databases created before tasks were leased
+ALTER TABLE scheduled_tasks ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS scheduled_tasks_due_idx ON scheduled_tasks (run_at) WHERE status = 'scheduled';
+CREATE INDEX IF NOT EXISTS scheduled_tasks_lease_idx ON scheduled_tasks (locked_until) WHERE status = 'running';
+
+-- Domain events written in the transaction of the state change, published by the relay
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id TEXT PRIMARY KEY,
+    sequence BIGSERIAL UNIQUE,
+    type TEXT NOT NULL,
+    video_id TEXT NOT NULL,
+    payload TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_events_pending_idx ON outbox_events (video_id, sequence) WHERE published_at IS NULL;
+
+-- Webhook subscriptions and the log of their deliveries
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id TEXT PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    event_types TEXT NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id TEXT PRIMARY KEY,
+    subscription_id TEXT NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    status TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_status_code INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_subscription_idx ON webhook_deliveries (subscription_id, created_at);
+
 -- RBAC Tables
 CREATE TABLE IF NOT EXISTS users (
     id TEXT PRIMARY KEY,
     email TEXT UNIQUE NOT NULL,
     username TEXT UNIQUE NOT NULL,
     password_hash TEXT NOT NULL,
+    email_verified_at TIMESTAMPTZ,
     created_at TIMESTAMPTZ,
     updated_at TIMESTAMPTZ
 );
@@ -52,3 +171,75 @@ CREATE TABLE IF NOT EXISTS role_permissions (
     permission_id TEXT REFERENCES permissions(id) ON DELETE CASCADE,
     PRIMARY KEY (role_id, permission_id)
 );
+
+-- Default permissions granted to the default roles at startup, each is granted once so removing it sticks
+-- Databases created before seeds were recorded grant the default permissions once more on the next startup
+CREATE TABLE IF NOT EXISTS role_permission_seeds (
+    role_id TEXT REFERENCES roles(id) ON DELETE CASCADE,
+    permission_id TEXT REFERENCES permissions(id) ON DELETE CASCADE,
+    PRIMARY KEY (role_id, permission_id)
+);
+
+-- Chains of rotated refresh tokens, one per login
+CREATE TABLE IF NOT EXISTS refresh_families (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    current_token_id TEXT NOT NULL,
+    rotations INT NOT NULL DEFAULT 0,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+-- Keys of services calling the api on behalf of a user, only the hash of their secret is stored
+CREATE TABLE IF NOT EXISTS api_keys (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    prefix TEXT UNIQUE NOT NULL,
+    key_hash TEXT NOT NULL,
+    permissions TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys (user_id, created_at DESC);
+
+-- Sign ins started at the identity provider, until it redirects back
+CREATE TABLE IF NOT EXISTS oidc_logins (
+    state TEXT PRIMARY KEY,
+    nonce TEXT NOT NULL,
+    code_verifier TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+-- Users of identity providers, by the subject the provider knows them by
+CREATE TABLE IF NOT EXISTS user_identities (
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    PRIMARY KEY (issuer, subject)
+);
+
+-- Single use tokens mailed to users to verify their email or reset their password, by sha256 hash
+CREATE TABLE IF NOT EXISTS user_tokens (
+    hash TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_tokens_user_id ON user_tokens(user_id, purpose);
+
+-- Keys signing access tokens, the private keys are PKCS #8 DER encoded
+CREATE TABLE IF NOT EXISTS signing_keys (
+    id TEXT PRIMARY KEY,
+    algorithm TEXT NOT NULL,
+    private_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    active_from TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ
+);